@@ -0,0 +1,98 @@
+package playwright
+
+// AccessibilityNode is a node of the accessibility tree of a page, see [Accessibility.Snapshot]. Properties the node
+// doesn't have are nil or empty.
+type AccessibilityNode struct {
+	// Role of the node, such as `button` or `heading`.
+	Role string `json:"role"`
+	// Accessible name of the node.
+	Name string `json:"name"`
+	// Value of the node, for text fields and range widgets. See [AccessibilityNode.Value].
+	ValueString *string  `json:"valueString"`
+	ValueNumber *float64 `json:"valueNumber"`
+	// Accessible description of the node.
+	Description     string `json:"description"`
+	KeyShortcuts    string `json:"keyshortcuts"`
+	RoleDescription string `json:"roledescription"`
+	// Human readable value of a range widget.
+	ValueText       string `json:"valuetext"`
+	Disabled        *bool  `json:"disabled"`
+	Expanded        *bool  `json:"expanded"`
+	Focused         *bool  `json:"focused"`
+	Modal           *bool  `json:"modal"`
+	Multiline       *bool  `json:"multiline"`
+	Multiselectable *bool  `json:"multiselectable"`
+	Readonly        *bool  `json:"readonly"`
+	Required        *bool  `json:"required"`
+	Selected        *bool  `json:"selected"`
+	// Checked state of a checkbox or radio button: `checked`, `unchecked` or `mixed`.
+	Checked *string `json:"checked"`
+	// Pressed state of a toggle button: `pressed`, `released` or `mixed`.
+	Pressed *string `json:"pressed"`
+	// Level of a heading.
+	Level        *int     `json:"level"`
+	ValueMin     *float64 `json:"valuemin"`
+	ValueMax     *float64 `json:"valuemax"`
+	AutoComplete string   `json:"autocomplete"`
+	HasPopup     string   `json:"haspopup"`
+	// Whether and in what way the value of the node is invalid.
+	Invalid     string `json:"invalid"`
+	Orientation string `json:"orientation"`
+	// Child nodes of the node.
+	Children []*AccessibilityNode `json:"children"`
+}
+
+// Value returns the value of the node, a string or a float64, or nil if it has none.
+func (n *AccessibilityNode) Value() interface{} {
+	if n.ValueNumber != nil {
+		return *n.ValueNumber
+	}
+	if n.ValueString != nil {
+		return *n.ValueString
+	}
+	return nil
+}
+
+// Find returns the first node of the tree rooted at n, in depth-first order, for which match returns true, or nil.
+func (n *AccessibilityNode) Find(match func(*AccessibilityNode) bool) *AccessibilityNode {
+	if n == nil {
+		return nil
+	}
+	if match(n) {
+		return n
+	}
+	for _, child := range n.Children {
+		if found := child.Find(match); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+type accessibilityImpl struct {
+	channel *channel
+}
+
+func newAccessibility(channel *channel) *accessibilityImpl {
+	return &accessibilityImpl{channel: channel}
+}
+
+func (a *accessibilityImpl) Snapshot(options ...AccessibilitySnapshotOptions) (*AccessibilityNode, error) {
+	params := map[string]interface{}{}
+	if len(options) == 1 {
+		if options[0].InterestingOnly != nil {
+			params["interestingOnly"] = *options[0].InterestingOnly
+		}
+		if options[0].Root != nil {
+			params["root"] = options[0].Root.(*elementHandleImpl).channel
+		}
+	}
+	result, err := a.channel.Send("accessibilitySnapshot", params)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, nil
+	}
+	return decodeCDPValue[*AccessibilityNode](result)
+}
@@ -0,0 +1,37 @@
+package playwright
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessibilityNodeDecoding(t *testing.T) {
+	node, err := decodeCDPValue[*AccessibilityNode](map[string]interface{}{
+		"role": "WebArea",
+		"name": "Form",
+		"children": []interface{}{
+			map[string]interface{}{"role": "heading", "name": "Title", "level": 1},
+			map[string]interface{}{"role": "checkbox", "name": "Agree", "checked": "mixed"},
+			map[string]interface{}{"role": "slider", "name": "Volume", "valueNumber": 0.5, "valuemin": 0, "valuemax": 1},
+			map[string]interface{}{"role": "textbox", "name": "Email", "valueString": "a@b.c", "focused": true},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "WebArea", node.Role)
+	require.Len(t, node.Children, 4)
+	require.Equal(t, 1, *node.Children[0].Level)
+	require.Equal(t, "mixed", *node.Children[1].Checked)
+	require.Equal(t, 0.5, node.Children[2].Value())
+	require.Equal(t, "a@b.c", node.Children[3].Value())
+	require.Nil(t, node.Value())
+
+	textbox := node.Find(func(n *AccessibilityNode) bool {
+		return n.Role == "textbox"
+	})
+	require.NotNil(t, textbox)
+	require.True(t, *textbox.Focused)
+	require.Nil(t, node.Find(func(n *AccessibilityNode) bool {
+		return n.Role == "button"
+	}))
+}
@@ -10,20 +10,26 @@ import (
 type apiResponseAssertionsImpl struct {
 	actual APIResponse
 	isNot  bool
+	soft   *softErrors
 }
 
-func newAPIResponseAssertions(actual APIResponse, isNot bool) *apiResponseAssertionsImpl {
+func newAPIResponseAssertions(actual APIResponse, isNot bool, soft *softErrors) *apiResponseAssertionsImpl {
 	return &apiResponseAssertionsImpl{
 		actual: actual,
 		isNot:  isNot,
+		soft:   soft,
 	}
 }
 
 func (ar *apiResponseAssertionsImpl) Not() APIResponseAssertions {
-	return newAPIResponseAssertions(ar.actual, true)
+	return newAPIResponseAssertions(ar.actual, true, ar.soft)
 }
 
 func (ar *apiResponseAssertionsImpl) ToBeOK() error {
+	return ar.soft.record(ar.toBeOK())
+}
+
+func (ar *apiResponseAssertionsImpl) toBeOK() error {
 	if ar.isNot != ar.actual.Ok() {
 		return nil
 	}
@@ -0,0 +1,503 @@
+package playwright
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ariaSnapshotScript computes the accessibility tree of an element. Elements without a role
+// are flattened into their parent, text is merged and whitespace normalized.
+const ariaSnapshotScript = `root => {
+  const normalize = text => text.replace(/\s+/g, ' ').trim();
+  const nameFromContent = new Set(['button', 'cell', 'checkbox', 'columnheader', 'gridcell', 'heading', 'link', 'menuitem', 'menuitemcheckbox', 'menuitemradio', 'option', 'radio', 'row', 'rowheader', 'switch', 'tab', 'tooltip', 'treeitem']);
+  const skipTags = new Set(['SCRIPT', 'STYLE', 'NOSCRIPT', 'TEMPLATE', 'HEAD', 'META', 'LINK']);
+  const inputRoles = { button: 'button', submit: 'button', reset: 'button', image: 'button', checkbox: 'checkbox', radio: 'radio', range: 'slider', number: 'spinbutton', search: 'searchbox' };
+
+  const implicitRole = element => {
+    const tag = element.tagName.toLowerCase();
+    switch (tag) {
+      case 'a': case 'area': return element.hasAttribute('href') ? 'link' : null;
+      case 'article': return 'article';
+      case 'aside': return 'complementary';
+      case 'button': return 'button';
+      case 'dialog': return 'dialog';
+      case 'fieldset': return 'group';
+      case 'footer': return 'contentinfo';
+      case 'form': return 'form';
+      case 'h1': case 'h2': case 'h3': case 'h4': case 'h5': case 'h6': return 'heading';
+      case 'header': return 'banner';
+      case 'hr': return 'separator';
+      case 'img': return element.getAttribute('alt') === '' ? 'presentation' : 'img';
+      case 'input': {
+        const type = (element.getAttribute('type') || 'text').toLowerCase();
+        if (type === 'hidden')
+          return null;
+        return inputRoles[type] || 'textbox';
+      }
+      case 'li': return 'listitem';
+      case 'main': return 'main';
+      case 'nav': return 'navigation';
+      case 'ol': case 'ul': case 'menu': return 'list';
+      case 'option': return 'option';
+      case 'p': return 'paragraph';
+      case 'progress': return 'progressbar';
+      case 'section': return element.hasAttribute('aria-label') || element.hasAttribute('aria-labelledby') ? 'region' : null;
+      case 'select': return element.multiple || element.size > 1 ? 'listbox' : 'combobox';
+      case 'table': return 'table';
+      case 'tbody': case 'thead': case 'tfoot': return 'rowgroup';
+      case 'td': return 'cell';
+      case 'th': return 'columnheader';
+      case 'tr': return 'row';
+      case 'textarea': return 'textbox';
+    }
+    return null;
+  };
+  const roleOf = element => {
+    const explicit = (element.getAttribute('role') || '').trim().split(/\s+/)[0];
+    return explicit || implicitRole(element);
+  };
+  const isHidden = element => {
+    if (element.getAttribute('aria-hidden') === 'true')
+      return true;
+    const style = getComputedStyle(element);
+    return style.display === 'none' || style.visibility === 'hidden';
+  };
+  const textOf = element => normalize(typeof element.innerText === 'string' ? element.innerText : element.textContent || '');
+  const nameOf = (element, role) => {
+    const labelledBy = element.getAttribute('aria-labelledby');
+    if (labelledBy) {
+      const text = labelledBy.split(/\s+/).map(id => document.getElementById(id)).filter(Boolean).map(textOf).join(' ');
+      if (normalize(text))
+        return normalize(text);
+    }
+    const label = element.getAttribute('aria-label');
+    if (label && normalize(label))
+      return normalize(label);
+    const tag = element.tagName;
+    if (tag === 'INPUT' && ['button', 'submit', 'reset'].includes(element.type))
+      return normalize(element.value || (element.type === 'submit' ? 'Submit' : element.type === 'reset' ? 'Reset' : ''));
+    if (tag === 'IMG' || tag === 'AREA' || (tag === 'INPUT' && element.type === 'image'))
+      return normalize(element.getAttribute('alt') || '');
+    if ((tag === 'INPUT' || tag === 'TEXTAREA' || tag === 'SELECT') && element.labels && element.labels.length)
+      return normalize([...element.labels].map(textOf).join(' '));
+    if (tag === 'FIELDSET' && element.querySelector(':scope > legend'))
+      return textOf(element.querySelector(':scope > legend'));
+    if (tag === 'TABLE' && element.caption)
+      return textOf(element.caption);
+    if (nameFromContent.has(role))
+      return textOf(element);
+    return normalize(element.getAttribute('title') || element.getAttribute('placeholder') || '');
+  };
+  const propsOf = (element, role) => {
+    const props = {};
+    if (role === 'heading') {
+      const level = element.getAttribute('aria-level') || (/^H[1-6]$/.test(element.tagName) ? element.tagName[1] : '');
+      if (level)
+        props.level = level;
+    }
+    if (['checkbox', 'radio', 'switch', 'menuitemcheckbox', 'menuitemradio'].includes(role)) {
+      const checked = element.getAttribute('aria-checked');
+      if (checked === 'true' || checked === 'mixed')
+        props.checked = checked;
+      else if (checked === null && element.indeterminate)
+        props.checked = 'mixed';
+      else if (checked === null && element.checked)
+        props.checked = 'true';
+    }
+    if (element.disabled || element.getAttribute('aria-disabled') === 'true')
+      props.disabled = 'true';
+    if (element.getAttribute('aria-expanded') === 'true')
+      props.expanded = 'true';
+    const pressed = element.getAttribute('aria-pressed');
+    if (pressed === 'true' || pressed === 'mixed')
+      props.pressed = pressed;
+    if (element.getAttribute('aria-selected') === 'true' || (role === 'option' && element.selected))
+      props.selected = 'true';
+    return props;
+  };
+
+  const pushText = (out, text) => {
+    const last = out[out.length - 1];
+    if (last && last.role === 'text')
+      last.name += text;
+    else
+      out.push({ role: 'text', name: text, props: {}, children: [] });
+  };
+  const finish = nodes => nodes.filter(node => {
+    if (node.role !== 'text')
+      return true;
+    node.name = normalize(node.name);
+    return !!node.name;
+  });
+  const visit = (node, out) => {
+    if (node.nodeType === Node.TEXT_NODE) {
+      pushText(out, node.nodeValue || '');
+      return;
+    }
+    if (node.nodeType !== Node.ELEMENT_NODE)
+      return;
+    const element = node;
+    if (skipTags.has(element.tagName) || isHidden(element))
+      return;
+    const role = roleOf(element);
+    const children = [];
+    for (const child of (element.shadowRoot || element).childNodes)
+      visit(child, children);
+    if (!role || role === 'presentation' || role === 'none' || role === 'generic') {
+      const block = getComputedStyle(element).display !== 'inline' || element.tagName === 'BR';
+      if (block)
+        pushText(out, ' ');
+      for (const child of children) {
+        if (child.role === 'text')
+          pushText(out, child.name);
+        else
+          out.push(child);
+      }
+      if (block)
+        pushText(out, ' ');
+      return;
+    }
+    const item = { role, name: nameOf(element, role), props: propsOf(element, role), children: finish(children) };
+    if (['textbox', 'searchbox', 'spinbutton', 'combobox'].includes(role) && 'value' in element && element.tagName !== 'SELECT' && normalize(String(element.value)))
+      item.children = [{ role: 'text', name: normalize(String(element.value)), props: {}, children: [] }];
+    if (item.children.length === 1 && item.children[0].role === 'text' && item.children[0].name === item.name)
+      item.children = [];
+    out.push(item);
+  };
+  const out = [];
+  visit(root, out);
+  return JSON.stringify(finish(out));
+}`
+
+// ariaNode is a node of an accessibility tree, or of an aria snapshot template. Text is a
+// node with the role "text" and the text as its name.
+type ariaNode struct {
+	Role     string            `json:"role"`
+	Name     string            `json:"name"`
+	Props    map[string]string `json:"props"`
+	Children []*ariaNode       `json:"children"`
+
+	// Only set in templates.
+	nameRegex *regexp.Regexp
+	hasName   bool
+}
+
+// ariaPropsOrder is the order properties are rendered in.
+var ariaPropsOrder = []string{"checked", "disabled", "expanded", "level", "pressed", "selected"}
+
+func (l *locatorImpl) ariaSnapshotTree(timeout *float64) ([]*ariaNode, error) {
+	result, err := l.Evaluate(ariaSnapshotScript, nil, LocatorEvaluateOptions{Timeout: timeout})
+	if err != nil {
+		return nil, err
+	}
+	encoded, ok := result.(string)
+	if !ok {
+		return nil, fmt.Errorf("unexpected aria snapshot result: %v", result)
+	}
+	var nodes []*ariaNode
+	if err := json.Unmarshal([]byte(encoded), &nodes); err != nil {
+		return nil, fmt.Errorf("could not parse aria snapshot: %w", err)
+	}
+	return nodes, nil
+}
+
+func (l *locatorImpl) AriaSnapshot(options ...LocatorAriaSnapshotOptions) (string, error) {
+	if l.err != nil {
+		return "", l.err
+	}
+	var timeout *float64
+	if len(options) == 1 {
+		timeout = options[0].Timeout
+	}
+	nodes, err := l.ariaSnapshotTree(timeout)
+	if err != nil {
+		return "", err
+	}
+	return renderAriaSnapshot(nodes), nil
+}
+
+func renderAriaSnapshot(nodes []*ariaNode) string {
+	lines := make([]string, 0)
+	for _, node := range nodes {
+		lines = node.render(lines, "")
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (n *ariaNode) render(lines []string, indent string) []string {
+	if n.Role == "text" {
+		return append(lines, indent+"- text: "+ariaYAMLValue(n.Name))
+	}
+	line := indent + "- " + n.Role
+	if n.Name != "" {
+		line += " " + strconv.Quote(n.Name)
+	}
+	for _, prop := range ariaPropsOrder {
+		value, ok := n.Props[prop]
+		if !ok {
+			continue
+		}
+		if value == "true" {
+			line += " [" + prop + "]"
+		} else {
+			line += " [" + prop + "=" + value + "]"
+		}
+	}
+	if len(n.Children) == 1 && n.Children[0].Role == "text" {
+		return append(lines, line+": "+ariaYAMLValue(n.Children[0].Name))
+	}
+	if len(n.Children) == 0 {
+		return append(lines, line)
+	}
+	lines = append(lines, line+":")
+	for _, child := range n.Children {
+		lines = child.render(lines, indent+"  ")
+	}
+	return lines
+}
+
+// ariaYAMLValue quotes text if it would be ambiguous as a plain YAML scalar.
+func ariaYAMLValue(text string) string {
+	if text == "" || strings.TrimSpace(text) != text || strings.ContainsAny(text[:1], "\"'[]{}&*!|>%@`#-/") ||
+		strings.Contains(text, ": ") || strings.Contains(text, " #") || strings.HasSuffix(text, ":") {
+		return strconv.Quote(text)
+	}
+	return text
+}
+
+// parseAriaTemplate parses an aria snapshot template. A template uses the format returned
+// by [Locator.AriaSnapshot], but names and text can also be regular expressions like
+// /Hello \d+/, and names and properties can be omitted to match any value.
+func parseAriaTemplate(template string) ([]*ariaNode, error) {
+	type entry struct {
+		indent int
+		node   *ariaNode
+	}
+	root := &ariaNode{}
+	stack := []entry{{indent: -1, node: root}}
+	for i, raw := range strings.Split(template, "\n") {
+		if strings.TrimSpace(raw) == "" {
+			continue
+		}
+		indent := len(raw) - len(strings.TrimLeft(raw, " \t"))
+		line := strings.TrimSpace(raw)
+		if !strings.HasPrefix(line, "- ") && line != "-" {
+			return nil, fmt.Errorf("invalid aria template line %d: expected \"- \": %q", i+1, line)
+		}
+		node, err := parseAriaTemplateEntry(strings.TrimSpace(strings.TrimPrefix(line, "-")))
+		if err != nil {
+			return nil, fmt.Errorf("invalid aria template line %d: %w", i+1, err)
+		}
+		for stack[len(stack)-1].indent >= indent {
+			stack = stack[:len(stack)-1]
+		}
+		parent := stack[len(stack)-1].node
+		parent.Children = append(parent.Children, node)
+		stack = append(stack, entry{indent: indent, node: node})
+	}
+	return root.Children, nil
+}
+
+var ariaTemplateRoleRegex = regexp.MustCompile(`^[a-z]+`)
+
+func parseAriaTemplateEntry(entry string) (*ariaNode, error) {
+	role := ariaTemplateRoleRegex.FindString(entry)
+	if role == "" {
+		return nil, fmt.Errorf("expected a role: %q", entry)
+	}
+	node := &ariaNode{Role: role, Props: map[string]string{}}
+	rest := strings.TrimSpace(entry[len(role):])
+	if role == "text" {
+		if !strings.HasPrefix(rest, ":") {
+			return nil, errors.New("expected text value after \"text:\"")
+		}
+		name, nameRegex, err := parseAriaTemplateValue(strings.TrimSpace(rest[1:]))
+		if err != nil {
+			return nil, err
+		}
+		node.Name, node.nameRegex, node.hasName = name, nameRegex, true
+		return node, nil
+	}
+	if strings.HasPrefix(rest, "\"") || strings.HasPrefix(rest, "/") {
+		end := ariaTemplateTokenEnd(rest)
+		if end < 0 {
+			return nil, fmt.Errorf("unterminated name: %q", rest)
+		}
+		name, nameRegex, err := parseAriaTemplateValue(rest[:end+1])
+		if err != nil {
+			return nil, err
+		}
+		node.Name, node.nameRegex, node.hasName = name, nameRegex, true
+		rest = strings.TrimSpace(rest[end+1:])
+	}
+	for strings.HasPrefix(rest, "[") {
+		end := strings.Index(rest, "]")
+		if end < 0 {
+			return nil, fmt.Errorf("unterminated property: %q", rest)
+		}
+		prop := strings.TrimSpace(rest[1:end])
+		key, value, found := strings.Cut(prop, "=")
+		if !found {
+			value = "true"
+		}
+		node.Props[strings.TrimSpace(key)] = strings.TrimSpace(value)
+		rest = strings.TrimSpace(rest[end+1:])
+	}
+	if rest == "" {
+		return node, nil
+	}
+	if !strings.HasPrefix(rest, ":") {
+		return nil, fmt.Errorf("unexpected %q", rest)
+	}
+	if text := strings.TrimSpace(rest[1:]); text != "" {
+		name, nameRegex, err := parseAriaTemplateValue(text)
+		if err != nil {
+			return nil, err
+		}
+		node.Children = []*ariaNode{{Role: "text", Name: name, nameRegex: nameRegex, hasName: true}}
+	}
+	return node, nil
+}
+
+// ariaTemplateTokenEnd returns the index of the quote or slash closing the token s starts with.
+func ariaTemplateTokenEnd(s string) int {
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case s[0]:
+			return i
+		}
+	}
+	return -1
+}
+
+func parseAriaTemplateValue(value string) (string, *regexp.Regexp, error) {
+	if len(value) >= 2 && strings.HasPrefix(value, "/") && strings.HasSuffix(value, "/") {
+		re, err := regexp.Compile(value[1 : len(value)-1])
+		if err != nil {
+			return "", nil, err
+		}
+		return value, re, nil
+	}
+	if strings.HasPrefix(value, "\"") {
+		unquoted, err := strconv.Unquote(value)
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid quoted string %s: %w", value, err)
+		}
+		return unquoted, nil, nil
+	}
+	return value, nil, nil
+}
+
+// matchesAriaTemplate reports whether template matches the tree, or any subtree of it.
+func matchesAriaTemplate(template, actual []*ariaNode) bool {
+	if matchAriaChildren(template, actual) {
+		return true
+	}
+	for _, node := range actual {
+		if matchesAriaTemplate(template, node.Children) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchAriaChildren reports whether all template nodes match actual nodes in order, other
+// actual nodes may appear in between.
+func matchAriaChildren(template, actual []*ariaNode) bool {
+	next := 0
+	for _, t := range template {
+		for next < len(actual) && !matchAriaNode(t, actual[next]) {
+			next++
+		}
+		if next == len(actual) {
+			return false
+		}
+		next++
+	}
+	return true
+}
+
+func matchAriaNode(template, actual *ariaNode) bool {
+	if template.Role != actual.Role {
+		return false
+	}
+	if template.hasName {
+		if template.nameRegex != nil {
+			if !template.nameRegex.MatchString(actual.Name) {
+				return false
+			}
+		} else if template.Name != actual.Name {
+			return false
+		}
+	}
+	for key, value := range template.Props {
+		actualValue, ok := actual.Props[key]
+		if !ok {
+			actualValue = "false"
+		}
+		if value != actualValue {
+			return false
+		}
+	}
+	if len(template.Children) == 0 {
+		return true
+	}
+	children := actual.Children
+	if len(children) == 0 && actual.Name != "" {
+		// Text equal to the name is not repeated as a child.
+		children = []*ariaNode{{Role: "text", Name: actual.Name}}
+	}
+	return matchAriaChildren(template.Children, children)
+}
+
+func (la *locatorAssertionsImpl) ToMatchAriaSnapshot(expected string, options ...LocatorAssertionsToMatchAriaSnapshotOptions) error {
+	return la.soft.record(la.toMatchAriaSnapshot(expected, options...))
+}
+
+func (la *locatorAssertionsImpl) toMatchAriaSnapshot(expected string, options ...LocatorAssertionsToMatchAriaSnapshotOptions) error {
+	template, err := parseAriaTemplate(expected)
+	if err != nil {
+		return err
+	}
+	timeout := la.defaultTimeout
+	if len(options) == 1 && options[0].Timeout != nil {
+		timeout = options[0].Timeout
+	}
+	interval := 100 * time.Millisecond
+	if la.pollInterval != nil {
+		interval = time.Duration(*la.pollInterval * float64(time.Millisecond))
+	}
+	deadline := time.Now().Add(time.Duration(*timeout * float64(time.Millisecond)))
+	locator := la.actualLocator.(*locatorImpl)
+	message := "Locator expected to match aria snapshot"
+	if la.isNot {
+		message = "Locator expected not to match aria snapshot"
+	}
+	for {
+		remaining := float64(time.Until(deadline).Milliseconds())
+		if remaining < 1 {
+			remaining = 1
+		}
+		actual, err := locator.ariaSnapshotTree(Float(remaining))
+		if err != nil {
+			if errors.Is(err, ErrTimeout) {
+				return fmt.Errorf("%s '%s'\nActual value: <element not found>", message, expected)
+			}
+			return err
+		}
+		if matchesAriaTemplate(template, actual) != la.isNot {
+			return nil
+		}
+		if time.Now().Add(interval).After(deadline) {
+			return fmt.Errorf("%s '%s'\nActual value: %s", message, expected, renderAriaSnapshot(actual))
+		}
+		time.Sleep(interval)
+	}
+}
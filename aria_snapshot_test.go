@@ -0,0 +1,95 @@
+package playwright
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func ariaText(text string) *ariaNode {
+	return &ariaNode{Role: "text", Name: text}
+}
+
+func TestRenderAriaSnapshot(t *testing.T) {
+	tree := []*ariaNode{
+		{Role: "heading", Name: "Title", Props: map[string]string{"level": "1"}},
+		{Role: "list", Children: []*ariaNode{
+			{Role: "listitem", Children: []*ariaNode{ariaText("One")}},
+			{Role: "listitem", Children: []*ariaNode{ariaText("Two: three")}},
+		}},
+		{Role: "checkbox", Name: "Accept", Props: map[string]string{"checked": "true", "disabled": "true"}},
+		ariaText("Footer"),
+	}
+	require.Equal(t, `- heading "Title" [level=1]
+- list:
+  - listitem: One
+  - listitem: "Two: three"
+- checkbox "Accept" [checked] [disabled]
+- text: Footer`, renderAriaSnapshot(tree))
+}
+
+func TestAriaTemplateShouldRoundTrip(t *testing.T) {
+	snapshot := `- heading "Title" [level=1]
+- list:
+  - listitem: One
+  - listitem: "Two: three"
+- checkbox "Accept" [checked] [disabled]
+- text: Footer`
+	template, err := parseAriaTemplate(snapshot)
+	require.NoError(t, err)
+	require.Len(t, template, 4)
+	require.Equal(t, map[string]string{"level": "1"}, template[0].Props)
+	require.Equal(t, "Two: three", template[1].Children[1].Children[0].Name)
+	require.True(t, matchesAriaTemplate(template, template))
+}
+
+func TestAriaTemplateMatching(t *testing.T) {
+	tree := []*ariaNode{
+		{Role: "main", Children: []*ariaNode{
+			{Role: "heading", Name: "Welcome back", Props: map[string]string{"level": "2"}},
+			{Role: "list", Children: []*ariaNode{
+				{Role: "listitem", Children: []*ariaNode{ariaText("Item 1")}},
+				{Role: "listitem", Children: []*ariaNode{ariaText("Item 2")}},
+				{Role: "listitem", Children: []*ariaNode{ariaText("Item 3")}},
+			}},
+			{Role: "button", Name: "Save"},
+		}},
+	}
+	for template, matches := range map[string]bool{
+		`- heading /Welcome/`:                                 true,
+		`- heading "Welcome back" [level=2]`:                  true,
+		`- heading [level=1]`:                                 false,
+		"- list:\n  - listitem: Item 1\n  - listitem: Item 3": true,
+		"- list:\n  - listitem: Item 3\n  - listitem: Item 1": false,
+		"- list:\n  - listitem: /Item \\d/":                   true,
+		"- heading\n- button \"Save\"":                        true,
+		"- button \"Save\"\n- heading":                        false,
+		`- button: Save`:                                      true,
+		`- button [pressed=false]`:                            true,
+		`- link`:                                              false,
+	} {
+		parsed, err := parseAriaTemplate(template)
+		require.NoError(t, err, template)
+		require.Equal(t, matches, matchesAriaTemplate(parsed, tree), template)
+	}
+}
+
+func TestAriaTemplateErrors(t *testing.T) {
+	for _, template := range []string{
+		"heading",
+		`- heading "unterminated`,
+		`- heading [level=1`,
+		`- heading /(/`,
+		`- text`,
+	} {
+		_, err := parseAriaTemplate(template)
+		require.Error(t, err, template)
+	}
+}
+
+func TestAriaTemplateShouldIgnoreCommonIndentation(t *testing.T) {
+	template, err := parseAriaTemplate("\n\t\t- list:\n\t\t  - listitem: One\n\t\t- button\n\t")
+	require.NoError(t, err)
+	require.Len(t, template, 2)
+	require.Len(t, template[0].Children, 1)
+}
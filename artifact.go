@@ -3,6 +3,7 @@ package playwright
 import (
 	"errors"
 	"fmt"
+	"io"
 )
 
 type artifactImpl struct {
@@ -63,6 +64,15 @@ func (a *artifactImpl) ReadIntoBuffer() ([]byte, error) {
 	return stream.(*streamImpl).ReadAll()
 }
 
+func (a *artifactImpl) ReadStream() (io.ReadCloser, error) {
+	streamChannel, err := a.channel.Send("stream")
+	if err != nil {
+		return nil, err
+	}
+	stream := fromChannel(streamChannel)
+	return stream.(*streamImpl).Reader(), nil
+}
+
 func newArtifact(parent *channelOwner, objectType string, guid string, initializer map[string]interface{}) *artifactImpl {
 	artifact := &artifactImpl{}
 	artifact.createChannelOwner(artifact, parent, objectType, guid, initializer)
@@ -0,0 +1,177 @@
+package playwright
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// AssertionError is returned by web-first assertions that fail. Its message renders the expected and received values,
+// a diff of them where it helps, the selector of the locator and the history of the attempts, so that failures can
+// be diagnosed from logs alone.
+type AssertionError struct {
+	// Message describes the assertion, e.g. "Locator expected to have text".
+	Message string
+	// Selector of the asserted locator. Empty for page assertions.
+	Selector string
+	// Expected value, nil for assertions without one such as [LocatorAssertions.ToBeVisible].
+	Expected interface{}
+	// Value received by the last attempt.
+	Received interface{}
+	// Attempts made by the assertion, in order.
+	Polls []AssertionPoll
+	// Additional context collected after the failure, e.g. the attributes of the element or the matched elements.
+	Details string
+	// Call log of the last attempt.
+	Log []string
+}
+
+// AssertionPoll is an attempt of a failed assertion, see [AssertionError].
+type AssertionPoll struct {
+	// Time the attempt finished at.
+	Time time.Time
+	// Value received by the attempt.
+	Received interface{}
+}
+
+func (e *AssertionError) Error() string {
+	var sb strings.Builder
+	sb.WriteString(e.Message)
+	if e.Expected != nil {
+		fmt.Fprintf(&sb, " '%v'", e.Expected)
+	}
+	if e.Selector != "" {
+		fmt.Fprintf(&sb, "\nLocator: %s", e.Selector)
+	}
+	if e.Expected != nil {
+		fmt.Fprintf(&sb, "\nExpected: %s", formatAssertionValue(e.Expected))
+	}
+	fmt.Fprintf(&sb, "\nActual value: %s", formatAssertionValue(e.Received))
+	if diff := assertionDiff(e.Expected, e.Received); diff != "" {
+		sb.WriteString("\nDiff (- expected, + received):\n")
+		sb.WriteString(diff)
+	}
+	if e.Details != "" {
+		sb.WriteString("\n")
+		sb.WriteString(e.Details)
+	}
+	if len(e.Polls) > 0 {
+		sb.WriteString("\nPoll history:")
+		for i, poll := range e.Polls {
+			fmt.Fprintf(&sb, "\n  [%s] attempt %d: %s", poll.Time.Format("15:04:05.000"), i+1, formatAssertionValue(poll.Received))
+		}
+	}
+	if len(e.Log) > 0 {
+		sb.WriteString("\nCall log:\n")
+		sb.WriteString(strings.Join(e.Log, "\n"))
+	}
+	return sb.String()
+}
+
+func formatAssertionValue(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return fmt.Sprintf("%q", v)
+	case *regexp.Regexp:
+		return "/" + v.String() + "/"
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// assertionDiff renders a line diff of expected and received strings or string lists, or an empty string if they
+// are not comparable as text.
+func assertionDiff(expected, received interface{}) string {
+	expectedLines, ok := assertionLines(expected)
+	if !ok {
+		return ""
+	}
+	receivedLines, ok := assertionLines(received)
+	if !ok {
+		return ""
+	}
+	if reflect.DeepEqual(expectedLines, receivedLines) {
+		return ""
+	}
+	return lineDiff(expectedLines, receivedLines)
+}
+
+func assertionLines(v interface{}) ([]string, bool) {
+	switch v := v.(type) {
+	case nil:
+		return nil, false
+	case string:
+		return strings.Split(v, "\n"), true
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice {
+		return nil, false
+	}
+	lines := make([]string, 0, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		s, ok := rv.Index(i).Interface().(string)
+		if !ok {
+			return nil, false
+		}
+		lines = append(lines, s)
+	}
+	return lines, true
+}
+
+// lineDiff renders the changes from a to b based on their longest common subsequence of lines.
+func lineDiff(a, b []string) string {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+	var out []string
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			out = append(out, "  "+a[i])
+			i++
+			j++
+		case j == len(b) || (i < len(a) && lcs[i+1][j] >= lcs[i][j+1]):
+			out = append(out, "- "+a[i])
+			i++
+		default:
+			out = append(out, "+ "+b[j])
+			j++
+		}
+	}
+	return strings.Join(out, "\n")
+}
+
+// formatTable renders rows as columns aligned on the widest cell of each column, with header as first row.
+func formatTable(header []string, rows [][]string) string {
+	widths := make([]int, len(header))
+	for _, row := range append([][]string{header}, rows...) {
+		for i, cell := range row {
+			if len(cell) > widths[i] {
+				widths[i] = len(cell)
+			}
+		}
+	}
+	lines := make([]string, 0, len(rows)+1)
+	for _, row := range append([][]string{header}, rows...) {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			cells[i] = fmt.Sprintf("%-*s", widths[i], cell)
+		}
+		lines = append(lines, "  "+strings.TrimRight(strings.Join(cells, " | "), " "))
+	}
+	return strings.Join(lines, "\n")
+}
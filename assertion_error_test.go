@@ -0,0 +1,55 @@
+package playwright
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssertionErrorRendersDiffAndHistory(t *testing.T) {
+	at := time.Date(2024, 1, 2, 3, 4, 5, 6000000, time.UTC)
+	err := &AssertionError{
+		Message:  "Locator expected to have text",
+		Selector: "#node",
+		Expected: []string{"a", "b", "c"},
+		Received: []interface{}{"a", "x", "c"},
+		Polls:    []AssertionPoll{{Time: at, Received: "a"}},
+		Details:  "Matched elements (1):\n  1. <p>a</p>",
+		Log:      []string{"waiting for locator('#node')"},
+	}
+	require.Equal(t, `Locator expected to have text '[a b c]'
+Locator: #node
+Expected: [a b c]
+Actual value: [a x c]
+Diff (- expected, + received):
+  a
+- b
++ x
+  c
+Matched elements (1):
+  1. <p>a</p>
+Poll history:
+  [03:04:05.006] attempt 1: "a"
+Call log:
+waiting for locator('#node')`, err.Error())
+}
+
+func TestAssertionErrorWithoutDiff(t *testing.T) {
+	err := &AssertionError{Message: "Locator expected to have count", Expected: 2, Received: 1}
+	require.Equal(t, "Locator expected to have count '2'\nExpected: 2\nActual value: 1", err.Error())
+
+	err = &AssertionError{Message: "Locator expected to have text", Expected: regexp.MustCompile("^a"), Received: "b"}
+	require.Equal(t, "Locator expected to have text '^a'\nExpected: /^a/\nActual value: \"b\"", err.Error())
+
+	err = &AssertionError{Message: "Locator expected not to have text", Expected: "a", Received: "a"}
+	require.NotContains(t, err.Error(), "Diff")
+}
+
+func TestFormatTable(t *testing.T) {
+	require.Equal(t, "  name  | value\n  class | \"a\"\n  id    | \"main\"", formatTable(
+		[]string{"name", "value"},
+		[][]string{{"class", `"a"`}, {"id", `"main"`}},
+	))
+}
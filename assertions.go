@@ -2,37 +2,146 @@ package playwright
 
 import (
 	"errors"
-	"fmt"
 	"reflect"
 	"regexp"
 	"strings"
+	"sync"
+	"time"
+
+	"github.com/playwright-community/playwright-go/internal/multierror"
 )
 
 const assertionsDefaultTimeout = 5000 // 5s
 
 type playwrightAssertionsImpl struct {
 	defaultTimeout *float64
+	pollInterval   *float64
+	soft           *softErrors
+	snapshotDir    string
 }
 
 // NewPlaywrightAssertions creates a new instance of PlaywrightAssertions
 //   - timeout: default value is 5000 (ms)
 func NewPlaywrightAssertions(timeout ...float64) PlaywrightAssertions {
 	if len(timeout) > 0 {
-		return &playwrightAssertionsImpl{Float(timeout[0])}
+		return &playwrightAssertionsImpl{defaultTimeout: Float(timeout[0])}
+	}
+	return &playwrightAssertionsImpl{defaultTimeout: Float(assertionsDefaultTimeout)}
+}
+
+// ExpectOptions configures the assertions created by [Expect].
+type ExpectOptions struct {
+	// Time to retry each assertion for in milliseconds. Defaults to `5000`.
+	Timeout *float64
+	// Interval between two attempts of an assertion in milliseconds. By default the
+	// Playwright server retries the assertion until it passes or times out.
+	PollInterval *float64
+	// Directory to store the snapshots of [PageAssertions.ToHaveScreenshot],
+	// [LocatorAssertions.ToHaveScreenshot] and [SnapshotAssertions.ToMatchSnapshot] in.
+	// Defaults to [DefaultSnapshotDir].
+	SnapshotDir *string
+}
+
+// Expect creates web-first assertions that retry until the expected condition is met
+// or the timeout is reached:
+//
+//	expect := playwright.Expect(playwright.ExpectOptions{PollInterval: playwright.Float(200)})
+//	err := expect.Locator(page.GetByRole("button")).ToHaveText("Submit")
+func Expect(options ...ExpectOptions) PlaywrightAssertions {
+	return newExpect(options)
+}
+
+// SoftAssertions are web-first assertions that record failures instead of returning
+// them, so a run can report every mismatch at once, see [ExpectSoft].
+type SoftAssertions interface {
+	PlaywrightAssertions
+	// Errors returns the failures recorded so far, in order.
+	Errors() []error
+	// Err returns the recorded failures joined into a single error, or nil if every
+	// assertion passed.
+	Err() error
+}
+
+// ExpectSoft creates soft assertions. Failing assertions return nil and are recorded,
+// check them at the end of the test:
+//
+//	soft := playwright.ExpectSoft()
+//	_ = soft.Locator(page.GetByRole("heading")).ToHaveText("Welcome")
+//	_ = soft.Page(page).ToHaveTitle("Home")
+//	require.NoError(t, soft.Err())
+func ExpectSoft(options ...ExpectOptions) SoftAssertions {
+	pa := newExpect(options)
+	pa.soft = &softErrors{}
+	return &softAssertionsImpl{pa}
+}
+
+func newExpect(options []ExpectOptions) *playwrightAssertionsImpl {
+	pa := &playwrightAssertionsImpl{defaultTimeout: Float(assertionsDefaultTimeout)}
+	if len(options) == 1 {
+		if options[0].Timeout != nil {
+			pa.defaultTimeout = options[0].Timeout
+		}
+		pa.pollInterval = options[0].PollInterval
+		if options[0].SnapshotDir != nil {
+			pa.snapshotDir = *options[0].SnapshotDir
+		}
+	}
+	return pa
+}
+
+type softAssertionsImpl struct {
+	*playwrightAssertionsImpl
+}
+
+func (sa *softAssertionsImpl) Errors() []error {
+	return sa.soft.Errors()
+}
+
+func (sa *softAssertionsImpl) Err() error {
+	return multierror.Join(sa.soft.Errors()...)
+}
+
+// softErrors collects the failures of soft assertions.
+type softErrors struct {
+	sync.Mutex
+	errs []error
+}
+
+// record stores err and swallows it. A nil collector returns err unchanged.
+func (s *softErrors) record(err error) error {
+	if s == nil || err == nil {
+		return err
 	}
-	return &playwrightAssertionsImpl{Float(assertionsDefaultTimeout)}
+	s.Lock()
+	defer s.Unlock()
+	s.errs = append(s.errs, err)
+	return nil
+}
+
+func (s *softErrors) Errors() []error {
+	s.Lock()
+	defer s.Unlock()
+	return append([]error{}, s.errs...)
 }
 
 func (pa *playwrightAssertionsImpl) APIResponse(response APIResponse) APIResponseAssertions {
-	return newAPIResponseAssertions(response, false)
+	return newAPIResponseAssertions(response, false, pa.soft)
 }
 
 func (pa *playwrightAssertionsImpl) Locator(locator Locator) LocatorAssertions {
-	return newLocatorAssertions(locator, false, pa.defaultTimeout)
+	la := newLocatorAssertions(locator, false, pa.defaultTimeout, pa.pollInterval, pa.soft)
+	la.snapshotDir = pa.snapshotDir
+	return la
 }
 
 func (pa *playwrightAssertionsImpl) Page(page Page) PageAssertions {
-	return newPageAssertions(page, false, pa.defaultTimeout)
+	pga := newPageAssertions(page, false, pa.defaultTimeout, pa.pollInterval, pa.soft)
+	pga.snapshotDir = pa.snapshotDir
+	return pga
+}
+
+func (pa *playwrightAssertionsImpl) Snapshot(actual []byte) SnapshotAssertions {
+	return &snapshotAssertionsImpl{actual: actual, snapshotDir: pa.snapshotDir, soft: pa.soft}
 }
 
 type expectedTextValue struct {
@@ -64,6 +173,10 @@ type assertionsBase struct {
 	actualLocator  Locator
 	isNot          bool
 	defaultTimeout *float64
+	pollInterval   *float64
+	soft           *softErrors
+	snapshotDir    string
+	isPage         bool
 }
 
 func (b *assertionsBase) expect(
@@ -71,6 +184,19 @@ func (b *assertionsBase) expect(
 	options frameExpectOptions,
 	expected interface{},
 	message string,
+	details ...func() string,
+) error {
+	return b.soft.record(b.check(expression, options, expected, message, details...))
+}
+
+// check runs the assertion and returns an [AssertionError] if it fails. details, if any, is called after a failure to
+// collect additional context for the error.
+func (b *assertionsBase) check(
+	expression string,
+	options frameExpectOptions,
+	expected interface{},
+	message string,
+	details ...func() string,
 ) error {
 	options.IsNot = b.isNot
 	if options.Timeout == nil {
@@ -79,26 +205,61 @@ func (b *assertionsBase) expect(
 	if options.IsNot {
 		message = strings.ReplaceAll(message, "expected to", "expected not to")
 	}
-	result, err := b.actualLocator.(*locatorImpl).expect(expression, options)
+	result, polls, err := b.poll(expression, options)
 	if err != nil {
 		return err
 	}
 
 	if result.Matches == b.isNot {
-		actual := result.Received
-		log := strings.Join(result.Log, "\n")
-		if log != "" {
-			log = "\nCall log:\n" + log
+		failure := &AssertionError{
+			Message:  message,
+			Expected: expected,
+			Received: result.Received,
+			Polls:    polls,
+			Log:      result.Log,
 		}
-		if expected != nil {
-			return fmt.Errorf("%s '%v'\nActual value: %v %s", message, expected, actual, log)
+		if locator, ok := b.actualLocator.(*locatorImpl); ok && !b.isPage {
+			failure.Selector = locator.selector
 		}
-		return fmt.Errorf("%s\nActual value: %v %s", message, actual, log)
+		if len(details) == 1 {
+			failure.Details = details[0]()
+		}
+		return failure
 	}
 
 	return nil
 }
 
+// poll runs the assertion on the server. With a poll interval, every attempt is a
+// single check and the assertion is retried on the client until it passes or times out.
+// It also returns the history of the attempts.
+func (b *assertionsBase) poll(expression string, options frameExpectOptions) (*frameExpectResult, []AssertionPoll, error) {
+	locator := b.actualLocator.(*locatorImpl)
+	if b.pollInterval == nil || options.Timeout == nil {
+		result, err := locator.expect(expression, options)
+		if err != nil {
+			return nil, nil, err
+		}
+		return result, []AssertionPoll{{Time: time.Now(), Received: result.Received}}, nil
+	}
+	deadline := time.Now().Add(time.Duration(*options.Timeout * float64(time.Millisecond)))
+	interval := time.Duration(*b.pollInterval * float64(time.Millisecond))
+	attempt := options
+	attempt.Timeout = Float(1)
+	var polls []AssertionPoll
+	for {
+		result, err := locator.expect(expression, attempt)
+		if err != nil {
+			return result, polls, err
+		}
+		polls = append(polls, AssertionPoll{Time: time.Now(), Received: result.Received})
+		if result.Matches != b.isNot || time.Now().Add(interval).After(deadline) {
+			return result, polls, nil
+		}
+		time.Sleep(interval)
+	}
+}
+
 func toExpectedTextValues(
 	items []interface{},
 	matchSubstring bool,
@@ -0,0 +1,713 @@
+package playwright
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+)
+
+func getAuditCategory(in string) *AuditCategory {
+	v := AuditCategory(in)
+	return &v
+}
+
+// AuditCategory is a category of the audits of [Page.Audit].
+type AuditCategory string
+
+var (
+	AuditCategoryAccessibility *AuditCategory = getAuditCategory("accessibility")
+	AuditCategoryBestPractices                = getAuditCategory("best-practices")
+	AuditCategoryPerformance                  = getAuditCategory("performance")
+	AuditCategorySeo                          = getAuditCategory("seo")
+)
+
+// ErrAuditNotSupported is returned when a page is audited in a browser other than Chromium.
+var ErrAuditNotSupported = errors.New("audits are only supported in Chromium")
+
+// auditScript collects the data of the audits from the document and the performance timeline of the page. The
+// elements failing a check are reported by their start tag.
+const auditScript = `async () => {
+  const observe = type => new Promise(resolve => {
+    if (!PerformanceObserver.supportedEntryTypes.includes(type))
+      return resolve(null);
+    const observer = new PerformanceObserver(list => {
+      observer.disconnect();
+      resolve(list.getEntries());
+    });
+    observer.observe({ type, buffered: true });
+    setTimeout(() => {
+      observer.disconnect();
+      resolve([]);
+    }, 100);
+  });
+  const snippet = element => {
+    const html = element.outerHTML;
+    const tag = html.slice(0, html.indexOf('>') + 1) || html;
+    return tag.length > 120 ? tag.slice(0, 117) + '...' : tag;
+  };
+  const visible = element => element.getClientRects().length > 0 && getComputedStyle(element).visibility !== 'hidden';
+  const check = (selector, failing) => {
+    const elements = [...document.querySelectorAll(selector)].filter(visible);
+    return { count: elements.length, failures: elements.filter(failing).map(snippet) };
+  };
+  const labelledBy = element => (element.getAttribute('aria-labelledby') || '').split(/\s+/).filter(Boolean)
+    .some(id => { const label = document.getElementById(id); return label && label.textContent.trim(); });
+  const ariaName = element => !!((element.getAttribute('aria-label') || '').trim() || labelledBy(element) ||
+    (element.getAttribute('title') || '').trim());
+  const textName = element => !!((element.innerText || element.textContent || '').trim() ||
+    [...element.querySelectorAll('img[alt]')].some(img => img.alt.trim()));
+  const genericLinkTexts = new Set(['click here', 'click this', 'go', 'here', 'this', 'start', 'right here', 'more',
+    'learn more', 'read more', 'link']);
+
+  let legible = 0, total = 0;
+  const walker = document.createTreeWalker(document.body || document.documentElement, NodeFilter.SHOW_TEXT);
+  for (let node = walker.nextNode(); node; node = walker.nextNode()) {
+    const length = node.textContent.trim().length;
+    if (!length || !node.parentElement || !visible(node.parentElement))
+      continue;
+    total += length;
+    if (parseFloat(getComputedStyle(node.parentElement).fontSize) >= 12)
+      legible += length;
+  }
+
+  const [navigation] = performance.getEntriesByType('navigation');
+  const paint = performance.getEntriesByName('first-contentful-paint')[0];
+  const lcp = await observe('largest-contentful-paint');
+  const layoutShifts = await observe('layout-shift');
+  const longTasks = await observe('longtask');
+  let cls = null;
+  if (layoutShifts) {
+    cls = 0;
+    let session = 0, first = 0, last = 0;
+    for (const shift of layoutShifts.filter(shift => !shift.hadRecentInput)) {
+      if (session && (shift.startTime - last > 1000 || shift.startTime - first > 5000))
+        session = 0;
+      if (!session)
+        first = shift.startTime;
+      session += shift.value;
+      last = shift.startTime;
+      cls = Math.max(cls, session);
+    }
+  }
+  const meta = name => [...document.querySelectorAll('meta[name]')]
+    .filter(meta => meta.name.toLowerCase() === name).map(meta => meta.content || '');
+  return {
+    url: location.href,
+    title: document.title.trim(),
+    lang: document.documentElement.lang || '',
+    doctype: document.doctype ? document.doctype.name : '',
+    quirksMode: document.compatMode === 'BackCompat',
+    viewport: meta('viewport')[0] ?? null,
+    description: meta('description')[0] ?? null,
+    robots: [...meta('robots'), ...meta('googlebot')],
+    canonicals: [...document.querySelectorAll('link[rel]')].filter(link => link.relList.contains('canonical'))
+      .map(link => link.href),
+    fontSize: { legible, total },
+    domSize: document.getElementsByTagName('*').length,
+    status: navigation && navigation.responseStatus || 0,
+    serverResponseTime: navigation && navigation.responseStart > 0 ? navigation.responseStart - navigation.requestStart : null,
+    firstContentfulPaint: paint ? paint.startTime : null,
+    largestContentfulPaint: lcp && lcp.length ? lcp[lcp.length - 1].startTime : null,
+    cumulativeLayoutShift: cls,
+    longTasks: longTasks && longTasks.map(task => ({ startTime: task.startTime, duration: task.duration })),
+    resources: [
+      ...(navigation ? [{ url: navigation.name, transferSize: navigation.transferSize || 0 }] : []),
+      ...performance.getEntriesByType('resource').map(entry => ({ url: entry.name, transferSize: entry.transferSize || 0 })),
+    ],
+    checks: {
+      'image-alt': check('img', element => !element.hasAttribute('alt') &&
+        !['presentation', 'none'].includes(element.getAttribute('role')) && !ariaName(element)),
+      'button-name': check('button, input[type=button], input[type=submit], input[type=reset], [role=button]',
+        element => !ariaName(element) && (element.tagName === 'INPUT' ?
+          !element.value && element.type === 'button' : !textName(element))),
+      'link-name': check('a[href], [role=link]', element => !ariaName(element) && !textName(element)),
+      'label': check('input:not([type=hidden]):not([type=button]):not([type=submit]):not([type=reset]):not([type=image]), select, textarea',
+        element => !ariaName(element) && ![...(element.labels || [])].some(label => label.textContent.trim())),
+      'link-text': check('a[href]', element => genericLinkTexts.has(
+        (element.innerText || element.textContent || '').trim().toLowerCase().replace(/\s+/g, ' '))),
+      'crawlable-anchors': check('a', element => element.hasAttribute('href') ?
+        /^\s*javascript:/i.test(element.getAttribute('href')) : element.hasAttribute('onclick')),
+      'image-aspect-ratio': check('img', element => {
+        const rect = element.getBoundingClientRect();
+        if (!element.naturalWidth || !element.naturalHeight || !rect.width || !rect.height ||
+            getComputedStyle(element).objectFit !== 'fill')
+          return false;
+        const natural = element.naturalWidth / element.naturalHeight;
+        return Math.abs(rect.width / rect.height - natural) / natural > 0.05;
+      }),
+    },
+  };
+}`
+
+// AuditResult is the result of an audit of [Page.Audit].
+type AuditResult struct {
+	// Identifier of the audit, such as `largest-contentful-paint` or `image-alt`.
+	ID    string
+	Title string
+	// Score of the audit between 0 and 1, 1 or 0 for the audits that pass or fail. It is nil when the audit is not
+	// applicable to the page, such as `image-alt` on a page without images, or its data is not available.
+	Score *float64
+	// Value measured by the audit, in NumericUnit, for the audits that measure a value.
+	NumericValue *float64
+	// Unit of NumericValue: `millisecond`, `byte`, `element` or `unitless`.
+	NumericUnit string
+	// NumericValue formatted for display, such as `1.2 s`.
+	DisplayValue string
+	// Elements, by their start tag, or resources causing the audit to fail.
+	Items []string
+}
+
+// Passed returns whether the audit passed, with a score of at least 0.9, or is not applicable.
+func (a AuditResult) Passed() bool {
+	return a.Score == nil || *a.Score >= 0.9
+}
+
+// AuditCategoryResult is the result of a category of audits of [Page.Audit].
+type AuditCategoryResult struct {
+	ID    AuditCategory
+	Title string
+	// Score of the category between 0 and 1: the weighted mean of the scores of its audits. It is nil when none of its
+	// weighted audits is applicable.
+	Score *float64
+	// Identifiers of the audits of the category. An audit may be in several categories.
+	AuditIDs []string
+}
+
+// AuditReport is the result of [Page.Audit].
+type AuditReport struct {
+	// URL of the audited page.
+	URL        string
+	Categories []AuditCategoryResult
+	// Audits of the categories, in the order of the categories.
+	Audits []AuditResult
+}
+
+// Category returns the result of the category, or nil if it was not audited.
+func (r *AuditReport) Category(category AuditCategory) *AuditCategoryResult {
+	for i := range r.Categories {
+		if r.Categories[i].ID == category {
+			return &r.Categories[i]
+		}
+	}
+	return nil
+}
+
+// Audit returns the result of the audit with the identifier, or nil if it was not run.
+func (r *AuditReport) Audit(id string) *AuditResult {
+	for i := range r.Audits {
+		if r.Audits[i].ID == id {
+			return &r.Audits[i]
+		}
+	}
+	return nil
+}
+
+// Failed returns the audits that did not pass, see [AuditResult.Passed].
+func (r *AuditReport) Failed() []AuditResult {
+	failed := make([]AuditResult, 0)
+	for _, audit := range r.Audits {
+		if !audit.Passed() {
+			failed = append(failed, audit)
+		}
+	}
+	return failed
+}
+
+type auditRef struct {
+	id     string
+	weight float64
+}
+
+// auditCategories are the categories and their audits, with the weight of the audits in the score of the category.
+var auditCategories = []struct {
+	id     *AuditCategory
+	title  string
+	audits []auditRef
+}{
+	{AuditCategoryPerformance, "Performance", []auditRef{
+		{"first-contentful-paint", 10},
+		{"largest-contentful-paint", 25},
+		{"total-blocking-time", 30},
+		{"cumulative-layout-shift", 25},
+		{"server-response-time", 0},
+		{"total-byte-weight", 0},
+		{"dom-size", 0},
+		{"mainthread-work-breakdown", 0},
+	}},
+	{AuditCategoryAccessibility, "Accessibility", []auditRef{
+		{"image-alt", 1},
+		{"button-name", 1},
+		{"link-name", 1},
+		{"label", 1},
+		{"document-title", 1},
+		{"html-has-lang", 1},
+		{"meta-viewport", 1},
+	}},
+	{AuditCategoryBestPractices, "Best Practices", []auditRef{
+		{"is-on-https", 1},
+		{"doctype", 1},
+		{"image-aspect-ratio", 1},
+		{"inspector-issues", 1},
+	}},
+	{AuditCategorySeo, "SEO", []auditRef{
+		{"viewport", 1},
+		{"document-title", 1},
+		{"meta-description", 1},
+		{"http-status-code", 1},
+		{"is-crawlable", 1},
+		{"link-text", 1},
+		{"crawlable-anchors", 1},
+		{"canonical", 1},
+		{"font-size", 1},
+		{"image-alt", 1},
+	}},
+}
+
+var auditTitles = map[string]string{
+	"first-contentful-paint":    "First Contentful Paint",
+	"largest-contentful-paint":  "Largest Contentful Paint",
+	"total-blocking-time":       "Total Blocking Time",
+	"cumulative-layout-shift":   "Cumulative Layout Shift",
+	"server-response-time":      "Initial server response time was short",
+	"total-byte-weight":         "Avoids enormous network payloads",
+	"dom-size":                  "Avoids an excessive DOM size",
+	"mainthread-work-breakdown": "Minimizes main-thread work",
+	"image-alt":                 "Image elements have [alt] attributes",
+	"button-name":               "Buttons have an accessible name",
+	"link-name":                 "Links have a discernible name",
+	"label":                     "Form elements have associated labels",
+	"document-title":            "Document has a <title> element",
+	"html-has-lang":             "<html> element has a [lang] attribute",
+	"meta-viewport":             "[user-scalable=\"no\"] is not used and [maximum-scale] is not less than 5",
+	"is-on-https":               "Uses HTTPS",
+	"doctype":                   "Page has the HTML doctype",
+	"image-aspect-ratio":        "Displays images with correct aspect ratio",
+	"inspector-issues":          "No issues in the Issues panel in Chrome Devtools",
+	"viewport":                  "Has a <meta name=\"viewport\"> tag with width or initial-scale",
+	"meta-description":          "Document has a meta description",
+	"http-status-code":          "Page has successful HTTP status code",
+	"is-crawlable":              "Page isn't blocked from indexing",
+	"link-text":                 "Links have descriptive text",
+	"crawlable-anchors":         "Links are crawlable",
+	"canonical":                 "Document has a valid rel=canonical",
+	"font-size":                 "Document uses legible font sizes",
+}
+
+type auditCheck struct {
+	Count    int      `json:"count"`
+	Failures []string `json:"failures"`
+}
+
+type auditResource struct {
+	URL          string  `json:"url"`
+	TransferSize float64 `json:"transferSize"`
+}
+
+type rawAudit struct {
+	URL         string   `json:"url"`
+	Title       string   `json:"title"`
+	Lang        string   `json:"lang"`
+	Doctype     string   `json:"doctype"`
+	QuirksMode  bool     `json:"quirksMode"`
+	Viewport    *string  `json:"viewport"`
+	Description *string  `json:"description"`
+	Robots      []string `json:"robots"`
+	Canonicals  []string `json:"canonicals"`
+	FontSize    struct {
+		Legible int `json:"legible"`
+		Total   int `json:"total"`
+	} `json:"fontSize"`
+	DomSize                int      `json:"domSize"`
+	Status                 int      `json:"status"`
+	ServerResponseTime     *float64 `json:"serverResponseTime"`
+	FirstContentfulPaint   *float64 `json:"firstContentfulPaint"`
+	LargestContentfulPaint *float64 `json:"largestContentfulPaint"`
+	CumulativeLayoutShift  *float64 `json:"cumulativeLayoutShift"`
+	// LongTasks is nil when the browser doesn't report long tasks.
+	LongTasks []struct {
+		StartTime float64 `json:"startTime"`
+		Duration  float64 `json:"duration"`
+	} `json:"longTasks"`
+	Resources []auditResource       `json:"resources"`
+	Checks    map[string]auditCheck `json:"checks"`
+	// MainThreadTime is the time spent by the renderer running tasks while the page was reloaded and audited, in
+	// milliseconds, from Performance.getMetrics. It is nil when the page is not reloaded.
+	MainThreadTime *float64 `json:"-"`
+	// Issues are the codes of the issues reported by the Audits domain of the page.
+	Issues []string `json:"-"`
+}
+
+func (p *pageImpl) Audit(options ...PageAuditOptions) (*AuditReport, error) {
+	option := PageAuditOptions{}
+	if len(options) == 1 {
+		option = options[0]
+	}
+	categories := option.Categories
+	if len(categories) == 0 {
+		categories = []AuditCategory{*AuditCategoryPerformance, *AuditCategoryAccessibility, *AuditCategoryBestPractices, *AuditCategorySeo}
+	}
+	for _, category := range categories {
+		if findAuditCategory(category) < 0 {
+			return nil, fmt.Errorf("unknown audit category: %s", category)
+		}
+	}
+	session, err := p.cdpSession()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrAuditNotSupported, err)
+	}
+	// The time spent running tasks is cumulated by the renderer, so the main-thread work is the difference between
+	// the times before the reload and after the audit.
+	reload := option.Reload != nil && *option.Reload
+	var taskDuration float64
+	if reload {
+		if _, err := session.Send("Performance.enable", map[string]interface{}{}); err != nil {
+			return nil, err
+		}
+		defer func() {
+			_, _ = session.Send("Performance.disable", map[string]interface{}{})
+		}()
+		if taskDuration, err = p.taskDuration(session); err != nil {
+			return nil, err
+		}
+		if _, err := p.Reload(PageReloadOptions{Timeout: option.Timeout}); err != nil {
+			return nil, err
+		}
+	}
+	result, err := p.mainFrame.Evaluate(auditScript)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := decodeCDPValue[rawAudit](result)
+	if err != nil {
+		return nil, err
+	}
+	if reload {
+		duration, err := p.taskDuration(session)
+		if err != nil {
+			return nil, err
+		}
+		raw.MainThreadTime = Float((duration - taskDuration) * 1000)
+	}
+	if raw.Issues, err = p.inspectorIssues(); err != nil {
+		return nil, err
+	}
+	return newAuditReport(raw, categories), nil
+}
+
+// taskDuration returns the time spent by the renderer of the page running tasks, in seconds, from the Performance
+// domain, which must be enabled.
+func (p *pageImpl) taskDuration(session CDPSession) (float64, error) {
+	metrics, err := SendCDP[struct {
+		Metrics []struct {
+			Name  string  `json:"name"`
+			Value float64 `json:"value"`
+		} `json:"metrics"`
+	}](session, "Performance.getMetrics", nil)
+	if err != nil {
+		return 0, err
+	}
+	for _, metric := range metrics.Metrics {
+		if metric.Name == "TaskDuration" {
+			return metric.Value, nil
+		}
+	}
+	return 0, nil
+}
+
+// inspectorIssues returns the codes of the issues reported by the Audits domain. The domain reports the issues found
+// so far when it is enabled, so it is enabled in a new session each time.
+func (p *pageImpl) inspectorIssues() ([]string, error) {
+	session, err := p.browserContext.NewCDPSession(p)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = session.Detach()
+	}()
+	var mu sync.Mutex
+	issues := make([]string, 0)
+	OnCDPEvent(session, "Audits.issueAdded", func(event struct {
+		Issue struct {
+			Code string `json:"code"`
+		} `json:"issue"`
+	}) {
+		mu.Lock()
+		defer mu.Unlock()
+		issues = append(issues, event.Issue.Code)
+	})
+	// The issues found so far are dispatched before the response.
+	if _, err := session.Send("Audits.enable", map[string]interface{}{}); err != nil {
+		return nil, err
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	return issues, nil
+}
+
+func findAuditCategory(category AuditCategory) int {
+	for i, c := range auditCategories {
+		if *c.id == category {
+			return i
+		}
+	}
+	return -1
+}
+
+func newAuditReport(raw rawAudit, categories []AuditCategory) *AuditReport {
+	audits := raw.audits()
+	report := &AuditReport{
+		URL:        raw.URL,
+		Categories: make([]AuditCategoryResult, 0, len(categories)),
+		Audits:     make([]AuditResult, 0),
+	}
+	added := make(map[string]bool)
+	for _, id := range categories {
+		category := auditCategories[findAuditCategory(id)]
+		result := AuditCategoryResult{ID: id, Title: category.title, AuditIDs: make([]string, 0, len(category.audits))}
+		var sum, weights float64
+		for _, ref := range category.audits {
+			audit := audits[ref.id]
+			result.AuditIDs = append(result.AuditIDs, ref.id)
+			if !added[ref.id] {
+				added[ref.id] = true
+				report.Audits = append(report.Audits, audit)
+			}
+			if audit.Score != nil && ref.weight > 0 {
+				sum += *audit.Score * ref.weight
+				weights += ref.weight
+			}
+		}
+		if weights > 0 {
+			result.Score = Float(math.Round(sum/weights*100) / 100)
+		}
+		report.Categories = append(report.Categories, result)
+	}
+	return report
+}
+
+// audits returns the results of the audits by identifier.
+func (raw rawAudit) audits() map[string]AuditResult {
+	audits := make(map[string]AuditResult)
+	add := func(audit AuditResult) {
+		audit.Title = auditTitles[audit.ID]
+		if audit.Items == nil {
+			audit.Items = make([]string, 0)
+		}
+		audits[audit.ID] = audit
+	}
+	metric := func(id string, value *float64, unit string, p10, median float64) {
+		audit := AuditResult{ID: id}
+		if value != nil {
+			audit.Score = Float(logNormalScore(*value, p10, median))
+			audit.NumericValue, audit.NumericUnit, audit.DisplayValue = value, unit, formatAuditValue(*value, unit)
+		}
+		add(audit)
+	}
+	binary := func(id string, applicable, passed bool, items ...string) {
+		audit := AuditResult{ID: id}
+		if applicable {
+			audit.Score = Float(1)
+			if !passed {
+				audit.Score, audit.Items = Float(0), items
+			}
+		}
+		add(audit)
+	}
+	check := func(id string) {
+		c := raw.Checks[id]
+		binary(id, c.Count > 0, len(c.Failures) == 0, c.Failures...)
+	}
+
+	// Performance, scored on log-normal curves.
+	metric("first-contentful-paint", raw.FirstContentfulPaint, "millisecond", 1800, 3000)
+	metric("largest-contentful-paint", raw.LargestContentfulPaint, "millisecond", 2500, 4000)
+	var blockingTime *float64
+	if raw.LongTasks != nil {
+		blockingTime = Float(0)
+		for _, task := range raw.LongTasks {
+			if raw.FirstContentfulPaint == nil || task.StartTime >= *raw.FirstContentfulPaint {
+				*blockingTime += math.Max(0, task.Duration-50)
+			}
+		}
+	}
+	metric("total-blocking-time", blockingTime, "millisecond", 200, 600)
+	metric("cumulative-layout-shift", raw.CumulativeLayoutShift, "unitless", 0.1, 0.25)
+	serverResponseTime := AuditResult{ID: "server-response-time"}
+	if raw.ServerResponseTime != nil {
+		serverResponseTime.Score = Float(0)
+		if *raw.ServerResponseTime < 600 {
+			serverResponseTime.Score = Float(1)
+		}
+		serverResponseTime.NumericValue, serverResponseTime.NumericUnit = raw.ServerResponseTime, "millisecond"
+		serverResponseTime.DisplayValue = formatAuditValue(*raw.ServerResponseTime, "millisecond")
+	}
+	add(serverResponseTime)
+	var byteWeight float64
+	resources := append([]auditResource(nil), raw.Resources...)
+	for _, resource := range resources {
+		byteWeight += resource.TransferSize
+	}
+	metric("total-byte-weight", &byteWeight, "byte", 2667*1024, 4000*1024)
+	if audit := audits["total-byte-weight"]; byteWeight > 0 {
+		sort.SliceStable(resources, func(i, j int) bool { return resources[i].TransferSize > resources[j].TransferSize })
+		for _, resource := range resources {
+			if len(audit.Items) == 10 || resource.TransferSize == 0 {
+				break
+			}
+			audit.Items = append(audit.Items, fmt.Sprintf("%s (%s)", resource.URL, formatAuditValue(resource.TransferSize, "byte")))
+		}
+		audits[audit.ID] = audit
+	}
+	domSize := float64(raw.DomSize)
+	metric("dom-size", &domSize, "element", 818, 1400)
+	metric("mainthread-work-breakdown", raw.MainThreadTime, "millisecond", 2017, 4000)
+
+	// Accessibility.
+	check("image-alt")
+	check("button-name")
+	check("link-name")
+	check("label")
+	binary("document-title", true, raw.Title != "")
+	binary("html-has-lang", true, strings.TrimSpace(raw.Lang) != "")
+	if raw.Viewport != nil {
+		binary("meta-viewport", true, isScalableViewport(*raw.Viewport), *raw.Viewport)
+	} else {
+		binary("meta-viewport", false, true)
+	}
+
+	// Best practices.
+	page, _ := url.Parse(raw.URL)
+	if page != nil && (page.Scheme == "http" || page.Scheme == "https") {
+		insecure := make([]string, 0)
+		for _, resource := range raw.Resources {
+			if u, err := url.Parse(resource.URL); err == nil && u.Scheme == "http" && !isLocalhost(u.Hostname()) {
+				insecure = append(insecure, resource.URL)
+			}
+		}
+		binary("is-on-https", true, len(insecure) == 0, insecure...)
+	} else {
+		binary("is-on-https", false, true)
+	}
+	binary("doctype", true, strings.EqualFold(raw.Doctype, "html") && !raw.QuirksMode)
+	check("image-aspect-ratio")
+	binary("inspector-issues", true, len(raw.Issues) == 0, countAuditItems(raw.Issues)...)
+
+	// SEO.
+	binary("viewport", true, raw.Viewport != nil && isMobileViewport(*raw.Viewport))
+	binary("meta-description", true, raw.Description != nil && strings.TrimSpace(*raw.Description) != "")
+	binary("http-status-code", raw.Status > 0, raw.Status < 400, fmt.Sprint(raw.Status))
+	blocking := make([]string, 0)
+	for _, robots := range raw.Robots {
+		for _, directive := range strings.Split(strings.ToLower(robots), ",") {
+			if directive = strings.TrimSpace(directive); directive == "noindex" || directive == "none" {
+				blocking = append(blocking, robots)
+				break
+			}
+		}
+	}
+	binary("is-crawlable", true, len(blocking) == 0, blocking...)
+	check("link-text")
+	check("crawlable-anchors")
+	canonicals := countAuditItems(raw.Canonicals)
+	validCanonicals := len(canonicals) == 1
+	for _, canonical := range raw.Canonicals {
+		if u, err := url.Parse(canonical); err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			validCanonicals = false
+		}
+	}
+	binary("canonical", len(raw.Canonicals) > 0, validCanonicals, canonicals...)
+	fontSize := AuditResult{ID: "font-size"}
+	if raw.FontSize.Total > 0 {
+		legible := float64(raw.FontSize.Legible) / float64(raw.FontSize.Total) * 100
+		fontSize.Score = Float(0)
+		if legible >= 60 {
+			fontSize.Score = Float(1)
+		}
+		fontSize.NumericValue, fontSize.NumericUnit = Float(legible), "unitless"
+		fontSize.DisplayValue = fmt.Sprintf("%.0f%% legible text", legible)
+	}
+	add(fontSize)
+	return audits
+}
+
+// logNormalScore returns the score of value on the log-normal curve scoring p10 0.9 and median 0.5.
+func logNormalScore(value, p10, median float64) float64 {
+	if value <= 0 {
+		return 1
+	}
+	// 1.2815515655446004 is the quantile of the standard normal distribution at 0.9.
+	sigma := (math.Log(median) - math.Log(p10)) / 1.2815515655446004
+	score := 0.5 * math.Erfc((math.Log(value)-math.Log(median))/(sigma*math.Sqrt2))
+	return math.Round(score*100) / 100
+}
+
+func formatAuditValue(value float64, unit string) string {
+	switch unit {
+	case "millisecond":
+		if value >= 1000 {
+			return fmt.Sprintf("%.1f s", value/1000)
+		}
+		return fmt.Sprintf("%.0f ms", value)
+	case "byte":
+		return fmt.Sprintf("%.0f KiB", value/1024)
+	case "element":
+		return fmt.Sprintf("%.0f elements", value)
+	}
+	return fmt.Sprintf("%.3f", value)
+}
+
+// viewportProperties returns the properties of the content of a viewport meta tag, in lower case.
+func viewportProperties(content string) map[string]string {
+	properties := make(map[string]string)
+	for _, property := range strings.FieldsFunc(strings.ToLower(content), func(r rune) bool { return r == ',' || r == ';' }) {
+		key, value, _ := strings.Cut(property, "=")
+		properties[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return properties
+}
+
+func isMobileViewport(content string) bool {
+	properties := viewportProperties(content)
+	_, width := properties["width"]
+	_, scale := properties["initial-scale"]
+	return width || scale
+}
+
+func isScalableViewport(content string) bool {
+	properties := viewportProperties(content)
+	if scalable, ok := properties["user-scalable"]; ok && (scalable == "no" || scalable == "0") {
+		return false
+	}
+	if maximum, ok := properties["maximum-scale"]; ok {
+		var scale float64
+		if _, err := fmt.Sscanf(maximum, "%g", &scale); err == nil && scale < 5 {
+			return false
+		}
+	}
+	return true
+}
+
+func isLocalhost(host string) bool {
+	return host == "localhost" || strings.HasSuffix(host, ".localhost") || host == "127.0.0.1" || host == "::1"
+}
+
+// countAuditItems returns the distinct items, sorted, with their count when repeated.
+func countAuditItems(items []string) []string {
+	counts := make(map[string]int)
+	for _, item := range items {
+		counts[item]++
+	}
+	counted := make([]string, 0, len(counts))
+	for item, count := range counts {
+		if count > 1 {
+			item = fmt.Sprintf("%s (%d)", item, count)
+		}
+		counted = append(counted, item)
+	}
+	sort.Strings(counted)
+	return counted
+}
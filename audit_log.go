@@ -0,0 +1,213 @@
+package playwright
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// auditLogArchiveEntry is the name of the audit log in the traces of a context with an audit log.
+const auditLogArchiveEntry = "audit-log.jsonl"
+
+// AuditLogEntry is an action performed in a context, see [BrowserContext.AuditLog].
+type AuditLogEntry struct {
+	// Time the action was sent to the browser.
+	Time time.Time `json:"time"`
+	// Duration of the action, until its result was received. It is zero while the action is in progress, and for
+	// actions without result. In JSON, it is in nanoseconds.
+	Duration time.Duration `json:"duration"`
+	// API called, such as `Locator.Click`.
+	Action string `json:"action"`
+	// Protocol method of the action, such as `click`.
+	Method string `json:"method"`
+	// Selector of the element of the action, if any.
+	Selector string `json:"selector,omitempty"`
+	// URL of the action, such as the URL of a navigation, or the URL of the page of the action when it was sent.
+	URL string `json:"url,omitempty"`
+	// ID of the goroutine that performed the action.
+	Goroutine int64 `json:"goroutine"`
+	// Stack of the caller of the action, a `function (file:line)` frame per line, innermost first.
+	Stack []string `json:"stack,omitempty"`
+	// Error of the action, if it failed.
+	Error string `json:"error,omitempty"`
+	// Whether the action is still in progress.
+	Pending bool `json:"pending,omitempty"`
+}
+
+// auditLog is the audit log of a context, enabled with [BrowserNewContextOptions.AuditLog].
+type auditLog struct {
+	sync.Mutex
+	entries []*AuditLogEntry
+}
+
+// auditLogEntry is an entry of an audit log, completed when the result of its action is received.
+type auditLogEntry struct {
+	log   *auditLog
+	entry *AuditLogEntry
+}
+
+func (a *auditLogEntry) finish(err error) {
+	a.log.Lock()
+	defer a.log.Unlock()
+	a.entry.Duration = time.Since(a.entry.Time)
+	a.entry.Pending = false
+	if err != nil {
+		a.entry.Error = Redact(err.Error())
+	}
+}
+
+// startAuditLogEntry records the action sent to object in the audit log of its context, if it has one. Only the
+// actions called by users are recorded, not the calls made by the package to implement them.
+func startAuditLogEntry(object *channelOwner, method string, params interface{}, metadata map[string]interface{}, stack []map[string]interface{}) *auditLogEntry {
+	apiName, _ := metadata["apiName"].(string)
+	if apiName == "" {
+		return nil
+	}
+	var context *browserContextImpl
+	var page *pageImpl
+	for o := object; o != nil && context == nil; o = o.parent {
+		switch v := o.channel.object.(type) {
+		case *pageImpl:
+			if page == nil {
+				page = v
+			}
+		case *browserContextImpl:
+			context = v
+		}
+	}
+	if context == nil || context.auditLog == nil {
+		return nil
+	}
+	entry := &AuditLogEntry{
+		Time:      time.Now(),
+		Action:    apiName,
+		Method:    method,
+		Goroutine: currentGoroutineID(),
+		Pending:   true,
+	}
+	if params, ok := params.(map[string]interface{}); ok {
+		if selector, ok := params["selector"].(string); ok {
+			entry.Selector = Redact(selector)
+		}
+		if url, ok := params["url"].(string); ok {
+			entry.URL = Redact(url)
+		}
+	}
+	if entry.URL == "" && page != nil {
+		entry.URL = Redact(page.mainFrame.URL())
+	}
+	for _, frame := range stack {
+		entry.Stack = append(entry.Stack, fmt.Sprintf("%v (%v:%v)", frame["function"], frame["file"], frame["line"]))
+	}
+	context.auditLog.Lock()
+	defer context.auditLog.Unlock()
+	context.auditLog.entries = append(context.auditLog.entries, entry)
+	return &auditLogEntry{log: context.auditLog, entry: entry}
+}
+
+// currentGoroutineID returns the ID of the calling goroutine, from the header of its stack: `goroutine 42 [running]:`.
+func currentGoroutineID() int64 {
+	buf := make([]byte, 64)
+	buf = buf[:runtime.Stack(buf, false)]
+	fields := strings.Fields(strings.TrimPrefix(string(buf), "goroutine "))
+	if len(fields) == 0 {
+		return 0
+	}
+	id, _ := strconv.ParseInt(fields[0], 10, 64)
+	return id
+}
+
+func (b *browserContextImpl) AuditLog() []AuditLogEntry {
+	entries := make([]AuditLogEntry, 0)
+	if b.auditLog == nil {
+		return entries
+	}
+	b.auditLog.Lock()
+	defer b.auditLog.Unlock()
+	for _, entry := range b.auditLog.entries {
+		e := *entry
+		e.Stack = append([]string(nil), entry.Stack...)
+		entries = append(entries, e)
+	}
+	return entries
+}
+
+func (b *browserContextImpl) ExportAuditLog(path string) error {
+	if b.auditLog == nil {
+		return errors.New("the context has no audit log, see BrowserNewContextOptions.AuditLog")
+	}
+	data, err := marshalAuditLog(b.AuditLog())
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// marshalAuditLog returns the entries as JSON Lines, an entry per line.
+func marshalAuditLog(entries []AuditLogEntry) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	for _, entry := range entries {
+		if err := encoder.Encode(entry); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// attachAuditLog adds the audit log of the context to the trace saved at path, if the context has one.
+func (b *browserContextImpl) attachAuditLog(path string) error {
+	if b == nil || b.auditLog == nil {
+		return nil
+	}
+	data, err := marshalAuditLog(b.AuditLog())
+	if err != nil {
+		return err
+	}
+	if err := appendToArchive(path, auditLogArchiveEntry, data); err != nil {
+		return fmt.Errorf("could not attach audit log to %s: %w", path, err)
+	}
+	return nil
+}
+
+// appendToArchive adds an entry to the zip archive at path, replacing the entry with the same name, if any.
+func appendToArchive(path string, name string, content []byte) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return err
+	}
+	var out bytes.Buffer
+	writer := zip.NewWriter(&out)
+	for _, file := range reader.File {
+		if file.Name == name {
+			continue
+		}
+		if err := writer.Copy(file); err != nil {
+			return err
+		}
+	}
+	w, err := writer.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: time.Now()})
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(content); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	return os.WriteFile(path, out.Bytes(), 0o644)
+}
@@ -0,0 +1,105 @@
+package playwright
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStartAuditLogEntry(t *testing.T) {
+	context := &browserContextImpl{auditLog: &auditLog{}}
+	context.channel = &channel{object: context}
+	page := &pageImpl{mainFrame: &frameImpl{url: "https://example.com/"}}
+	page.channel = &channel{object: page}
+	page.parent = &context.channelOwner
+	frame := &frameImpl{}
+	frame.channel = &channel{object: frame}
+	frame.parent = &page.channelOwner
+
+	stack := []map[string]interface{}{{"file": "main.go", "line": 12, "function": "main.run"}}
+	// Calls made by the package are not recorded.
+	require.Nil(t, startAuditLogEntry(&frame.channelOwner, "click", map[string]interface{}{}, map[string]interface{}{"apiName": ""}, stack))
+
+	click := startAuditLogEntry(&frame.channelOwner, "click", map[string]interface{}{"selector": "#submit"},
+		map[string]interface{}{"apiName": "Locator.Click"}, stack)
+	require.NotNil(t, click)
+	navigation := startAuditLogEntry(&frame.channelOwner, "goto", map[string]interface{}{"url": "https://example.com/next"},
+		map[string]interface{}{"apiName": "Page.Goto"}, nil)
+	require.NotNil(t, navigation)
+
+	entries := context.AuditLog()
+	require.Len(t, entries, 2)
+	require.Equal(t, "Locator.Click", entries[0].Action)
+	require.Equal(t, "click", entries[0].Method)
+	require.Equal(t, "#submit", entries[0].Selector)
+	require.Equal(t, "https://example.com/", entries[0].URL)
+	require.Equal(t, currentGoroutineID(), entries[0].Goroutine)
+	require.Equal(t, []string{"main.run (main.go:12)"}, entries[0].Stack)
+	require.True(t, entries[0].Pending)
+	require.Equal(t, "https://example.com/next", entries[1].URL)
+
+	click.finish(nil)
+	navigation.finish(errors.New("net::ERR_ABORTED"))
+	entries = context.AuditLog()
+	require.False(t, entries[0].Pending)
+	require.Empty(t, entries[0].Error)
+	require.Equal(t, "net::ERR_ABORTED", entries[1].Error)
+
+	// Contexts without audit log record nothing.
+	context.auditLog = nil
+	require.Nil(t, startAuditLogEntry(&frame.channelOwner, "click", nil, map[string]interface{}{"apiName": "Locator.Click"}, nil))
+	require.Empty(t, context.AuditLog())
+	require.Error(t, context.ExportAuditLog(filepath.Join(t.TempDir(), "audit.jsonl")))
+}
+
+func TestCurrentGoroutineID(t *testing.T) {
+	id := currentGoroutineID()
+	require.Positive(t, id)
+	other := make(chan int64)
+	go func() {
+		other <- currentGoroutineID()
+	}()
+	require.NotEqual(t, id, <-other)
+}
+
+func TestAttachAuditLog(t *testing.T) {
+	context := &browserContextImpl{auditLog: &auditLog{}}
+	context.auditLog.entries = append(context.auditLog.entries, &AuditLogEntry{Action: "Page.Goto", Method: "goto"})
+
+	path := filepath.Join(t.TempDir(), "trace.zip")
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	w, err := writer.Create("trace.trace")
+	require.NoError(t, err)
+	_, err = w.Write([]byte("{}\n"))
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0o644))
+
+	require.NoError(t, context.attachAuditLog(path))
+	// Attaching again replaces the audit log.
+	require.NoError(t, context.attachAuditLog(path))
+
+	reader, err := zip.OpenReader(path)
+	require.NoError(t, err)
+	defer reader.Close()
+	names := make([]string, 0)
+	for _, file := range reader.File {
+		names = append(names, file.Name)
+	}
+	require.Equal(t, []string{"trace.trace", auditLogArchiveEntry}, names)
+	file, err := reader.Open(auditLogArchiveEntry)
+	require.NoError(t, err)
+	content, err := io.ReadAll(file)
+	require.NoError(t, err)
+	var entry AuditLogEntry
+	require.NoError(t, json.Unmarshal(content, &entry))
+	require.Equal(t, "Page.Goto", entry.Action)
+}
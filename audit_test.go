@@ -0,0 +1,93 @@
+package playwright
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogNormalScore(t *testing.T) {
+	require.Equal(t, 1.0, logNormalScore(0, 1800, 3000))
+	require.Equal(t, 0.9, logNormalScore(1800, 1800, 3000))
+	require.Equal(t, 0.5, logNormalScore(3000, 1800, 3000))
+	require.Less(t, logNormalScore(10000, 1800, 3000), 0.05)
+	require.Greater(t, logNormalScore(900, 1800, 3000), 0.99)
+}
+
+func TestViewportAudits(t *testing.T) {
+	require.True(t, isMobileViewport("width=device-width, initial-scale=1"))
+	require.True(t, isMobileViewport("initial-scale=1"))
+	require.False(t, isMobileViewport("user-scalable=yes"))
+	require.True(t, isScalableViewport("width=device-width, maximum-scale=5"))
+	require.False(t, isScalableViewport("width=device-width, user-scalable=no"))
+	require.False(t, isScalableViewport("width=device-width; maximum-scale=1.0"))
+}
+
+func TestNewAuditReport(t *testing.T) {
+	raw := rawAudit{
+		URL:        "https://example.com/",
+		Title:      "Example",
+		Lang:       "en",
+		Doctype:    "html",
+		Viewport:   String("width=device-width, user-scalable=no"),
+		Robots:     []string{"noindex, nofollow"},
+		Canonicals: []string{"https://example.com/", "https://example.com/"},
+		DomSize:    100,
+		Status:     200,
+		Resources:  []auditResource{{URL: "https://example.com/", TransferSize: 2048}, {URL: "http://cdn.example.com/a.js", TransferSize: 1024}},
+		Issues:     []string{"DeprecationIssue", "DeprecationIssue", "CookieIssue"},
+		Checks: map[string]auditCheck{
+			"image-alt": {Count: 2, Failures: []string{`<img src="a.png">`}},
+			"link-name": {Count: 1, Failures: []string{}},
+		},
+		FirstContentfulPaint: Float(1800),
+	}
+	raw.FontSize.Legible, raw.FontSize.Total = 80, 100
+	report := newAuditReport(raw, []AuditCategory{*AuditCategoryPerformance, *AuditCategoryAccessibility, *AuditCategoryBestPractices, *AuditCategorySeo})
+	require.Equal(t, "https://example.com/", report.URL)
+	require.Len(t, report.Categories, 4)
+
+	// Only the first contentful paint is measured among the weighted performance audits.
+	require.Equal(t, 0.9, *report.Category(*AuditCategoryPerformance).Score)
+	require.Nil(t, report.Audit("largest-contentful-paint").Score)
+	require.Nil(t, report.Audit("total-blocking-time").Score)
+	require.Equal(t, "1.8 s", report.Audit("first-contentful-paint").DisplayValue)
+	require.Equal(t, []string{"https://example.com/ (2 KiB)", "http://cdn.example.com/a.js (1 KiB)"}, report.Audit("total-byte-weight").Items)
+
+	imageAlt := report.Audit("image-alt")
+	require.Equal(t, 0.0, *imageAlt.Score)
+	require.Equal(t, []string{`<img src="a.png">`}, imageAlt.Items)
+	require.Equal(t, 1.0, *report.Audit("link-name").Score)
+	require.Nil(t, report.Audit("button-name").Score)
+	require.False(t, report.Audit("meta-viewport").Passed())
+	// image-alt, meta-viewport fail, link-name, document-title, html-has-lang pass.
+	require.Equal(t, 0.6, *report.Category(*AuditCategoryAccessibility).Score)
+
+	require.Equal(t, []string{"http://cdn.example.com/a.js"}, report.Audit("is-on-https").Items)
+	require.Equal(t, []string{"CookieIssue", "DeprecationIssue (2)"}, report.Audit("inspector-issues").Items)
+	require.True(t, report.Audit("doctype").Passed())
+
+	require.Equal(t, []string{"noindex, nofollow"}, report.Audit("is-crawlable").Items)
+	require.True(t, report.Audit("canonical").Passed())
+	require.False(t, report.Audit("meta-description").Passed())
+	require.True(t, report.Audit("viewport").Passed())
+	require.Equal(t, "80% legible text", report.Audit("font-size").DisplayValue)
+	require.Contains(t, report.Category(*AuditCategorySeo).AuditIDs, "image-alt")
+
+	// Audits shared by categories are reported once.
+	ids := make(map[string]bool)
+	for _, audit := range report.Audits {
+		require.False(t, ids[audit.ID], audit.ID)
+		ids[audit.ID] = true
+		require.NotEmpty(t, audit.Title, audit.ID)
+	}
+	failed := make([]string, 0)
+	for _, audit := range report.Failed() {
+		failed = append(failed, audit.ID)
+	}
+	require.ElementsMatch(t, []string{"image-alt", "meta-viewport", "is-on-https", "inspector-issues", "meta-description", "is-crawlable"}, failed)
+
+	report = newAuditReport(raw, []AuditCategory{*AuditCategorySeo})
+	require.Len(t, report.Categories, 1)
+	require.Nil(t, report.Audit("first-contentful-paint"))
+}
@@ -0,0 +1,133 @@
+package playwright
+
+import (
+	"sync"
+)
+
+// BandwidthUsage is the network traffic of requests, headers included, see [Page.Bandwidth].
+type BandwidthUsage struct {
+	// Number of finished or failed requests.
+	Requests int
+	// Bytes sent in the requests.
+	BytesSent int64
+	// Bytes received in the responses.
+	BytesReceived int64
+}
+
+func (u *BandwidthUsage) add(sizes *RequestSizesResult) {
+	u.Requests++
+	u.BytesSent += int64(sizes.RequestHeadersSize + sizes.RequestBodySize)
+	u.BytesReceived += int64(sizes.ResponseHeadersSize + sizes.ResponseBodySize)
+}
+
+// PageBandwidth is the network traffic of a page over its lifetime, see [Page.Bandwidth].
+type PageBandwidth struct {
+	// Traffic of all the requests of the page.
+	Total BandwidthUsage
+	// Traffic of the requests of the page by resource type, such as `document` or `image`, see
+	// [Request.ResourceType].
+	ByResourceType map[string]BandwidthUsage
+}
+
+// pageBandwidth is the network traffic of a page, recorded when its context has
+// [BrowserNewContextOptions.RecordBandwidth].
+type pageBandwidth struct {
+	sync.Mutex
+	total          BandwidthUsage
+	byResourceType map[string]BandwidthUsage
+	// pending is the number of requests whose sizes are being fetched, idle is signaled when it drops to zero.
+	pending int
+	idle    *sync.Cond
+}
+
+func (p *pageBandwidth) startLookup() {
+	p.Lock()
+	defer p.Unlock()
+	p.pending++
+}
+
+// finishLookup ends the lookup of the sizes of a request, and adds them unless they could not be fetched.
+func (p *pageBandwidth) finishLookup(resourceType string, sizes *RequestSizesResult) {
+	p.Lock()
+	defer p.Unlock()
+	p.pending--
+	if p.pending == 0 && p.idle != nil {
+		p.idle.Broadcast()
+	}
+	if sizes == nil {
+		return
+	}
+	p.total.add(sizes)
+	if p.byResourceType == nil {
+		p.byResourceType = make(map[string]BandwidthUsage)
+	}
+	usage := p.byResourceType[resourceType]
+	usage.add(sizes)
+	p.byResourceType[resourceType] = usage
+}
+
+func (p *pageImpl) Bandwidth() PageBandwidth {
+	p.bandwidth.Lock()
+	defer p.bandwidth.Unlock()
+	if p.bandwidth.idle == nil {
+		p.bandwidth.idle = sync.NewCond(&p.bandwidth.Mutex)
+	}
+	for p.bandwidth.pending > 0 {
+		p.bandwidth.idle.Wait()
+	}
+	bandwidth := PageBandwidth{Total: p.bandwidth.total, ByResourceType: make(map[string]BandwidthUsage)}
+	for resourceType, usage := range p.bandwidth.byResourceType {
+		bandwidth.ByResourceType[resourceType] = usage
+	}
+	return bandwidth
+}
+
+// trackRequestSizes counts the traffic of the finished and failed requests of the context in its network budget and
+// in the bandwidth of their pages.
+func (b *browserContextImpl) trackRequestSizes() {
+	b.On("requestfinished", b.onRequestDone)
+	b.On("requestfailed", b.onRequestDone)
+}
+
+func (b *browserContextImpl) onRequestDone(request Request) {
+	var page *pageImpl
+	if b.recordBandwidth {
+		page = request.(*requestImpl).safePage()
+	}
+	if page != nil {
+		// The lookup is pending from now on, so that the bandwidth read once the request is done includes it.
+		page.bandwidth.startLookup()
+	}
+	offDispatcher(func() {
+		ret, err := b.connection.WrapAPICall(func() (interface{}, error) {
+			return requestSizes(request)
+		}, true)
+		var sizes *RequestSizesResult
+		if err == nil {
+			sizes = ret.(*RequestSizesResult)
+			if b.networkBudget != nil {
+				b.networkBudget.addBytes(int64(sizes.ResponseHeadersSize + sizes.ResponseBodySize))
+			}
+		}
+		if page != nil {
+			page.bandwidth.finishLookup(request.ResourceType(), sizes)
+		}
+	})
+}
+
+// requestSizes returns the sizes of a finished or failed request. Only the body is counted for the requests that failed
+// before they received a response, whose headers are unknown.
+func requestSizes(request Request) (*RequestSizesResult, error) {
+	response, err := request.Response()
+	if err != nil {
+		return nil, err
+	}
+	if response == nil {
+		body, err := request.PostDataBuffer()
+		if err != nil {
+			return nil, err
+		}
+		return &RequestSizesResult{RequestBodySize: len(body)}, nil
+	}
+	return request.Sizes()
+}
@@ -0,0 +1,53 @@
+package playwright
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageBandwidth(t *testing.T) {
+	page := &pageImpl{}
+	require.Equal(t, PageBandwidth{ByResourceType: map[string]BandwidthUsage{}}, page.Bandwidth())
+
+	for _, request := range []struct {
+		resourceType string
+		sizes        *RequestSizesResult
+	}{
+		{"document", &RequestSizesResult{RequestHeadersSize: 100, ResponseHeadersSize: 200, ResponseBodySize: 1000}},
+		{"fetch", &RequestSizesResult{RequestHeadersSize: 80, RequestBodySize: 20, ResponseHeadersSize: 150, ResponseBodySize: 50}},
+		{"fetch", &RequestSizesResult{RequestHeadersSize: 80, ResponseHeadersSize: 150}},
+		{"image", nil},
+	} {
+		page.bandwidth.startLookup()
+		page.bandwidth.finishLookup(request.resourceType, request.sizes)
+	}
+
+	bandwidth := page.Bandwidth()
+	require.Equal(t, BandwidthUsage{Requests: 3, BytesSent: 280, BytesReceived: 1550}, bandwidth.Total)
+	require.Equal(t, map[string]BandwidthUsage{
+		"document": {Requests: 1, BytesSent: 100, BytesReceived: 1200},
+		"fetch":    {Requests: 2, BytesSent: 180, BytesReceived: 350},
+	}, bandwidth.ByResourceType)
+
+	// The result is a copy.
+	bandwidth.ByResourceType["image"] = BandwidthUsage{Requests: 1}
+	require.NotContains(t, page.Bandwidth().ByResourceType, "image")
+}
+
+func TestPageBandwidthShouldWaitForPendingLookups(t *testing.T) {
+	page := &pageImpl{}
+	page.bandwidth.startLookup()
+	done := make(chan PageBandwidth)
+	go func() {
+		done <- page.Bandwidth()
+	}()
+	select {
+	case <-done:
+		t.Fatal("the bandwidth was read before the lookup finished")
+	case <-time.After(50 * time.Millisecond):
+	}
+	page.bandwidth.finishLookup("fetch", &RequestSizesResult{RequestBodySize: 10})
+	require.Equal(t, BandwidthUsage{Requests: 1, BytesSent: 10}, (<-done).Total)
+}
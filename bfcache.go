@@ -0,0 +1,139 @@
+package playwright
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrBFCacheNotSupported is returned when the back/forward cache is inspected in a browser other than Chromium.
+	ErrBFCacheNotSupported = errors.New("back/forward cache inspection is only supported in Chromium")
+	// ErrNotRestoredFromBFCache is returned by [BFCacheReport.Err] when the page was not restored from the back/forward
+	// cache.
+	ErrNotRestoredFromBFCache = errors.New("page was not restored from the back/forward cache")
+)
+
+// bfcacheNotUsedGracePeriod is how long the reasons why the back/forward cache was not used are awaited once the
+// navigation committed, as Chromium may report them right after.
+const bfcacheNotUsedGracePeriod = 500 * time.Millisecond
+
+// BFCacheNotRestoredReason is a reason why a page was not restored from the back/forward cache.
+type BFCacheNotRestoredReason struct {
+	// Type of the reason: `SupportPending` if the browser does not support the feature of the page yet,
+	// `PageSupportNeeded` if the page can be fixed, or `Circumstantial`.
+	Type string
+	// Reason, such as `UnloadHandlerExistsInMainFrame` or `BackForwardCacheDisabled`.
+	Reason string
+	// Context of the reason, such as the URL of the frame which caused it, if any.
+	Context string
+}
+
+// BFCacheReport is the result of a history navigation of a page, see [Page.ExpectBFCacheNavigation].
+type BFCacheReport struct {
+	// Whether the page was restored from the back/forward cache.
+	Restored bool
+	// URL of the page navigated to.
+	URL string
+	// Reasons why the page was not restored from the back/forward cache, if any.
+	NotRestoredReasons []BFCacheNotRestoredReason
+}
+
+// Err returns an error wrapping [ErrNotRestoredFromBFCache] with the reasons if the page was not restored from the
+// back/forward cache, and nil otherwise.
+func (r *BFCacheReport) Err() error {
+	if r.Restored {
+		return nil
+	}
+	reasons := make([]string, 0, len(r.NotRestoredReasons))
+	for _, reason := range r.NotRestoredReasons {
+		reasons = append(reasons, reason.Reason)
+	}
+	if len(reasons) == 0 {
+		return fmt.Errorf("%w: %s", ErrNotRestoredFromBFCache, r.URL)
+	}
+	return fmt.Errorf("%w: %s: %s", ErrNotRestoredFromBFCache, r.URL, strings.Join(reasons, ", "))
+}
+
+// parseBFCacheNotRestoredReasons returns the reasons of a `Page.backForwardCacheNotUsed` event of the DevTools
+// protocol.
+func parseBFCacheNotRestoredReasons(params map[string]interface{}) []BFCacheNotRestoredReason {
+	reasons := make([]BFCacheNotRestoredReason, 0)
+	explanations, _ := params["notRestoredExplanations"].([]interface{})
+	for _, e := range explanations {
+		explanation, ok := e.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		reason := BFCacheNotRestoredReason{}
+		reason.Type, _ = explanation["type"].(string)
+		reason.Reason, _ = explanation["reason"].(string)
+		reason.Context, _ = explanation["context"].(string)
+		reasons = append(reasons, reason)
+	}
+	return reasons
+}
+
+func (p *pageImpl) ExpectBFCacheNavigation(cb func() error, options ...PageExpectBFCacheNavigationOptions) (*BFCacheReport, error) {
+	session, err := p.cdpSession()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrBFCacheNotSupported, err)
+	}
+	var mu sync.Mutex
+	notUsed := make(map[string][]BFCacheNotRestoredReason)
+	notUsedReceived := make(chan struct{}, 1)
+	onNotUsed := func(params map[string]interface{}) {
+		frameID, _ := params["frameId"].(string)
+		mu.Lock()
+		notUsed[frameID] = parseBFCacheNotRestoredReasons(params)
+		mu.Unlock()
+		select {
+		case notUsedReceived <- struct{}{}:
+		default:
+		}
+	}
+	session.On("Page.backForwardCacheNotUsed", onNotUsed)
+	defer session.RemoveListener("Page.backForwardCacheNotUsed", onNotUsed)
+
+	timeout := p.timeoutSettings.NavigationTimeout()
+	if len(options) == 1 && options[0].Timeout != nil {
+		timeout = *options[0].Timeout
+	}
+	w := newWaiter().WithTimeout(timeout)
+	w.RejectOnEvent(p, "close", p.closeErrorWithReason())
+	w.RejectOnEvent(p, "crash", errors.New("navigation failed because page crashed"))
+	ret, err := w.WaitForEvent(session, "Page.frameNavigated", func(params map[string]interface{}) bool {
+		frame, _ := params["frame"].(map[string]interface{})
+		_, hasParent := frame["parentId"]
+		return frame != nil && !hasParent
+	}).RunAndWait(cb)
+	if err != nil {
+		return nil, err
+	}
+	params := ret.(map[string]interface{})
+	frame := params["frame"].(map[string]interface{})
+	report := &BFCacheReport{NotRestoredReasons: make([]BFCacheNotRestoredReason, 0)}
+	report.URL, _ = frame["url"].(string)
+	report.Restored = params["type"] == "BackForwardCacheRestore"
+	if report.Restored {
+		return report, nil
+	}
+	frameID, _ := frame["id"].(string)
+	deadline := time.After(bfcacheNotUsedGracePeriod)
+	for {
+		mu.Lock()
+		reasons, ok := notUsed[frameID]
+		mu.Unlock()
+		if ok {
+			report.NotRestoredReasons = reasons
+			return report, nil
+		}
+		select {
+		case <-notUsedReceived:
+		case <-deadline:
+			return report, nil
+		}
+	}
+}
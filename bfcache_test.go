@@ -0,0 +1,29 @@
+package playwright
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBFCacheReportErr(t *testing.T) {
+	reasons := parseBFCacheNotRestoredReasons(map[string]interface{}{
+		"frameId": "main",
+		"notRestoredExplanations": []interface{}{
+			map[string]interface{}{"type": "PageSupportNeeded", "reason": "UnloadHandlerExistsInMainFrame"},
+			map[string]interface{}{"type": "Circumstantial", "reason": "BackForwardCacheDisabled", "context": "https://example.com/"},
+		},
+	})
+	require.Equal(t, []BFCacheNotRestoredReason{
+		{Type: "PageSupportNeeded", Reason: "UnloadHandlerExistsInMainFrame"},
+		{Type: "Circumstantial", Reason: "BackForwardCacheDisabled", Context: "https://example.com/"},
+	}, reasons)
+
+	report := &BFCacheReport{URL: "https://example.com/", NotRestoredReasons: reasons}
+	err := report.Err()
+	require.ErrorIs(t, err, ErrNotRestoredFromBFCache)
+	require.ErrorContains(t, err, "https://example.com/: UnloadHandlerExistsInMainFrame, BackForwardCacheDisabled")
+	require.ErrorIs(t, (&BFCacheReport{URL: "https://example.com/"}).Err(), ErrNotRestoredFromBFCache)
+	require.NoError(t, (&BFCacheReport{Restored: true}).Err())
+	require.Empty(t, parseBFCacheNotRestoredReasons(map[string]interface{}{}))
+}
@@ -24,11 +24,7 @@ type BindingCallFunction func(source *BindingSource, args ...interface{}) interf
 func (b *bindingCallImpl) Call(f BindingCallFunction) {
 	defer func() {
 		if r := recover(); r != nil {
-			if _, err := b.channel.Send("reject", map[string]interface{}{
-				"error": serializeError(r.(error)),
-			}); err != nil {
-				logger.Printf("could not reject BindingCall: %v\n", err)
-			}
+			b.reject(r.(error))
 		}
 	}()
 
@@ -57,6 +53,15 @@ func (b *bindingCallImpl) Call(f BindingCallFunction) {
 	}
 }
 
+// reject fails the call in the page with err.
+func (b *bindingCallImpl) reject(err error) {
+	if _, err := b.channel.Send("reject", map[string]interface{}{
+		"error": serializeError(err),
+	}); err != nil {
+		logger.Printf("could not reject BindingCall: %v\n", err)
+	}
+}
+
 func newBindingCall(parent *channelOwner, objectType string, guid string, initializer map[string]interface{}) *bindingCallImpl {
 	bt := &bindingCallImpl{}
 	bt.createChannelOwner(bt, parent, objectType, guid, initializer)
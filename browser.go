@@ -6,16 +6,22 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sync"
 )
 
 type browserImpl struct {
 	channelOwner
 	isConnected                  bool
+	closeWasCalled               bool
 	shouldCloseConnectionOnClose bool
 	contexts                     []BrowserContext
 	browserType                  BrowserType
 	chromiumTracingPath          *string
 	closeReason                  *string
+	// sharedSession is the DevTools session of the browser used by the library, see [browserImpl.browserSession].
+	// browserSessionMu also guards the cdpContextID of the contexts.
+	sharedSession    CDPSession
+	browserSessionMu sync.Mutex
 }
 
 func (b *browserImpl) BrowserType() BrowserType {
@@ -59,11 +65,21 @@ func (b *browserImpl) NewContext(options ...BrowserNewContextOptions) (BrowserCo
 		options[0].StorageState = storageState
 		options[0].StorageStatePath = nil
 	}
+	// The driver drops the partition keys of the cookies, which are added once the context is created.
+	var partitionedCookies []OptionalCookie
+	if len(options) == 1 && options[0].StorageState != nil {
+		state := *options[0].StorageState
+		state.Cookies, partitionedCookies = splitPartitionedCookies(state.Cookies)
+		options[0].StorageState = &state
+	}
 	if option.NoViewport != nil && *options[0].NoViewport {
 		overrides["noDefaultViewport"] = true
 		options[0].NoViewport = nil
 	}
 	if option.RecordHarPath != nil {
+		if _, err := newHarEntryFilter(options[0].RecordHarURLFilter, options[0].RecordHarMethodFilter, options[0].BaseURL); err != nil {
+			return nil, err
+		}
 		overrides["recordHar"] = prepareRecordHarOptions(recordHarInputOptions{
 			Path:        *options[0].RecordHarPath,
 			URL:         options[0].RecordHarURLFilter,
@@ -73,17 +89,100 @@ func (b *browserImpl) NewContext(options ...BrowserNewContextOptions) (BrowserCo
 		})
 		options[0].RecordHarPath = nil
 		options[0].RecordHarURLFilter = nil
+		options[0].RecordHarMethodFilter = nil
 		options[0].RecordHarMode = nil
 		options[0].RecordHarContent = nil
 		options[0].RecordHarOmitContent = nil
 	}
-	channel, err := b.channel.Send("newContext", options, overrides)
+	var proxyProvider ProxyProvider
+	var proxy *Proxy
+	if option.ProxyProvider != nil {
+		proxyProvider = option.ProxyProvider
+		options[0].ProxyProvider = nil
+		if option.Proxy == nil {
+			var err error
+			if proxy, err = proxyProvider.NextProxy(); err != nil {
+				return nil, fmt.Errorf("could not get proxy: %w", err)
+			}
+			options[0].Proxy = proxy
+		}
+	}
+	disableCache := option.DisableCache != nil && *option.DisableCache
+	if option.DisableCache != nil {
+		options[0].DisableCache = nil
+	}
+	if option.AuditLog != nil {
+		options[0].AuditLog = nil
+	}
+	if option.AllowedOrigins != nil {
+		if _, err := newOriginAllowlist(option.AllowedOrigins); err != nil {
+			return nil, err
+		}
+		options[0].AllowedOrigins = nil
+	}
+	if option.DryRun != nil {
+		if *option.DryRun != *DryRunModeBlock && *option.DryRun != *DryRunModeLog {
+			return nil, fmt.Errorf("unknown dry-run mode: %s", *option.DryRun)
+		}
+		options[0].DryRun = nil
+	}
+	if option.RecordBandwidth != nil {
+		options[0].RecordBandwidth = nil
+	}
+	if option.NetworkBudget != nil {
+		if err := option.NetworkBudget.validate(); err != nil {
+			return nil, err
+		}
+		options[0].NetworkBudget = nil
+	}
+	if option.OriginTrialTokens != nil {
+		if err := validateOriginTrialTokens(option.OriginTrialTokens); err != nil {
+			return nil, err
+		}
+		options[0].OriginTrialTokens = nil
+	}
+	if option.Name != nil || option.Metadata != nil {
+		options[0].Name = nil
+		options[0].Metadata = nil
+	}
+	channel, err := b.channel.Send("newContext", optionsOf(options), overrides)
 	if err != nil {
 		return nil, err
 	}
 	context := fromChannel(channel).(*browserContextImpl)
 	context.browser = b
+	if proxy != nil {
+		trackProxyHealth(context, proxyProvider, proxy)
+	}
 	b.browserType.(*browserTypeImpl).didCreateContext(context, &option, nil)
+	if disableCache {
+		if err := context.SetCacheEnabled(false); err != nil {
+			return nil, fmt.Errorf("could not disable cache: %w", err)
+		}
+	}
+	if len(partitionedCookies) > 0 {
+		if err := context.addPartitionedCookies(partitionedCookies); err != nil {
+			return nil, err
+		}
+	}
+	if len(option.OriginTrialTokens) > 0 {
+		if err := context.addUntrackedInitScript(originTrialScript(option.OriginTrialTokens)); err != nil {
+			return nil, fmt.Errorf("could not add origin trial tokens: %w", err)
+		}
+	}
+	if option.DryRun != nil {
+		if err := context.blockFormSubmissions(); err != nil {
+			return nil, fmt.Errorf("could not block form submissions: %w", err)
+		}
+	}
+	if option.DryRun != nil || option.AllowedOrigins != nil || option.NetworkBudget != nil {
+		context.Lock()
+		err := context.updateInterceptionPatterns()
+		context.Unlock()
+		if err != nil {
+			return nil, fmt.Errorf("could not intercept requests: %w", err)
+		}
+	}
 	return context, nil
 }
 
@@ -126,6 +225,9 @@ func (b *browserImpl) Close(options ...BrowserCloseOptions) (err error) {
 	if len(options) == 1 {
 		b.closeReason = options[0].Reason
 	}
+	b.Lock()
+	b.closeWasCalled = true
+	b.Unlock()
 
 	if b.shouldCloseConnectionOnClose {
 		err = b.connection.Stop()
@@ -14,6 +14,8 @@ import (
 
 type browserContextImpl struct {
 	channelOwner
+	named
+	activity
 	timeoutSettings *timeoutSettings
 	closeWasCalled  bool
 	options         *BrowserNewContextOptions
@@ -24,12 +26,35 @@ type browserContextImpl struct {
 	serviceWorkers  []Worker
 	backgroundPages []Page
 	bindings        map[string]BindingCallFunction
+	bindingHandles  map[string]bool
 	tracing         *tracingImpl
 	request         *apiRequestContextImpl
 	harRecorders    map[string]harRecordingMetadata
 	closed          chan struct{}
 	closeReason     *string
 	harRouters      []*harRouter
+	webSocketRoutes []*webSocketRouteHandler
+	webSocketRouter *webSocketRouter
+	clock           *clockImpl
+	initScripts     *initScripts
+	extraHeaders    map[string]string
+	privacyMasks    privacyMasks
+	auditLog        *auditLog
+	dryRun          *dryRun
+	originAllowlist *originAllowlist
+	networkBudget   *networkBudget
+	recordBandwidth bool
+	// cspReporting is whether the CSP violations are reported, see [BrowserContext.CaptureCSPViolations].
+	cspReporting   bool
+	cspReportingMu sync.Mutex
+	// webSocketCloseTracking is whether the close status of the WebSockets is reported, see [WebSocket.CloseStatus].
+	webSocketCloseTracking bool
+	webSocketCloseMu       sync.Mutex
+	// cacheDisabled is whether the requests are intercepted for the browser not to use its cache for them, see
+	// [BrowserContext.SetCacheEnabled].
+	cacheDisabled bool
+	// cdpContextID is the id of the context in the DevTools protocol, guarded by the browserSessionMu of the browser.
+	cdpContextID string
 }
 
 func (b *browserContextImpl) SetDefaultNavigationTimeout(timeout float64) {
@@ -75,6 +100,8 @@ func (b *browserContextImpl) NewCDPSession(page interface{}) (CDPSession, error)
 		params["page"] = p.channel
 	} else if f, ok := page.(*frameImpl); ok {
 		params["frame"] = f.channel
+	} else if w, ok := page.(*workerImpl); ok {
+		return b.newWorkerCDPSession(w)
 	} else {
 		return nil, fmt.Errorf("not page or frame: %v", page)
 	}
@@ -100,6 +127,10 @@ func (b *browserContextImpl) NewPage() (Page, error) {
 	return fromChannel(channel).(*pageImpl), nil
 }
 
+func (b *browserContextImpl) ConsoleMessages() *EventSubscription[ConsoleMessage] {
+	return SubscribeEvent[ConsoleMessage](b, "console")
+}
+
 func (b *browserContextImpl) Cookies(urls ...string) ([]Cookie, error) {
 	result, err := b.channel.Send("cookies", map[string]interface{}{
 		"urls": urls,
@@ -107,6 +138,7 @@ func (b *browserContextImpl) Cookies(urls ...string) ([]Cookie, error) {
 	if err != nil {
 		return nil, err
 	}
+	b.addPartitionKeys(result.([]interface{}))
 	cookies := make([]Cookie, len(result.([]interface{})))
 	for i, item := range result.([]interface{}) {
 		cookie := &Cookie{}
@@ -117,10 +149,23 @@ func (b *browserContextImpl) Cookies(urls ...string) ([]Cookie, error) {
 }
 
 func (b *browserContextImpl) AddCookies(cookies []OptionalCookie) error {
-	_, err := b.channel.Send("addCookies", map[string]interface{}{
-		"cookies": cookies,
-	})
-	return err
+	cookies, partitioned := splitPartitionedCookies(cookies)
+	if len(cookies) > 0 || len(partitioned) == 0 {
+		_, err := b.channel.Send("addCookies", map[string]interface{}{
+			"cookies": cookies,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if len(partitioned) > 0 {
+		return b.addPartitionedCookies(partitioned)
+	}
+	return nil
+}
+
+func (b *browserContextImpl) Clock() Clock {
+	return b.clock
 }
 
 func (b *browserContextImpl) ClearCookies(options ...BrowserContextClearCookiesOptions) error {
@@ -197,13 +242,6 @@ func (b *browserContextImpl) ResetGeolocation() error {
 	return err
 }
 
-func (b *browserContextImpl) SetExtraHTTPHeaders(headers map[string]string) error {
-	_, err := b.channel.Send("setExtraHTTPHeaders", map[string]interface{}{
-		"headers": serializeMapToNameAndValue(headers),
-	})
-	return err
-}
-
 func (b *browserContextImpl) SetOffline(offline bool) error {
 	_, err := b.channel.Send("setOffline", map[string]interface{}{
 		"offline": offline,
@@ -212,21 +250,18 @@ func (b *browserContextImpl) SetOffline(offline bool) error {
 }
 
 func (b *browserContextImpl) AddInitScript(script Script) error {
-	var source string
-	if script.Content != nil {
-		source = *script.Content
+	entry, err := newInitScript(script)
+	if err != nil {
+		return err
 	}
-	if script.Path != nil {
-		content, err := os.ReadFile(*script.Path)
-		if err != nil {
-			return err
-		}
-		source = string(content)
+	if entry.world() != "" {
+		return b.addIsolatedInitScript(entry)
 	}
-	_, err := b.channel.Send("addInitScript", map[string]interface{}{
-		"source": source,
-	})
-	return err
+	if err := b.addUntrackedInitScript(entry.guardedSource()); err != nil {
+		return err
+	}
+	b.initScripts.add(entry)
+	return nil
 }
 
 func (b *browserContextImpl) ExposeBinding(name string, binding BindingCallFunction, handle ...bool) error {
@@ -242,7 +277,15 @@ func (b *browserContextImpl) ExposeBinding(name string, binding BindingCallFunct
 	if _, ok := b.bindings[name]; ok {
 		return fmt.Errorf("Function '%s' has been already registered", name)
 	}
+	if registeredHandle, ok := b.bindingHandles[name]; ok {
+		if registeredHandle != needsHandle {
+			return fmt.Errorf("Function '%s' was exposed with a different handle option before", name)
+		}
+		b.bindings[name] = binding
+		return b.restoreBinding(name)
+	}
 	b.bindings[name] = binding
+	b.bindingHandles[name] = needsHandle
 	_, err := b.channel.Send("exposeBinding", map[string]interface{}{
 		"name":        name,
 		"needsHandle": needsHandle,
@@ -259,38 +302,38 @@ func (b *browserContextImpl) ExposeFunction(name string, binding ExposedFunction
 func (b *browserContextImpl) Route(url interface{}, handler routeHandler, times ...int) error {
 	b.Lock()
 	defer b.Unlock()
-	b.routes = slices.Insert(b.routes, 0, newRouteHandlerEntry(newURLMatcher(url, b.options.BaseURL), handler, times...))
+	b.routes = insertRouteHandlerEntry(b.routes, newRouteHandlerEntry(newURLMatcher(url, b.options.BaseURL), handler, times...))
 	return b.updateInterceptionPatterns()
 }
 
-func (b *browserContextImpl) Unroute(url interface{}, handlers ...routeHandler) error {
-	removed, remaining, err := unroute(b.routes, url, handlers...)
-	if err != nil {
-		return err
-	}
-	return b.unrouteInternal(removed, remaining, UnrouteBehaviorDefault)
+func (b *browserContextImpl) RouteWithPriority(url interface{}, handler routeHandler, priority int, times ...int) error {
+	b.Lock()
+	defer b.Unlock()
+	entry := newRouteHandlerEntry(newURLMatcher(url, b.options.BaseURL), handler, times...)
+	entry.priority = priority
+	b.routes = insertRouteHandlerEntry(b.routes, entry)
+	return b.updateInterceptionPatterns()
+}
+
+func (b *browserContextImpl) RouteWebSocket(url interface{}, handler func(WebSocketRoute)) error {
+	b.Lock()
+	b.webSocketRoutes = slices.Insert(b.webSocketRoutes, 0, &webSocketRouteHandler{
+		matcher: newURLMatcher(url, webSocketBaseURL(b.options.BaseURL)),
+		handler: handler,
+	})
+	b.Unlock()
+	return b.installWebSocketRouting()
 }
 
-func (b *browserContextImpl) unrouteInternal(removed []*routeHandlerEntry, remaining []*routeHandlerEntry, behavior *UnrouteBehavior) error {
+func (b *browserContextImpl) Unroute(url interface{}, handlers ...routeHandler) error {
 	b.Lock()
 	defer b.Unlock()
-	b.routes = remaining
-	if err := b.updateInterceptionPatterns(); err != nil {
+	_, remaining, err := unroute(b.routes, url, handlers...)
+	if err != nil {
 		return err
 	}
-	if behavior == nil || behavior == UnrouteBehaviorDefault {
-		return nil
-	}
-	wg := &sync.WaitGroup{}
-	for _, entry := range removed {
-		wg.Add(1)
-		go func(entry *routeHandlerEntry) {
-			defer wg.Done()
-			entry.Stop(string(*behavior))
-		}(entry)
-	}
-	wg.Wait()
-	return nil
+	b.routes = remaining
+	return b.updateInterceptionPatterns()
 }
 
 func (b *browserContextImpl) UnrouteAll(options ...BrowserContextUnrouteAllOptions) error {
@@ -298,8 +341,14 @@ func (b *browserContextImpl) UnrouteAll(options ...BrowserContextUnrouteAllOptio
 	if len(options) == 1 {
 		behavior = options[0].Behavior
 	}
-	defer b.disposeHarRouters()
-	return b.unrouteInternal(b.routes, []*routeHandlerEntry{}, behavior)
+	b.Lock()
+	removed := b.routes
+	b.routes = []*routeHandlerEntry{}
+	err := b.updateInterceptionPatterns()
+	b.Unlock()
+	stopRouteHandlers(removed, behavior)
+	b.disposeHarRouters()
+	return err
 }
 
 func (b *browserContextImpl) disposeHarRouters() {
@@ -319,17 +368,11 @@ func (b *browserContextImpl) RouteFromHAR(har string, options ...BrowserContextR
 		opt = options[0]
 	}
 	if opt.Update != nil && *opt.Update {
-		var updateContent *HarContentPolicy
-		switch opt.UpdateContent {
-		case RouteFromHarUpdateContentPolicyAttach:
-			updateContent = HarContentPolicyAttach
-		case RouteFromHarUpdateContentPolicyEmbed:
-			updateContent = HarContentPolicyEmbed
-		}
 		return b.recordIntoHar(har, browserContextRecordIntoHarOptions{
 			URL:           opt.URL,
-			UpdateContent: updateContent,
+			UpdateContent: toHarContentPolicy(opt.UpdateContent),
 			UpdateMode:    opt.UpdateMode,
+			Merge:         opt.UpdateMerge != nil && *opt.UpdateMerge,
 		})
 	}
 	notFound := opt.NotFound
@@ -438,6 +481,19 @@ func (b *browserContextImpl) Close(options ...BrowserContextCloseOptions) error
 			if err := artifact.Delete(); err != nil {
 				return nil, err
 			}
+			if harMetaData.Filter != nil {
+				if err := harMetaData.Filter.apply(harMetaData.Path); err != nil {
+					return nil, err
+				}
+			}
+			if harMetaData.Previous != nil {
+				if err := mergeHar(harMetaData.Path, harMetaData.Previous); err != nil {
+					return nil, err
+				}
+			}
+			if err := redactFile(harMetaData.Path); err != nil {
+				return nil, err
+			}
 		}
 		return nil, nil
 	}
@@ -460,8 +516,10 @@ func (b *browserContextImpl) Close(options ...BrowserContextCloseOptions) error
 type browserContextRecordIntoHarOptions struct {
 	Page          Page
 	URL           interface{}
+	MethodFilter  []string
 	UpdateContent *HarContentPolicy
 	UpdateMode    *HarMode
+	Merge         bool
 }
 
 func (b *browserContextImpl) recordIntoHar(har string, options ...browserContextRecordIntoHarOptions) error {
@@ -471,6 +529,7 @@ func (b *browserContextImpl) recordIntoHar(har string, options ...browserContext
 		Content: HarContentPolicyAttach,
 		Mode:    HarModeMinimal,
 	}
+	metadata := harRecordingMetadata{Path: har}
 	if len(options) == 1 {
 		if options[0].UpdateContent != nil {
 			harOptions.Content = options[0].UpdateContent
@@ -483,15 +542,23 @@ func (b *browserContextImpl) recordIntoHar(har string, options ...browserContext
 		if options[0].Page != nil {
 			overrides["page"] = options[0].Page.(*pageImpl).channel
 		}
+		filter, err := newHarEntryFilter(options[0].URL, options[0].MethodFilter, b.options.BaseURL)
+		if err != nil {
+			return err
+		}
+		metadata.Filter = filter
+		if options[0].Merge {
+			if metadata.Previous, err = readPreviousHar(har); err != nil {
+				return err
+			}
+		}
 	}
 	harId, err := b.channel.Send("harStart", overrides)
 	if err != nil {
 		return err
 	}
-	b.harRecorders[harId.(string)] = harRecordingMetadata{
-		Path:    har,
-		Content: harOptions.Content,
-	}
+	metadata.Content = harOptions.Content
+	b.harRecorders[harId.(string)] = metadata
 	return nil
 }
 
@@ -500,6 +567,11 @@ func (b *browserContextImpl) StorageState(paths ...string) (*StorageState, error
 	if err != nil {
 		return nil, err
 	}
+	if state, ok := result.(map[string]interface{}); ok {
+		if cookies, ok := state["cookies"].([]interface{}); ok {
+			b.addPartitionKeys(cookies)
+		}
+	}
 	if len(paths) == 1 {
 		file, err := os.Create(paths[0])
 		if err != nil {
@@ -518,8 +590,11 @@ func (b *browserContextImpl) StorageState(paths ...string) (*StorageState, error
 }
 
 func (b *browserContextImpl) onBinding(binding *bindingCallImpl) {
-	function := b.bindings[binding.initializer["name"].(string)]
+	name := binding.initializer["name"].(string)
+	function := b.bindings[name]
 	if function == nil {
+		// The function was unexposed, the call fails instead of waiting for a result.
+		go binding.reject(fmt.Errorf("Function '%s' is not exposed in the browser context", name))
 		return
 	}
 	go binding.Call(function)
@@ -545,6 +620,18 @@ func (b *browserContextImpl) onPage(page Page) {
 	b.Lock()
 	b.pages = append(b.pages, page)
 	b.Unlock()
+	if scripts := b.initScripts.filter(true); len(scripts) > 0 {
+		// The page is reported once its isolated world init scripts are added.
+		offDispatcher(func() {
+			b.applyIsolatedInitScripts(page.(*pageImpl), scripts)
+			b.emitPage(page)
+		})
+		return
+	}
+	b.emitPage(page)
+}
+
+func (b *browserContextImpl) emitPage(page Page) {
 	b.Emit("page", page)
 	opener, _ := page.Opener()
 	if opener != nil && !opener.IsClosed() {
@@ -556,59 +643,109 @@ func (b *browserContextImpl) onRoute(route *routeImpl) {
 	go func() {
 		b.Lock()
 		route.context = b
-		page := route.Request().(*requestImpl).safePage()
-		routes := make([]*routeHandlerEntry, len(b.routes))
-		copy(routes, b.routes)
 		b.Unlock()
+		if b.handleOriginAllowlist(route) || b.handleDryRun(route) || b.handleNetworkBudget(route) {
+			return
+		}
+		b.dispatchRoute(route, nil, nil)
+	}()
+}
 
-		checkInterceptionIfNeeded := func() {
-			b.Lock()
-			defer b.Unlock()
-			if len(b.routes) == 0 {
-				_, err := b.connection.WrapAPICall(func() (interface{}, error) {
-					err := b.updateInterceptionPatterns()
-					return nil, err
-				}, true)
-				if err != nil {
-					logger.Printf("could not update interception patterns: %v\n", err)
-				}
-			}
+// routeChainEntry is a route matched against a request, registered on page, or on the context if page is nil.
+type routeChainEntry struct {
+	*routeHandlerEntry
+	page *pageImpl
+}
+
+// mergeRouteChains returns the routes of a page and of its context in the order they are matched against requests: by
+// priority, the routes of the page first for the same priority. Both lists are already in that order.
+func mergeRouteChains(page *pageImpl, pageRoutes []*routeHandlerEntry, contextRoutes []*routeHandlerEntry) []routeChainEntry {
+	chain := make([]routeChainEntry, 0, len(pageRoutes)+len(contextRoutes))
+	for len(pageRoutes) > 0 || len(contextRoutes) > 0 {
+		if len(pageRoutes) > 0 && (len(contextRoutes) == 0 || pageRoutes[0].priority >= contextRoutes[0].priority) {
+			chain = append(chain, routeChainEntry{pageRoutes[0], page})
+			pageRoutes = pageRoutes[1:]
+		} else {
+			chain = append(chain, routeChainEntry{contextRoutes[0], nil})
+			contextRoutes = contextRoutes[1:]
 		}
+	}
+	return chain
+}
 
-		url := route.Request().URL()
-		for _, handlerEntry := range routes {
-			// If the page or the context was closed we stall all requests right away.
-			if (page != nil && page.closeWasCalled) || b.closeWasCalled {
-				return
-			}
-			if !handlerEntry.Matches(url) {
-				continue
-			}
-			if !slices.ContainsFunc(b.routes, func(entry *routeHandlerEntry) bool {
-				return entry == handlerEntry
-			}) {
-				continue
-			}
-			if handlerEntry.WillExceed() {
-				b.routes = slices.DeleteFunc(b.routes, func(rhe *routeHandlerEntry) bool {
-					return rhe == handlerEntry
-				})
-			}
-			handled := handlerEntry.Handle(route)
-			checkInterceptionIfNeeded()
-			yes := <-handled
-			if yes {
-				return
+// dispatchRoute passes the route to the matching handlers of page, whose routes are pageRoutes, and of the context,
+// until one of them handles it. Requests which no handler handles are continued.
+func (b *browserContextImpl) dispatchRoute(route *routeImpl, page *pageImpl, pageRoutes []*routeHandlerEntry) {
+	b.Lock()
+	contextRoutes := make([]*routeHandlerEntry, len(b.routes))
+	copy(contextRoutes, b.routes)
+	b.Unlock()
+	requestPage := route.Request().(*requestImpl).safePage()
+
+	// scope returns the lock and the routes the entry was registered in, and the function updating their interception.
+	scope := func(entry routeChainEntry) (sync.Locker, *[]*routeHandlerEntry, func() error) {
+		if entry.page != nil {
+			return entry.page, &entry.page.routes, entry.page.updateInterceptionPatterns
+		}
+		return b, &b.routes, b.updateInterceptionPatterns
+	}
+	checkInterceptionIfNeeded := func(entry routeChainEntry) {
+		lock, routes, update := scope(entry)
+		lock.Lock()
+		defer lock.Unlock()
+		if len(*routes) == 0 {
+			_, err := b.connection.WrapAPICall(func() (interface{}, error) {
+				err := update()
+				return nil, err
+			}, true)
+			if err != nil {
+				b.logf("could not update interception patterns: %v\n", err)
 			}
 		}
-		// If the page is closed or unrouteAll() was called without waiting and interception disabled,
-		// the method will throw an error - silence it.
-		_ = route.internalContinue(true)
-	}()
+	}
+
+	url := route.Request().URL()
+	for _, handlerEntry := range mergeRouteChains(page, pageRoutes, contextRoutes) {
+		// If the page or the context was closed we stall all requests right away.
+		if (requestPage != nil && requestPage.closeWasCalled) || (page != nil && page.closeWasCalled) || b.closeWasCalled {
+			return
+		}
+		if !handlerEntry.Matches(url) {
+			continue
+		}
+		// The handler is registered while it is still routed, so that unrouting it waits for it.
+		lock, routes, _ := scope(handlerEntry)
+		lock.Lock()
+		if !slices.ContainsFunc(*routes, func(entry *routeHandlerEntry) bool {
+			return entry == handlerEntry.routeHandlerEntry
+		}) {
+			lock.Unlock()
+			continue
+		}
+		if handlerEntry.WillExceed() {
+			*routes = slices.DeleteFunc(*routes, func(rhe *routeHandlerEntry) bool {
+				return rhe == handlerEntry.routeHandlerEntry
+			})
+		}
+		invocation := handlerEntry.start(route)
+		lock.Unlock()
+		handled := handlerEntry.Handle(invocation)
+		checkInterceptionIfNeeded(handlerEntry)
+		if <-handled {
+			return
+		}
+	}
+	b.continueWithinBudget(route)
 }
 
 func (b *browserContextImpl) updateInterceptionPatterns() error {
 	patterns := prepareInterceptionPatterns(b.routes)
+	if b.dryRun != nil || b.originAllowlist != nil || b.networkBudget != nil || b.cacheDisabled {
+		// The requests that change state, go to origins that are not allowed or exceed the budget are intercepted
+		// before the routes see them. The browser does not use its cache for intercepted requests, which are
+		// continued unchanged if no route handles them.
+		patterns = []map[string]interface{}{{"glob": "**/*"}}
+	}
 	_, err := b.channel.Send("setNetworkInterceptionPatterns", map[string]interface{}{
 		"patterns": patterns,
 	})
@@ -634,8 +771,10 @@ func (b *browserContextImpl) onBackgroundPage(ev map[string]interface{}) {
 }
 
 func (b *browserContextImpl) onServiceWorker(worker *workerImpl) {
+	b.Lock()
 	worker.context = b
 	b.serviceWorkers = append(b.serviceWorkers, worker)
+	b.Unlock()
 	b.Emit("serviceworker", worker)
 }
 
@@ -644,33 +783,70 @@ func (b *browserContextImpl) setOptions(options *BrowserNewContextOptions, trace
 		options = &BrowserNewContextOptions{}
 	}
 	b.options = options
+	b.extraHeaders = normalizeHeaders(options.ExtraHttpHeaders)
+	if options.Name != nil {
+		b.SetName(*options.Name)
+	}
+	for key, value := range options.Metadata {
+		b.SetMetadata(key, value)
+	}
 	if b.options != nil && b.options.RecordHarPath != nil {
+		// The filter was validated when the context was created.
+		filter, _ := newHarEntryFilter(b.options.RecordHarURLFilter, b.options.RecordHarMethodFilter, b.options.BaseURL)
 		b.harRecorders[""] = harRecordingMetadata{
 			Path:    *b.options.RecordHarPath,
 			Content: b.options.RecordHarContent,
+			Filter:  filter,
 		}
 	}
 	if tracesDir != nil {
 		b.tracing.tracesDir = *tracesDir
 	}
+	if options.AuditLog != nil && *options.AuditLog {
+		b.auditLog = &auditLog{}
+	}
+	if options.DryRun != nil {
+		b.dryRun = &dryRun{mode: *options.DryRun}
+	}
+	if options.AllowedOrigins != nil {
+		// The origins were validated when the context was created.
+		b.originAllowlist, _ = newOriginAllowlist(options.AllowedOrigins)
+		b.trackOriginRedirects()
+	}
+	if options.NetworkBudget != nil {
+		b.networkBudget = newNetworkBudget(*options.NetworkBudget)
+		b.trackNetworkBudget()
+	}
+	b.recordBandwidth = options.RecordBandwidth != nil && *options.RecordBandwidth
+	if b.networkBudget != nil || b.recordBandwidth {
+		b.trackRequestSizes()
+	}
 }
 
 func (b *browserContextImpl) BackgroundPages() []Page {
 	b.Lock()
 	defer b.Unlock()
-	return b.backgroundPages
+	return append([]Page{}, b.backgroundPages...)
 }
 
 func (b *browserContextImpl) ServiceWorkers() []Worker {
 	b.Lock()
 	defer b.Unlock()
-	return b.serviceWorkers
+	return append([]Worker{}, b.serviceWorkers...)
+}
+
+func (b *browserContextImpl) OnBackgroundPage(fn func(Page)) {
+	b.On("backgroundpage", fn)
 }
 
 func (b *browserContextImpl) OnClose(fn func(BrowserContext)) {
 	b.On("close", fn)
 }
 
+func (b *browserContextImpl) OnIdle(fn func(BrowserContext)) {
+	b.On("idle", fn)
+}
+
 func (b *browserContextImpl) OnConsole(fn func(ConsoleMessage)) {
 	b.On("console", fn)
 }
@@ -699,6 +875,10 @@ func (b *browserContextImpl) OnResponse(fn func(Response)) {
 	b.On("response", fn)
 }
 
+func (b *browserContextImpl) OnServiceWorker(fn func(Worker)) {
+	b.On("serviceworker", fn)
+}
+
 func (b *browserContextImpl) OnWebError(fn func(WebError)) {
 	b.On("weberror", fn)
 }
@@ -722,17 +902,24 @@ func newBrowserContext(parent *channelOwner, objectType string, guid string, ini
 		backgroundPages: make([]Page, 0),
 		routes:          make([]*routeHandlerEntry, 0),
 		bindings:        make(map[string]BindingCallFunction),
+		bindingHandles:  make(map[string]bool),
 		harRecorders:    make(map[string]harRecordingMetadata),
 		closed:          make(chan struct{}, 1),
 		harRouters:      make([]*harRouter, 0),
+		webSocketRouter: newWebSocketRouter(),
+		initScripts:     &initScripts{},
 	}
 	bt.createChannelOwner(bt, parent, objectType, guid, initializer)
+	bt.clock = newClock(bt)
+	bt.touch()
 	if parent.objectType == "Browser" {
 		bt.browser = fromChannel(parent.channel).(*browserImpl)
 		bt.browser.contexts = append(bt.browser.contexts, bt)
 	}
 	bt.tracing = fromChannel(initializer["tracing"]).(*tracingImpl)
+	bt.tracing.context = bt
 	bt.request = fromChannel(initializer["requestContext"]).(*apiRequestContextImpl)
+	bt.request.context = bt
 	bt.channel.On("bindingCall", func(params map[string]interface{}) {
 		bt.onBinding(fromChannel(params["binding"]).(*bindingCallImpl))
 	})
@@ -0,0 +1,141 @@
+package playwright
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// browserServerCloseTimeout is the time given to a browser server to close gracefully before it is killed.
+const browserServerCloseTimeout = 30 * time.Second
+
+type browserServerImpl struct {
+	cmd        *exec.Cmd
+	wsEndpoint string
+	exited     chan struct{}
+	exitErr    error
+	closeOnce  sync.Once
+	closeErr   error
+}
+
+func (b *browserTypeImpl) LaunchServer(options ...BrowserTypeLaunchServerOptions) (BrowserServer, error) {
+	driver := b.playwright.driver
+	if driver == nil {
+		return nil, errors.New("browser servers can only be launched by a Playwright instance started with Run")
+	}
+	config, err := launchServerConfig(options...)
+	if err != nil {
+		return nil, err
+	}
+	dir, err := os.MkdirTemp("", "playwright-server-")
+	if err != nil {
+		return nil, fmt.Errorf("could not create temporary directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+	configPath := filepath.Join(dir, "config.json")
+	if err := os.WriteFile(configPath, config, 0o600); err != nil {
+		return nil, fmt.Errorf("could not write server options: %w", err)
+	}
+
+	cmd := driver.Command("launch-server", "--browser", b.Name(), "--config", configPath)
+	cmd.SysProcAttr = browserServerSysProcAttr()
+	cmd.Stderr = driver.options.Stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("could not create stdout pipe: %w", err)
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("could not start browser server: %w", err)
+	}
+	reader := bufio.NewReader(stdout)
+	// The config file is read once the server is launched, so it is removed only after the endpoint is printed.
+	line, err := reader.ReadString('\n')
+	wsEndpoint := strings.TrimSpace(line)
+	if !strings.HasPrefix(wsEndpoint, "ws://") {
+		_ = killProcessTree(cmd)
+		_ = cmd.Wait()
+		if err != nil {
+			return nil, fmt.Errorf("could not launch browser server: %w", err)
+		}
+		return nil, fmt.Errorf("could not launch browser server: unexpected output %q", wsEndpoint)
+	}
+	server := &browserServerImpl{
+		cmd:        cmd,
+		wsEndpoint: wsEndpoint,
+		exited:     make(chan struct{}),
+	}
+	go func() {
+		_, _ = io.Copy(io.Discard, reader)
+		server.exitErr = cmd.Wait()
+		close(server.exited)
+	}()
+	return server, nil
+}
+
+// launchServerConfig serializes the options of the browser server, leaving out the unset ones.
+func launchServerConfig(options ...BrowserTypeLaunchServerOptions) ([]byte, error) {
+	config := map[string]interface{}{}
+	if len(options) == 1 {
+		data, err := json.Marshal(options[0])
+		if err != nil {
+			return nil, fmt.Errorf("could not serialize server options: %w", err)
+		}
+		if err := json.Unmarshal(data, &config); err != nil {
+			return nil, fmt.Errorf("could not serialize server options: %w", err)
+		}
+		for key, value := range config {
+			if value == nil {
+				delete(config, key)
+			}
+		}
+	}
+	return json.Marshal(config)
+}
+
+func (s *browserServerImpl) WSEndpoint() string {
+	return s.wsEndpoint
+}
+
+func (s *browserServerImpl) Process() *os.Process {
+	return s.cmd.Process
+}
+
+func (s *browserServerImpl) Close() error {
+	s.closeOnce.Do(func() {
+		if err := interruptProcess(s.cmd); err != nil {
+			s.closeErr = s.kill()
+			return
+		}
+		select {
+		case <-s.exited:
+		case <-time.After(browserServerCloseTimeout):
+			s.closeErr = s.kill()
+		}
+	})
+	return s.closeErr
+}
+
+func (s *browserServerImpl) Kill() error {
+	return s.kill()
+}
+
+func (s *browserServerImpl) kill() error {
+	select {
+	case <-s.exited:
+		return nil
+	default:
+	}
+	if err := killProcessTree(s.cmd); err != nil {
+		return fmt.Errorf("could not kill browser server: %w", err)
+	}
+	<-s.exited
+	return nil
+}
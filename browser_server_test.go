@@ -0,0 +1,21 @@
+package playwright
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLaunchServerConfig(t *testing.T) {
+	config, err := launchServerConfig()
+	require.NoError(t, err)
+	require.JSONEq(t, `{}`, string(config))
+
+	config, err = launchServerConfig(BrowserTypeLaunchServerOptions{
+		Headless: Bool(false),
+		Port:     Int(9222),
+		Env:      map[string]string{"TZ": "UTC"},
+	})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"headless": false, "port": 9222, "env": {"TZ": "UTC"}}`, string(config))
+}
@@ -0,0 +1,23 @@
+//go:build !windows
+
+package playwright
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+)
+
+// browserServerSysProcAttr starts browser servers in their own process group, so that killing them kills their
+// browser as well.
+func browserServerSysProcAttr() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{Setpgid: true}
+}
+
+func interruptProcess(cmd *exec.Cmd) error {
+	return cmd.Process.Signal(os.Interrupt)
+}
+
+func killProcessTree(cmd *exec.Cmd) error {
+	return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+}
@@ -0,0 +1,23 @@
+//go:build windows
+
+package playwright
+
+import (
+	"errors"
+	"os/exec"
+	"strconv"
+	"syscall"
+)
+
+func browserServerSysProcAttr() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{HideWindow: true}
+}
+
+// interruptProcess is not supported on Windows, where processes can't be sent Ctrl-C without a console.
+func interruptProcess(cmd *exec.Cmd) error {
+	return errors.New("not supported on windows")
+}
+
+func killProcessTree(cmd *exec.Cmd) error {
+	return exec.Command("taskkill", "/T", "/F", "/PID", strconv.Itoa(cmd.Process.Pid)).Run()
+}
@@ -23,7 +23,12 @@ func (b *browserTypeImpl) Launch(options ...BrowserTypeLaunchOptions) (Browser,
 		overrides["env"] = serializeMapToNameAndValue(options[0].Env)
 		options[0].Env = nil
 	}
-	channel, err := b.channel.Send("launch", options, overrides)
+	if len(options) == 1 {
+		if err := b.applyBlinkFeatures(&options[0].Args, &options[0].EnableBlinkFeatures, &options[0].DisableBlinkFeatures); err != nil {
+			return nil, err
+		}
+	}
+	channel, err := b.channel.Send("launch", optionsOf(options), overrides)
 	if err != nil {
 		return nil, err
 	}
@@ -56,6 +61,12 @@ func (b *browserTypeImpl) LaunchPersistentContext(userDataDir string, options ..
 			overrides["extraHTTPHeaders"] = serializeMapToNameAndValue(options[0].ExtraHttpHeaders)
 			options[0].ExtraHttpHeaders = nil
 		}
+		if err := b.loadExtensions(&options[0]); err != nil {
+			return nil, err
+		}
+		if err := b.applyBlinkFeatures(&options[0].Args, &options[0].EnableBlinkFeatures, &options[0].DisableBlinkFeatures); err != nil {
+			return nil, err
+		}
 		if options[0].Env != nil {
 			overrides["env"] = serializeMapToNameAndValue(options[0].Env)
 			options[0].Env = nil
@@ -65,6 +76,9 @@ func (b *browserTypeImpl) LaunchPersistentContext(userDataDir string, options ..
 			options[0].NoViewport = nil
 		}
 		if options[0].RecordHarPath != nil {
+			if _, err := newHarEntryFilter(options[0].RecordHarURLFilter, options[0].RecordHarMethodFilter, options[0].BaseURL); err != nil {
+				return nil, err
+			}
 			overrides["recordHar"] = prepareRecordHarOptions(recordHarInputOptions{
 				Path:        *options[0].RecordHarPath,
 				URL:         options[0].RecordHarURLFilter,
@@ -74,12 +88,13 @@ func (b *browserTypeImpl) LaunchPersistentContext(userDataDir string, options ..
 			})
 			options[0].RecordHarPath = nil
 			options[0].RecordHarURLFilter = nil
+			options[0].RecordHarMethodFilter = nil
 			options[0].RecordHarMode = nil
 			options[0].RecordHarContent = nil
 			options[0].RecordHarOmitContent = nil
 		}
 	}
-	channel, err := b.channel.Send("launchPersistentContext", options, overrides)
+	channel, err := b.channel.Send("launchPersistentContext", optionsOf(options), overrides)
 	if err != nil {
 		return nil, err
 	}
@@ -89,38 +104,17 @@ func (b *browserTypeImpl) LaunchPersistentContext(userDataDir string, options ..
 }
 
 func (b *browserTypeImpl) Connect(wsEndpoint string, options ...BrowserTypeConnectOptions) (Browser, error) {
-	overrides := map[string]interface{}{
-		"wsEndpoint": wsEndpoint,
+	option := BrowserTypeConnectOptions{}
+	if len(options) == 1 {
+		option = options[0]
 	}
-	localUtils := b.connection.LocalUtils()
-	pipe, err := localUtils.channel.SendReturnAsDict("connect", options, overrides)
-	if err != nil {
-		return nil, err
+	if option.Adapter != nil {
+		return b.connectWithAdapter(wsEndpoint, option)
 	}
-	jsonPipe := fromChannel(pipe.(map[string]interface{})["pipe"]).(*jsonPipe)
-	connection := newConnection(jsonPipe, localUtils)
-
-	playwright, err := connection.Start()
+	browser, err := newRemoteConnection(b, wsEndpoint, option).connect()
 	if err != nil {
 		return nil, err
 	}
-	playwright.setSelectors(b.playwright.Selectors)
-	browser := fromChannel(playwright.initializer["preLaunchedBrowser"]).(*browserImpl)
-	browser.shouldCloseConnectionOnClose = true
-	pipeClosed := func() {
-		for _, context := range browser.Contexts() {
-			pages := context.Pages()
-			for _, page := range pages {
-				page.(*pageImpl).onClose()
-			}
-			context.(*browserContextImpl).onClose()
-		}
-		browser.onClose()
-		connection.cleanup()
-	}
-	jsonPipe.On("closed", pipeClosed)
-
-	b.didLaunchBrowser(browser)
 	return browser, nil
 }
 
@@ -134,7 +128,7 @@ func (b *browserTypeImpl) ConnectOverCDP(endpointURL string, options ...BrowserT
 			options[0].Headers = nil
 		}
 	}
-	response, err := b.channel.SendReturnAsDict("connectOverCDP", options, overrides)
+	response, err := b.channel.SendReturnAsDict("connectOverCDP", optionsOf(options), overrides)
 	if err != nil {
 		return nil, err
 	}
@@ -1,15 +1,38 @@
 package playwright
 
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync/atomic"
+)
+
 type cdpSessionImpl struct {
 	channelOwner
+	detached atomic.Bool
+}
+
+func (c *cdpSessionImpl) OnDetached(fn func(CDPSession)) {
+	c.On("detached", fn)
 }
 
 func (c *cdpSessionImpl) Detach() error {
+	if c.detached.Load() {
+		return nil
+	}
 	_, err := c.channel.Send("detach")
+	c.onDetached()
 	return err
 }
 
+func (c *cdpSessionImpl) IsDetached() bool {
+	return c.detached.Load()
+}
+
 func (c *cdpSessionImpl) Send(method string, params map[string]interface{}) (interface{}, error) {
+	if c.detached.Load() {
+		return nil, fmt.Errorf("%w: CDP session is detached", ErrTargetClosed)
+	}
 	result, err := c.channel.Send("send", map[string]interface{}{
 		"method": method,
 		"params": params,
@@ -22,9 +45,25 @@ func (c *cdpSessionImpl) Send(method string, params map[string]interface{}) (int
 }
 
 func (c *cdpSessionImpl) onEvent(params map[string]interface{}) {
+	if c.detached.Load() {
+		return
+	}
 	c.Emit(params["method"].(string), params["params"])
 }
 
+// onDetached is called once the session is detached by the client, or disposed by the server because its target was
+// closed.
+func (c *cdpSessionImpl) onDetached() {
+	if c.detached.Swap(true) {
+		return
+	}
+	c.Emit("detached", c)
+}
+
+func (c *cdpSessionImpl) onDispose() {
+	c.onDetached()
+}
+
 func newCDPSession(parent *channelOwner, objectType string, guid string, initializer map[string]interface{}) *cdpSessionImpl {
 	bt := &cdpSessionImpl{}
 
@@ -36,3 +75,107 @@ func newCDPSession(parent *channelOwner, objectType string, guid string, initial
 
 	return bt
 }
+
+// SendCDP sends a message of method on session and returns its result decoded as T, such as a struct with json tags
+// or map[string]interface{}. params may be nil, a map or a struct, which is encoded with encoding/json.
+//
+//	type Metrics struct {
+//		Metrics []struct {
+//			Name  string  `json:"name"`
+//			Value float64 `json:"value"`
+//		} `json:"metrics"`
+//	}
+//	metrics, err := playwright.SendCDP[Metrics](session, "Performance.getMetrics", nil)
+func SendCDP[T any](session CDPSession, method string, params interface{}) (T, error) {
+	var zero T
+	args, err := cdpParams(params)
+	if err != nil {
+		return zero, fmt.Errorf("could not encode params of %s: %w", method, err)
+	}
+	result, err := session.Send(method, args)
+	if err != nil {
+		return zero, err
+	}
+	value, err := decodeCDPValue[T](result)
+	if err != nil {
+		return zero, fmt.Errorf("could not decode result of %s: %w", method, err)
+	}
+	return value, nil
+}
+
+// OnCDPEvent calls handler with the params of event of session decoded as T, see [SendCDP]. Events whose params can't
+// be decoded are logged and skipped. It returns a function removing handler.
+//
+//	remove := playwright.OnCDPEvent(session, "Network.loadingFinished", func(e LoadingFinished) {
+//		fmt.Println(e.EncodedDataLength)
+//	})
+//	defer remove()
+func OnCDPEvent[T any](session CDPSession, event string, handler func(T)) (remove func()) {
+	return addListener(session, event, func(data ...interface{}) {
+		var params interface{}
+		if len(data) > 0 {
+			params = data[0]
+		}
+		value, err := decodeCDPValue[T](params)
+		if err != nil {
+			logger.Printf("could not decode params of %s: %v\n", event, err)
+			return
+		}
+		handler(value)
+	})
+}
+
+// SubscribeCDPEvent delivers the params of event of session decoded as T over a channel, see [SubscribeEvent]. Events
+// are buffered until they are received, and the channel is closed once the session is detached and the remaining
+// events are received. Events whose params can't be decoded are logged and skipped.
+//
+//	responses := playwright.SubscribeCDPEvent[ResponseReceived](session, "Network.responseReceived")
+//	defer responses.Unsubscribe()
+func SubscribeCDPEvent[T any](session CDPSession, event string) *EventSubscription[T] {
+	return subscribeEvent(session, event, func(data interface{}) (T, bool) {
+		value, err := decodeCDPValue[T](data)
+		if err != nil {
+			logger.Printf("could not decode params of %s: %v\n", event, err)
+			return value, false
+		}
+		return value, true
+	})
+}
+
+// cdpParams converts the params of a message to the map sent by [CDPSession.Send].
+func cdpParams(params interface{}) (map[string]interface{}, error) {
+	switch p := params.(type) {
+	case nil:
+		return map[string]interface{}{}, nil
+	case map[string]interface{}:
+		return p, nil
+	}
+	data, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+	var out map[string]interface{}
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// decodeCDPValue converts the result of a message, or the params of an event, to T.
+func decodeCDPValue[T any](in interface{}) (T, error) {
+	var out T
+	if value, ok := in.(T); ok {
+		return value, nil
+	}
+	if in == nil {
+		return out, nil
+	}
+	data, err := json.Marshal(in)
+	if err != nil {
+		return out, err
+	}
+	if err := json.Unmarshal(data, &out); err != nil {
+		return out, fmt.Errorf("%w, into a %s", err, reflect.TypeOf(&out).Elem())
+	}
+	return out, nil
+}
@@ -0,0 +1,65 @@
+package playwright
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendCDP(t *testing.T) {
+	session, err := attachTargetCDPSession(&fakeBrowserCDPSession{}, "target-1")
+	require.NoError(t, err)
+
+	type evaluateResult struct {
+		Result struct {
+			Value int `json:"value"`
+		} `json:"result"`
+	}
+	result, err := SendCDP[evaluateResult](session, "Runtime.evaluate", struct {
+		Expression string `json:"expression"`
+	}{Expression: "1 + 1"})
+	require.NoError(t, err)
+	require.Equal(t, 2, result.Result.Value)
+
+	_, err = SendCDP[string](session, "Runtime.evaluate", nil)
+	require.ErrorContains(t, err, "could not decode result of Runtime.evaluate")
+}
+
+func TestSubscribeCDPEvent(t *testing.T) {
+	session, err := attachTargetCDPSession(&fakeBrowserCDPSession{}, "target-1")
+	require.NoError(t, err)
+
+	type consoleAPICalled struct {
+		Type string `json:"type"`
+	}
+	events := SubscribeCDPEvent[consoleAPICalled](session, "Runtime.consoleAPICalled")
+	handled := make([]string, 0)
+	remove := OnCDPEvent(session, "Runtime.consoleAPICalled", func(e consoleAPICalled) {
+		handled = append(handled, e.Type)
+	})
+	detached := 0
+	session.OnDetached(func(CDPSession) {
+		detached++
+	})
+
+	for i := 0; i < 2; i++ {
+		_, err = session.Send("Runtime.evaluate", nil)
+		require.NoError(t, err)
+	}
+	remove()
+	_, err = session.Send("Runtime.evaluate", nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"log", "log"}, handled)
+
+	require.NoError(t, session.Detach())
+	require.NoError(t, session.Detach())
+	require.True(t, session.IsDetached())
+	require.Equal(t, 1, detached)
+	// The events are buffered until they are received, then the channel is closed.
+	received := 0
+	for e := range events.Events() {
+		require.Equal(t, "log", e.Type)
+		received++
+	}
+	require.Equal(t, 3, received)
+}
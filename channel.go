@@ -26,6 +26,9 @@ func (c *channel) SendReturnAsDict(method string, options ...interface{}) (inter
 
 func (c *channel) innerSend(method string, returnAsDict bool, options ...interface{}) (interface{}, error) {
 	params := transformOptions(options...)
+	if err := validateTimeoutParam(params); err != nil {
+		return nil, err
+	}
 	callback, err := c.connection.sendMessageToServer(c.owner, method, params, false)
 	if err != nil {
 		return nil, err
@@ -54,6 +57,10 @@ func (c *channel) innerSend(method string, returnAsDict bool, options ...interfa
 
 func (c *channel) SendNoReply(method string, options ...interface{}) {
 	params := transformOptions(options...)
+	if err := validateTimeoutParam(params); err != nil {
+		logger.Printf("SendNoReply failed: %v\n", err)
+		return
+	}
 	_, err := c.connection.WrapAPICall(func() (interface{}, error) {
 		return c.connection.sendMessageToServer(c.owner, method, params, true)
 	}, false)
@@ -62,6 +69,22 @@ func (c *channel) SendNoReply(method string, options ...interface{}) {
 	}
 }
 
+// validateTimeoutParam rejects the call if its timeout is negative or NaN, which the server would
+// otherwise treat as no timeout or reject with a protocol error.
+func validateTimeoutParam(params map[string]interface{}) error {
+	switch v := params["timeout"].(type) {
+	case float64:
+		return validateTimeout(v)
+	case *float64:
+		if v != nil {
+			return validateTimeout(*v)
+		}
+	case int:
+		return validateTimeout(float64(v))
+	}
+	return nil
+}
+
 func newChannel(owner *channelOwner, object interface{}) *channel {
 	channel := &channel{
 		connection: owner.connection,
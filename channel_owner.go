@@ -33,6 +33,10 @@ func (c *channelOwner) dispose(reason ...string) {
 		object.dispose(reason...)
 	}
 	c.objects = make(map[string]*channelOwner)
+
+	if d, ok := c.channel.object.(interface{ onDispose() }); ok {
+		d.onDispose()
+	}
 }
 
 func (c *channelOwner) adopt(child *channelOwner) {
@@ -70,6 +74,19 @@ func (c *channelOwner) addEvent(name string, handler interface{}, once bool) {
 	c.eventEmitter.addEvent(name, handler, once)
 }
 
+func (c *channelOwner) addListener(name string, handler interface{}) func() {
+	if c.ListenerCount(name) == 0 {
+		c.updateSubscription(name, true)
+	}
+	remove := c.eventEmitter.addListener(name, handler)
+	return func() {
+		remove()
+		if c.ListenerCount(name) == 0 {
+			c.updateSubscription(name, false)
+		}
+	}
+}
+
 func (c *channelOwner) RemoveListener(name string, handler interface{}) {
 	c.eventEmitter.RemoveListener(name, handler)
 	if c.ListenerCount(name) == 0 {
@@ -0,0 +1,464 @@
+package playwright
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/playwright-community/playwright-go/internal/multierror"
+)
+
+// clockSource installs globalThis.__pwClock, a controller that replaces Date, timers,
+// requestAnimationFrame and performance.now with versions driven by a virtual clock.
+// Until the clock is paused, virtual time follows real time.
+const clockSource = `(() => {
+  if (globalThis.__pwClock)
+    return;
+  const native = {
+    Date: globalThis.Date,
+    setTimeout: globalThis.setTimeout.bind(globalThis),
+    clearTimeout: globalThis.clearTimeout.bind(globalThis),
+    performanceNow: globalThis.performance.now.bind(globalThis.performance),
+  };
+  const state = {
+    installed: false,
+    datePatched: false,
+    paused: false,
+    ticks: 0,
+    anchor: native.performanceNow(),
+    origin: native.Date.now(),
+    fixedTime: undefined,
+    timers: new Map(),
+    lastId: 0,
+    realTimer: undefined,
+  };
+
+  const now = () => {
+    if (!state.paused) {
+      const real = native.performanceNow();
+      state.ticks += real - state.anchor;
+      state.anchor = real;
+    }
+    return state.ticks;
+  };
+  const wallTime = () => state.fixedTime !== undefined ? state.fixedTime : Math.round(state.origin + now());
+  const toTime = value => {
+    const time = typeof value === 'string' ? new native.Date(value).getTime() : value;
+    if (typeof time !== 'number' || isNaN(time))
+      throw new Error('Invalid date: ' + value);
+    return time;
+  };
+
+  const nextTimer = upTo => {
+    let next;
+    for (const timer of state.timers.values()) {
+      if (timer.callAt > upTo)
+        continue;
+      if (!next || timer.callAt < next.callAt || (timer.callAt === next.callAt && timer.id < next.id))
+        next = timer;
+    }
+    return next;
+  };
+  const fire = timer => {
+    if (timer.type === 'interval')
+      timer.callAt += timer.delay;
+    else
+      state.timers.delete(timer.id);
+    if (typeof timer.callback !== 'function')
+      return;
+    try {
+      timer.callback.apply(globalThis, timer.type === 'animationFrame' ? [timer.callAt] : timer.args);
+    } catch (e) {
+      native.setTimeout(() => { throw e; }, 0);
+    }
+  };
+  const runTo = target => {
+    for (let timer = nextTimer(target); timer; timer = nextTimer(target)) {
+      state.ticks = Math.max(state.ticks, timer.callAt);
+      fire(timer);
+    }
+    state.ticks = Math.max(state.ticks, target);
+  };
+  const jumpTo = target => {
+    const due = [...state.timers.values()].filter(timer => timer.callAt <= target).sort((a, b) => a.callAt - b.callAt || a.id - b.id);
+    state.ticks = target;
+    for (const timer of due) {
+      if (timer.type === 'interval')
+        timer.callAt = target;
+      fire(timer);
+    }
+  };
+  const scheduleRealTimer = () => {
+    if (state.realTimer !== undefined)
+      native.clearTimeout(state.realTimer);
+    state.realTimer = undefined;
+    if (!state.installed || state.paused)
+      return;
+    const next = nextTimer(Infinity);
+    if (!next)
+      return;
+    state.realTimer = native.setTimeout(() => {
+      state.realTimer = undefined;
+      runTo(now());
+      scheduleRealTimer();
+    }, Math.max(0, next.callAt - now()));
+  };
+
+  const addTimer = (type, callback, delay, args) => {
+    const id = ++state.lastId;
+    delay = Math.max(0, Number(delay) || 0);
+    if (type === 'interval')
+      delay = Math.max(1, delay);
+    let callAt = now() + delay;
+    if (type === 'animationFrame')
+      callAt = (Math.floor(now() / 16) + 1) * 16;
+    state.timers.set(id, { id, type, callback, args, delay, callAt });
+    scheduleRealTimer();
+    return id;
+  };
+  const removeTimer = id => {
+    state.timers.delete(id);
+    scheduleRealTimer();
+  };
+
+  const patchDate = () => {
+    if (state.datePatched)
+      return;
+    state.datePatched = true;
+    function ClockDate(...args) {
+      if (!new.target)
+        return new native.Date(wallTime()).toString();
+      if (args.length === 0)
+        return new native.Date(wallTime());
+      return new native.Date(...args);
+    }
+    ClockDate.prototype = native.Date.prototype;
+    ClockDate.now = () => wallTime();
+    ClockDate.parse = native.Date.parse;
+    ClockDate.UTC = native.Date.UTC;
+    globalThis.Date = ClockDate;
+  };
+  const patchTimers = () => {
+    globalThis.setTimeout = (callback, delay, ...args) => addTimer('timeout', callback, delay, args);
+    globalThis.clearTimeout = removeTimer;
+    globalThis.setInterval = (callback, delay, ...args) => addTimer('interval', callback, delay, args);
+    globalThis.clearInterval = removeTimer;
+    globalThis.requestAnimationFrame = callback => addTimer('animationFrame', callback, 0, []);
+    globalThis.cancelAnimationFrame = removeTimer;
+    globalThis.performance.now = () => now();
+  };
+
+  globalThis.__pwClock = {
+    install(time) {
+      if (state.installed)
+        throw new Error('Clock is already installed');
+      if (time !== undefined)
+        state.origin = toTime(time) - now();
+      patchDate();
+      patchTimers();
+      state.installed = true;
+    },
+    fastForward(ticks) {
+      jumpTo(now() + ticks);
+      scheduleRealTimer();
+    },
+    runFor(ticks) {
+      runTo(now() + ticks);
+      scheduleRealTimer();
+    },
+    pauseAt(time) {
+      const target = toTime(time) - state.origin;
+      if (target < now())
+        throw new Error('Cannot pause the clock at ' + new native.Date(toTime(time)).toISOString() + ', it is in the past');
+      jumpTo(target);
+      state.paused = true;
+      scheduleRealTimer();
+    },
+    resume() {
+      state.anchor = native.performanceNow();
+      state.paused = false;
+      scheduleRealTimer();
+    },
+    setFixedTime(time) {
+      patchDate();
+      state.fixedTime = toTime(time);
+    },
+    setSystemTime(time) {
+      patchDate();
+      state.fixedTime = undefined;
+      state.origin = toTime(time) - now();
+    },
+    restore(saved) {
+      const time = saved.paused ? saved.time : native.Date.now() + saved.offset;
+      state.origin = time - now();
+      state.paused = saved.paused;
+      state.fixedTime = saved.fixedTime === null ? undefined : saved.fixedTime;
+      if (saved.datePatched)
+        patchDate();
+      if (saved.installed && !state.installed) {
+        patchTimers();
+        state.installed = true;
+      }
+      scheduleRealTimer();
+    },
+  };
+})();`
+
+// clockState is the state of the clock that pages created or navigated later are restored to, times are milliseconds
+// since the epoch.
+type clockState struct {
+	Installed   bool `json:"installed"`
+	DatePatched bool `json:"datePatched"`
+	Paused      bool `json:"paused"`
+	// Time is the time the clock is paused at.
+	Time float64 `json:"time"`
+	// Offset is the difference between the clock and the system time while the clock is not paused.
+	Offset    float64  `json:"offset"`
+	FixedTime *float64 `json:"fixedTime"`
+}
+
+func (s *clockState) now() float64 {
+	if s.Paused {
+		return s.Time
+	}
+	return float64(time.Now().UnixMilli()) + s.Offset
+}
+
+func (s *clockState) setTime(value float64) {
+	if s.Paused {
+		s.Time = value
+	} else {
+		s.Offset = value - float64(time.Now().UnixMilli())
+	}
+}
+
+type clockImpl struct {
+	sync.Mutex
+	browserContext *browserContextImpl
+	sourceAdded    bool
+	state          clockState
+}
+
+func newClock(browserContext *browserContextImpl) *clockImpl {
+	return &clockImpl{browserContext: browserContext}
+}
+
+func (c *clockImpl) Install(options ...ClockInstallOptions) error {
+	c.Lock()
+	defer c.Unlock()
+	var installTime *float64
+	if len(options) == 1 && options[0].Time != nil {
+		value, err := c.parseTime(options[0].Time)
+		if err != nil {
+			return err
+		}
+		installTime = &value
+	}
+	if c.state.Installed {
+		return errors.New("Clock is already installed")
+	}
+	c.state.Installed = true
+	c.state.DatePatched = true
+	if installTime == nil {
+		return c.run("install", nil)
+	}
+	c.state.setTime(*installTime)
+	return c.run("install", *installTime)
+}
+
+func (c *clockImpl) FastForward(ticks interface{}) error {
+	ms, err := parseClockTicks(ticks)
+	if err != nil {
+		return err
+	}
+	c.Lock()
+	defer c.Unlock()
+	c.state.setTime(c.state.now() + ms)
+	return c.run("fastForward", ms)
+}
+
+func (c *clockImpl) PauseAt(pauseTime interface{}) error {
+	c.Lock()
+	defer c.Unlock()
+	value, err := c.parseTime(pauseTime)
+	if err != nil {
+		return err
+	}
+	if value < c.state.now() {
+		return fmt.Errorf("Cannot pause the clock at %s, it is in the past", time.UnixMilli(int64(value)).UTC().Format(time.RFC3339Nano))
+	}
+	c.state.Paused = true
+	c.state.Time = value
+	return c.run("pauseAt", value)
+}
+
+func (c *clockImpl) Resume() error {
+	c.Lock()
+	defer c.Unlock()
+	if c.state.Paused {
+		now := c.state.now()
+		c.state.Paused = false
+		c.state.setTime(now)
+	}
+	return c.run("resume", nil)
+}
+
+func (c *clockImpl) RunFor(ticks interface{}) error {
+	ms, err := parseClockTicks(ticks)
+	if err != nil {
+		return err
+	}
+	c.Lock()
+	defer c.Unlock()
+	c.state.setTime(c.state.now() + ms)
+	return c.run("runFor", ms)
+}
+
+func (c *clockImpl) SetFixedTime(fixedTime interface{}) error {
+	c.Lock()
+	defer c.Unlock()
+	value, err := c.parseTime(fixedTime)
+	if err != nil {
+		return err
+	}
+	c.state.DatePatched = true
+	c.state.FixedTime = &value
+	return c.run("setFixedTime", value)
+}
+
+func (c *clockImpl) SetSystemTime(systemTime interface{}) error {
+	c.Lock()
+	defer c.Unlock()
+	value, err := c.parseTime(systemTime)
+	if err != nil {
+		return err
+	}
+	c.state.DatePatched = true
+	c.state.FixedTime = nil
+	c.state.setTime(value)
+	return c.run("setSystemTime", value)
+}
+
+// run calls method of the clock controller in every frame of the context, then adds an init script restoring the
+// resulting state, so pages created or navigated later start from it rather than replaying the calls. The init
+// scripts of the context can't be removed, each call adds one and the last one wins.
+func (c *clockImpl) run(method string, arg interface{}) error {
+	if !c.sourceAdded {
+		if err := c.evaluate(clockSource); err != nil {
+			return err
+		}
+		if err := c.browserContext.addUntrackedInitScript(clockSource); err != nil {
+			return err
+		}
+		c.sourceAdded = true
+	}
+	script := fmt.Sprintf("globalThis.__pwClock.%s()", method)
+	if arg != nil {
+		encoded, err := json.Marshal(arg)
+		if err != nil {
+			return err
+		}
+		script = fmt.Sprintf("globalThis.__pwClock.%s(%s)", method, encoded)
+	}
+	err := c.evaluate(script)
+	state, marshalErr := json.Marshal(c.state)
+	if marshalErr != nil {
+		return marshalErr
+	}
+	return multierror.Join(err, c.browserContext.addUntrackedInitScript(fmt.Sprintf("globalThis.__pwClock.restore(%s)", state)))
+}
+
+func (c *clockImpl) evaluate(script string) error {
+	var errs []error
+	for _, page := range c.browserContext.Pages() {
+		for _, frame := range page.Frames() {
+			if _, err := frame.Evaluate(script); err != nil && !errors.Is(err, ErrTargetClosed) {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return multierror.Join(errs...)
+}
+
+// parseTime converts a point in time to milliseconds since the epoch. Strings are parsed by the browser's Date in the
+// first page of the context, or as RFC 3339 date-times and dates if there is no page.
+func (c *clockImpl) parseTime(value interface{}) (float64, error) {
+	text, ok := value.(string)
+	if !ok {
+		return parseClockTime(value)
+	}
+	pages := c.browserContext.Pages()
+	if len(pages) == 0 {
+		return parseClockTimeString(text)
+	}
+	result, err := pages[0].MainFrame().Evaluate("text => new Date(text).getTime()", text)
+	if err != nil {
+		return 0, err
+	}
+	ms, ok := result.(float64)
+	if !ok || math.IsNaN(ms) {
+		return 0, fmt.Errorf("invalid date: %s", text)
+	}
+	return ms, nil
+}
+
+// parseClockTicks converts ticks to milliseconds. Ticks are a number of milliseconds, a
+// [time.Duration] or a string like "08" for eight seconds, "01:00" for one minute or
+// "02:34:10" for two hours, 34 minutes and ten seconds.
+func parseClockTicks(ticks interface{}) (float64, error) {
+	switch v := ticks.(type) {
+	case int:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case float64:
+		return v, nil
+	case time.Duration:
+		return float64(v.Milliseconds()), nil
+	case string:
+		parts := strings.Split(v, ":")
+		if len(parts) > 3 {
+			return 0, fmt.Errorf("invalid ticks %q: expected ss, mm:ss or hh:mm:ss", v)
+		}
+		var seconds float64
+		for i, part := range parts {
+			n, err := strconv.Atoi(part)
+			if err != nil || n < 0 || (i > 0 && n >= 60) {
+				return 0, fmt.Errorf("invalid ticks %q: expected ss, mm:ss or hh:mm:ss", v)
+			}
+			seconds = seconds*60 + float64(n)
+		}
+		return seconds * 1000, nil
+	}
+	return 0, fmt.Errorf("invalid ticks type %T: expected int, int64, float64, time.Duration or string", ticks)
+}
+
+// parseClockTime converts a point in time, other than a string, to milliseconds since the epoch.
+func parseClockTime(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case float64:
+		return v, nil
+	case time.Time:
+		return float64(v.UnixMilli()), nil
+	}
+	return 0, fmt.Errorf("invalid time type %T: expected int, int64, float64, time.Time or string", value)
+}
+
+// parseClockTimeString parses a RFC 3339 date-time, or a date which is UTC like in the browser's Date.
+func parseClockTimeString(value string) (float64, error) {
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return float64(t.UnixMilli()), nil
+		}
+	}
+	return 0, fmt.Errorf("invalid date %q: expected a RFC 3339 date-time or a date when the context has no page", value)
+}
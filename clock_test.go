@@ -0,0 +1,57 @@
+package playwright
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseClockTicks(t *testing.T) {
+	for ticks, expected := range map[interface{}]float64{
+		1500:                   1500,
+		int64(20):              20,
+		2.5:                    2.5,
+		3 * time.Second:        3000,
+		"08":                   8000,
+		"01:00":                60000,
+		"02:34:10":             9250000,
+		time.Millisecond * 250: 250,
+	} {
+		ms, err := parseClockTicks(ticks)
+		require.NoError(t, err)
+		require.Equal(t, expected, ms, "ticks %v", ticks)
+	}
+	for _, ticks := range []interface{}{"1:2:3:4", "01:60", "abc", "-1", true} {
+		_, err := parseClockTicks(ticks)
+		require.Error(t, err, "ticks %v", ticks)
+	}
+}
+
+func TestParseClockTime(t *testing.T) {
+	value, err := parseClockTime(time.Date(2024, 2, 2, 8, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Equal(t, float64(1706860800000), value)
+	_, err = parseClockTime(time.Second)
+	require.Error(t, err)
+	value, err = parseClockTimeString("2024-02-02T08:00:00Z")
+	require.NoError(t, err)
+	require.Equal(t, float64(1706860800000), value)
+	value, err = parseClockTimeString("2024-02-02")
+	require.NoError(t, err)
+	require.Equal(t, float64(1706832000000), value)
+	_, err = parseClockTimeString("Feb 2, 2024")
+	require.Error(t, err)
+}
+
+func TestClockStateShouldKeepTheResultingTime(t *testing.T) {
+	state := clockState{}
+	state.setTime(1000)
+	state.Paused = true
+	state.Time = 5000
+	state.setTime(state.now() + 1000)
+	require.Equal(t, float64(6000), state.now())
+	state.Paused = false
+	state.setTime(6000)
+	require.InDelta(t, 6000, state.now(), 50)
+}
@@ -1,20 +1,32 @@
 package main
 
 import (
+	"flag"
+	"fmt"
 	"log"
 	"os"
+	"strings"
 
 	"github.com/playwright-community/playwright-go"
 )
 
 func main() {
-	driver, err := playwright.NewDriver(&playwright.RunOptions{})
+	driver, err := playwright.NewDriver(&playwright.RunOptions{Stdout: os.Stdout, Stderr: os.Stderr})
 	if err != nil {
 		log.Fatalf("could not start driver: %v", err)
 	}
 	if err = driver.DownloadDriver(); err != nil {
 		log.Fatalf("could not download driver: %v", err)
 	}
+	if len(os.Args) > 1 && os.Args[1] == "show-trace" {
+		showTrace(driver, os.Args[2:])
+		return
+	}
+	// codegen emits Go code unless another target of the driver is asked for.
+	if len(os.Args) > 1 && os.Args[1] == "codegen" && !hasTargetFlag(os.Args[2:]) {
+		codegen(driver, os.Args[2:])
+		return
+	}
 	cmd := driver.Command(os.Args[1:]...)
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
@@ -23,3 +35,86 @@ func main() {
 	}
 	os.Exit(cmd.ProcessState.ExitCode())
 }
+
+func showTrace(driver *playwright.PlaywrightDriver, args []string) {
+	flags := flag.NewFlagSet("show-trace", flag.ExitOnError)
+	browser := flags.String("browser", "", "browser to open the trace viewer in: chromium, firefox or webkit")
+	host := flags.String("host", "", "host to serve the trace viewer on")
+	port := flags.Int("port", 0, "port to serve the trace viewer on")
+	_ = flags.Parse(args)
+
+	options := playwright.ShowTraceViewerOptions{}
+	flags.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "browser":
+			options.Browser = browser
+		case "host":
+			options.Host = host
+		case "port":
+			options.Port = port
+		}
+	})
+	if err := driver.ShowTraceViewer(flags.Args(), options); err != nil {
+		log.Fatalf("could not show trace: %v", err)
+	}
+}
+
+func hasTargetFlag(args []string) bool {
+	for _, arg := range args {
+		if arg == "--target" || strings.HasPrefix(arg, "--target=") {
+			return true
+		}
+	}
+	return false
+}
+
+func codegen(driver *playwright.PlaywrightDriver, args []string) {
+	flags := flag.NewFlagSet("codegen", flag.ExitOnError)
+	values := map[string]*string{
+		"browser":           flags.String("browser", "", "browser to record in: chromium, firefox or webkit"),
+		"channel":           flags.String("channel", "", "chromium distribution channel to record in, e.g. chrome or msedge"),
+		"device":            flags.String("device", "", "device to emulate, e.g. \"iPhone 13\""),
+		"lang":              flags.String("lang", "", "locale to emulate, e.g. en-GB"),
+		"timezone":          flags.String("timezone", "", "timezone to emulate, e.g. Europe/Rome"),
+		"test-id-attribute": flags.String("test-id-attribute", "", "attribute used by the suggested test id locators"),
+		"load-storage":      flags.String("load-storage", "", "storage state to load before recording"),
+		"save-storage":      flags.String("save-storage", "", "file to save the storage state to"),
+		"output":            flags.String("output", "", "file to write the generated code to"),
+	}
+	viewport := flags.String("viewport-size", "", "viewport size of the recorded pages, e.g. 1280,720")
+	_ = flags.Parse(args)
+
+	options := playwright.CodegenOptions{}
+	fields := map[string]**string{
+		"browser":           &options.Browser,
+		"channel":           &options.Channel,
+		"device":            &options.Device,
+		"lang":              &options.Locale,
+		"timezone":          &options.TimezoneId,
+		"test-id-attribute": &options.TestIdAttribute,
+		"load-storage":      &options.LoadStorage,
+		"save-storage":      &options.SaveStorage,
+		"output":            &options.Output,
+	}
+	flags.Visit(func(f *flag.Flag) {
+		if field, ok := fields[f.Name]; ok {
+			*field = values[f.Name]
+		} else if f.Name == "viewport-size" {
+			size := &playwright.Size{}
+			if _, err := fmt.Sscanf(*viewport, "%d,%d", &size.Width, &size.Height); err != nil {
+				log.Fatalf("invalid viewport size %q: %v", *viewport, err)
+			}
+			options.Viewport = size
+		}
+	})
+	if flags.NArg() > 0 {
+		options.URL = playwright.String(flags.Arg(0))
+	}
+	code, err := driver.Codegen(options)
+	if err != nil {
+		log.Fatalf("could not record: %v", err)
+	}
+	if options.Output == nil {
+		fmt.Print(code)
+	}
+}
@@ -0,0 +1,578 @@
+package playwright
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// CodegenOptions are the options of [Codegen].
+type CodegenOptions struct {
+	// URL to open when the recorder starts.
+	URL *string
+	// Browser to record in, one of `chromium`, `firefox` or `webkit`. Defaults to `chromium`.
+	Browser *string
+	// Chromium distribution channel to record in, e.g. `chrome` or `msedge`.
+	Channel *string
+	// Name of the device to emulate, a key of [Playwright.Devices].
+	Device *string
+	// Locale to emulate, e.g. `en-GB`.
+	Locale *string
+	// Timezone to emulate, e.g. `Europe/Rome`.
+	TimezoneId *string
+	// Viewport size of the recorded pages.
+	Viewport *Size
+	// Attribute used by the suggested [Page.GetByTestId] locators. Defaults to `data-testid`.
+	TestIdAttribute *string
+	// Path of a storage state to load before recording, see [BrowserContext.StorageState].
+	LoadStorage *string
+	// Path to save the storage state to when the recorder is closed.
+	SaveStorage *string
+	// Path to write the generated code to, in addition to returning it.
+	Output *string
+}
+
+// Codegen opens a browser with the Playwright inspector in record mode and waits until it is closed. It returns Go code
+// performing the recorded interactions with the locators suggested by the recorder, as a program to bootstrap tests
+// from.
+//
+// Requires the driver and the browsers to be installed before, see [Install].
+func Codegen(options ...CodegenOptions) (string, error) {
+	driver, err := NewDriver(transformRunOptions(nil))
+	if err != nil {
+		return "", fmt.Errorf("could not get driver instance: %w", err)
+	}
+	up2date, err := driver.isUpToDateDriver()
+	if err != nil || !up2date {
+		return "", fmt.Errorf("please install the driver (v%s) and browsers first: %w", playwrightCliVersion, err)
+	}
+	return driver.Codegen(options...)
+}
+
+// Codegen records interactions with the driver and returns them as Go code, see [Codegen].
+func (d *PlaywrightDriver) Codegen(options ...CodegenOptions) (string, error) {
+	option := CodegenOptions{}
+	if len(options) == 1 {
+		option = options[0]
+	}
+	dir, err := os.MkdirTemp("", "playwright-codegen-")
+	if err != nil {
+		return "", fmt.Errorf("could not create temporary directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+	recording := filepath.Join(dir, "recording.jsonl")
+
+	cmd := d.Command(codegenArgs(recording, option)...)
+	cmd.Stdout = d.options.Stdout
+	cmd.Stderr = d.options.Stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("could not run recorder: %w", err)
+	}
+	data, err := os.ReadFile(recording)
+	if err != nil {
+		return "", fmt.Errorf("could not read recording: %w", err)
+	}
+	code, err := generateGoCode(data)
+	if err != nil {
+		return "", err
+	}
+	if option.Output != nil {
+		if err := os.WriteFile(*option.Output, []byte(code), 0o644); err != nil {
+			return "", fmt.Errorf("could not write generated code: %w", err)
+		}
+	}
+	return code, nil
+}
+
+func codegenArgs(recording string, option CodegenOptions) []string {
+	args := []string{"codegen", "--target", "jsonl", "--output", recording}
+	flags := []struct {
+		name  string
+		value *string
+	}{
+		{"--browser", option.Browser},
+		{"--channel", option.Channel},
+		{"--device", option.Device},
+		{"--lang", option.Locale},
+		{"--timezone", option.TimezoneId},
+		{"--test-id-attribute", option.TestIdAttribute},
+		{"--load-storage", option.LoadStorage},
+		{"--save-storage", option.SaveStorage},
+	}
+	for _, flag := range flags {
+		if flag.value != nil {
+			args = append(args, flag.name, *flag.value)
+		}
+	}
+	if option.Viewport != nil {
+		args = append(args, "--viewport-size", fmt.Sprintf("%d,%d", option.Viewport.Width, option.Viewport.Height))
+	}
+	if option.URL != nil {
+		args = append(args, *option.URL)
+	}
+	return args
+}
+
+type (
+	// codegenHeader is the first line of a recording, describing the browser and context it was recorded in.
+	codegenHeader struct {
+		BrowserName   string `json:"browserName"`
+		LaunchOptions struct {
+			Channel *string `json:"channel"`
+		} `json:"launchOptions"`
+		ContextOptions struct {
+			Locale     *string `json:"locale"`
+			TimezoneId *string `json:"timezoneId"`
+			Viewport   *Size   `json:"viewport"`
+		} `json:"contextOptions"`
+		DeviceName  string `json:"deviceName"`
+		SaveStorage string `json:"saveStorage"`
+	}
+	// codegenAction is a recorded action, on the element of Locator or Selector of the page PageAlias.
+	codegenAction struct {
+		Name       string          `json:"name"`
+		PageAlias  string          `json:"pageAlias"`
+		Selector   string          `json:"selector"`
+		Locator    *codegenLocator `json:"locator"`
+		Signals    []codegenSignal `json:"signals"`
+		URL        string          `json:"url"`
+		Button     string          `json:"button"`
+		Modifiers  int             `json:"modifiers"`
+		ClickCount int             `json:"clickCount"`
+		Position   *Position       `json:"position"`
+		Text       string          `json:"text"`
+		Substring  bool            `json:"substring"`
+		Value      string          `json:"value"`
+		Checked    bool            `json:"checked"`
+		Key        string          `json:"key"`
+		Options    []string        `json:"options"`
+		Files      []string        `json:"files"`
+	}
+	// codegenLocator is a part of a suggested locator, chained to the Next one.
+	codegenLocator struct {
+		Kind    string                 `json:"kind"`
+		Body    interface{}            `json:"body"`
+		Options map[string]interface{} `json:"options"`
+		Next    *codegenLocator        `json:"next"`
+	}
+	codegenSignal struct {
+		Name          string `json:"name"`
+		PopupAlias    string `json:"popupAlias"`
+		DownloadAlias string `json:"downloadAlias"`
+	}
+)
+
+// generateGoCode converts a recording of the jsonl codegen target into a Go program.
+func generateGoCode(recording []byte) (string, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(recording))
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	var (
+		header  *codegenHeader
+		actions []codegenAction
+	)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		if header == nil {
+			header = &codegenHeader{}
+			if err := json.Unmarshal(line, header); err != nil {
+				return "", fmt.Errorf("could not parse recording header: %w", err)
+			}
+			continue
+		}
+		var action codegenAction
+		if err := json.Unmarshal(line, &action); err != nil {
+			return "", fmt.Errorf("could not parse recorded action: %w", err)
+		}
+		actions = append(actions, action)
+	}
+	if err := scanner.Err(); err != nil {
+		return "", fmt.Errorf("could not read recording: %w", err)
+	}
+	if header == nil {
+		return "", fmt.Errorf("recording is empty")
+	}
+	g := &goCodegen{}
+	g.generate(header, actions)
+	code, err := format.Source([]byte(g.sb.String()))
+	if err != nil {
+		return "", fmt.Errorf("could not format generated code: %w", err)
+	}
+	return string(code), nil
+}
+
+type goCodegen struct {
+	sb strings.Builder
+}
+
+func (g *goCodegen) line(format string, args ...interface{}) {
+	fmt.Fprintf(&g.sb, format+"\n", args...)
+}
+
+func (g *goCodegen) check(what string, expression string) {
+	g.line("assertErrorToNilf(%s, %s)", strconv.Quote("could not "+what+": %v"), expression)
+}
+
+func (g *goCodegen) generate(header *codegenHeader, actions []codegenAction) {
+	g.line("package main")
+	g.line("")
+	g.line("import (")
+	g.line(`"log"`)
+	g.line("")
+	g.line(`"github.com/playwright-community/playwright-go"`)
+	g.line(")")
+	g.line("")
+	g.line("func assertErrorToNilf(message string, err error) {")
+	g.line("if err != nil {")
+	g.line("log.Fatalf(message, err)")
+	g.line("}")
+	g.line("}")
+	g.line("")
+	g.line("func main() {")
+	g.line("pw, err := playwright.Run()")
+	g.check("launch playwright", "err")
+
+	browserType := map[string]string{"firefox": "Firefox", "webkit": "WebKit"}[header.BrowserName]
+	if browserType == "" {
+		browserType = "Chromium"
+	}
+	g.line("browser, err := pw.%s.Launch(playwright.BrowserTypeLaunchOptions{", browserType)
+	g.line("Headless: playwright.Bool(false),")
+	if header.LaunchOptions.Channel != nil {
+		g.line("Channel: playwright.String(%s),", strconv.Quote(*header.LaunchOptions.Channel))
+	}
+	g.line("})")
+	g.check("launch browser", "err")
+
+	if header.DeviceName != "" {
+		g.line("device := pw.Devices[%s]", strconv.Quote(header.DeviceName))
+	}
+	g.line("context, err := browser.NewContext(playwright.BrowserNewContextOptions{")
+	if header.DeviceName != "" {
+		g.line("UserAgent: playwright.String(device.UserAgent),")
+		g.line("Viewport: device.Viewport,")
+		g.line("Screen: device.Screen,")
+		g.line("DeviceScaleFactor: playwright.Float(device.DeviceScaleFactor),")
+		g.line("IsMobile: playwright.Bool(device.IsMobile),")
+		g.line("HasTouch: playwright.Bool(device.HasTouch),")
+	} else if header.ContextOptions.Viewport != nil {
+		g.line("Viewport: &playwright.Size{Width: %d, Height: %d},", header.ContextOptions.Viewport.Width, header.ContextOptions.Viewport.Height)
+	}
+	if header.ContextOptions.Locale != nil {
+		g.line("Locale: playwright.String(%s),", strconv.Quote(*header.ContextOptions.Locale))
+	}
+	if header.ContextOptions.TimezoneId != nil {
+		g.line("TimezoneId: playwright.String(%s),", strconv.Quote(*header.ContextOptions.TimezoneId))
+	}
+	g.line("})")
+	g.check("create context", "err")
+
+	for _, action := range actions {
+		if strings.HasPrefix(action.Name, "assert") {
+			g.line("expect := playwright.NewPlaywrightAssertions()")
+			break
+		}
+	}
+	for _, action := range actions {
+		g.action(action)
+	}
+
+	if header.SaveStorage != "" {
+		g.line("_, err = context.StorageState(%s)", strconv.Quote(header.SaveStorage))
+		g.check("save storage state", "err")
+	}
+	g.check("close browser", "browser.Close()")
+	g.check("stop playwright", "pw.Stop()")
+	g.line("}")
+}
+
+func (g *goCodegen) action(action codegenAction) {
+	page := action.PageAlias
+	if page == "" {
+		page = "page"
+	}
+	switch action.Name {
+	case "openPage":
+		g.line("%s, err := context.NewPage()", page)
+		g.check("create page", "err")
+		if action.URL != "" && action.URL != "about:blank" && action.URL != "chrome://newtab/" {
+			g.line("_, err = %s.Goto(%s)", page, strconv.Quote(action.URL))
+			g.check("goto", "err")
+		}
+		return
+	case "closePage":
+		g.check("close page", page+".Close()")
+		return
+	case "navigate":
+		g.line("_, err = %s.Goto(%s)", page, strconv.Quote(action.URL))
+		g.check("goto", "err")
+		return
+	}
+
+	locator := g.locator(page, action.Locator, action.Selector)
+	var what, call string
+	switch action.Name {
+	case "click":
+		what, call = "click", g.click(locator, action)
+	case "check":
+		what, call = "check", locator+".Check()"
+	case "uncheck":
+		what, call = "uncheck", locator+".Uncheck()"
+	case "fill":
+		what, call = "fill", fmt.Sprintf("%s.Fill(%s)", locator, strconv.Quote(action.Text))
+	case "press":
+		what, call = "press", fmt.Sprintf("%s.Press(%s)", locator, strconv.Quote(strings.Join(append(codegenModifiers(action.Modifiers), action.Key), "+")))
+	case "select":
+		what = "select option"
+		call = fmt.Sprintf("%s.SelectOption(playwright.SelectOptionValues{Values: playwright.StringSlice(%s)})", locator, quoteAll(action.Options))
+	case "setInputFiles":
+		what, call = "set input files", fmt.Sprintf("%s.SetInputFiles([]string{%s})", locator, quoteAll(action.Files))
+	case "assertText":
+		what = "assert text"
+		if action.Substring {
+			call = fmt.Sprintf("expect.Locator(%s).ToContainText(%s)", locator, strconv.Quote(action.Text))
+		} else {
+			call = fmt.Sprintf("expect.Locator(%s).ToHaveText(%s)", locator, strconv.Quote(action.Text))
+		}
+	case "assertValue":
+		what, call = "assert value", fmt.Sprintf("expect.Locator(%s).ToHaveValue(%s)", locator, strconv.Quote(action.Value))
+	case "assertChecked":
+		what = "assert checked"
+		if action.Checked {
+			call = fmt.Sprintf("expect.Locator(%s).ToBeChecked()", locator)
+		} else {
+			call = fmt.Sprintf("expect.Locator(%s).ToBeChecked(playwright.LocatorAssertionsToBeCheckedOptions{Checked: playwright.Bool(false)})", locator)
+		}
+	case "assertVisible":
+		what, call = "assert visibility", fmt.Sprintf("expect.Locator(%s).ToBeVisible()", locator)
+	default:
+		g.line("// TODO: unsupported action %q", action.Name)
+		return
+	}
+	if action.Name == "select" {
+		call = "_, err = " + call
+	}
+
+	for _, signal := range action.Signals {
+		switch signal.Name {
+		case "popup":
+			g.line("%s, err := %s.ExpectPopup(func() error {", signal.PopupAlias, page)
+			g.line("%s", returnCall(call))
+			g.line("})")
+			g.check(what, "err")
+			g.line("_ = %s", signal.PopupAlias)
+			return
+		case "download":
+			g.line("%s, err := %s.ExpectDownload(func() error {", signal.DownloadAlias, page)
+			g.line("%s", returnCall(call))
+			g.line("})")
+			g.check(what, "err")
+			g.line("_ = %s", signal.DownloadAlias)
+			return
+		case "dialog":
+			g.line(`%s.Once("dialog", func(dialog playwright.Dialog) {`, page)
+			g.line(`log.Printf("Dialog message: %%s", dialog.Message())`)
+			g.line("_ = dialog.Dismiss()")
+			g.line("})")
+		}
+	}
+	if strings.HasPrefix(call, "_, err = ") {
+		g.line("%s", call)
+		g.check(what, "err")
+		return
+	}
+	g.check(what, call)
+}
+
+// returnCall returns call from the callback of an Expect method.
+func returnCall(call string) string {
+	if strings.HasPrefix(call, "_, err = ") {
+		return "_, err := " + strings.TrimPrefix(call, "_, err = ") + "\nreturn err"
+	}
+	return "return " + call
+}
+
+func (g *goCodegen) click(locator string, action codegenAction) string {
+	var options []string
+	if action.Button != "" && action.Button != "left" {
+		options = append(options, fmt.Sprintf("Button: playwright.MouseButton%s", exportedName(action.Button)))
+	}
+	if modifiers := codegenModifiers(action.Modifiers); len(modifiers) > 0 {
+		values := make([]string, 0, len(modifiers))
+		for _, modifier := range modifiers {
+			values = append(values, "*playwright.KeyboardModifier"+modifier)
+		}
+		options = append(options, fmt.Sprintf("Modifiers: []playwright.KeyboardModifier{%s}", strings.Join(values, ", ")))
+	}
+	if action.Position != nil {
+		options = append(options, fmt.Sprintf("Position: &playwright.Position{X: %v, Y: %v}", action.Position.X, action.Position.Y))
+	}
+	method := "Click"
+	if action.ClickCount == 2 {
+		method = "Dblclick"
+	} else if action.ClickCount > 2 {
+		options = append(options, fmt.Sprintf("ClickCount: playwright.Int(%d)", action.ClickCount))
+	}
+	if len(options) == 0 {
+		return fmt.Sprintf("%s.%s()", locator, method)
+	}
+	return fmt.Sprintf("%s.%s(playwright.Locator%sOptions{%s})", locator, method, method, strings.Join(options, ", "))
+}
+
+// codegenModifiers returns the names of the keyboard modifiers set in the bit mask of the recorder.
+func codegenModifiers(mask int) []string {
+	var modifiers []string
+	for i, name := range []string{"Alt", "Control", "Meta", "Shift"} {
+		if mask&(1<<i) != 0 {
+			modifiers = append(modifiers, name)
+		}
+	}
+	return modifiers
+}
+
+// locator renders the suggested locator on page, or falls back to selector if a part of it is not supported.
+func (g *goCodegen) locator(page string, suggested *codegenLocator, selector string) string {
+	if suggested != nil {
+		if code, ok := renderLocator(page, "Page", suggested); ok {
+			return code
+		}
+	}
+	return fmt.Sprintf("%s.Locator(%s)", page, strconv.Quote(selector))
+}
+
+// renderLocator renders the chain of parts of a locator on subject, a value of the receiver type.
+func renderLocator(subject, receiver string, part *codegenLocator) (string, bool) {
+	for ; part != nil; part = part.Next {
+		body, isString := part.Body.(string)
+		exact, _ := part.Options["exact"].(bool)
+		next := "Locator"
+		switch part.Kind {
+		case "default":
+			if !isString {
+				return "", false
+			}
+			if hasText, ok := part.Options["hasText"].(string); ok {
+				subject = fmt.Sprintf("%s.Locator(%s, playwright.%sLocatorOptions{HasText: %s})", subject, strconv.Quote(body), receiver, strconv.Quote(hasText))
+			} else if hasNotText, ok := part.Options["hasNotText"].(string); ok {
+				subject = fmt.Sprintf("%s.Locator(%s, playwright.%sLocatorOptions{HasNotText: %s})", subject, strconv.Quote(body), receiver, strconv.Quote(hasNotText))
+			} else {
+				subject = fmt.Sprintf("%s.Locator(%s)", subject, strconv.Quote(body))
+			}
+		case "frame":
+			if !isString {
+				return "", false
+			}
+			subject = fmt.Sprintf("%s.FrameLocator(%s)", subject, strconv.Quote(body))
+			next = "FrameLocator"
+		case "nth", "first", "last":
+			if receiver != "Locator" {
+				return "", false
+			}
+			switch part.Kind {
+			case "first":
+				subject += ".First()"
+			case "last":
+				subject += ".Last()"
+			default:
+				index, err := strconv.Atoi(fmt.Sprint(part.Body))
+				if err != nil {
+					return "", false
+				}
+				subject = fmt.Sprintf("%s.Nth(%d)", subject, index)
+			}
+		case "has-text", "hasNot-text":
+			if receiver != "Locator" || !isString {
+				return "", false
+			}
+			field := "HasText"
+			if part.Kind == "hasNot-text" {
+				field = "HasNotText"
+			}
+			subject = fmt.Sprintf("%s.Filter(playwright.LocatorFilterOptions{%s: %s})", subject, field, strconv.Quote(body))
+		case "test-id":
+			if !isString {
+				return "", false
+			}
+			subject = fmt.Sprintf("%s.GetByTestId(%s)", subject, strconv.Quote(body))
+		case "text", "label", "placeholder", "alt", "title":
+			if !isString {
+				return "", false
+			}
+			method := map[string]string{"text": "Text", "label": "Label", "placeholder": "Placeholder", "alt": "AltText", "title": "Title"}[part.Kind]
+			if exact {
+				subject = fmt.Sprintf("%s.GetBy%s(%s, playwright.%sGetBy%sOptions{Exact: playwright.Bool(true)})", subject, method, strconv.Quote(body), receiver, method)
+			} else {
+				subject = fmt.Sprintf("%s.GetBy%s(%s)", subject, method, strconv.Quote(body))
+			}
+		case "role":
+			options, ok := roleOptions(part.Options)
+			if !isString || !ok {
+				return "", false
+			}
+			role := "*playwright.AriaRole" + exportedName(body)
+			if options == "" {
+				subject = fmt.Sprintf("%s.GetByRole(%s)", subject, role)
+			} else {
+				subject = fmt.Sprintf("%s.GetByRole(%s, playwright.%sGetByRoleOptions{%s})", subject, role, receiver, options)
+			}
+		default:
+			return "", false
+		}
+		receiver = next
+	}
+	return subject, receiver == "Locator"
+}
+
+// roleOptions renders the fields of the GetByRole options of a suggested locator.
+func roleOptions(options map[string]interface{}) (string, bool) {
+	var fields []string
+	if name, ok := options["name"]; ok {
+		s, isString := name.(string)
+		if !isString {
+			return "", false
+		}
+		fields = append(fields, "Name: "+strconv.Quote(s))
+	}
+	if exact, _ := options["exact"].(bool); exact {
+		fields = append(fields, "Exact: playwright.Bool(true)")
+	}
+	attrs, _ := options["attrs"].([]interface{})
+	for _, attr := range attrs {
+		attr, _ := attr.(map[string]interface{})
+		name, _ := attr["name"].(string)
+		switch value := attr["value"].(type) {
+		case bool:
+			fields = append(fields, fmt.Sprintf("%s: playwright.Bool(%t)", exportedName(name), value))
+		case float64:
+			fields = append(fields, fmt.Sprintf("%s: playwright.Int(%d)", exportedName(name), int(value)))
+		default:
+			return "", false
+		}
+	}
+	sort.SliceStable(fields, func(i, j int) bool { return fields[i] < fields[j] })
+	return strings.Join(fields, ", "), true
+}
+
+func exportedName(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+func quoteAll(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, value := range values {
+		quoted = append(quoted, strconv.Quote(value))
+	}
+	return strings.Join(quoted, ", ")
+}
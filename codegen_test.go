@@ -0,0 +1,79 @@
+package playwright
+
+import (
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const codegenRecording = `{"browserName":"firefox","launchOptions":{"headless":false},"contextOptions":{"locale":"en-GB","viewport":{"width":800,"height":600}},"deviceName":""}
+{"name":"openPage","pageAlias":"page","url":"about:blank","signals":[]}
+{"name":"navigate","pageAlias":"page","url":"https://example.com/","signals":[]}
+{"name":"click","pageAlias":"page","selector":"internal:role=link[name=\"Sign in\"i]","locator":{"kind":"role","body":"link","options":{"name":"Sign in","attrs":[]}},"signals":[],"button":"left","modifiers":8,"clickCount":1}
+{"name":"fill","pageAlias":"page","selector":"internal:label=\"Email\"i","locator":{"kind":"label","body":"Email","options":{"exact":false}},"signals":[],"text":"me@example.com"}
+{"name":"press","pageAlias":"page","selector":"internal:label=\"Email\"i","locator":{"kind":"label","body":"Email","options":{"exact":false}},"signals":[],"key":"Enter","modifiers":2}
+{"name":"click","pageAlias":"page","selector":"internal:testid=[data-testid=\"menu\"s] >> nth=1","locator":{"kind":"test-id","body":"menu","options":{},"next":{"kind":"nth","body":"1","options":{}}},"signals":[],"button":"right","modifiers":0,"clickCount":2}
+{"name":"select","pageAlias":"page","selector":"#country","locator":{"kind":"default","body":"#country","options":{}},"signals":[],"options":["fr","de"]}
+{"name":"click","pageAlias":"page","selector":"iframe >> internal:control=enter-frame >> internal:text=\"Help\"i","locator":{"kind":"frame","body":"iframe","options":{},"next":{"kind":"text","body":"Help","options":{"exact":true}}},"signals":[{"name":"popup","popupAlias":"page1"}],"button":"left","modifiers":0,"clickCount":1}
+{"name":"assertText","pageAlias":"page1","selector":"h1","locator":{"kind":"default","body":"h1","options":{}},"signals":[],"text":"Help","substring":true}
+{"name":"assertChecked","pageAlias":"page","selector":"internal:role=checkbox","locator":{"kind":"role","body":"checkbox","options":{"attrs":[{"name":"checked","value":true}]}},"signals":[],"checked":false}
+{"name":"click","pageAlias":"page","selector":"text=/Down.*/","locator":{"kind":"text","body":{"source":"Down.*","flags":""},"options":{}},"signals":[{"name":"download","downloadAlias":"download"}],"button":"left","modifiers":0,"clickCount":1}
+{"name":"closePage","pageAlias":"page1","signals":[]}
+`
+
+func TestGenerateGoCode(t *testing.T) {
+	code, err := generateGoCode([]byte(codegenRecording))
+	require.NoError(t, err)
+	_, err = parser.ParseFile(token.NewFileSet(), "main.go", code, 0)
+	require.NoError(t, err)
+
+	for _, expected := range []string{
+		`browser, err := pw.Firefox.Launch(playwright.BrowserTypeLaunchOptions{`,
+		`Viewport: &playwright.Size{Width: 800, Height: 600},`,
+		`Locale:   playwright.String("en-GB"),`,
+		`expect := playwright.NewPlaywrightAssertions()`,
+		`page, err := context.NewPage()`,
+		`_, err = page.Goto("https://example.com/")`,
+		`assertErrorToNilf("could not click: %v", page.GetByRole(*playwright.AriaRoleLink, playwright.PageGetByRoleOptions{Name: "Sign in"}).Click(playwright.LocatorClickOptions{Modifiers: []playwright.KeyboardModifier{*playwright.KeyboardModifierShift}}))`,
+		`assertErrorToNilf("could not fill: %v", page.GetByLabel("Email").Fill("me@example.com"))`,
+		`page.GetByLabel("Email").Press("Control+Enter")`,
+		`page.GetByTestId("menu").Nth(1).Dblclick(playwright.LocatorDblclickOptions{Button: playwright.MouseButtonRight})`,
+		`_, err = page.Locator("#country").SelectOption(playwright.SelectOptionValues{Values: playwright.StringSlice("fr", "de")})`,
+		`page1, err := page.ExpectPopup(func() error {`,
+		`return page.FrameLocator("iframe").GetByText("Help", playwright.FrameLocatorGetByTextOptions{Exact: playwright.Bool(true)}).Click()`,
+		`expect.Locator(page1.Locator("h1")).ToContainText("Help")`,
+		`expect.Locator(page.GetByRole(*playwright.AriaRoleCheckbox, playwright.PageGetByRoleOptions{Checked: playwright.Bool(true)})).ToBeChecked(playwright.LocatorAssertionsToBeCheckedOptions{Checked: playwright.Bool(false)})`,
+		`download, err := page.ExpectDownload(func() error {`,
+		`return page.Locator("text=/Down.*/").Click()`,
+		`assertErrorToNilf("could not close page: %v", page1.Close())`,
+		`assertErrorToNilf("could not stop playwright: %v", pw.Stop())`,
+	} {
+		require.Contains(t, code, expected)
+	}
+}
+
+func TestGenerateGoCodeDevice(t *testing.T) {
+	code, err := generateGoCode([]byte(`{"browserName":"webkit","launchOptions":{},"contextOptions":{},"deviceName":"iPhone 13","saveStorage":"auth.json"}` + "\n"))
+	require.NoError(t, err)
+	require.Contains(t, code, `device := pw.Devices["iPhone 13"]`)
+	require.Contains(t, code, `UserAgent:         playwright.String(device.UserAgent),`)
+	require.Contains(t, code, `_, err = context.StorageState("auth.json")`)
+	require.NotContains(t, code, "expect :=")
+
+	_, err = generateGoCode(nil)
+	require.ErrorContains(t, err, "recording is empty")
+}
+
+func TestCodegenArgs(t *testing.T) {
+	args := codegenArgs("recording.jsonl", CodegenOptions{
+		URL:      String("https://example.com"),
+		Browser:  String("webkit"),
+		Viewport: &Size{Width: 800, Height: 600},
+	})
+	require.Equal(t, []string{
+		"codegen", "--target", "jsonl", "--output", "recording.jsonl",
+		"--browser", "webkit", "--viewport-size", "800,600", "https://example.com",
+	}, args)
+}
@@ -102,7 +102,7 @@ func (c *connection) Dispatch(msg *message) {
 		}
 		if msg.Error != nil {
 			cb.(*protocolCallback).SetResult(result{
-				Error: parseError(msg.Error.Error),
+				Error: parseCallError(msg.Error.Error, cb.(*protocolCallback).method, cb.(*protocolCallback).apiName),
 			})
 		} else {
 			cb.(*protocolCallback).SetResult(result{
@@ -145,6 +145,13 @@ func (c *connection) Dispatch(msg *message) {
 	}
 }
 
+// offDispatcher runs fn in its own goroutine. Event handlers are called by Dispatch on the goroutine reading
+// the driver's messages, so one that sends a message would wait for a reply that is never read; such work
+// has to be handed off with offDispatcher.
+func offDispatcher(fn func()) {
+	go fn()
+}
+
 func (c *connection) LocalUtils() *localUtilsImpl {
 	return c.localUtils
 }
@@ -224,6 +231,7 @@ func (c *connection) sendMessageToServer(object *channelOwner, method string, pa
 	if object.wasCollected {
 		return nil, errors.New("The object has been collected to prevent unbounded heap growth.")
 	}
+	touchActivity(object)
 
 	id := c.lastID.Add(1)
 	cb, _ := c.callbacks.LoadOrStore(id, newProtocolCallback(noReply, c.abort))
@@ -238,6 +246,14 @@ func (c *connection) sendMessageToServer(object *channelOwner, method string, pa
 		}
 		stack = append(stack, apiZone.(parsedStackTrace).frames...)
 	}
+	cb.(*protocolCallback).method = method
+	if apiName, ok := metadata["apiName"].(string); ok {
+		cb.(*protocolCallback).apiName = apiName
+	}
+	cb.(*protocolCallback).audit = startAuditLogEntry(object, method, params, metadata, stack)
+	if noReply && cb.(*protocolCallback).audit != nil {
+		cb.(*protocolCallback).audit.finish(nil)
+	}
 	metadata["wallTime"] = time.Now().Nanosecond()
 	message := map[string]interface{}{
 		"id":       id,
@@ -355,9 +371,16 @@ type protocolCallback struct {
 	callback chan result
 	noReply  bool
 	abort    <-chan struct{}
+	method   string
+	apiName  string
+	// audit is the entry of the call in the audit log of its context, if it has one.
+	audit *auditLogEntry
 }
 
 func (pc *protocolCallback) SetResult(r result) {
+	if pc.audit != nil {
+		pc.audit.finish(r.Error)
+	}
 	if pc.noReply {
 		return
 	}
@@ -0,0 +1,105 @@
+package playwright
+
+import (
+	"errors"
+)
+
+// ErrCoverageNotSupported is returned when coverage is collected in a browser other than Chromium.
+var ErrCoverageNotSupported = errors.New("coverage is only supported in Chromium")
+
+// CoverageRange is a range of a script and the number of times it was executed, see [JSCoverageEntry]. The offsets
+// are in UTF-16 code units, as in JavaScript strings.
+type CoverageRange struct {
+	StartOffset int `json:"startOffset"`
+	EndOffset   int `json:"endOffset"`
+	Count       int `json:"count"`
+}
+
+// FunctionCoverage is the coverage of a function. The first range is the range of the function, the following ones
+// are the ranges of its blocks, nested in it, when they were executed a different number of times.
+type FunctionCoverage struct {
+	FunctionName    string          `json:"functionName"`
+	IsBlockCoverage bool            `json:"isBlockCoverage"`
+	Ranges          []CoverageRange `json:"ranges"`
+}
+
+// JSCoverageEntry is the coverage of a script, see [Coverage.StopJSCoverage].
+type JSCoverageEntry struct {
+	// URL of the script, empty for anonymous scripts.
+	URL string `json:"url"`
+	// Id of the script in the page.
+	ScriptID string `json:"scriptId"`
+	// Source of the script.
+	Source string `json:"source"`
+	// Coverage of the functions of the script, as reported by V8.
+	Functions []FunctionCoverage `json:"functions"`
+}
+
+// TextRange is a range of a text, in UTF-16 code units.
+type TextRange struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+// CSSCoverageEntry is the coverage of a style sheet, see [Coverage.StopCSSCoverage].
+type CSSCoverageEntry struct {
+	// URL of the style sheet.
+	URL string `json:"url"`
+	// Text of the style sheet.
+	Text string `json:"text"`
+	// Ranges of the rules that were used, sorted and not overlapping.
+	Ranges []TextRange `json:"ranges"`
+}
+
+type coverageImpl struct {
+	page *pageImpl
+}
+
+func newCoverage(page *pageImpl) *coverageImpl {
+	return &coverageImpl{page: page}
+}
+
+func (c *coverageImpl) checkSupported() error {
+	if c.page.browserContext == nil || !c.page.browserContext.isChromium() {
+		return ErrCoverageNotSupported
+	}
+	return nil
+}
+
+func (c *coverageImpl) StartJSCoverage(options ...CoverageStartJSCoverageOptions) error {
+	if err := c.checkSupported(); err != nil {
+		return err
+	}
+	_, err := c.page.channel.Send("startJSCoverage", optionsOf(options))
+	return err
+}
+
+func (c *coverageImpl) StopJSCoverage() ([]JSCoverageEntry, error) {
+	if err := c.checkSupported(); err != nil {
+		return nil, err
+	}
+	entries, err := c.page.channel.Send("stopJSCoverage")
+	if err != nil {
+		return nil, err
+	}
+	return decodeCDPValue[[]JSCoverageEntry](entries)
+}
+
+func (c *coverageImpl) StartCSSCoverage(options ...CoverageStartCSSCoverageOptions) error {
+	if err := c.checkSupported(); err != nil {
+		return err
+	}
+	_, err := c.page.channel.Send("startCSSCoverage", optionsOf(options))
+	return err
+}
+
+func (c *coverageImpl) StopCSSCoverage() ([]CSSCoverageEntry, error) {
+	if err := c.checkSupported(); err != nil {
+		return nil, err
+	}
+	entries, err := c.page.channel.Send("stopCSSCoverage")
+	if err != nil {
+		return nil, err
+	}
+	return decodeCDPValue[[]CSSCoverageEntry](entries)
+}
@@ -0,0 +1,280 @@
+package playwright
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+)
+
+// IstanbulPosition is a position in an Istanbul file coverage, with a 1-based line and a 0-based column.
+type IstanbulPosition struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// IstanbulLocation is a range in an Istanbul file coverage.
+type IstanbulLocation struct {
+	Start IstanbulPosition `json:"start"`
+	End   IstanbulPosition `json:"end"`
+}
+
+// IstanbulFunction is a function in an Istanbul file coverage.
+type IstanbulFunction struct {
+	Name string           `json:"name"`
+	Decl IstanbulLocation `json:"decl"`
+	Loc  IstanbulLocation `json:"loc"`
+	Line int              `json:"line"`
+}
+
+// IstanbulFileCoverage is the coverage of a file in the format of Istanbul, the coverage tool of nyc and Jest, see
+// [JSCoverageToIstanbul]. Every line of code is a statement, branches are not reported.
+type IstanbulFileCoverage struct {
+	Path         string                      `json:"path"`
+	StatementMap map[string]IstanbulLocation `json:"statementMap"`
+	FnMap        map[string]IstanbulFunction `json:"fnMap"`
+	BranchMap    map[string]interface{}      `json:"branchMap"`
+	S            map[string]int              `json:"s"`
+	F            map[string]int              `json:"f"`
+	B            map[string][]int            `json:"b"`
+}
+
+// fileCoverage is the coverage of a text by offset, the entries of the same URL and text being summed.
+type fileCoverage struct {
+	url       string
+	text      []uint16
+	counts    []int
+	functions []functionCoverage
+}
+
+type functionCoverage struct {
+	name  string
+	start int
+	end   int
+	count int
+}
+
+// coverageLine is a line of code of a text, from its first character that is not white space, which gives the count
+// of the line.
+type coverageLine struct {
+	number int
+	start  int
+	end    int
+	count  int
+}
+
+func jsFileCoverages(entries []JSCoverageEntry) []*fileCoverage {
+	return groupFileCoverages(len(entries), func(i int) (string, string) {
+		return entries[i].URL, entries[i].Source
+	}, func(i int, file *fileCoverage, counts []int) {
+		// Ranges are nested, so applying the outer ranges first leaves the innermost count of every offset.
+		ranges := make([]CoverageRange, 0)
+		for _, function := range entries[i].Functions {
+			ranges = append(ranges, function.Ranges...)
+			if len(function.Ranges) > 0 && function.FunctionName != "" {
+				file.functions = append(file.functions, functionCoverage{
+					name:  function.FunctionName,
+					start: function.Ranges[0].StartOffset,
+					end:   function.Ranges[0].EndOffset,
+					count: function.Ranges[0].Count,
+				})
+			}
+		}
+		sort.SliceStable(ranges, func(a, b int) bool {
+			if ranges[a].StartOffset != ranges[b].StartOffset {
+				return ranges[a].StartOffset < ranges[b].StartOffset
+			}
+			return ranges[a].EndOffset > ranges[b].EndOffset
+		})
+		for _, r := range ranges {
+			for offset := clampOffset(r.StartOffset, counts); offset < clampOffset(r.EndOffset, counts); offset++ {
+				counts[offset] = r.Count
+			}
+		}
+	})
+}
+
+func cssFileCoverages(entries []CSSCoverageEntry) []*fileCoverage {
+	return groupFileCoverages(len(entries), func(i int) (string, string) {
+		return entries[i].URL, entries[i].Text
+	}, func(i int, file *fileCoverage, counts []int) {
+		for _, r := range entries[i].Ranges {
+			for offset := clampOffset(r.Start, counts); offset < clampOffset(r.End, counts); offset++ {
+				counts[offset] = 1
+			}
+		}
+	})
+}
+
+// groupFileCoverages sums the counts of the entries of the same URL and text, in the order of their first entry. The
+// counts of an entry are filled by fill.
+func groupFileCoverages(n int, entry func(int) (string, string), fill func(int, *fileCoverage, []int)) []*fileCoverage {
+	files := make([]*fileCoverage, 0, n)
+	byKey := make(map[string]*fileCoverage)
+	for i := 0; i < n; i++ {
+		url, text := entry(i)
+		key := url + "\x00" + text
+		file, ok := byKey[key]
+		if !ok {
+			file = &fileCoverage{url: url, text: utf16.Encode([]rune(text))}
+			file.counts = make([]int, len(file.text))
+			byKey[key] = file
+			files = append(files, file)
+		}
+		counts := make([]int, len(file.text))
+		fill(i, file, counts)
+		for offset, count := range counts {
+			file.counts[offset] += count
+		}
+	}
+	for _, file := range files {
+		file.functions = mergeFunctionCoverages(file.functions)
+	}
+	return files
+}
+
+// mergeFunctionCoverages sums the counts of the functions at the same range.
+func mergeFunctionCoverages(functions []functionCoverage) []functionCoverage {
+	merged := make([]functionCoverage, 0, len(functions))
+	index := make(map[[2]int]int)
+	for _, function := range functions {
+		key := [2]int{function.start, function.end}
+		if i, ok := index[key]; ok {
+			merged[i].count += function.count
+			continue
+		}
+		index[key] = len(merged)
+		merged = append(merged, function)
+	}
+	sort.SliceStable(merged, func(a, b int) bool {
+		return merged[a].start < merged[b].start
+	})
+	return merged
+}
+
+func clampOffset(offset int, counts []int) int {
+	if offset < 0 {
+		return 0
+	}
+	if offset > len(counts) {
+		return len(counts)
+	}
+	return offset
+}
+
+// lines returns the lines of the text that contain code, that is characters other than white space.
+func (f *fileCoverage) lines() []coverageLine {
+	lines := make([]coverageLine, 0)
+	start := 0
+	for number := 1; start <= len(f.text); number++ {
+		end := start
+		for end < len(f.text) && f.text[end] != '\n' {
+			end++
+		}
+		for offset := start; offset < end; offset++ {
+			if !unicode.IsSpace(rune(f.text[offset])) {
+				lines = append(lines, coverageLine{number: number, start: offset, end: end, count: f.counts[offset]})
+				break
+			}
+		}
+		start = end + 1
+	}
+	return lines
+}
+
+// position returns the position of offset in the text.
+func (f *fileCoverage) position(offset int) IstanbulPosition {
+	position := IstanbulPosition{Line: 1}
+	for i := 0; i < offset && i < len(f.text); i++ {
+		if f.text[i] == '\n' {
+			position.Line++
+			position.Column = 0
+		} else {
+			position.Column++
+		}
+	}
+	return position
+}
+
+func (f *fileCoverage) location(start, end int) IstanbulLocation {
+	return IstanbulLocation{Start: f.position(start), End: f.position(end)}
+}
+
+// JSCoverageToIstanbul converts JS coverage to the format of Istanbul, by URL, e.g. to write it as the JSON files
+// of `.nyc_output` and report it with `nyc report`. The entries of the same URL and source are merged.
+func JSCoverageToIstanbul(entries []JSCoverageEntry) map[string]*IstanbulFileCoverage {
+	out := make(map[string]*IstanbulFileCoverage)
+	for _, file := range jsFileCoverages(entries) {
+		coverage := &IstanbulFileCoverage{
+			Path:         file.url,
+			StatementMap: make(map[string]IstanbulLocation),
+			FnMap:        make(map[string]IstanbulFunction),
+			BranchMap:    make(map[string]interface{}),
+			S:            make(map[string]int),
+			F:            make(map[string]int),
+			B:            make(map[string][]int),
+		}
+		for i, line := range file.lines() {
+			key := strconv.Itoa(i)
+			coverage.StatementMap[key] = file.location(line.start, line.end)
+			coverage.S[key] = line.count
+		}
+		for i, function := range file.functions {
+			key := strconv.Itoa(i)
+			loc := file.location(function.start, function.end)
+			coverage.FnMap[key] = IstanbulFunction{
+				Name: function.name,
+				Decl: loc,
+				Loc:  loc,
+				Line: loc.Start.Line,
+			}
+			coverage.F[key] = function.count
+		}
+		out[file.url] = coverage
+	}
+	return out
+}
+
+// JSCoverageToLcov converts JS coverage to the lcov tracefile format, e.g. to merge it with the coverage of other
+// tests with `lcov` or to upload it to a coverage service. The entries of the same URL and source are merged.
+func JSCoverageToLcov(entries []JSCoverageEntry) string {
+	return lcov(jsFileCoverages(entries))
+}
+
+// CSSCoverageToLcov converts CSS coverage to the lcov tracefile format, see [JSCoverageToLcov]. A line is covered
+// when its code is in the used rules.
+func CSSCoverageToLcov(entries []CSSCoverageEntry) string {
+	return lcov(cssFileCoverages(entries))
+}
+
+func lcov(files []*fileCoverage) string {
+	var b strings.Builder
+	for _, file := range files {
+		b.WriteString("TN:\n")
+		fmt.Fprintf(&b, "SF:%s\n", file.url)
+		hit := 0
+		for _, function := range file.functions {
+			fmt.Fprintf(&b, "FN:%d,%s\n", file.position(function.start).Line, function.name)
+		}
+		for _, function := range file.functions {
+			fmt.Fprintf(&b, "FNDA:%d,%s\n", function.count, function.name)
+			if function.count > 0 {
+				hit++
+			}
+		}
+		fmt.Fprintf(&b, "FNF:%d\nFNH:%d\n", len(file.functions), hit)
+		lines := file.lines()
+		hit = 0
+		for _, line := range lines {
+			fmt.Fprintf(&b, "DA:%d,%d\n", line.number, line.count)
+			if line.count > 0 {
+				hit++
+			}
+		}
+		fmt.Fprintf(&b, "LF:%d\nLH:%d\n", len(lines), hit)
+		b.WriteString("end_of_record\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,87 @@
+package playwright
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testJSCoverageEntry = JSCoverageEntry{
+	URL:    "http://localhost/app.js",
+	Source: "function a() {\n  return 1;\n}\nfunction b() {\n  return 2;\n}\na();\n",
+	Functions: []FunctionCoverage{
+		{FunctionName: "", Ranges: []CoverageRange{{StartOffset: 0, EndOffset: 63, Count: 1}}},
+		{FunctionName: "a", Ranges: []CoverageRange{{StartOffset: 0, EndOffset: 28, Count: 1}}},
+		{FunctionName: "b", Ranges: []CoverageRange{{StartOffset: 29, EndOffset: 57, Count: 0}}},
+	},
+}
+
+func TestJSCoverageToLcov(t *testing.T) {
+	require.Equal(t, `TN:
+SF:http://localhost/app.js
+FN:1,a
+FN:4,b
+FNDA:1,a
+FNDA:0,b
+FNF:2
+FNH:1
+DA:1,1
+DA:2,1
+DA:3,1
+DA:4,0
+DA:5,0
+DA:6,0
+DA:7,1
+LF:7
+LH:4
+end_of_record
+`, JSCoverageToLcov([]JSCoverageEntry{testJSCoverageEntry}))
+}
+
+func TestJSCoverageToIstanbulShouldMergeEntries(t *testing.T) {
+	coverage := JSCoverageToIstanbul([]JSCoverageEntry{testJSCoverageEntry, testJSCoverageEntry})
+	require.Len(t, coverage, 1)
+	file := coverage["http://localhost/app.js"]
+	require.Equal(t, "http://localhost/app.js", file.Path)
+	require.Len(t, file.StatementMap, 7)
+	require.Equal(t, IstanbulLocation{
+		Start: IstanbulPosition{Line: 2, Column: 2},
+		End:   IstanbulPosition{Line: 2, Column: 11},
+	}, file.StatementMap["1"])
+	require.Equal(t, map[string]int{"0": 2, "1": 2, "2": 2, "3": 0, "4": 0, "5": 0, "6": 2}, file.S)
+	require.Equal(t, "b", file.FnMap["1"].Name)
+	require.Equal(t, 4, file.FnMap["1"].Line)
+	require.Equal(t, map[string]int{"0": 2, "1": 0}, file.F)
+}
+
+func TestJSCoverageShouldUseInnermostBlockCount(t *testing.T) {
+	entry := JSCoverageEntry{
+		URL:    "http://localhost/branch.js",
+		Source: "if (x) {\n  y();\n}\n",
+		Functions: []FunctionCoverage{
+			{IsBlockCoverage: true, Ranges: []CoverageRange{
+				{StartOffset: 0, EndOffset: 18, Count: 1},
+				{StartOffset: 7, EndOffset: 17, Count: 0},
+			}},
+		},
+	}
+	file := JSCoverageToIstanbul([]JSCoverageEntry{entry})["http://localhost/branch.js"]
+	require.Equal(t, map[string]int{"0": 1, "1": 0, "2": 0}, file.S)
+}
+
+func TestCSSCoverageToLcov(t *testing.T) {
+	require.Equal(t, `TN:
+SF:http://localhost/style.css
+FNF:0
+FNH:0
+DA:1,1
+DA:2,0
+LF:2
+LH:1
+end_of_record
+`, CSSCoverageToLcov([]CSSCoverageEntry{{
+		URL:    "http://localhost/style.css",
+		Text:   "a { color: red }\nb { color: blue }\n",
+		Ranges: []TextRange{{Start: 0, End: 16}},
+	}}))
+}
@@ -0,0 +1,23 @@
+package playwright
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrCPUThrottlingNotSupported is returned when the CPU is throttled in a browser other than Chromium.
+var ErrCPUThrottlingNotSupported = errors.New("CPU throttling emulation is only supported in Chromium")
+
+func (p *pageImpl) EmulateCPUThrottling(rate float64) error {
+	if rate < 1 {
+		return fmt.Errorf("invalid CPU throttling rate %v: precondition 1 <= RATE failed", rate)
+	}
+	session, err := p.cdpSession()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrCPUThrottlingNotSupported, err)
+	}
+	_, err = session.Send("Emulation.setCPUThrottlingRate", map[string]interface{}{
+		"rate": rate,
+	})
+	return err
+}
@@ -0,0 +1,254 @@
+package playwright
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime"
+	"strings"
+	"time"
+)
+
+// cspViolationBinding is the binding [cspViolationScript] reports the violations of the documents with.
+const cspViolationBinding = "__pwCSPViolation"
+
+// cspViolationScript reports the `securitypolicyviolation` events of the documents.
+const cspViolationScript = `(() => {
+  if (window.__pwCSPViolationListener)
+    return;
+  window.__pwCSPViolationListener = true;
+  document.addEventListener('securitypolicyviolation', event => {
+    window.` + cspViolationBinding + `({
+      documentURL: event.documentURI,
+      blockedURL: event.blockedURI,
+      violatedDirective: event.violatedDirective,
+      effectiveDirective: event.effectiveDirective,
+      originalPolicy: event.originalPolicy,
+      disposition: event.disposition,
+      sourceFile: event.sourceFile,
+      lineNumber: event.lineNumber,
+      columnNumber: event.columnNumber,
+      sample: event.sample,
+      statusCode: event.statusCode,
+    }).catch(() => {});
+  }, true);
+})()`
+
+func getCSPViolationSource(in string) *CSPViolationSource {
+	v := CSPViolationSource(in)
+	return &v
+}
+
+// CSPViolationSource is how a [CSPViolation] was captured.
+type CSPViolationSource string
+
+var (
+	// The violation was captured from a `securitypolicyviolation` event of the document.
+	CSPViolationSourceEvent *CSPViolationSource = getCSPViolationSource("event")
+	// The violation was captured from a report sent by the browser to the `report-uri` or `report-to` endpoint of the
+	// policy.
+	CSPViolationSourceReport = getCSPViolationSource("report")
+)
+
+// CSPViolation is a violation of the Content Security Policy of a document, see [Page.OnCSPViolation].
+type CSPViolation struct {
+	// Time the violation was captured.
+	Time time.Time
+	// How the violation was captured. A violation of a policy with a reporting endpoint is captured twice, from the
+	// event and from the report.
+	Source CSPViolationSource
+	// URL of the document.
+	DocumentURL string
+	// URL of the blocked resource, or `inline`, `eval` or `wasm-eval`.
+	BlockedURL string
+	// Directive violated, as written in the policy.
+	ViolatedDirective string
+	// Directive enforced, such as `script-src-elem`.
+	EffectiveDirective string
+	// Policy violated.
+	OriginalPolicy string
+	// `enforce` if the resource was blocked, `report` if the policy is report-only.
+	Disposition string
+	// Location of the violation in the source, if any.
+	SourceFile   string
+	LineNumber   int
+	ColumnNumber int
+	// First characters of the inline script or style blocked, if the policy has `'report-sample'`.
+	Sample string
+	// HTTP status code of the document.
+	StatusCode int
+}
+
+// cspEventViolation is a violation reported by [cspViolationScript], or the body of a report of the Reporting API.
+type cspEventViolation struct {
+	DocumentURL        string `json:"documentURL"`
+	BlockedURL         string `json:"blockedURL"`
+	ViolatedDirective  string `json:"violatedDirective"`
+	EffectiveDirective string `json:"effectiveDirective"`
+	OriginalPolicy     string `json:"originalPolicy"`
+	Disposition        string `json:"disposition"`
+	SourceFile         string `json:"sourceFile"`
+	LineNumber         int    `json:"lineNumber"`
+	ColumnNumber       int    `json:"columnNumber"`
+	Sample             string `json:"sample"`
+	StatusCode         int    `json:"statusCode"`
+}
+
+func (v *cspEventViolation) violation(source *CSPViolationSource) CSPViolation {
+	return CSPViolation{
+		Time:               time.Now(),
+		Source:             *source,
+		DocumentURL:        v.DocumentURL,
+		BlockedURL:         v.BlockedURL,
+		ViolatedDirective:  v.ViolatedDirective,
+		EffectiveDirective: v.EffectiveDirective,
+		OriginalPolicy:     v.OriginalPolicy,
+		Disposition:        v.Disposition,
+		SourceFile:         v.SourceFile,
+		LineNumber:         v.LineNumber,
+		ColumnNumber:       v.ColumnNumber,
+		Sample:             v.Sample,
+		StatusCode:         v.StatusCode,
+	}
+}
+
+// cspReport is a report sent to the `report-uri` endpoint of a policy.
+type cspReport struct {
+	Report struct {
+		DocumentURI        string `json:"document-uri"`
+		BlockedURI         string `json:"blocked-uri"`
+		ViolatedDirective  string `json:"violated-directive"`
+		EffectiveDirective string `json:"effective-directive"`
+		OriginalPolicy     string `json:"original-policy"`
+		Disposition        string `json:"disposition"`
+		SourceFile         string `json:"source-file"`
+		LineNumber         int    `json:"line-number"`
+		ColumnNumber       int    `json:"column-number"`
+		ScriptSample       string `json:"script-sample"`
+		StatusCode         int    `json:"status-code"`
+	} `json:"csp-report"`
+}
+
+// parseCSPReports returns the violations of the body of a request sent to a `report-uri` or `report-to` endpoint, nil
+// if the request is not a report.
+func parseCSPReports(contentType string, body []byte) ([]CSPViolation, error) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, nil
+	}
+	switch mediaType {
+	case "application/csp-report":
+		var report cspReport
+		if err := json.Unmarshal(body, &report); err != nil {
+			return nil, fmt.Errorf("could not parse CSP report: %w", err)
+		}
+		r := report.Report
+		violation := cspEventViolation{
+			DocumentURL:        r.DocumentURI,
+			BlockedURL:         r.BlockedURI,
+			ViolatedDirective:  r.ViolatedDirective,
+			EffectiveDirective: r.EffectiveDirective,
+			OriginalPolicy:     r.OriginalPolicy,
+			Disposition:        r.Disposition,
+			SourceFile:         r.SourceFile,
+			LineNumber:         r.LineNumber,
+			ColumnNumber:       r.ColumnNumber,
+			Sample:             r.ScriptSample,
+			StatusCode:         r.StatusCode,
+		}
+		return []CSPViolation{violation.violation(CSPViolationSourceReport)}, nil
+	case "application/reports+json":
+		var reports []struct {
+			Type string            `json:"type"`
+			Body cspEventViolation `json:"body"`
+		}
+		if err := json.Unmarshal(body, &reports); err != nil {
+			return nil, fmt.Errorf("could not parse reports: %w", err)
+		}
+		violations := make([]CSPViolation, 0)
+		for _, report := range reports {
+			if report.Type == "csp-violation" {
+				violations = append(violations, report.Body.violation(CSPViolationSourceReport))
+			}
+		}
+		return violations, nil
+	}
+	return nil, nil
+}
+
+func (p *pageImpl) OnCSPViolation(fn func(CSPViolation)) {
+	p.On("cspviolation", fn)
+}
+
+// CaptureCSPViolations reports the CSP violations of the pages of the context, from the events of the documents and
+// from the requests to the reporting endpoints. The documents already loaded get the event listener as well.
+func (b *browserContextImpl) CaptureCSPViolations() error {
+	b.cspReportingMu.Lock()
+	defer b.cspReportingMu.Unlock()
+	if b.cspReporting {
+		return nil
+	}
+	if err := b.ExposeBinding(cspViolationBinding, b.onCSPViolationBinding); err != nil {
+		return err
+	}
+	b.cspReporting = true
+	b.On("request", b.onCSPReportRequest)
+	if err := b.addUntrackedInitScript(cspViolationScript); err != nil {
+		return err
+	}
+	for _, page := range b.Pages() {
+		for _, frame := range page.Frames() {
+			// The frames may navigate or be detached meanwhile, the new documents have the listener anyway.
+			_, _ = frame.Evaluate(cspViolationScript)
+		}
+	}
+	return nil
+}
+
+func (b *browserContextImpl) onCSPViolationBinding(source *BindingSource, args ...interface{}) interface{} {
+	if len(args) != 1 || source.Page == nil {
+		return nil
+	}
+	event, err := decodeCDPValue[cspEventViolation](args[0])
+	if err != nil {
+		b.logf("could not decode CSP violation: %v\n", err)
+		return nil
+	}
+	source.Page.Emit("cspviolation", event.violation(CSPViolationSourceEvent))
+	return nil
+}
+
+func (b *browserContextImpl) onCSPReportRequest(request Request) {
+	if request.Method() != "POST" {
+		return
+	}
+	contentType := request.Headers()["content-type"]
+	if !strings.Contains(contentType, "report") {
+		return
+	}
+	body, err := request.PostDataBuffer()
+	if err != nil {
+		return
+	}
+	violations, err := parseCSPReports(contentType, body)
+	if err != nil {
+		b.logf("could not capture CSP report to %s: %v\n", Redact(request.URL()), err)
+		return
+	}
+	page := request.(*requestImpl).safePage()
+	for _, violation := range violations {
+		if page != nil {
+			page.Emit("cspviolation", violation)
+			continue
+		}
+		// The reports of `report-to` endpoints are uploaded by the browser outside of the pages, they are emitted by
+		// the pages showing their document.
+		for _, p := range b.Pages() {
+			for _, frame := range p.Frames() {
+				if frame.URL() == violation.DocumentURL {
+					p.(*pageImpl).Emit("cspviolation", violation)
+					break
+				}
+			}
+		}
+	}
+}
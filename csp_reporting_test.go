@@ -0,0 +1,44 @@
+package playwright
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCSPReports(t *testing.T) {
+	violations, err := parseCSPReports("application/csp-report", []byte(`{"csp-report": {
+		"document-uri": "https://example.com/",
+		"blocked-uri": "inline",
+		"violated-directive": "script-src-elem",
+		"effective-directive": "script-src-elem",
+		"original-policy": "script-src 'self'; report-uri /csp",
+		"disposition": "enforce",
+		"line-number": 3,
+		"status-code": 200
+	}}`))
+	require.NoError(t, err)
+	require.Len(t, violations, 1)
+	require.Equal(t, *CSPViolationSourceReport, violations[0].Source)
+	require.Equal(t, "https://example.com/", violations[0].DocumentURL)
+	require.Equal(t, "inline", violations[0].BlockedURL)
+	require.Equal(t, "script-src-elem", violations[0].EffectiveDirective)
+	require.Equal(t, 3, violations[0].LineNumber)
+	require.Equal(t, 200, violations[0].StatusCode)
+
+	violations, err = parseCSPReports("application/reports+json", []byte(`[
+		{"type": "deprecation", "body": {}},
+		{"type": "csp-violation", "body": {"documentURL": "https://example.com/", "blockedURL": "eval", "effectiveDirective": "script-src", "disposition": "report"}}
+	]`))
+	require.NoError(t, err)
+	require.Len(t, violations, 1)
+	require.Equal(t, "eval", violations[0].BlockedURL)
+	require.Equal(t, "report", violations[0].Disposition)
+
+	violations, err = parseCSPReports("application/json", []byte(`{}`))
+	require.NoError(t, err)
+	require.Nil(t, violations)
+
+	_, err = parseCSPReports("application/csp-report; charset=utf-8", []byte(`not json`))
+	require.ErrorContains(t, err, "could not parse CSP report")
+}
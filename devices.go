@@ -0,0 +1,103 @@
+package playwright
+
+import "fmt"
+
+// DevicePreset bundles a device with the location, locale and permissions it is emulated
+// with, e.g. "Pixel 5 in Berlin". All settings are applied together when the context is
+// created:
+//
+//	berlin := playwright.DevicePreset{
+//		Device:      pw.Devices["Pixel 5"],
+//		Geolocation: &playwright.Geolocation{Latitude: 52.52, Longitude: 13.405},
+//		Locale:      playwright.String("de-DE"),
+//		TimezoneId:  playwright.String("Europe/Berlin"),
+//	}
+//	options, err := berlin.ContextOptions()
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	context, err := browser.NewContext(options)
+type DevicePreset struct {
+	// Device to emulate, e.g. an entry of [Playwright.Devices]. Optional.
+	Device *DeviceDescriptor
+	// Emulated geolocation. The "geolocation" permission is granted automatically.
+	Geolocation *Geolocation
+	// Emulated user locale, e.g. "de-DE".
+	Locale *string
+	// Emulated timezone, e.g. "Europe/Berlin".
+	TimezoneId *string
+	// Permissions to grant to all pages in the context.
+	Permissions []string
+}
+
+// ContextOptions returns the options that emulate the device, e.g. for [Browser.NewContext].
+func (d *DeviceDescriptor) ContextOptions() BrowserNewContextOptions {
+	options := BrowserNewContextOptions{
+		UserAgent:         String(d.UserAgent),
+		DeviceScaleFactor: Float(d.DeviceScaleFactor),
+		IsMobile:          Bool(d.IsMobile),
+		HasTouch:          Bool(d.HasTouch),
+	}
+	if d.Viewport != nil {
+		options.Viewport = &Size{Width: d.Viewport.Width, Height: d.Viewport.Height}
+	}
+	if d.Screen != nil {
+		options.Screen = &Size{Width: d.Screen.Width, Height: d.Screen.Height}
+	}
+	return options
+}
+
+// ContextOptions validates the preset and returns the options that apply it, e.g. for
+// [Browser.NewContext]. Fields set in base are kept unless the preset overrides them.
+func (p DevicePreset) ContextOptions(base ...BrowserNewContextOptions) (BrowserNewContextOptions, error) {
+	options := BrowserNewContextOptions{}
+	if len(base) == 1 {
+		options = base[0]
+	}
+	if p.Device != nil {
+		device := p.Device.ContextOptions()
+		options.UserAgent = device.UserAgent
+		options.DeviceScaleFactor = device.DeviceScaleFactor
+		options.IsMobile = device.IsMobile
+		options.HasTouch = device.HasTouch
+		options.Viewport = device.Viewport
+		options.Screen = device.Screen
+	}
+	permissions := append([]string{}, options.Permissions...)
+	permissions = append(permissions, p.Permissions...)
+	if p.Geolocation != nil {
+		if p.Geolocation.Latitude < -90 || p.Geolocation.Latitude > 90 {
+			return options, fmt.Errorf("invalid latitude %v: precondition -90 <= LATITUDE <= 90 failed", p.Geolocation.Latitude)
+		}
+		if p.Geolocation.Longitude < -180 || p.Geolocation.Longitude > 180 {
+			return options, fmt.Errorf("invalid longitude %v: precondition -180 <= LONGITUDE <= 180 failed", p.Geolocation.Longitude)
+		}
+		if p.Geolocation.Accuracy != nil && *p.Geolocation.Accuracy < 0 {
+			return options, fmt.Errorf("invalid accuracy %v: precondition 0 <= ACCURACY failed", *p.Geolocation.Accuracy)
+		}
+		options.Geolocation = p.Geolocation
+		permissions = append(permissions, "geolocation")
+	}
+	if p.Locale != nil {
+		options.Locale = p.Locale
+	}
+	if p.TimezoneId != nil {
+		options.TimezoneId = p.TimezoneId
+	}
+	if len(permissions) > 0 {
+		options.Permissions = dedupeStrings(permissions)
+	}
+	return options, nil
+}
+
+func dedupeStrings(in []string) []string {
+	seen := make(map[string]bool, len(in))
+	out := make([]string, 0, len(in))
+	for _, v := range in {
+		if !seen[v] {
+			seen[v] = true
+			out = append(out, v)
+		}
+	}
+	return out
+}
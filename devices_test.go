@@ -0,0 +1,56 @@
+package playwright
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDevicePresetContextOptions(t *testing.T) {
+	device := &DeviceDescriptor{
+		UserAgent:         "Mozilla/5.0 (Linux; Android 11; Pixel 5)",
+		Viewport:          &Size{Width: 393, Height: 727},
+		Screen:            &Size{Width: 393, Height: 851},
+		DeviceScaleFactor: 2.75,
+		IsMobile:          true,
+		HasTouch:          true,
+	}
+	preset := DevicePreset{
+		Device:      device,
+		Geolocation: &Geolocation{Latitude: 52.52, Longitude: 13.405},
+		Locale:      String("de-DE"),
+		TimezoneId:  String("Europe/Berlin"),
+		Permissions: []string{"notifications", "geolocation"},
+	}
+	options, err := preset.ContextOptions(BrowserNewContextOptions{
+		Locale:      String("en-US"),
+		BaseURL:     String("https://example.com"),
+		Permissions: []string{"clipboard-read"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com", *options.BaseURL)
+	require.Equal(t, "de-DE", *options.Locale)
+	require.Equal(t, "Europe/Berlin", *options.TimezoneId)
+	require.Equal(t, device.UserAgent, *options.UserAgent)
+	require.Equal(t, 2.75, *options.DeviceScaleFactor)
+	require.True(t, *options.IsMobile)
+	require.True(t, *options.HasTouch)
+	require.Equal(t, &Size{Width: 393, Height: 727}, options.Viewport)
+	require.NotSame(t, device.Viewport, options.Viewport)
+	require.Equal(t, []string{"clipboard-read", "notifications", "geolocation"}, options.Permissions)
+	require.Equal(t, 52.52, options.Geolocation.Latitude)
+}
+
+func TestDevicePresetValidation(t *testing.T) {
+	_, err := DevicePreset{Geolocation: &Geolocation{Latitude: 100}}.ContextOptions()
+	require.ErrorContains(t, err, "invalid latitude")
+	_, err = DevicePreset{Geolocation: &Geolocation{Longitude: -200}}.ContextOptions()
+	require.ErrorContains(t, err, "invalid longitude")
+	_, err = DevicePreset{Geolocation: &Geolocation{Accuracy: Float(-1)}}.ContextOptions()
+	require.ErrorContains(t, err, "invalid accuracy")
+
+	options, err := DevicePreset{Locale: String("fr-FR")}.ContextOptions()
+	require.NoError(t, err)
+	require.Nil(t, options.Permissions)
+	require.Nil(t, options.UserAgent)
+}
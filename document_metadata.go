@@ -0,0 +1,279 @@
+package playwright
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// documentMetadataScript collects the title, the meta tags and the link tags of the document. The URLs of the links
+// are resolved by the browser, against the base URL of the document.
+const documentMetadataScript = `() => ({
+  title: document.title,
+  baseURL: document.baseURI,
+  lang: document.documentElement.lang || '',
+  metas: [...document.querySelectorAll('meta')].map(meta => ({
+    name: meta.getAttribute('name') || '',
+    property: meta.getAttribute('property') || '',
+    content: meta.getAttribute('content') || '',
+  })),
+  links: [...document.querySelectorAll('link[href]')].map(link => ({
+    rel: link.getAttribute('rel') || '',
+    href: link.href,
+    type: link.getAttribute('type') || '',
+    sizes: link.getAttribute('sizes') || '',
+  })),
+})`
+
+// Favicon is an icon of a document, see [DocumentMetadata].
+type Favicon struct {
+	// Absolute URL of the icon.
+	URL string `json:"url"`
+	// Relation of the link, such as `icon` or `apple-touch-icon`.
+	Rel string `json:"rel"`
+	// MIME type of the icon, if declared.
+	Type string `json:"type"`
+	// Sizes of the icon, such as `32x32` or `any`, if declared.
+	Sizes string `json:"sizes"`
+}
+
+// OpenGraphMedia is an image, a video or an audio of an [OpenGraph] object.
+type OpenGraphMedia struct {
+	// Absolute URL of the media.
+	URL       string `json:"url"`
+	SecureURL string `json:"secureUrl"`
+	Type      string `json:"type"`
+	Width     int    `json:"width"`
+	Height    int    `json:"height"`
+	// Description of the image, for images only.
+	Alt string `json:"alt"`
+}
+
+// OpenGraph is the [Open Graph] metadata of a document, from its `og:` meta tags.
+//
+// [Open Graph]: https://ogp.me/
+type OpenGraph struct {
+	Title       string           `json:"title"`
+	Type        string           `json:"type"`
+	URL         string           `json:"url"`
+	Description string           `json:"description"`
+	SiteName    string           `json:"siteName"`
+	Locale      string           `json:"locale"`
+	Determiner  string           `json:"determiner"`
+	Images      []OpenGraphMedia `json:"images"`
+	Videos      []OpenGraphMedia `json:"videos"`
+	Audios      []OpenGraphMedia `json:"audios"`
+}
+
+// TwitterCard is the [Twitter card] metadata of a document, from its `twitter:` meta tags.
+//
+// [Twitter card]: https://developer.x.com/en/docs/x-for-websites/cards/overview/markup
+type TwitterCard struct {
+	// Type of the card, such as `summary` or `summary_large_image`.
+	Card        string `json:"card"`
+	Site        string `json:"site"`
+	Creator     string `json:"creator"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	// Absolute URL of the image.
+	Image    string `json:"image"`
+	ImageAlt string `json:"imageAlt"`
+}
+
+// DocumentMetadata is the metadata of a document, read from its head, see [Page.DocumentMetadata].
+type DocumentMetadata struct {
+	Title string `json:"title"`
+	// Content of the `description` meta tag.
+	Description string `json:"description"`
+	// Language of the document, from the `lang` attribute of its root element.
+	Lang string `json:"lang"`
+	// Absolute URL of the `canonical` link, empty if there is none.
+	CanonicalURL string `json:"canonicalUrl"`
+	// Icons declared by the document, in document order. When it declares none, it contains the `/favicon.ico` of
+	// its origin, which browsers request by default.
+	Favicons    []Favicon   `json:"favicons"`
+	OpenGraph   OpenGraph   `json:"openGraph"`
+	TwitterCard TwitterCard `json:"twitterCard"`
+	// Content of the meta tags by name or property, such as `robots` or `og:title`, in lower case. The first tag
+	// wins when a name is repeated.
+	Meta map[string]string `json:"meta"`
+}
+
+type rawDocumentMetadata struct {
+	Title   string `json:"title"`
+	BaseURL string `json:"baseURL"`
+	Lang    string `json:"lang"`
+	Metas   []struct {
+		Name     string `json:"name"`
+		Property string `json:"property"`
+		Content  string `json:"content"`
+	} `json:"metas"`
+	Links []struct {
+		Rel   string `json:"rel"`
+		Href  string `json:"href"`
+		Type  string `json:"type"`
+		Sizes string `json:"sizes"`
+	} `json:"links"`
+}
+
+// faviconRels are the link relations of the icons of a document.
+var faviconRels = map[string]bool{
+	"icon":                         true,
+	"apple-touch-icon":             true,
+	"apple-touch-icon-precomposed": true,
+	"mask-icon":                    true,
+}
+
+func (p *pageImpl) DocumentMetadata() (*DocumentMetadata, error) {
+	result, err := p.mainFrame.Evaluate(documentMetadataScript)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := decodeCDPValue[rawDocumentMetadata](result)
+	if err != nil {
+		return nil, err
+	}
+	return newDocumentMetadata(raw), nil
+}
+
+func newDocumentMetadata(raw rawDocumentMetadata) *DocumentMetadata {
+	metadata := &DocumentMetadata{
+		Title:    strings.TrimSpace(raw.Title),
+		Lang:     raw.Lang,
+		Favicons: make([]Favicon, 0),
+		Meta:     make(map[string]string),
+		OpenGraph: OpenGraph{
+			Images: make([]OpenGraphMedia, 0),
+			Videos: make([]OpenGraphMedia, 0),
+			Audios: make([]OpenGraphMedia, 0),
+		},
+	}
+	base, _ := url.Parse(raw.BaseURL)
+	resolve := func(ref string) string {
+		if base == nil || ref == "" {
+			return ref
+		}
+		u, err := base.Parse(strings.TrimSpace(ref))
+		if err != nil {
+			return ref
+		}
+		return u.String()
+	}
+	for _, meta := range raw.Metas {
+		key := strings.ToLower(strings.TrimSpace(meta.Property))
+		if key == "" {
+			key = strings.ToLower(strings.TrimSpace(meta.Name))
+		}
+		if key == "" {
+			continue
+		}
+		content := strings.TrimSpace(meta.Content)
+		if _, ok := metadata.Meta[key]; !ok {
+			metadata.Meta[key] = content
+		}
+		if strings.HasPrefix(key, "og:") {
+			metadata.OpenGraph.set(strings.TrimPrefix(key, "og:"), content, resolve)
+		} else if strings.HasPrefix(key, "twitter:") {
+			metadata.TwitterCard.set(strings.TrimPrefix(key, "twitter:"), content, resolve)
+		}
+	}
+	metadata.Description = metadata.Meta["description"]
+	for _, link := range raw.Links {
+		rels := strings.Fields(strings.ToLower(link.Rel))
+		for _, rel := range rels {
+			if rel == "canonical" && metadata.CanonicalURL == "" {
+				metadata.CanonicalURL = link.Href
+			}
+		}
+		for _, rel := range rels {
+			if faviconRels[rel] {
+				metadata.Favicons = append(metadata.Favicons, Favicon{
+					URL:   link.Href,
+					Rel:   strings.Join(rels, " "),
+					Type:  link.Type,
+					Sizes: link.Sizes,
+				})
+				break
+			}
+		}
+	}
+	if len(metadata.Favicons) == 0 && base != nil && (base.Scheme == "http" || base.Scheme == "https") {
+		metadata.Favicons = append(metadata.Favicons, Favicon{URL: resolve("/favicon.ico"), Rel: "icon"})
+	}
+	return metadata
+}
+
+// set sets a property of the Open Graph object. The structured properties of the media, such as `og:image:width`,
+// apply to the last media, and the media are added by their URL.
+func (o *OpenGraph) set(property, content string, resolve func(string) string) {
+	kind, field, _ := strings.Cut(property, ":")
+	var media *[]OpenGraphMedia
+	switch kind {
+	case "title":
+		o.Title = content
+	case "type":
+		o.Type = content
+	case "url":
+		o.URL = resolve(content)
+	case "description":
+		o.Description = content
+	case "site_name":
+		o.SiteName = content
+	case "locale":
+		if field == "" {
+			o.Locale = content
+		}
+	case "determiner":
+		o.Determiner = content
+	case "image":
+		media = &o.Images
+	case "video":
+		media = &o.Videos
+	case "audio":
+		media = &o.Audios
+	}
+	if media == nil {
+		return
+	}
+	if field == "" || field == "url" {
+		*media = append(*media, OpenGraphMedia{URL: resolve(content)})
+		return
+	}
+	if len(*media) == 0 {
+		// A structured property before the media itself starts a media without URL.
+		*media = append(*media, OpenGraphMedia{})
+	}
+	last := &(*media)[len(*media)-1]
+	switch field {
+	case "secure_url":
+		last.SecureURL = resolve(content)
+	case "type":
+		last.Type = content
+	case "width":
+		last.Width, _ = strconv.Atoi(content)
+	case "height":
+		last.Height, _ = strconv.Atoi(content)
+	case "alt":
+		last.Alt = content
+	}
+}
+
+// set sets a property of the Twitter card. Cards may use `twitter:image:src` for the image.
+func (c *TwitterCard) set(property, content string, resolve func(string) string) {
+	switch property {
+	case "card":
+		c.Card = content
+	case "site":
+		c.Site = content
+	case "creator":
+		c.Creator = content
+	case "title":
+		c.Title = content
+	case "description":
+		c.Description = content
+	case "image", "image:src":
+		c.Image = resolve(content)
+	case "image:alt":
+		c.ImageAlt = content
+	}
+}
@@ -0,0 +1,72 @@
+package playwright
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDocumentMetadata(t *testing.T) {
+	raw := rawDocumentMetadata{Title: " Article ", BaseURL: "https://example.com/blog/post", Lang: "en"}
+	for _, meta := range [][3]string{
+		{"description", "", "A post"},
+		{"", "og:title", "OG Article"},
+		{"", "og:image", "/images/cover.png"},
+		{"", "og:image:width", "1200"},
+		{"", "og:image:height", "630"},
+		{"", "og:image:alt", "Cover"},
+		{"", "og:image", "https://cdn.example.com/second.png"},
+		{"", "og:url", "https://example.com/post"},
+		{"", "og:locale", "en_US"},
+		{"", "og:locale:alternate", "fr_FR"},
+		{"twitter:card", "", "summary_large_image"},
+		{"twitter:image:src", "", "thumb.png"},
+		{"DESCRIPTION", "", "Duplicate"},
+	} {
+		raw.Metas = append(raw.Metas, struct {
+			Name     string `json:"name"`
+			Property string `json:"property"`
+			Content  string `json:"content"`
+		}{meta[0], meta[1], meta[2]})
+	}
+	for _, link := range [][3]string{
+		{"canonical", "https://example.com/post", ""},
+		{"Shortcut Icon", "https://example.com/favicon.png", "32x32"},
+		{"apple-touch-icon", "https://example.com/touch.png", "180x180"},
+		{"stylesheet", "https://example.com/style.css", ""},
+	} {
+		raw.Links = append(raw.Links, struct {
+			Rel   string `json:"rel"`
+			Href  string `json:"href"`
+			Type  string `json:"type"`
+			Sizes string `json:"sizes"`
+		}{Rel: link[0], Href: link[1], Sizes: link[2]})
+	}
+	metadata := newDocumentMetadata(raw)
+	require.Equal(t, "Article", metadata.Title)
+	require.Equal(t, "A post", metadata.Description)
+	require.Equal(t, "en", metadata.Lang)
+	require.Equal(t, "https://example.com/post", metadata.CanonicalURL)
+	require.Equal(t, []Favicon{
+		{URL: "https://example.com/favicon.png", Rel: "shortcut icon", Sizes: "32x32"},
+		{URL: "https://example.com/touch.png", Rel: "apple-touch-icon", Sizes: "180x180"},
+	}, metadata.Favicons)
+	require.Equal(t, "OG Article", metadata.OpenGraph.Title)
+	require.Equal(t, "en_US", metadata.OpenGraph.Locale)
+	require.Equal(t, []OpenGraphMedia{
+		{URL: "https://example.com/images/cover.png", Width: 1200, Height: 630, Alt: "Cover"},
+		{URL: "https://cdn.example.com/second.png"},
+	}, metadata.OpenGraph.Images)
+	require.Equal(t, "summary_large_image", metadata.TwitterCard.Card)
+	require.Equal(t, "https://example.com/blog/thumb.png", metadata.TwitterCard.Image)
+	require.Equal(t, "OG Article", metadata.Meta["og:title"])
+}
+
+func TestNewDocumentMetadataShouldDefaultToFaviconIco(t *testing.T) {
+	metadata := newDocumentMetadata(rawDocumentMetadata{BaseURL: "http://localhost:8080/a/b.html"})
+	require.Equal(t, []Favicon{{URL: "http://localhost:8080/favicon.ico", Rel: "icon"}}, metadata.Favicons)
+	require.Empty(t, metadata.CanonicalURL)
+
+	metadata = newDocumentMetadata(rawDocumentMetadata{BaseURL: "about:blank"})
+	require.Empty(t, metadata.Favicons)
+}
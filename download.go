@@ -1,5 +1,7 @@
 package playwright
 
+import "io"
+
 type downloadImpl struct {
 	page              *pageImpl
 	url               string
@@ -42,6 +44,10 @@ func (d *downloadImpl) SaveAs(path string) error {
 	return err
 }
 
+func (d *downloadImpl) CreateReadStream() (io.ReadCloser, error) {
+	return d.artifact.ReadStream()
+}
+
 func (d *downloadImpl) Cancel() error {
 	return d.artifact.Cancel()
 }
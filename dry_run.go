@@ -0,0 +1,220 @@
+package playwright
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const dryRunSubmitBinding = "__pwDryRunSubmit"
+
+// dryRunSubmitScript stops the submissions of the forms of the documents, before the page handles them, and reports
+// them. Dialog forms only close their dialog and are left alone.
+const dryRunSubmitScript = `(() => {
+  if (window.__pwDryRunSubmitListener)
+    return;
+  window.__pwDryRunSubmitListener = true;
+  window.addEventListener('submit', event => {
+    const form = event.target;
+    const submitter = event.submitter;
+    const method = submitter && submitter.hasAttribute('formmethod') ? submitter.formMethod : form.method;
+    if (method === 'dialog')
+      return;
+    event.preventDefault();
+    event.stopImmediatePropagation();
+    const action = submitter && submitter.hasAttribute('formaction') ? submitter.formAction : form.action;
+    window.` + dryRunSubmitBinding + `(method, action).catch(() => {});
+  }, true);
+})()`
+
+// ErrDryRun is returned by the requests of [APIRequestContext] that change state in a context in the
+// [DryRunModeBlock] mode, see [BrowserNewContextOptions.DryRun].
+var ErrDryRun = errors.New("request blocked by dry-run mode")
+
+func getDryRunMode(in string) *DryRunMode {
+	v := DryRunMode(in)
+	return &v
+}
+
+// DryRunMode is how a context in dry-run mode handles the requests that change state, see
+// [BrowserNewContextOptions.DryRun].
+type DryRunMode string
+
+var (
+	// Blocks the requests: the requests of pages fail as blocked by the client, and the requests of
+	// [APIRequestContext] return [ErrDryRun].
+	DryRunModeBlock *DryRunMode = getDryRunMode("block")
+	// Replaces the requests with no-ops: they receive an empty `204 No Content` response without reaching the server.
+	DryRunModeLog = getDryRunMode("log")
+)
+
+// DryRunAction is a request that changes state, which a context in dry-run mode blocked or replaced with a no-op, or a
+// form submission it stopped.
+type DryRunAction struct {
+	// Time the request was intercepted.
+	Time time.Time
+	// Method of the request or of the form, such as `POST`.
+	Method string
+	// URL of the request.
+	URL string
+	// Whether the request was sent by an [APIRequestContext] rather than a page.
+	API bool
+	// Mode of the context, which decided how the request was handled.
+	Mode DryRunMode
+}
+
+// dryRun is the dry-run mode of a context, enabled with [BrowserNewContextOptions.DryRun].
+type dryRun struct {
+	sync.Mutex
+	mode    DryRunMode
+	actions []DryRunAction
+}
+
+// isStateChangingMethod returns whether requests with the HTTP method change state on the server, as opposed to the
+// safe methods such as GET.
+func isStateChangingMethod(method string) bool {
+	switch strings.ToUpper(method) {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+func (b *browserContextImpl) DryRunActions() []DryRunAction {
+	actions := make([]DryRunAction, 0)
+	if b.dryRun == nil {
+		return actions
+	}
+	b.dryRun.Lock()
+	defer b.dryRun.Unlock()
+	return append(actions, b.dryRun.actions...)
+}
+
+func (b *browserContextImpl) OnDryRun(fn func(DryRunAction)) {
+	b.On("dryrun", fn)
+}
+
+// recordDryRun logs a request intercepted by the dry-run mode, and emits it.
+func (b *browserContextImpl) recordDryRun(method string, url string, api bool) DryRunAction {
+	action := DryRunAction{Time: time.Now(), Method: strings.ToUpper(method), URL: url, API: api, Mode: b.dryRun.mode}
+	b.dryRun.Lock()
+	b.dryRun.actions = append(b.dryRun.actions, action)
+	b.dryRun.Unlock()
+	if action.Mode == *DryRunModeBlock {
+		b.logf("dry run: blocked %s %s\n", action.Method, Redact(url))
+	} else {
+		b.logf("dry run: skipped %s %s\n", action.Method, Redact(url))
+	}
+	b.Emit("dryrun", action)
+	return action
+}
+
+// blockFormSubmissions stops the form submissions of the pages of the context in dry-run mode, whatever their method.
+// The forms submitted with `HTMLFormElement.submit()`, which fires no event, are only handled by their request.
+func (b *browserContextImpl) blockFormSubmissions() error {
+	if err := b.ExposeBinding(dryRunSubmitBinding, b.onDryRunSubmitBinding); err != nil {
+		return err
+	}
+	return b.addUntrackedInitScript(dryRunSubmitScript)
+}
+
+func (b *browserContextImpl) onDryRunSubmitBinding(source *BindingSource, args ...interface{}) interface{} {
+	if len(args) != 2 {
+		return nil
+	}
+	method, _ := args[0].(string)
+	url, _ := args[1].(string)
+	b.recordDryRun(method, url, false)
+	return nil
+}
+
+// handleDryRun blocks or fulfills the route if its request changes state and the context is in dry-run mode, before
+// the route handlers see it. It returns whether the route was handled.
+func (b *browserContextImpl) handleDryRun(route *routeImpl) bool {
+	if b.dryRun == nil {
+		return false
+	}
+	request := route.Request()
+	if !isStateChangingMethod(request.Method()) {
+		return false
+	}
+	action := b.recordDryRun(request.Method(), request.URL(), false)
+	_, err := b.connection.WrapAPICall(func() (interface{}, error) {
+		if action.Mode == *DryRunModeBlock {
+			return nil, route.Abort("blockedbyclient")
+		}
+		return nil, route.Fulfill(RouteFulfillOptions{Status: Int(http.StatusNoContent)})
+	}, true)
+	if err != nil && !errors.Is(err, ErrTargetClosed) {
+		b.logf("could not handle request in dry-run mode: %v\n", err)
+	}
+	return true
+}
+
+// dryRunFetch handles a request of an [APIRequestContext] that changes state in dry-run mode.
+func (b *browserContextImpl) dryRunFetch(method string, url string) (APIResponse, error) {
+	action := b.recordDryRun(method, url, true)
+	if action.Mode == *DryRunModeBlock {
+		return nil, fmt.Errorf("%w: %s %s", ErrDryRun, action.Method, url)
+	}
+	return &dryRunAPIResponse{url: url}, nil
+}
+
+// fetchMethod returns the HTTP method of a request of an [APIRequestContext].
+func fetchMethod(request Request, options ...APIRequestContextFetchOptions) string {
+	if len(options) == 1 && options[0].Method != nil {
+		return *options[0].Method
+	}
+	if request != nil {
+		return request.Method()
+	}
+	return http.MethodGet
+}
+
+// dryRunAPIResponse is the empty `204 No Content` response of a request replaced with a no-op in dry-run mode.
+type dryRunAPIResponse struct {
+	url string
+}
+
+func (r *dryRunAPIResponse) Body() ([]byte, error) {
+	return []byte{}, nil
+}
+
+func (r *dryRunAPIResponse) Dispose() error {
+	return nil
+}
+
+func (r *dryRunAPIResponse) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (r *dryRunAPIResponse) HeadersArray() []NameValue {
+	return []NameValue{}
+}
+
+func (r *dryRunAPIResponse) JSON(v interface{}) error {
+	return errors.New("the response of a request skipped in dry-run mode has no body")
+}
+
+func (r *dryRunAPIResponse) Ok() bool {
+	return true
+}
+
+func (r *dryRunAPIResponse) Status() int {
+	return http.StatusNoContent
+}
+
+func (r *dryRunAPIResponse) StatusText() string {
+	return http.StatusText(http.StatusNoContent)
+}
+
+func (r *dryRunAPIResponse) Text() (string, error) {
+	return "", nil
+}
+
+func (r *dryRunAPIResponse) URL() string {
+	return r.url
+}
@@ -0,0 +1,55 @@
+package playwright
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsStateChangingMethod(t *testing.T) {
+	for _, method := range []string{"POST", "put", "PATCH", "DELETE"} {
+		require.True(t, isStateChangingMethod(method), method)
+	}
+	for _, method := range []string{"GET", "HEAD", "OPTIONS"} {
+		require.False(t, isStateChangingMethod(method), method)
+	}
+}
+
+func TestFetchMethod(t *testing.T) {
+	require.Equal(t, "GET", fetchMethod(nil))
+	require.Equal(t, "DELETE", fetchMethod(nil, APIRequestContextFetchOptions{Method: String("DELETE")}))
+	require.Equal(t, "GET", fetchMethod(nil, APIRequestContextFetchOptions{}))
+}
+
+func TestDryRunFetch(t *testing.T) {
+	context := &browserContextImpl{dryRun: &dryRun{mode: *DryRunModeBlock}}
+	var emitted []DryRunAction
+	context.OnDryRun(func(action DryRunAction) {
+		emitted = append(emitted, action)
+	})
+
+	_, err := context.dryRunFetch("post", "https://example.com/orders")
+	require.ErrorIs(t, err, ErrDryRun)
+
+	context.dryRun.mode = *DryRunModeLog
+	response, err := context.dryRunFetch("DELETE", "https://example.com/orders/1")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, response.Status())
+	require.True(t, response.Ok())
+	require.Equal(t, "https://example.com/orders/1", response.URL())
+	body, err := response.Body()
+	require.NoError(t, err)
+	require.Empty(t, body)
+
+	actions := context.DryRunActions()
+	require.Len(t, actions, 2)
+	require.Equal(t, "POST", actions[0].Method)
+	require.Equal(t, *DryRunModeBlock, actions[0].Mode)
+	require.True(t, actions[0].API)
+	require.Equal(t, "DELETE", actions[1].Method)
+	require.Equal(t, *DryRunModeLog, actions[1].Mode)
+	require.Equal(t, actions, emitted)
+
+	require.Empty(t, (&browserContextImpl{}).DryRunActions())
+}
@@ -86,17 +86,17 @@ func (e *elementHandleImpl) DispatchEvent(typ string, initObjects ...interface{}
 }
 
 func (e *elementHandleImpl) Hover(options ...ElementHandleHoverOptions) error {
-	_, err := e.channel.Send("hover", options)
+	_, err := e.channel.Send("hover", optionsOf(options))
 	return err
 }
 
 func (e *elementHandleImpl) Click(options ...ElementHandleClickOptions) error {
-	_, err := e.channel.Send("click", options)
+	_, err := e.channel.Send("click", optionsOf(options))
 	return err
 }
 
 func (e *elementHandleImpl) Dblclick(options ...ElementHandleDblclickOptions) error {
-	_, err := e.channel.Send("dblclick", options)
+	_, err := e.channel.Send("dblclick", optionsOf(options))
 	return err
 }
 
@@ -160,7 +160,7 @@ func (e *elementHandleImpl) EvalOnSelectorAll(selector string, expression string
 }
 
 func (e *elementHandleImpl) ScrollIntoViewIfNeeded(options ...ElementHandleScrollIntoViewIfNeededOptions) error {
-	_, err := e.channel.Send("scrollIntoViewIfNeeded", options)
+	_, err := e.channel.Send("scrollIntoViewIfNeeded", optionsOf(options))
 	if err != nil {
 		return err
 	}
@@ -195,16 +195,19 @@ func (e *elementHandleImpl) BoundingBox() (*Rect, error) {
 }
 
 func (e *elementHandleImpl) Check(options ...ElementHandleCheckOptions) error {
-	_, err := e.channel.Send("check", options)
+	_, err := e.channel.Send("check", optionsOf(options))
 	return err
 }
 
 func (e *elementHandleImpl) Uncheck(options ...ElementHandleUncheckOptions) error {
-	_, err := e.channel.Send("uncheck", options)
+	_, err := e.channel.Send("uncheck", optionsOf(options))
 	return err
 }
 
 func (e *elementHandleImpl) Press(key string, options ...ElementHandlePressOptions) error {
+	if page, layout := e.keyboardLayout(); layout != nil {
+		return e.pressWithLayout(page, layout, key, options...)
+	}
 	_, err := e.channel.Send("press", map[string]interface{}{
 		"key": key,
 	}, options)
@@ -219,6 +222,9 @@ func (e *elementHandleImpl) Fill(value string, options ...ElementHandleFillOptio
 }
 
 func (e *elementHandleImpl) Type(value string, options ...ElementHandleTypeOptions) error {
+	if page, layout := e.keyboardLayout(); layout != nil {
+		return e.typeWithLayout(page, layout, value, options...)
+	}
 	_, err := e.channel.Send("type", map[string]interface{}{
 		"text": value,
 	}, options)
@@ -231,16 +237,24 @@ func (e *elementHandleImpl) Focus() error {
 }
 
 func (e *elementHandleImpl) SelectText(options ...ElementHandleSelectTextOptions) error {
-	_, err := e.channel.Send("selectText", options)
+	_, err := e.channel.Send("selectText", optionsOf(options))
 	return err
 }
 
 func (e *elementHandleImpl) Screenshot(options ...ElementHandleScreenshotOptions) ([]byte, error) {
 	var path *string
 	overrides := map[string]interface{}{}
+	if len(options) == 0 {
+		options = []ElementHandleScreenshotOptions{{}}
+	}
 	if len(options) == 1 {
 		path = options[0].Path
 		options[0].Path = nil
+		if page := e.privacyMaskPage(); page != nil && page.browserContext != nil {
+			if err := page.browserContext.refreshPrivacyMasks(page); err != nil {
+				return nil, err
+			}
+		}
 		if options[0].Mask != nil {
 			masks := make([]map[string]interface{}, 0)
 			for _, m := range options[0].Mask {
@@ -259,7 +273,7 @@ func (e *elementHandleImpl) Screenshot(options ...ElementHandleScreenshotOptions
 			options[0].Mask = nil
 		}
 	}
-	data, err := e.channel.Send("screenshot", options, overrides)
+	data, err := e.channel.Send("screenshot", optionsOf(options), overrides)
 	if err != nil {
 		return nil, err
 	}
@@ -275,8 +289,17 @@ func (e *elementHandleImpl) Screenshot(options ...ElementHandleScreenshotOptions
 	return image, nil
 }
 
+// privacyMaskPage returns the page of the element, whose privacy masks are added to the masks of its screenshots.
+func (e *elementHandleImpl) privacyMaskPage() *pageImpl {
+	frame, err := e.OwnerFrame()
+	if err != nil || frame == nil {
+		return nil
+	}
+	return frame.(*frameImpl).page
+}
+
 func (e *elementHandleImpl) Tap(options ...ElementHandleTapOptions) error {
-	_, err := e.channel.Send("tap", options)
+	_, err := e.channel.Send("tap", optionsOf(options))
 	return err
 }
 
@@ -364,7 +387,7 @@ func (e *elementHandleImpl) WaitForSelector(selector string, options ...ElementH
 }
 
 func (e *elementHandleImpl) InputValue(options ...ElementHandleInputValueOptions) (string, error) {
-	result, err := e.channel.Send("inputValue", options)
+	result, err := e.channel.Send("inputValue", optionsOf(options))
 	if result == nil {
 		return "", err
 	}
@@ -373,10 +396,10 @@ func (e *elementHandleImpl) InputValue(options ...ElementHandleInputValueOptions
 
 func (e *elementHandleImpl) SetChecked(checked bool, options ...ElementHandleSetCheckedOptions) error {
 	if checked {
-		_, err := e.channel.Send("check", options)
+		_, err := e.channel.Send("check", optionsOf(options))
 		return err
 	} else {
-		_, err := e.channel.Send("uncheck", options)
+		_, err := e.channel.Send("uncheck", optionsOf(options))
 		return err
 	}
 }
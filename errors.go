@@ -3,17 +3,23 @@ package playwright
 import (
 	"errors"
 	"fmt"
+	"math"
 )
 
 var (
 	// ErrPlaywright wraps all Playwright errors.
 	//   - Use errors.Is to check if the error is a Playwright error.
 	//   - Use errors.As to cast an error to [Error] if you want to access "Stack".
+	//   - Use errors.As with [TimeoutError], [TargetClosedError], [NavigationError] or
+	//     [ProtocolError] to branch on the failure mode.
 	ErrPlaywright = errors.New("playwright")
 	// ErrTargetClosed usually wraps a reason.
 	ErrTargetClosed = errors.New("target closed")
 	// ErrTimeout wraps timeout errors. It can be either Playwright TimeoutError or client timeout.
 	ErrTimeout = errors.New("timeout")
+	// ErrAbortResponse is returned by the handler of [Route.ContinueWithResponse] to abort the request instead of
+	// fulfilling it with the response.
+	ErrAbortResponse = errors.New("abort response")
 )
 
 // Error represents a Playwright error
@@ -41,18 +47,155 @@ func (e *Error) Is(target error) bool {
 	return e.Message == err.Message
 }
 
+// TimeoutError is returned when an operation does not finish within its timeout,
+// either on the server or while waiting on the client side.
+//   - errors.Is(err, ErrTimeout) reports true.
+//   - Use errors.As to access the failing API call.
+type TimeoutError struct {
+	// Err is the underlying error, including the server-side stack if any.
+	Err *Error
+	// APIName is the client API that issued the call, e.g. "Page.Click". Empty for client timeouts.
+	APIName string
+}
+
+func (e *TimeoutError) Error() string {
+	return e.Err.Message
+}
+
+func (e *TimeoutError) Is(target error) bool {
+	return target == ErrTimeout
+}
+
+func (e *TimeoutError) Unwrap() error {
+	return e.Err
+}
+
+// InvalidTimeoutError is returned when a timeout is negative or NaN. The call is rejected on the
+// client side, before anything is sent to the server.
+type InvalidTimeoutError struct {
+	// Timeout is the rejected value, in milliseconds.
+	Timeout float64
+}
+
+func (e *InvalidTimeoutError) Error() string {
+	return fmt.Sprintf("invalid timeout %v: must be a non-negative number of milliseconds", e.Timeout)
+}
+
+func validateTimeout(timeout float64) error {
+	if math.IsNaN(timeout) || timeout < 0 {
+		return &InvalidTimeoutError{Timeout: timeout}
+	}
+	return nil
+}
+
+// TargetClosedError is returned when the page, context or browser an operation
+// targets has been closed.
+//   - errors.Is(err, ErrTargetClosed) reports true.
+type TargetClosedError struct {
+	// Err is the underlying error, including the server-side stack if any.
+	Err *Error
+	// APIName is the client API that issued the call, e.g. "Page.Click". Empty when closed on the client side.
+	APIName string
+}
+
+func (e *TargetClosedError) Error() string {
+	return e.Err.Message
+}
+
+func (e *TargetClosedError) Is(target error) bool {
+	return target == ErrTargetClosed
+}
+
+func (e *TargetClosedError) Unwrap() error {
+	return e.Err
+}
+
+// NavigationError is returned when a navigation (Goto, Reload, GoBack, GoForward)
+// fails for a reason other than a timeout or a closed target, e.g. "net::ERR_NAME_NOT_RESOLVED".
+type NavigationError struct {
+	// Err is the underlying error, including the server-side stack.
+	Err *Error
+	// APIName is the client API that issued the call, e.g. "Page.Goto".
+	APIName string
+}
+
+func (e *NavigationError) Error() string {
+	return e.Err.Message
+}
+
+func (e *NavigationError) Unwrap() error {
+	return e.Err
+}
+
+// ProtocolError is returned when the Playwright server rejects a call and the
+// failure does not map to a more specific error type.
+type ProtocolError struct {
+	// Err is the underlying error, including the server-side stack.
+	Err *Error
+	// Method is the protocol method that failed, e.g. "click".
+	Method string
+	// APIName is the client API that issued the call, e.g. "Locator.Click".
+	APIName string
+}
+
+func (e *ProtocolError) Error() string {
+	return e.Err.Message
+}
+
+func (e *ProtocolError) Unwrap() error {
+	return e.Err
+}
+
+// navigationMethods are the protocol methods whose failures are reported as [NavigationError].
+var navigationMethods = map[string]bool{
+	"goto":      true,
+	"reload":    true,
+	"goBack":    true,
+	"goForward": true,
+}
+
 func parseError(err Error) error {
+	err = redactError(err)
 	if err.Name == "TimeoutError" {
-		return fmt.Errorf("%w: %w: %w", ErrPlaywright, ErrTimeout, &err)
+		return fmt.Errorf("%w: %w: %w", ErrPlaywright, ErrTimeout, &TimeoutError{Err: &err})
 	} else if err.Name == "TargetClosedError" {
-		return fmt.Errorf("%w: %w: %w", ErrPlaywright, ErrTargetClosed, &err)
+		return fmt.Errorf("%w: %w: %w", ErrPlaywright, ErrTargetClosed, &TargetClosedError{Err: &err})
 	}
 	return fmt.Errorf("%w: %w", ErrPlaywright, &err)
 }
 
+// parseCallError converts the error reply of a protocol call into a typed error,
+// keeping the method and API name of the call.
+func parseCallError(err Error, method, apiName string) error {
+	err = redactError(err)
+	switch {
+	case err.Name == "TimeoutError":
+		return fmt.Errorf("%w: %w: %w", ErrPlaywright, ErrTimeout, &TimeoutError{Err: &err, APIName: apiName})
+	case err.Name == "TargetClosedError":
+		return fmt.Errorf("%w: %w: %w", ErrPlaywright, ErrTargetClosed, &TargetClosedError{Err: &err, APIName: apiName})
+	case navigationMethods[method]:
+		return fmt.Errorf("%w: %w", ErrPlaywright, &NavigationError{Err: &err, APIName: apiName})
+	}
+	return fmt.Errorf("%w: %w", ErrPlaywright, &ProtocolError{Err: &err, Method: method, APIName: apiName})
+}
+
 func targetClosedError(reason *string) error {
-	if reason == nil {
-		return ErrTargetClosed
+	message := ErrTargetClosed.Error()
+	if reason != nil {
+		message = fmt.Sprintf("%s: %s", message, *reason)
+	}
+	return &TargetClosedError{
+		Err: &Error{Name: "TargetClosedError", Message: message},
+	}
+}
+
+func timeoutError(timeout float64) error {
+	return fmt.Errorf("%w: %w", ErrTimeout, newTimeoutError(fmt.Sprintf("Timeout %.2fms exceeded.", timeout)))
+}
+
+// newTimeoutError returns a TimeoutError of a wait on the client side.
+func newTimeoutError(message string) *TimeoutError {
+	return &TimeoutError{
+		Err: &Error{Name: "TimeoutError", Message: message},
 	}
-	return fmt.Errorf("%w: %s", ErrTargetClosed, *reason)
 }
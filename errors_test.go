@@ -0,0 +1,83 @@
+package playwright
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCallErrorTimeout(t *testing.T) {
+	err := parseCallError(Error{Name: "TimeoutError", Message: "Timeout 30000ms exceeded."}, "click", "Locator.Click")
+	require.ErrorIs(t, err, ErrPlaywright)
+	require.ErrorIs(t, err, ErrTimeout)
+	var timeoutErr *TimeoutError
+	require.True(t, errors.As(err, &timeoutErr))
+	require.Equal(t, "Locator.Click", timeoutErr.APIName)
+	require.Equal(t, "Timeout 30000ms exceeded.", timeoutErr.Error())
+	var pwErr *Error
+	require.True(t, errors.As(err, &pwErr))
+	require.Equal(t, "TimeoutError", pwErr.Name)
+}
+
+func TestParseCallErrorTargetClosed(t *testing.T) {
+	err := parseCallError(Error{Name: "TargetClosedError", Message: "Target page, context or browser has been closed"}, "click", "Locator.Click")
+	require.ErrorIs(t, err, ErrPlaywright)
+	require.ErrorIs(t, err, ErrTargetClosed)
+	var closedErr *TargetClosedError
+	require.True(t, errors.As(err, &closedErr))
+	require.Equal(t, "Locator.Click", closedErr.APIName)
+}
+
+func TestParseCallErrorNavigation(t *testing.T) {
+	err := parseCallError(Error{Name: "Error", Message: "net::ERR_NAME_NOT_RESOLVED", Stack: "stack"}, "goto", "Page.Goto")
+	require.ErrorIs(t, err, ErrPlaywright)
+	require.NotErrorIs(t, err, ErrTimeout)
+	var navErr *NavigationError
+	require.True(t, errors.As(err, &navErr))
+	require.Equal(t, "Page.Goto", navErr.APIName)
+	require.Equal(t, "stack", navErr.Err.Stack)
+	var protocolErr *ProtocolError
+	require.False(t, errors.As(err, &protocolErr))
+}
+
+func TestParseCallErrorProtocol(t *testing.T) {
+	err := parseCallError(Error{Name: "Error", Message: "Element is not attached to the DOM"}, "click", "Locator.Click")
+	require.ErrorIs(t, err, ErrPlaywright)
+	var protocolErr *ProtocolError
+	require.True(t, errors.As(err, &protocolErr))
+	require.Equal(t, "click", protocolErr.Method)
+	require.Equal(t, "Locator.Click", protocolErr.APIName)
+	require.ErrorIs(t, err, &Error{Name: "Error", Message: "Element is not attached to the DOM"})
+}
+
+func TestClientSideTypedErrors(t *testing.T) {
+	err := targetClosedError(String("page closed"))
+	require.ErrorIs(t, err, ErrTargetClosed)
+	require.EqualError(t, err, "target closed: page closed")
+	require.ErrorIs(t, targetClosedError(nil), ErrTargetClosed)
+
+	err = timeoutError(100)
+	require.ErrorIs(t, err, ErrTimeout)
+	require.NotErrorIs(t, err, ErrPlaywright)
+	var timeoutErr *TimeoutError
+	require.True(t, errors.As(err, &timeoutErr))
+	require.Empty(t, timeoutErr.APIName)
+	require.EqualError(t, err, "timeout: Timeout 100.00ms exceeded.")
+}
+
+func TestValidateTimeoutParam(t *testing.T) {
+	require.NoError(t, validateTimeoutParam(transformOptions(FrameClickOptions{Timeout: Float(0)})))
+	require.NoError(t, validateTimeoutParam(transformOptions(map[string]interface{}{"selector": "div"})))
+	for _, options := range []interface{}{
+		FrameClickOptions{Timeout: Float(-1)},
+		optionsOf([]FrameClickOptions{{Timeout: Float(-1)}}),
+		map[string]interface{}{"timeout": math.NaN()},
+	} {
+		err := validateTimeoutParam(transformOptions(options))
+		var timeoutErr *InvalidTimeoutError
+		require.True(t, errors.As(err, &timeoutErr), "%#v", options)
+		require.NotErrorIs(t, err, ErrTimeout)
+	}
+}
@@ -21,11 +21,13 @@ type (
 		eventsMutex sync.Mutex
 		events      map[string]*eventRegister
 		hasInit     bool
+		lastID      uint64
 	}
 	eventRegister struct {
 		listeners []listener
 	}
 	listener struct {
+		id      uint64
 		handler interface{}
 		once    bool
 	}
@@ -101,6 +103,32 @@ func (e *eventEmitter) addEvent(name string, handler interface{}, once bool) {
 	e.eventsMutex.Unlock()
 }
 
+// addListener is like On, but returns a function removing exactly this listener. RemoveListener instead removes
+// every listener sharing the code of handler, such as all closures of the same function literal.
+func (e *eventEmitter) addListener(name string, handler interface{}) (remove func()) {
+	e.eventsMutex.Lock()
+	defer e.eventsMutex.Unlock()
+	e.init()
+
+	if _, ok := e.events[name]; !ok {
+		e.events[name] = &eventRegister{
+			listeners: make([]listener, 0),
+		}
+	}
+	e.lastID++
+	id := e.lastID
+	e.events[name].listeners = append(e.events[name].listeners, listener{id: id, handler: handler})
+	return func() {
+		e.eventsMutex.Lock()
+		defer e.eventsMutex.Unlock()
+		if evt, ok := e.events[name]; ok {
+			evt.listeners = slices.DeleteFunc(evt.listeners, func(l listener) bool {
+				return l.id == id
+			})
+		}
+	}
+}
+
 func (e *eventEmitter) init() {
 	if !e.hasInit {
 		e.events = make(map[string]*eventRegister, 0)
@@ -0,0 +1,149 @@
+package playwright
+
+import "sync"
+
+// EventSubscription delivers the data of an event over a channel, see [SubscribeEvent].
+type EventSubscription[T any] struct {
+	events  chan T
+	mu      sync.Mutex
+	queue   []T
+	ready   chan struct{}
+	ended   chan struct{}
+	stopped chan struct{}
+	once    sync.Once
+	removes []func()
+}
+
+// SubscribeEvent subscribes to event of target, such as a [Page], [BrowserContext] or [WebSocket], and delivers
+// its data as T on the channel returned by [EventSubscription.Events], so that events can be received in a select
+// statement instead of a callback. Events whose data is not a T are skipped.
+//
+// Events are queued while the channel is not read, so that the emitter is never blocked and no event is lost.
+// The channel is closed after [EventSubscription.Unsubscribe] is called, or once the remaining events are received
+// after target is closed.
+//
+//	messages := playwright.SubscribeEvent[playwright.ConsoleMessage](page, "console")
+//	defer messages.Unsubscribe()
+//	for message := range messages.Events() {
+//		fmt.Println(message.Text())
+//	}
+func SubscribeEvent[T any](target EventEmitter, event string) *EventSubscription[T] {
+	return subscribeEvent(target, event, eventDataAs[T])
+}
+
+// subscribeEvent subscribes to event of target, delivering the data converted by convert. Data convert rejects is
+// skipped.
+func subscribeEvent[T any](target EventEmitter, event string, convert func(interface{}) (T, bool)) *EventSubscription[T] {
+	s := &EventSubscription[T]{
+		events:  make(chan T),
+		ready:   make(chan struct{}, 1),
+		ended:   make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+	s.removes = append(s.removes, addListener(target, event, func(data ...interface{}) {
+		var payload interface{}
+		if len(data) > 0 {
+			payload = data[0]
+		}
+		value, ok := convert(payload)
+		if !ok {
+			return
+		}
+		s.mu.Lock()
+		s.queue = append(s.queue, value)
+		s.mu.Unlock()
+		select {
+		case s.ready <- struct{}{}:
+		default:
+		}
+	}))
+	if closeEvent := closeEventOf(target); closeEvent != "" {
+		var endOnce sync.Once
+		s.removes = append(s.removes, addListener(target, closeEvent, func() {
+			endOnce.Do(func() { close(s.ended) })
+		}))
+	}
+	go s.deliver()
+	return s
+}
+
+// Events returns the channel the events are delivered on.
+func (s *EventSubscription[T]) Events() <-chan T {
+	return s.events
+}
+
+// Unsubscribe stops the subscription and closes its channel. Events not received yet are dropped. It is safe to call
+// Unsubscribe more than once, and after target is closed.
+func (s *EventSubscription[T]) Unsubscribe() {
+	s.once.Do(func() { close(s.stopped) })
+}
+
+func (s *EventSubscription[T]) deliver() {
+	defer close(s.events)
+	// Listeners are removed here rather than in the close listener, which runs while the emitter is locked.
+	defer func() {
+		for _, remove := range s.removes {
+			remove()
+		}
+	}()
+	ended := false
+	for {
+		value, ok := s.next()
+		if !ok {
+			if ended {
+				return
+			}
+			select {
+			case <-s.ready:
+			case <-s.ended:
+				ended = true
+			case <-s.stopped:
+				return
+			}
+			continue
+		}
+		select {
+		case s.events <- value:
+		case <-s.stopped:
+			return
+		}
+	}
+}
+
+func (s *EventSubscription[T]) next() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.queue) == 0 {
+		var zero T
+		return zero, false
+	}
+	value := s.queue[0]
+	s.queue = s.queue[1:]
+	return value, true
+}
+
+// addListener adds handler to target and returns a function removing only this handler.
+func addListener(target EventEmitter, event string, handler interface{}) func() {
+	if t, ok := target.(interface {
+		addListener(string, interface{}) func()
+	}); ok {
+		return t.addListener(event, handler)
+	}
+	target.On(event, handler)
+	return func() {
+		target.RemoveListener(event, handler)
+	}
+}
+
+// closeEventOf returns the event emitted when target is closed, or an empty string if it is unknown.
+func closeEventOf(target EventEmitter) string {
+	switch target.(type) {
+	case *pageImpl, *browserContextImpl, *webSocketImpl, *workerImpl:
+		return "close"
+	case *browserImpl:
+		return "disconnected"
+	case *cdpSessionImpl, *targetCDPSession:
+		return "detached"
+	}
+	return ""
+}
@@ -0,0 +1,53 @@
+package playwright
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscribeEventDeliversEventsInOrder(t *testing.T) {
+	emitter := &eventEmitter{}
+	subscription := SubscribeEvent[string](emitter, testEventNameFoobar)
+	defer subscription.Unsubscribe()
+	// Emitting must not block while nobody receives.
+	emitter.Emit(testEventNameFoobar, "1")
+	emitter.Emit(testEventNameFoobar, 2)
+	emitter.Emit(testEventNameFoobar, "3")
+	require.Equal(t, "1", <-subscription.Events())
+	require.Equal(t, "3", <-subscription.Events())
+}
+
+func TestSubscribeEventUnsubscribeClosesChannel(t *testing.T) {
+	emitter := &eventEmitter{}
+	subscription := SubscribeEvent[string](emitter, testEventNameFoobar)
+	emitter.Emit(testEventNameFoobar, testEventPayload)
+	subscription.Unsubscribe()
+	subscription.Unsubscribe()
+	select {
+	case _, ok := <-subscription.Events():
+		if ok {
+			_, ok = <-subscription.Events()
+		}
+		require.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("channel was not closed")
+	}
+	require.Eventually(t, func() bool {
+		return emitter.ListenerCount(testEventNameFoobar) == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestSubscribeEventUnsubscribeKeepsOtherSubscriptions(t *testing.T) {
+	emitter := &eventEmitter{}
+	first := SubscribeEvent[string](emitter, testEventNameFoobar)
+	second := SubscribeEvent[string](emitter, testEventNameFoobar)
+	defer second.Unsubscribe()
+	first.Unsubscribe()
+	require.Eventually(t, func() bool {
+		return emitter.ListenerCount(testEventNameFoobar) == 1
+	}, time.Second, 10*time.Millisecond)
+	emitter.Emit(testEventNameFoobar, testEventPayload)
+	require.Equal(t, testEventPayload, <-second.Events())
+}
@@ -18,19 +18,20 @@ func main() {
 	if err != nil {
 		log.Fatalf("could not launch browser: %v", err)
 	}
-	device := pw.Devices["Pixel 5"]
-	context, err := browser.NewContext(playwright.BrowserNewContextOptions{
+	preset := playwright.DevicePreset{
+		Device: pw.Devices["Pixel 5"],
 		Geolocation: &playwright.Geolocation{
 			Longitude: 12.492507,
 			Latitude:  41.889938,
 		},
-		Permissions:       []string{"geolocation"},
-		Viewport:          device.Viewport,
-		UserAgent:         playwright.String(device.UserAgent),
-		DeviceScaleFactor: playwright.Float(device.DeviceScaleFactor),
-		IsMobile:          playwright.Bool(device.IsMobile),
-		HasTouch:          playwright.Bool(device.HasTouch),
-	})
+		Locale:     playwright.String("it-IT"),
+		TimezoneId: playwright.String("Europe/Rome"),
+	}
+	options, err := preset.ContextOptions()
+	if err != nil {
+		log.Fatalf("invalid device preset: %v", err)
+	}
+	context, err := browser.NewContext(options)
 	if err != nil {
 		log.Fatalf("could not create context: %v", err)
 	}
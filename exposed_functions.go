@@ -0,0 +1,119 @@
+package playwright
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/playwright-community/playwright-go/internal/multierror"
+)
+
+// The driver can't remove a binding once it is exposed, so unexposing a function stops
+// dispatching its calls and moves the page function out of the way, in the frames that are
+// loaded and, with an init script, in the documents created later. Bindings are installed
+// before init scripts run, so the init script always sees them. Exposing the function again
+// reuses the binding and moves the page function back.
+
+func hideBindingScript(name string) string {
+	return fmt.Sprintf(`(() => {
+  const hidden = globalThis.__pwHiddenBindings = globalThis.__pwHiddenBindings || {};
+  if (%[1]q in globalThis) {
+    hidden[%[1]q] = globalThis[%[1]q];
+    delete globalThis[%[1]q];
+  }
+})()`, name)
+}
+
+func restoreBindingScript(name string) string {
+	return fmt.Sprintf(`(() => {
+  const hidden = globalThis.__pwHiddenBindings || {};
+  if (%[1]q in hidden) {
+    globalThis[%[1]q] = hidden[%[1]q];
+    delete hidden[%[1]q];
+  }
+})()`, name)
+}
+
+// isInternalBinding reports whether the binding is used by the library itself.
+func isInternalBinding(name string) bool {
+	return strings.HasPrefix(name, "__pw")
+}
+
+func exposedFunctionNames(bindings map[string]BindingCallFunction) []string {
+	names := make([]string, 0, len(bindings))
+	for name := range bindings {
+		if !isInternalBinding(name) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// evaluateInFrames evaluates script in every frame of the pages, ignoring closed ones.
+func evaluateInFrames(pages []Page, script string) error {
+	var errs []error
+	for _, page := range pages {
+		for _, frame := range page.Frames() {
+			if _, err := frame.Evaluate(script); err != nil && !errors.Is(err, ErrTargetClosed) {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return multierror.Join(errs...)
+}
+
+func (p *pageImpl) ListExposedFunctions() []string {
+	return exposedFunctionNames(p.bindings)
+}
+
+func (p *pageImpl) UnexposeFunction(name string) error {
+	if _, ok := p.bindings[name]; !ok || isInternalBinding(name) {
+		return fmt.Errorf("Function '%s' is not exposed in the page", name)
+	}
+	delete(p.bindings, name)
+	script := hideBindingScript(name)
+	if _, err := p.channel.Send("addInitScript", map[string]interface{}{
+		"source": script,
+	}); err != nil {
+		return err
+	}
+	return evaluateInFrames([]Page{p}, script)
+}
+
+// restoreBinding makes a binding that was unexposed available again.
+func (p *pageImpl) restoreBinding(name string) error {
+	script := restoreBindingScript(name)
+	if _, err := p.channel.Send("addInitScript", map[string]interface{}{
+		"source": script,
+	}); err != nil {
+		return err
+	}
+	return evaluateInFrames([]Page{p}, script)
+}
+
+func (b *browserContextImpl) ListExposedFunctions() []string {
+	return exposedFunctionNames(b.bindings)
+}
+
+func (b *browserContextImpl) UnexposeFunction(name string) error {
+	if _, ok := b.bindings[name]; !ok || isInternalBinding(name) {
+		return fmt.Errorf("Function '%s' is not exposed in the browser context", name)
+	}
+	delete(b.bindings, name)
+	script := hideBindingScript(name)
+	if err := b.addUntrackedInitScript(script); err != nil {
+		return err
+	}
+	return evaluateInFrames(b.Pages(), script)
+}
+
+// restoreBinding makes a binding that was unexposed available again.
+func (b *browserContextImpl) restoreBinding(name string) error {
+	script := restoreBindingScript(name)
+	if err := b.addUntrackedInitScript(script); err != nil {
+		return err
+	}
+	return evaluateInFrames(b.Pages(), script)
+}
@@ -0,0 +1,52 @@
+package playwright
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ErrExtensionsNotSupported is returned when extensions are loaded in a browser other than Chromium.
+var ErrExtensionsNotSupported = errors.New("extensions are only supported in Chromium")
+
+// extensionArgs returns the command line arguments of Chromium loading the unpacked extensions in the directories.
+func extensionArgs(dirs []string) ([]string, error) {
+	paths := make([]string, 0, len(dirs))
+	for _, dir := range dirs {
+		path, err := filepath.Abs(dir)
+		if err != nil {
+			return nil, fmt.Errorf("invalid extension directory %s: %w", dir, err)
+		}
+		if _, err := os.Stat(filepath.Join(path, "manifest.json")); err != nil {
+			return nil, fmt.Errorf("invalid extension directory %s: %w", dir, err)
+		}
+		if strings.Contains(path, ",") {
+			return nil, fmt.Errorf("invalid extension directory %s: the path cannot contain a comma", dir)
+		}
+		paths = append(paths, path)
+	}
+	list := strings.Join(paths, ",")
+	return []string{"--disable-extensions-except=" + list, "--load-extension=" + list}, nil
+}
+
+// loadExtensions adds the arguments loading the extensions of the options to the launch options.
+func (b *browserTypeImpl) loadExtensions(options *BrowserTypeLaunchPersistentContextOptions) error {
+	if len(options.Extensions) == 0 {
+		options.Extensions = nil
+		return nil
+	}
+	if b.Name() != "chromium" {
+		return ErrExtensionsNotSupported
+	}
+	args, err := extensionArgs(options.Extensions)
+	if err != nil {
+		return err
+	}
+	options.Args = append(append([]string(nil), options.Args...), args...)
+	// The extensions are disabled by default.
+	options.IgnoreDefaultArgs = append(append([]string(nil), options.IgnoreDefaultArgs...), "--disable-extensions")
+	options.Extensions = nil
+	return nil
+}
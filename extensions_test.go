@@ -0,0 +1,25 @@
+package playwright
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtensionArgs(t *testing.T) {
+	first, second := t.TempDir(), t.TempDir()
+	for _, dir := range []string{first, second} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "manifest.json"), []byte(`{"manifest_version": 3}`), 0o644))
+	}
+	args, err := extensionArgs([]string{first, second})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"--disable-extensions-except=" + first + "," + second,
+		"--load-extension=" + first + "," + second,
+	}, args)
+
+	_, err = extensionArgs([]string{t.TempDir()})
+	require.ErrorContains(t, err, "invalid extension directory")
+}
@@ -0,0 +1,176 @@
+package playwright
+
+import (
+	"strings"
+)
+
+// The server only supports extra HTTP headers for contexts and pages, and replaces all of them on
+// every update, so the headers are tracked here to remove them one by one. Frame headers are applied
+// on the client side: while a frame has extra headers, the page intercepts all requests and adds the
+// headers to the ones of the frame before they reach the route handlers.
+//
+// Headers are merged in a fixed order, each level overriding the previous one: context, page, frame,
+// then headers set by route handlers with [Route.Continue] or [Route.Fallback].
+
+// normalizeHeaders returns a copy of headers with lowercase names.
+func normalizeHeaders(headers map[string]string) map[string]string {
+	normalized := make(map[string]string, len(headers))
+	for name, value := range headers {
+		normalized[strings.ToLower(name)] = value
+	}
+	return normalized
+}
+
+func (b *browserContextImpl) SetExtraHTTPHeaders(headers map[string]string) error {
+	b.Lock()
+	b.extraHeaders = normalizeHeaders(headers)
+	b.Unlock()
+	return b.sendExtraHTTPHeaders(headers)
+}
+
+func (b *browserContextImpl) RemoveExtraHTTPHeader(name string) error {
+	b.Lock()
+	if _, ok := b.extraHeaders[strings.ToLower(name)]; !ok {
+		b.Unlock()
+		return nil
+	}
+	delete(b.extraHeaders, strings.ToLower(name))
+	headers := normalizeHeaders(b.extraHeaders)
+	b.Unlock()
+	return b.sendExtraHTTPHeaders(headers)
+}
+
+func (b *browserContextImpl) sendExtraHTTPHeaders(headers map[string]string) error {
+	_, err := b.channel.Send("setExtraHTTPHeaders", map[string]interface{}{
+		"headers": serializeMapToNameAndValue(headers),
+	})
+	return err
+}
+
+func (p *pageImpl) SetExtraHTTPHeaders(headers map[string]string) error {
+	p.Lock()
+	p.extraHeaders = normalizeHeaders(headers)
+	p.Unlock()
+	return p.sendExtraHTTPHeaders(headers)
+}
+
+func (p *pageImpl) RemoveExtraHTTPHeader(name string) error {
+	p.Lock()
+	if _, ok := p.extraHeaders[strings.ToLower(name)]; !ok {
+		p.Unlock()
+		return nil
+	}
+	delete(p.extraHeaders, strings.ToLower(name))
+	headers := normalizeHeaders(p.extraHeaders)
+	p.Unlock()
+	return p.sendExtraHTTPHeaders(headers)
+}
+
+func (p *pageImpl) sendExtraHTTPHeaders(headers map[string]string) error {
+	_, err := p.channel.Send("setExtraHTTPHeaders", map[string]interface{}{
+		"headers": serializeMapToNameAndValue(headers),
+	})
+	return err
+}
+
+func (f *frameImpl) SetExtraHTTPHeaders(headers map[string]string) error {
+	if f.page == nil {
+		return ErrTargetClosed
+	}
+	return f.page.setFrameHeaders(f, normalizeHeaders(headers))
+}
+
+func (f *frameImpl) RemoveExtraHTTPHeader(name string) error {
+	if f.page == nil {
+		return ErrTargetClosed
+	}
+	p := f.page
+	p.frameHeadersMu.Lock()
+	headers, ok := p.frameHeaders[f]
+	if !ok {
+		p.frameHeadersMu.Unlock()
+		return nil
+	}
+	headers = normalizeHeaders(headers)
+	p.frameHeadersMu.Unlock()
+	delete(headers, strings.ToLower(name))
+	return p.setFrameHeaders(f, headers)
+}
+
+// hasFrameHeaders returns whether a frame of the page has extra headers.
+func (p *pageImpl) hasFrameHeaders() bool {
+	p.frameHeadersMu.Lock()
+	defer p.frameHeadersMu.Unlock()
+	return len(p.frameHeaders) > 0
+}
+
+// putFrameHeaders replaces the extra headers of frame and returns whether a frame of the page had
+// extra headers before and after.
+func (p *pageImpl) putFrameHeaders(frame *frameImpl, headers map[string]string) (bool, bool) {
+	p.frameHeadersMu.Lock()
+	defer p.frameHeadersMu.Unlock()
+	hadHeaders := len(p.frameHeaders) > 0
+	if len(headers) > 0 {
+		p.frameHeaders[frame] = headers
+	} else {
+		delete(p.frameHeaders, frame)
+	}
+	return hadHeaders, len(p.frameHeaders) > 0
+}
+
+// setFrameHeaders replaces the extra headers of frame and turns the interception of all requests on
+// or off as needed.
+func (p *pageImpl) setFrameHeaders(frame *frameImpl, headers map[string]string) error {
+	hadHeaders, hasHeaders := p.putFrameHeaders(frame, headers)
+	if hadHeaders == hasHeaders {
+		return nil
+	}
+	p.Lock()
+	defer p.Unlock()
+	return p.updateInterceptionPatterns()
+}
+
+// removeFrameHeaders drops the extra headers of a detached frame. It runs on the dispatcher, which
+// must not wait for the page lock, held while messages are sent, so the interception patterns are
+// updated in the background.
+func (p *pageImpl) removeFrameHeaders(frame *frameImpl) {
+	p.frameHeadersMu.Lock()
+	_, ok := p.frameHeaders[frame]
+	delete(p.frameHeaders, frame)
+	hasHeaders := len(p.frameHeaders) > 0
+	p.frameHeadersMu.Unlock()
+	if !ok || hasHeaders {
+		return
+	}
+	go func() {
+		p.Lock()
+		defer p.Unlock()
+		_, err := p.connection.WrapAPICall(func() (interface{}, error) {
+			return nil, p.updateInterceptionPatterns()
+		}, true)
+		if err != nil {
+			logger.Printf("could not update interception patterns: %v\n", err)
+		}
+	}()
+}
+
+// applyFrameHeaders adds the extra headers of the frame that issued the request to its headers,
+// before any route handler sees them.
+func (p *pageImpl) applyFrameHeaders(route *routeImpl) {
+	request := route.Request().(*requestImpl)
+	frame, ok := request.Frame().(*frameImpl)
+	if !ok || frame == nil {
+		return
+	}
+	p.frameHeadersMu.Lock()
+	frameHeaders := p.frameHeaders[frame]
+	p.frameHeadersMu.Unlock()
+	if len(frameHeaders) == 0 {
+		return
+	}
+	headers := request.Headers()
+	for name, value := range frameHeaders {
+		headers[name] = value
+	}
+	request.fallbackOverrides.Headers = headers
+}
@@ -0,0 +1,33 @@
+package playwright
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoveFrameHeadersDoesNotWaitForPageLock(t *testing.T) {
+	detached, other := &frameImpl{}, &frameImpl{}
+	p := &pageImpl{frameHeaders: map[*frameImpl]map[string]string{
+		detached: {"foo": "bar"},
+		other:    {"baz": "qux"},
+	}}
+	// The page lock is held while messages are sent, like during a Route call.
+	p.Lock()
+	defer p.Unlock()
+	done := make(chan struct{})
+	go func() {
+		p.removeFrameHeaders(detached)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("removeFrameHeaders waited for the page lock")
+	}
+	require.True(t, p.hasFrameHeaders())
+	hadHeaders, hasHeaders := p.putFrameHeaders(other, nil)
+	require.True(t, hadHeaders)
+	require.False(t, hasHeaders)
+}
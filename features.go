@@ -0,0 +1,177 @@
+package playwright
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrBlinkFeaturesNotSupported is returned when web platform features are toggled in a browser other than Chromium.
+	ErrBlinkFeaturesNotSupported = errors.New("blink features are only supported in Chromium")
+	// ErrOriginTrialsNotSupported is returned when origin trials are inspected in a browser other than Chromium.
+	ErrOriginTrialsNotSupported = errors.New("origin trial inspection is only supported in Chromium")
+)
+
+const (
+	enableBlinkFeaturesArg  = "--enable-blink-features="
+	disableBlinkFeaturesArg = "--disable-blink-features="
+)
+
+// blinkFeatureArgs returns args with the features to enable and disable added to the Chromium switches toggling the
+// Blink runtime features. Chromium only reads the last of the repeated switches, so the features of the switches
+// already in args are kept in a single switch.
+func blinkFeatureArgs(args []string, enable []string, disable []string) ([]string, error) {
+	for _, feature := range append(append([]string(nil), enable...), disable...) {
+		if feature == "" || strings.ContainsAny(feature, ", ") {
+			return nil, fmt.Errorf("invalid blink feature name %q", feature)
+		}
+	}
+	out := make([]string, 0, len(args)+2)
+	var enabled, disabled []string
+	for _, arg := range args {
+		switch {
+		case strings.HasPrefix(arg, enableBlinkFeaturesArg):
+			enabled = append(enabled, strings.Split(strings.TrimPrefix(arg, enableBlinkFeaturesArg), ",")...)
+		case strings.HasPrefix(arg, disableBlinkFeaturesArg):
+			disabled = append(disabled, strings.Split(strings.TrimPrefix(arg, disableBlinkFeaturesArg), ",")...)
+		default:
+			out = append(out, arg)
+		}
+	}
+	enabled = append(enabled, enable...)
+	disabled = append(disabled, disable...)
+	if len(enabled) > 0 {
+		out = append(out, enableBlinkFeaturesArg+strings.Join(enabled, ","))
+	}
+	if len(disabled) > 0 {
+		out = append(out, disableBlinkFeaturesArg+strings.Join(disabled, ","))
+	}
+	return out, nil
+}
+
+// applyBlinkFeatures adds the switches toggling the Blink features of the launch options to its arguments.
+func (b *browserTypeImpl) applyBlinkFeatures(args *[]string, enable *[]string, disable *[]string) error {
+	if len(*enable) == 0 && len(*disable) == 0 {
+		*enable, *disable = nil, nil
+		return nil
+	}
+	if b.Name() != "chromium" {
+		return ErrBlinkFeaturesNotSupported
+	}
+	out, err := blinkFeatureArgs(*args, *enable, *disable)
+	if err != nil {
+		return err
+	}
+	*args = out
+	*enable, *disable = nil, nil
+	return nil
+}
+
+func validateOriginTrialTokens(tokens []string) error {
+	for _, token := range tokens {
+		if _, err := base64.StdEncoding.DecodeString(token); err != nil || token == "" {
+			return fmt.Errorf("invalid origin trial token %q: expected a base64 encoded token", token)
+		}
+	}
+	return nil
+}
+
+// originTrialScript adds the tokens to the documents as `<meta http-equiv="origin-trial">` tags once their head is
+// created, as Chromium enables the trials of the tokens added dynamically.
+func originTrialScript(tokens []string) string {
+	data, _ := json.Marshal(tokens)
+	return fmt.Sprintf(`(tokens => {
+	const inject = head => {
+		for (const token of tokens) {
+			const meta = document.createElement('meta');
+			meta.httpEquiv = 'origin-trial';
+			meta.content = token;
+			head.append(meta);
+		}
+	};
+	if (document.head) {
+		inject(document.head);
+		return;
+	}
+	const observer = new MutationObserver(() => {
+		if (document.head) {
+			observer.disconnect();
+			inject(document.head);
+		}
+	});
+	observer.observe(document, { childList: true, subtree: true });
+})(%s)`, data)
+}
+
+// OriginTrial is an origin trial of a page, see [Page.OriginTrials].
+type OriginTrial struct {
+	// Name of the trial, such as `WebGPU`.
+	Name string
+	// Status of the trial for the page: `Enabled`, `ValidTokenNotProvided`, `OSNotSupported` or `TrialNotAllowed`.
+	Status string
+	// Tokens of the trial provided by the page.
+	Tokens []OriginTrialToken
+}
+
+// OriginTrialToken is an origin trial token provided by a page, see [OriginTrial].
+type OriginTrialToken struct {
+	// Token as provided by the page.
+	Token string
+	// Status of the token, `Success` if it is valid, or the reason it is not, such as `Expired` or `WrongOrigin`.
+	Status string
+	// Origin the token was issued for, if it could be parsed.
+	Origin string
+	// Whether the token is a third-party token.
+	ThirdParty bool
+}
+
+func (p *pageImpl) OriginTrials() ([]OriginTrial, error) {
+	session, err := p.cdpSession()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrOriginTrialsNotSupported, err)
+	}
+	tree, err := SendCDP[struct {
+		FrameTree struct {
+			Frame struct {
+				ID string `json:"id"`
+			} `json:"frame"`
+		} `json:"frameTree"`
+	}](session, "Page.getFrameTree", nil)
+	if err != nil {
+		return nil, err
+	}
+	result, err := SendCDP[struct {
+		OriginTrials []struct {
+			TrialName        string `json:"trialName"`
+			Status           string `json:"status"`
+			TokensWithStatus []struct {
+				RawTokenText string `json:"rawTokenText"`
+				ParsedToken  *struct {
+					Origin       string `json:"origin"`
+					IsThirdParty bool   `json:"isThirdParty"`
+				} `json:"parsedToken"`
+				Status string `json:"status"`
+			} `json:"tokensWithStatus"`
+		} `json:"originTrials"`
+	}](session, "Page.getOriginTrials", map[string]interface{}{"frameId": tree.FrameTree.Frame.ID})
+	if err != nil {
+		return nil, err
+	}
+	trials := make([]OriginTrial, 0, len(result.OriginTrials))
+	for _, trial := range result.OriginTrials {
+		tokens := make([]OriginTrialToken, 0, len(trial.TokensWithStatus))
+		for _, token := range trial.TokensWithStatus {
+			t := OriginTrialToken{Token: token.RawTokenText, Status: token.Status}
+			if token.ParsedToken != nil {
+				t.Origin = token.ParsedToken.Origin
+				t.ThirdParty = token.ParsedToken.IsThirdParty
+			}
+			tokens = append(tokens, t)
+		}
+		trials = append(trials, OriginTrial{Name: trial.TrialName, Status: trial.Status, Tokens: tokens})
+	}
+	return trials, nil
+}
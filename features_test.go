@@ -0,0 +1,36 @@
+package playwright
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlinkFeatureArgs(t *testing.T) {
+	args, err := blinkFeatureArgs(
+		[]string{"--mute-audio", "--enable-blink-features=WebGPU"},
+		[]string{"CSSAnchorPositioning"},
+		[]string{"WebUSB", "WebBluetooth"},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"--mute-audio",
+		"--enable-blink-features=WebGPU,CSSAnchorPositioning",
+		"--disable-blink-features=WebUSB,WebBluetooth",
+	}, args)
+
+	_, err = blinkFeatureArgs(nil, []string{"WebGPU,WebUSB"}, nil)
+	require.ErrorContains(t, err, "invalid blink feature name")
+	_, err = blinkFeatureArgs(nil, nil, []string{""})
+	require.ErrorContains(t, err, "invalid blink feature name")
+}
+
+func TestValidateOriginTrialTokens(t *testing.T) {
+	require.NoError(t, validateOriginTrialTokens([]string{"A2JhY2tncm91bmQ="}))
+	require.Error(t, validateOriginTrialTokens([]string{"not a token"}))
+	require.Error(t, validateOriginTrialTokens([]string{""}))
+}
+
+func TestOriginTrialScript(t *testing.T) {
+	require.Contains(t, originTrialScript([]string{"A2JhY2tncm91bmQ="}), `})(["A2JhY2tncm91bmQ="])`)
+}
@@ -15,7 +15,13 @@ type apiRequestImpl struct {
 
 func (r *apiRequestImpl) NewContext(options ...APIRequestNewContextOptions) (APIRequestContext, error) {
 	overrides := map[string]interface{}{}
+	var policy *retryPolicy
 	if len(options) == 1 {
+		var err error
+		if policy, err = newRetryPolicy(options[0].RetryPolicy); err != nil {
+			return nil, err
+		}
+		options[0].RetryPolicy = nil
 		if options[0].ExtraHttpHeaders != nil {
 			overrides["extraHTTPHeaders"] = serializeMapToNameAndValue(options[0].ExtraHttpHeaders)
 			options[0].ExtraHttpHeaders = nil
@@ -35,11 +41,13 @@ func (r *apiRequestImpl) NewContext(options ...APIRequestNewContextOptions) (API
 		}
 	}
 
-	channel, err := r.channel.Send("newRequest", options, overrides)
+	channel, err := r.channel.Send("newRequest", optionsOf(options), overrides)
 	if err != nil {
 		return nil, err
 	}
-	return fromChannel(channel).(*apiRequestContextImpl), nil
+	requestContext := fromChannel(channel).(*apiRequestContextImpl)
+	requestContext.retryPolicy = policy
+	return requestContext, nil
 }
 
 func newApiRequestImpl(pw *Playwright) *apiRequestImpl {
@@ -48,7 +56,10 @@ func newApiRequestImpl(pw *Playwright) *apiRequestImpl {
 
 type apiRequestContextImpl struct {
 	channelOwner
-	tracing *tracingImpl
+	tracing     *tracingImpl
+	retryPolicy *retryPolicy
+	// context is the browser context of the request context, if any.
+	context *browserContextImpl
 }
 
 func (r *apiRequestContextImpl) Dispose() error {
@@ -88,11 +99,38 @@ func (r *apiRequestContextImpl) innerFetch(url string, request Request, options
 	} else if request != nil {
 		overrides["url"] = request.URL()
 	}
+	if r.context != nil {
+		method := fetchMethod(request, options...)
+		if err := r.context.checkFetchOrigin(method, overrides["url"].(string)); err != nil {
+			return nil, err
+		}
+		if r.context.dryRun != nil && isStateChangingMethod(method) {
+			return r.context.dryRunFetch(method, overrides["url"].(string))
+		}
+	}
 
+	policy := r.retryPolicy
 	if len(options) == 1 {
 		if options[0].MaxRedirects != nil && *options[0].MaxRedirects < 0 {
 			return nil, errors.New("maxRedirects must be non-negative")
 		}
+		if options[0].RetryPolicy != nil && options[0].MaxRetries != nil {
+			return nil, errors.New("only one of 'maxRetries' or 'retryPolicy' can be specified")
+		}
+		if options[0].RetryPolicy != nil {
+			var err error
+			if policy, err = newRetryPolicy(options[0].RetryPolicy); err != nil {
+				return nil, err
+			}
+			options[0].RetryPolicy = nil
+		}
+		if options[0].MaxRetries != nil {
+			var err error
+			if policy, err = newMaxRetriesPolicy(*options[0].MaxRetries); err != nil {
+				return nil, err
+			}
+			options[0].MaxRetries = nil
+		}
 		// only one of them can be specified
 		if countNonNil(options[0].Data, options[0].Form, options[0].Multipart) > 1 {
 			return nil, errors.New("only one of 'data', 'form' or 'multipart' can be specified")
@@ -149,30 +187,29 @@ func (r *apiRequestContextImpl) innerFetch(url string, request Request, options
 			overrides["formData"] = serializeMapToNameValue(form)
 			options[0].Form = nil
 		} else if options[0].Multipart != nil {
-			_, ok := options[0].Multipart.(map[string]interface{})
+			fields, ok := options[0].Multipart.(map[string]interface{})
 			if !ok {
 				return nil, errors.New("multipart must be a map")
 			}
-			multipartData := []map[string]interface{}{}
-			for name, value := range options[0].Multipart.(map[string]interface{}) {
-				switch v := value.(type) {
-				case InputFile:
-					multipartData = append(multipartData, map[string]interface{}{
-						"name": name,
-						"file": map[string]string{
-							"name":     v.Name,
-							"mimeType": v.MimeType,
-							"buffer":   base64.StdEncoding.EncodeToString(v.Buffer),
-						},
-					})
-				default:
-					multipartData = append(multipartData, map[string]interface{}{
-						"name":  name,
-						"value": String(fmt.Sprintf("%v", v)),
-					})
+			headersArray, _ := overrides["headers"].([]map[string]string)
+			contentType := ""
+			for _, header := range headersArray {
+				if strings.ToLower(header["name"]) == "content-type" {
+					contentType = header["value"]
 				}
 			}
-			overrides["multipartData"] = multipartData
+			body, contentType, err := encodeMultipart(fields, contentType)
+			if err != nil {
+				return nil, err
+			}
+			headers := []map[string]string{{"name": "content-type", "value": contentType}}
+			for _, header := range headersArray {
+				if strings.ToLower(header["name"]) != "content-type" {
+					headers = append(headers, header)
+				}
+			}
+			overrides["headers"] = headers
+			overrides["postData"] = base64.StdEncoding.EncodeToString(body)
 			options[0].Multipart = nil
 		} else if request != nil {
 			postDataBuf, err := request.PostDataBuffer()
@@ -186,12 +223,13 @@ func (r *apiRequestContextImpl) innerFetch(url string, request Request, options
 		}
 	}
 
-	response, err := r.channel.Send("fetch", options, overrides)
-	if err != nil {
-		return nil, err
-	}
-
-	return newAPIResponse(r, response.(map[string]interface{})), nil
+	return fetchWithRetry(policy.forMethod(fetchMethod(request, options...)), func() (APIResponse, error) {
+		response, err := r.channel.Send("fetch", optionsOf(options), overrides)
+		if err != nil {
+			return nil, err
+		}
+		return newAPIResponse(r, response.(map[string]interface{})), nil
+	})
 }
 
 func (r *apiRequestContextImpl) Get(url string, options ...APIRequestContextGetOptions) (APIResponse, error) {
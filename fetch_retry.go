@@ -0,0 +1,215 @@
+package playwright
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// APIRequestRetryPolicy configures how [APIRequestContext] retries requests. A request is retried when it fails
+// without a response or when the response has one of the retryable status codes, waiting longer after each attempt.
+// Each attempt gets the full request timeout. Only the requests with idempotent methods are retried unless
+// RetryNonIdempotent is set.
+type APIRequestRetryPolicy struct {
+	// Maximum number of attempts, including the first one. Defaults to `3`.
+	MaxAttempts *int
+	// Status codes of the responses that are retried. Defaults to 408, 429, 500, 502, 503 and 504.
+	RetryableStatusCodes []int
+	// Whether to retry requests that fail without a response, e.g. when the connection is refused. Defaults to
+	// `true`.
+	RetryOnError *bool
+	// Whether to retry the requests with methods that are not idempotent, such as POST and PATCH, which could apply
+	// their changes twice if the server handled the failed attempt. Defaults to `false`.
+	RetryNonIdempotent *bool
+	// Delay before the first retry. Defaults to 100 milliseconds.
+	InitialBackoff *time.Duration
+	// Maximum delay between two attempts. Defaults to 5 seconds.
+	MaxBackoff *time.Duration
+	// Factor by which the delay grows after each retry, at least `1`. Defaults to `2`.
+	Multiplier *float64
+	// Fraction of the delay, between `0` and `1`, that is randomized so concurrent clients don't retry in lockstep.
+	// A jitter of `0.5` waits between half and the full delay. Defaults to `0`.
+	Jitter *float64
+}
+
+var defaultRetryableStatusCodes = []int{408, 429, 500, 502, 503, 504}
+
+// retryPolicy is an [APIRequestRetryPolicy] with the defaults applied.
+type retryPolicy struct {
+	maxAttempts    int
+	statusCodes    map[int]bool
+	retryOnError   bool
+	nonIdempotent  bool
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	multiplier     float64
+	jitter         float64
+	random         func() float64
+	// errorCodes restricts the errors that are retried to the ones whose message contains one of
+	// the codes. All errors are retried if empty.
+	errorCodes []string
+}
+
+func newRetryPolicy(policy *APIRequestRetryPolicy) (*retryPolicy, error) {
+	if policy == nil {
+		return nil, nil
+	}
+	p := &retryPolicy{
+		maxAttempts:    3,
+		statusCodes:    map[int]bool{},
+		retryOnError:   true,
+		initialBackoff: 100 * time.Millisecond,
+		maxBackoff:     5 * time.Second,
+		multiplier:     2,
+		random:         rand.Float64,
+	}
+	if policy.MaxAttempts != nil {
+		if *policy.MaxAttempts < 1 {
+			return nil, fmt.Errorf("retry policy: maxAttempts must be at least 1, got %d", *policy.MaxAttempts)
+		}
+		p.maxAttempts = *policy.MaxAttempts
+	}
+	statusCodes := policy.RetryableStatusCodes
+	if statusCodes == nil {
+		statusCodes = defaultRetryableStatusCodes
+	}
+	for _, code := range statusCodes {
+		p.statusCodes[code] = true
+	}
+	if policy.RetryOnError != nil {
+		p.retryOnError = *policy.RetryOnError
+	}
+	if policy.RetryNonIdempotent != nil {
+		p.nonIdempotent = *policy.RetryNonIdempotent
+	}
+	if policy.InitialBackoff != nil {
+		if *policy.InitialBackoff < 0 {
+			return nil, fmt.Errorf("retry policy: initialBackoff must not be negative, got %s", *policy.InitialBackoff)
+		}
+		p.initialBackoff = *policy.InitialBackoff
+	}
+	if policy.MaxBackoff != nil {
+		if *policy.MaxBackoff < 0 {
+			return nil, fmt.Errorf("retry policy: maxBackoff must not be negative, got %s", *policy.MaxBackoff)
+		}
+		p.maxBackoff = *policy.MaxBackoff
+	}
+	if policy.Multiplier != nil {
+		if math.IsNaN(*policy.Multiplier) || *policy.Multiplier < 1 {
+			return nil, fmt.Errorf("retry policy: multiplier must be at least 1, got %v", *policy.Multiplier)
+		}
+		p.multiplier = *policy.Multiplier
+	}
+	if policy.Jitter != nil {
+		if math.IsNaN(*policy.Jitter) || *policy.Jitter < 0 || *policy.Jitter > 1 {
+			return nil, fmt.Errorf("retry policy: jitter must be between 0 and 1, got %v", *policy.Jitter)
+		}
+		p.jitter = *policy.Jitter
+	}
+	return p, nil
+}
+
+// newMaxRetriesPolicy returns the policy of the maxRetries option of a request, which retries the network errors
+// `ECONNRESET` only, whatever the method, or nil if it has no retries.
+func newMaxRetriesPolicy(maxRetries int) (*retryPolicy, error) {
+	if maxRetries < 0 {
+		return nil, errors.New("maxRetries must be non-negative")
+	}
+	if maxRetries == 0 {
+		return nil, nil
+	}
+	return &retryPolicy{
+		maxAttempts:    maxRetries + 1,
+		statusCodes:    map[int]bool{},
+		retryOnError:   true,
+		nonIdempotent:  true,
+		initialBackoff: 100 * time.Millisecond,
+		maxBackoff:     5 * time.Second,
+		multiplier:     2,
+		random:         rand.Float64,
+		errorCodes:     []string{"ECONNRESET"},
+	}, nil
+}
+
+// forMethod returns the policy of a request with the HTTP method, or nil if the request is not retried.
+func (p *retryPolicy) forMethod(method string) *retryPolicy {
+	if p == nil || p.nonIdempotent || isIdempotentMethod(method) {
+		return p
+	}
+	return nil
+}
+
+// isIdempotentMethod returns whether sending a request with the HTTP method several times has the same effect as
+// sending it once.
+func isIdempotentMethod(method string) bool {
+	switch strings.ToUpper(method) {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// shouldRetry reports whether the attempt that got the response status and err is retried.
+func (p *retryPolicy) shouldRetry(status int, err error) bool {
+	if err == nil {
+		return p.statusCodes[status]
+	}
+	var invalidTimeout *InvalidTimeoutError
+	if !p.retryOnError || errors.Is(err, ErrTargetClosed) || errors.As(err, &invalidTimeout) {
+		return false
+	}
+	if len(p.errorCodes) == 0 {
+		return true
+	}
+	for _, code := range p.errorCodes {
+		if strings.Contains(err.Error(), code) {
+			return true
+		}
+	}
+	return false
+}
+
+// retry calls attempt until it succeeds, the policy gives up or the attempts are exhausted. The
+// results of the attempts that are retried are passed to discard.
+func retry[T any](policy *retryPolicy, attempt func() (T, int, error), discard func(T)) (T, error) {
+	for i := 1; ; i++ {
+		result, status, err := attempt()
+		if policy == nil || i >= policy.maxAttempts || !policy.shouldRetry(status, err) {
+			return result, err
+		}
+		if err == nil {
+			discard(result)
+		}
+		time.Sleep(policy.backoff(i))
+	}
+}
+
+// backoff returns the delay after the given attempt, starting at 1.
+func (p *retryPolicy) backoff(attempt int) time.Duration {
+	delay := float64(p.initialBackoff) * math.Pow(p.multiplier, float64(attempt-1))
+	if delay > float64(p.maxBackoff) {
+		delay = float64(p.maxBackoff)
+	}
+	delay -= delay * p.jitter * p.random()
+	return time.Duration(delay)
+}
+
+// fetchWithRetry calls fetch until it succeeds, the policy gives up or the attempts are exhausted.
+// The responses of the attempts that are retried are disposed.
+func fetchWithRetry(policy *retryPolicy, fetch func() (APIResponse, error)) (APIResponse, error) {
+	return retry(policy, func() (APIResponse, int, error) {
+		response, err := fetch()
+		if err != nil {
+			return nil, 0, err
+		}
+		return response, response.Status(), nil
+	}, func(response APIResponse) {
+		if err := response.Dispose(); err != nil {
+			logger.Printf("could not dispose response: %v\n", err)
+		}
+	})
+}
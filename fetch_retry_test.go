@@ -0,0 +1,166 @@
+package playwright
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testAPIResponse struct {
+	APIResponse
+	status   int
+	disposed bool
+}
+
+func (r *testAPIResponse) Status() int {
+	return r.status
+}
+
+func (r *testAPIResponse) Dispose() error {
+	r.disposed = true
+	return nil
+}
+
+func TestNewRetryPolicy(t *testing.T) {
+	policy, err := newRetryPolicy(nil)
+	require.NoError(t, err)
+	require.Nil(t, policy)
+
+	policy, err = newRetryPolicy(&APIRequestRetryPolicy{})
+	require.NoError(t, err)
+	require.Equal(t, 3, policy.maxAttempts)
+	require.True(t, policy.statusCodes[503])
+	require.False(t, policy.statusCodes[404])
+
+	for _, invalid := range []APIRequestRetryPolicy{
+		{MaxAttempts: Int(0)},
+		{InitialBackoff: Duration(-time.Second)},
+		{MaxBackoff: Duration(-time.Second)},
+		{Multiplier: Float(0.5)},
+		{Jitter: Float(1.5)},
+	} {
+		_, err := newRetryPolicy(&invalid)
+		require.ErrorContains(t, err, "retry policy")
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy, err := newRetryPolicy(&APIRequestRetryPolicy{
+		InitialBackoff: Duration(100 * time.Millisecond),
+		MaxBackoff:     Duration(time.Second),
+		Multiplier:     Float(3),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 100*time.Millisecond, policy.backoff(1))
+	require.Equal(t, 300*time.Millisecond, policy.backoff(2))
+	require.Equal(t, 900*time.Millisecond, policy.backoff(3))
+	require.Equal(t, time.Second, policy.backoff(4))
+
+	policy.jitter = 0.5
+	policy.random = func() float64 { return 1 }
+	require.Equal(t, 50*time.Millisecond, policy.backoff(1))
+	policy.random = func() float64 { return 0 }
+	require.Equal(t, 100*time.Millisecond, policy.backoff(1))
+}
+
+func TestFetchWithRetry(t *testing.T) {
+	policy, err := newRetryPolicy(&APIRequestRetryPolicy{
+		MaxAttempts:          Int(4),
+		RetryableStatusCodes: []int{503},
+		InitialBackoff:       Duration(time.Millisecond),
+	})
+	require.NoError(t, err)
+
+	var responses []*testAPIResponse
+	results := []interface{}{errors.New("connection refused"), 503, 200, 503}
+	response, err := fetchWithRetry(policy, func() (APIResponse, error) {
+		result := results[0]
+		results = results[1:]
+		if err, ok := result.(error); ok {
+			return nil, err
+		}
+		response := &testAPIResponse{status: result.(int)}
+		responses = append(responses, response)
+		return response, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 200, response.Status())
+	require.Len(t, results, 1)
+	require.True(t, responses[0].disposed)
+	require.False(t, responses[1].disposed)
+
+	attempts := 0
+	response, err = fetchWithRetry(policy, func() (APIResponse, error) {
+		attempts++
+		return &testAPIResponse{status: 503}, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 503, response.Status())
+	require.Equal(t, 4, attempts)
+
+	attempts = 0
+	_, err = fetchWithRetry(policy, func() (APIResponse, error) {
+		attempts++
+		return nil, targetClosedError(nil)
+	})
+	require.ErrorIs(t, err, ErrTargetClosed)
+	require.Equal(t, 1, attempts)
+
+	policy.retryOnError = false
+	attempts = 0
+	_, err = fetchWithRetry(policy, func() (APIResponse, error) {
+		attempts++
+		return nil, errors.New("connection refused")
+	})
+	require.Error(t, err)
+	require.Equal(t, 1, attempts)
+}
+
+func TestRetryPolicyForMethod(t *testing.T) {
+	require.Nil(t, (*retryPolicy)(nil).forMethod("GET"))
+
+	policy, err := newRetryPolicy(&APIRequestRetryPolicy{})
+	require.NoError(t, err)
+	for _, method := range []string{"GET", "head", "OPTIONS", "PUT", "DELETE"} {
+		require.Same(t, policy, policy.forMethod(method), method)
+	}
+	require.Nil(t, policy.forMethod("POST"))
+	require.Nil(t, policy.forMethod("PATCH"))
+
+	policy, err = newRetryPolicy(&APIRequestRetryPolicy{RetryNonIdempotent: Bool(true)})
+	require.NoError(t, err)
+	require.Same(t, policy, policy.forMethod("POST"))
+
+	policy, err = newMaxRetriesPolicy(2)
+	require.NoError(t, err)
+	require.Same(t, policy, policy.forMethod("POST"))
+}
+
+func TestNewMaxRetriesPolicy(t *testing.T) {
+	_, err := newMaxRetriesPolicy(-1)
+	require.Error(t, err)
+	policy, err := newMaxRetriesPolicy(0)
+	require.NoError(t, err)
+	require.Nil(t, policy)
+
+	policy, err = newMaxRetriesPolicy(2)
+	require.NoError(t, err)
+	require.Equal(t, 3, policy.maxAttempts)
+	require.True(t, policy.shouldRetry(0, errors.New("read ECONNRESET")))
+	require.False(t, policy.shouldRetry(0, errors.New("connect ECONNREFUSED")))
+	require.False(t, policy.shouldRetry(503, nil))
+}
+
+func TestRouteFetchOptionsShouldMapToFetchOptions(t *testing.T) {
+	opt := &APIRequestContextFetchOptions{}
+	require.NoError(t, assignStructFields(opt, RouteFetchOptions{
+		MaxRedirects: Int(0),
+		MaxRetries:   Int(3),
+		Timeout:      Float(1000),
+	}, true))
+	require.Equal(t, 0, *opt.MaxRedirects)
+	require.Equal(t, 3, *opt.MaxRetries)
+	require.Equal(t, float64(1000), *opt.Timeout)
+}
@@ -77,6 +77,24 @@ func (f *frameImpl) Content() (string, error) {
 }
 
 func (f *frameImpl) Goto(url string, options ...FrameGotoOptions) (Response, error) {
+	var policy *retryPolicy
+	if len(options) == 1 {
+		var err error
+		if policy, err = newGotoRetryPolicy(options[0].RetryPolicy); err != nil {
+			return nil, err
+		}
+		options[0].RetryPolicy = nil
+	}
+	return retry(policy, func() (Response, int, error) {
+		response, err := f.innerGoto(url, options...)
+		if err != nil || response == nil {
+			return response, 0, err
+		}
+		return response, response.Status(), nil
+	}, func(Response) {})
+}
+
+func (f *frameImpl) innerGoto(url string, options ...FrameGotoOptions) (Response, error) {
 	channel, err := f.channel.Send("goto", map[string]interface{}{
 		"url": url,
 	}, options)
@@ -123,10 +141,21 @@ func (f *frameImpl) AddStyleTag(options FrameAddStyleTagOptions) (ElementHandle,
 	return fromChannel(channel).(*elementHandleImpl), nil
 }
 
+func (f *frameImpl) IsolatedWorld(name string) IsolatedWorld {
+	return &isolatedWorld{frame: f, name: name}
+}
+
 func (f *frameImpl) Page() Page {
 	return f.page
 }
 
+func (f *frameImpl) NewCDPSession() (CDPSession, error) {
+	if f.page == nil {
+		return nil, errors.New("frame is not attached to a page")
+	}
+	return f.page.browserContext.NewCDPSession(f)
+}
+
 func (f *frameImpl) WaitForLoadState(options ...FrameWaitForLoadStateOptions) error {
 	option := FrameWaitForLoadStateOptions{}
 	if len(options) == 1 {
@@ -212,7 +241,7 @@ func (f *frameImpl) ExpectNavigation(cb func() error, options ...FrameExpectNavi
 		err, ok := ev["error"]
 		if ok {
 			// Any failed navigation results in a rejection.
-			logger.Printf("navigated to %s error: %v", ev["url"].(string), err)
+			f.page.logf("navigated to %s error: %v", ev["url"].(string), err)
 			return true
 		}
 		return matcher == nil || matcher.Matches(ev["url"].(string))
@@ -270,8 +299,12 @@ func (f *frameImpl) onFrameNavigated(ev map[string]interface{}) {
 	f.name = ev["name"].(string)
 	f.Unlock()
 	f.Emit("navigated", ev)
+	if ev["newDocument"] != nil && f.page != nil {
+		f.page.webSocketClose.dropFrame(f)
+	}
 	_, ok := ev["error"]
 	if !ok && f.page != nil {
+		touchActivity(&f.page.channelOwner)
 		f.page.Emit("framenavigated", f)
 	}
 }
@@ -416,7 +449,7 @@ func (f *frameImpl) DispatchEvent(selector, typ string, eventInit interface{}, o
 		"selector":  selector,
 		"type":      typ,
 		"eventInit": serializeArgument(eventInit),
-	})
+	}, options)
 	return err
 }
 
@@ -469,6 +502,9 @@ func (f *frameImpl) SetInputFiles(selector string, files interface{}, options ..
 }
 
 func (f *frameImpl) Type(selector, text string, options ...FrameTypeOptions) error {
+	if layout := f.keyboardLayout(); layout != nil {
+		return f.typeWithLayout(layout, selector, text, options...)
+	}
 	_, err := f.channel.Send("type", map[string]interface{}{
 		"selector": selector,
 		"text":     text,
@@ -477,6 +513,9 @@ func (f *frameImpl) Type(selector, text string, options ...FrameTypeOptions) err
 }
 
 func (f *frameImpl) Press(selector, key string, options ...FramePressOptions) error {
+	if layout := f.keyboardLayout(); layout != nil {
+		return f.pressWithLayout(layout, selector, key, options...)
+	}
 	_, err := f.channel.Send("press", map[string]interface{}{
 		"selector": selector,
 		"key":      key,
@@ -748,13 +787,10 @@ func (f *frameImpl) GetByTestId(testId interface{}) Locator {
 }
 
 func (f *frameImpl) GetByText(text interface{}, options ...FrameGetByTextOptions) Locator {
-	exact := false
 	if len(options) == 1 {
-		if *options[0].Exact {
-			exact = true
-		}
+		return f.Locator(getByTextSelector(text, LocatorGetByTextOptions(options[0])))
 	}
-	return f.Locator(getByTextSelector(text, exact))
+	return f.Locator(getByTextSelector(text))
 }
 
 func (f *frameImpl) GetByTitle(text interface{}, options ...FrameGetByTitleOptions) Locator {
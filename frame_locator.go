@@ -10,18 +10,26 @@ import (
 type frameLocatorImpl struct {
 	frame         *frameImpl
 	frameSelector string
+	// err is the error of the locator the frame locator was created from, see [Locator.ContentFrame], returned by the
+	// locators created from the frame locator.
+	err error
 }
 
 func newFrameLocator(frame *frameImpl, frameSelector string) *frameLocatorImpl {
 	return &frameLocatorImpl{frame: frame, frameSelector: frameSelector}
 }
 
+// chain returns the frame locator of the frames matching the frame selector followed by selector.
+func (fl *frameLocatorImpl) chain(selector string) *frameLocatorImpl {
+	return &frameLocatorImpl{frame: fl.frame, frameSelector: fl.frameSelector + selector, err: fl.err}
+}
+
 func (fl *frameLocatorImpl) First() FrameLocator {
-	return newFrameLocator(fl.frame, fl.frameSelector+" >> nth=0")
+	return fl.chain(" >> nth=0")
 }
 
 func (fl *frameLocatorImpl) FrameLocator(selector string) FrameLocator {
-	return newFrameLocator(fl.frame, fl.frameSelector+" >> internal:control=enter-frame >> "+selector)
+	return fl.chain(" >> internal:control=enter-frame >> " + selector)
 }
 
 func (fl *frameLocatorImpl) GetByAltText(text interface{}, options ...FrameLocatorGetByAltTextOptions) Locator {
@@ -66,13 +74,10 @@ func (fl *frameLocatorImpl) GetByTestId(testId interface{}) Locator {
 }
 
 func (fl *frameLocatorImpl) GetByText(text interface{}, options ...FrameLocatorGetByTextOptions) Locator {
-	exact := false
 	if len(options) == 1 {
-		if *options[0].Exact {
-			exact = true
-		}
+		return fl.Locator(getByTextSelector(text, LocatorGetByTextOptions(options[0])))
 	}
-	return fl.Locator(getByTextSelector(text, exact))
+	return fl.Locator(getByTextSelector(text))
 }
 
 func (fl *frameLocatorImpl) GetByTitle(text interface{}, options ...FrameLocatorGetByTitleOptions) Locator {
@@ -86,7 +91,7 @@ func (fl *frameLocatorImpl) GetByTitle(text interface{}, options ...FrameLocator
 }
 
 func (fl *frameLocatorImpl) Last() FrameLocator {
-	return newFrameLocator(fl.frame, fl.frameSelector+" >> nth=-1")
+	return fl.chain(" >> nth=-1")
 }
 
 func (fl *frameLocatorImpl) Locator(selectorOrLocator interface{}, options ...FrameLocatorLocatorOptions) Locator {
@@ -97,30 +102,42 @@ func (fl *frameLocatorImpl) Locator(selectorOrLocator interface{}, options ...Fr
 
 	selector, ok := selectorOrLocator.(string)
 	if ok {
-		return newLocator(fl.frame, fl.frameSelector+" >> internal:control=enter-frame >> "+selector, option)
+		return fl.withErr(newLocator(fl.frame, fl.frameSelector+" >> internal:control=enter-frame >> "+selector, option))
 	}
 	locator, ok := selectorOrLocator.(*locatorImpl)
 	if ok {
 		if fl.frame != locator.frame {
-			locator.err = multierror.Join(locator.err, ErrLocatorNotSameFrame)
-			return locator
+			return &locatorImpl{
+				frame:    locator.frame,
+				selector: locator.selector,
+				options:  locator.options,
+				err:      multierror.Join(fl.err, locator.err, ErrLocatorNotSameFrame),
+			}
 		}
-		return newLocator(locator.frame,
+		return fl.withErr(newLocator(locator.frame,
 			fmt.Sprintf("%s >> internal:control=enter-frame >> %s", fl.frameSelector, locator.selector),
 			option,
-		)
+		))
 	}
 	return &locatorImpl{
 		frame:    fl.frame,
 		selector: fl.frameSelector,
-		err:      fmt.Errorf("invalid locator parameter: %v", selectorOrLocator),
+		err:      multierror.Join(fl.err, fmt.Errorf("invalid locator parameter: %v", selectorOrLocator)),
 	}
 }
 
 func (fl *frameLocatorImpl) Nth(index int) FrameLocator {
-	return newFrameLocator(fl.frame, fl.frameSelector+" >> nth="+strconv.Itoa(index))
+	return fl.chain(" >> nth=" + strconv.Itoa(index))
 }
 
 func (fl *frameLocatorImpl) Owner() Locator {
-	return newLocator(fl.frame, fl.frameSelector)
+	return fl.withErr(newLocator(fl.frame, fl.frameSelector))
+}
+
+// withErr adds the error of the frame locator to a locator created from it.
+func (fl *frameLocatorImpl) withErr(locator *locatorImpl) *locatorImpl {
+	if fl.err != nil {
+		locator.err = multierror.Join(fl.err, locator.err)
+	}
+	return locator
 }
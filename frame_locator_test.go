@@ -0,0 +1,37 @@
+package playwright
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFrameLocatorChain(t *testing.T) {
+	frame := &frameImpl{}
+	frameLocator := newLocator(frame, "#outer").ContentFrame().Locator("#inner").ContentFrame()
+	require.Equal(t, "#outer >> internal:control=enter-frame >> #inner", frameLocator.Owner().(*locatorImpl).selector)
+	require.Equal(t, "#outer >> internal:control=enter-frame >> #inner >> nth=1 >> internal:control=enter-frame >> button",
+		frameLocator.Nth(1).Locator("button").(*locatorImpl).selector)
+	require.Equal(t, "#outer >> internal:control=enter-frame >> #inner >> nth=0", frameLocator.First().Owner().(*locatorImpl).selector)
+	require.Equal(t, "#outer >> internal:control=enter-frame >> #inner >> nth=-1", frameLocator.Last().Owner().(*locatorImpl).selector)
+}
+
+func TestFrameLocatorShouldKeepLocatorError(t *testing.T) {
+	errLocator := errors.New("locator error")
+	locator := &locatorImpl{frame: &frameImpl{}, selector: "iframe", err: errLocator}
+
+	frameLocator := locator.ContentFrame()
+	require.ErrorIs(t, frameLocator.Owner().Err(), errLocator)
+	require.ErrorIs(t, frameLocator.Nth(1).GetByRole(*AriaRoleButton).Err(), errLocator)
+	require.ErrorIs(t, frameLocator.FrameLocator("iframe").Locator("button").Err(), errLocator)
+	require.ErrorIs(t, locator.FrameLocator("iframe").First().Owner().Err(), errLocator)
+	require.NoError(t, newLocator(locator.frame, "iframe").ContentFrame().Locator("button").Err())
+
+	// A locator of another frame is not modified.
+	other := newLocator(&frameImpl{}, "button")
+	result := frameLocator.Locator(other)
+	require.ErrorIs(t, result.Err(), ErrLocatorNotSameFrame)
+	require.ErrorIs(t, result.Err(), errLocator)
+	require.NoError(t, other.Err())
+}
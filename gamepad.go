@@ -0,0 +1,262 @@
+package playwright
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+const defaultGamepadID = "Playwright Gamepad (STANDARD GAMEPAD)"
+
+// gamepadScript replaces the Gamepad API of the page by the simulated gamepads, which are updated with
+// `__pwGamepads.update`. Disconnected gamepads leave a null entry at their index, as in browsers.
+const gamepadScript = `(() => {
+  if (globalThis.__pwGamepads)
+    return;
+  const gamepads = [];
+  const toGamepad = state => Object.freeze({
+    id: state.id,
+    index: state.index,
+    connected: state.connected,
+    mapping: state.mapping,
+    timestamp: performance.now(),
+    axes: Object.freeze([...state.axes]),
+    buttons: Object.freeze(state.buttons.map(value => Object.freeze({ pressed: value > 0, touched: value > 0, value }))),
+    vibrationActuator: null,
+  });
+  const dispatch = (type, gamepad) => {
+    const event = new Event(type);
+    Object.defineProperty(event, 'gamepad', { value: gamepad });
+    window.dispatchEvent(event);
+  };
+  Object.defineProperty(Navigator.prototype, 'getGamepads', {
+    configurable: true,
+    writable: true,
+    value: function getGamepads() {
+      const list = [null, null, null, null];
+      gamepads.forEach((gamepad, index) => list[index] = gamepad || null);
+      return list;
+    },
+  });
+  globalThis.__pwGamepads = {
+    update(state) {
+      const previous = gamepads[state.index];
+      const gamepad = toGamepad(state);
+      gamepads[state.index] = state.connected ? gamepad : undefined;
+      if (state.connected && !previous)
+        dispatch('gamepadconnected', gamepad);
+      else if (!state.connected && previous)
+        dispatch('gamepaddisconnected', gamepad);
+    },
+  };
+})()`
+
+var gamepadUpdateScript = fmt.Sprintf(`state => { %s; globalThis.__pwGamepads.update(state); }`, gamepadScript)
+
+type gamepadImpl struct {
+	page      *pageImpl
+	mu        sync.Mutex
+	index     int
+	id        string
+	mapping   string
+	buttons   []float64
+	axes      []float64
+	connected bool
+}
+
+// state returns the state of the gamepad as it is passed to `__pwGamepads.update`, the caller holds the lock.
+func (g *gamepadImpl) state() map[string]interface{} {
+	return map[string]interface{}{
+		"index":     g.index,
+		"id":        g.id,
+		"mapping":   g.mapping,
+		"connected": g.connected,
+		"buttons":   append([]float64{}, g.buttons...),
+		"axes":      append([]float64{}, g.axes...),
+	}
+}
+
+// sync sends the state of the gamepad to the main frame, the caller holds the lock.
+func (g *gamepadImpl) sync() error {
+	_, err := g.page.mainFrame.Evaluate(gamepadUpdateScript, g.state())
+	return err
+}
+
+func (g *gamepadImpl) Axes() []float64 {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return append([]float64{}, g.axes...)
+}
+
+func (g *gamepadImpl) Buttons() []float64 {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return append([]float64{}, g.buttons...)
+}
+
+func (g *gamepadImpl) ID() string {
+	return g.id
+}
+
+func (g *gamepadImpl) Index() int {
+	return g.index
+}
+
+func (g *gamepadImpl) IsConnected() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.connected
+}
+
+func (g *gamepadImpl) Disconnect() error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if !g.connected {
+		return nil
+	}
+	g.connected = false
+	g.page.gamepadsMu.Lock()
+	g.page.gamepads[g.index] = nil
+	g.page.gamepadsMu.Unlock()
+	return g.sync()
+}
+
+func (g *gamepadImpl) SetAxis(axis int, value float64) error {
+	if value < -1 || value > 1 {
+		return fmt.Errorf("invalid axis value %v: must be between -1 and 1", value)
+	}
+	return g.set(g.axes, "axis", axis, value)
+}
+
+func (g *gamepadImpl) SetButton(button int, value float64) error {
+	if value < 0 || value > 1 {
+		return fmt.Errorf("invalid button value %v: must be between 0 and 1", value)
+	}
+	return g.set(g.buttons, "button", button, value)
+}
+
+func (g *gamepadImpl) set(values []float64, kind string, index int, value float64) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if !g.connected {
+		return errors.New("gamepad is disconnected")
+	}
+	if index < 0 || index >= len(values) {
+		return fmt.Errorf("gamepad has no %s %d", kind, index)
+	}
+	values[index] = value
+	return g.sync()
+}
+
+func (g *gamepadImpl) PressButton(button int, options ...GamepadPressButtonOptions) error {
+	value := 1.0
+	var delay *float64
+	if len(options) == 1 {
+		if options[0].Value != nil {
+			value = *options[0].Value
+		}
+		delay = options[0].Delay
+	}
+	if err := g.SetButton(button, value); err != nil {
+		return err
+	}
+	sleepDelay(delay)
+	return g.SetButton(button, 0)
+}
+
+func (p *pageImpl) ConnectGamepad(options ...PageConnectGamepadOptions) (Gamepad, error) {
+	gamepad := &gamepadImpl{
+		page:      p,
+		id:        defaultGamepadID,
+		mapping:   "standard",
+		buttons:   make([]float64, 17),
+		axes:      make([]float64, 4),
+		connected: true,
+	}
+	if len(options) == 1 {
+		option := options[0]
+		if option.ID != nil {
+			gamepad.id = *option.ID
+		}
+		if option.Mapping != nil {
+			gamepad.mapping = *option.Mapping
+		}
+		if option.Buttons != nil {
+			if *option.Buttons < 0 {
+				return nil, fmt.Errorf("invalid number of buttons %d", *option.Buttons)
+			}
+			gamepad.buttons = make([]float64, *option.Buttons)
+		}
+		if option.Axes != nil {
+			if *option.Axes < 0 {
+				return nil, fmt.Errorf("invalid number of axes %d", *option.Axes)
+			}
+			gamepad.axes = make([]float64, *option.Axes)
+		}
+	}
+	p.gamepadsMu.Lock()
+	if p.gamepads == nil {
+		if _, err := p.channel.Send("addInitScript", map[string]interface{}{
+			"source": gamepadScript,
+		}); err != nil {
+			p.gamepadsMu.Unlock()
+			return nil, err
+		}
+		p.OnFrameNavigated(p.onGamepadFrameNavigated)
+		p.gamepads = make([]*gamepadImpl, 0)
+	}
+	gamepad.index = len(p.gamepads)
+	for i, g := range p.gamepads {
+		if g == nil {
+			gamepad.index = i
+			break
+		}
+	}
+	if gamepad.index == len(p.gamepads) {
+		p.gamepads = append(p.gamepads, gamepad)
+	} else {
+		p.gamepads[gamepad.index] = gamepad
+	}
+	p.gamepadsMu.Unlock()
+	gamepad.mu.Lock()
+	defer gamepad.mu.Unlock()
+	if err := gamepad.sync(); err != nil {
+		return nil, err
+	}
+	return gamepad, nil
+}
+
+func (p *pageImpl) Gamepads() []Gamepad {
+	p.gamepadsMu.Lock()
+	defer p.gamepadsMu.Unlock()
+	gamepads := make([]Gamepad, 0, len(p.gamepads))
+	for _, g := range p.gamepads {
+		if g != nil {
+			gamepads = append(gamepads, g)
+		}
+	}
+	return gamepads
+}
+
+// onGamepadFrameNavigated connects the gamepads again in the new document of the main frame.
+func (p *pageImpl) onGamepadFrameNavigated(frame Frame) {
+	if frame != p.mainFrame {
+		return
+	}
+	gamepads := p.Gamepads()
+	if len(gamepads) == 0 {
+		return
+	}
+	offDispatcher(func() {
+		for _, g := range gamepads {
+			gamepad := g.(*gamepadImpl)
+			gamepad.mu.Lock()
+			if gamepad.connected {
+				if err := gamepad.sync(); err != nil && !errors.Is(err, ErrTargetClosed) {
+					p.logf("could not connect gamepad %d after navigation: %v\n", gamepad.index, err)
+				}
+			}
+			gamepad.mu.Unlock()
+		}
+	})
+}
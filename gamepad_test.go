@@ -0,0 +1,26 @@
+package playwright
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnectGamepadShouldRejectInvalidOptions(t *testing.T) {
+	p := &pageImpl{}
+	_, err := p.ConnectGamepad(PageConnectGamepadOptions{Buttons: Int(-1)})
+	require.EqualError(t, err, "invalid number of buttons -1")
+	_, err = p.ConnectGamepad(PageConnectGamepadOptions{Axes: Int(-2)})
+	require.EqualError(t, err, "invalid number of axes -2")
+}
+
+func TestGamepadShouldValidateValues(t *testing.T) {
+	g := &gamepadImpl{buttons: make([]float64, 2), axes: make([]float64, 1)}
+	require.NoError(t, g.Disconnect())
+	require.EqualError(t, g.SetButton(0, 1.5), "invalid button value 1.5: must be between 0 and 1")
+	require.EqualError(t, g.SetAxis(0, -2), "invalid axis value -2: must be between -1 and 1")
+	require.EqualError(t, g.SetButton(0, 1), "gamepad is disconnected")
+	g.connected = true
+	require.EqualError(t, g.SetButton(2, 1), "gamepad has no button 2")
+	require.EqualError(t, g.SetAxis(-1, 0), "gamepad has no axis -1")
+}
@@ -1,5 +1,11 @@
 package playwright
 
+import (
+	"io"
+	"os"
+	"time"
+)
+
 // Exposes API that can be used for the Web API testing. This class is used for creating [APIRequestContext] instance
 // which in turn can be used for sending web requests. An instance of this class can be obtained via
 // [Playwright.Request]. For more information see [APIRequestContext].
@@ -139,6 +145,18 @@ type APIResponseAssertions interface {
 	ToBeOK() error
 }
 
+// The Accessibility class provides methods for inspecting the accessibility tree of a page, as seen by assistive
+// technologies such as screen readers, see [Page.Accessibility].
+// **NOTE** The snapshot is computed by the browser, so it differs between browsers, and the roles and properties of
+// the nodes follow the platform accessibility APIs rather than the ARIA specification.
+type Accessibility interface {
+	// Captures the current state of the accessibility tree, and returns its root node, or nil if the page or the root
+	// element has no accessibility node. By default, only the interesting nodes are returned: nodes with a role that
+	// conveys information, and focusable or named nodes. Their uninteresting ancestors are omitted, so the tree may be
+	// flatter than the DOM.
+	Snapshot(options ...AccessibilitySnapshotOptions) (*AccessibilityNode, error)
+}
+
 // A Browser is created via [BrowserType.Launch]. An example of using a [Browser] to create a [Page]:
 type Browser interface {
 	EventEmitter
@@ -160,9 +178,16 @@ type Browser interface {
 	// The [Browser] object itself is considered to be disposed and cannot be used anymore.
 	Close(options ...BrowserCloseOptions) error
 
+	// Returns the open browser context named name, see [BrowserContext.Name], or `nil` if there is none.
+	ContextByName(name string) BrowserContext
+
 	// Returns an array of all open browser contexts. In a newly created browser, this will return zero browser contexts.
 	Contexts() []BrowserContext
 
+	// Returns the open browser contexts whose metadata contains all the entries of metadata, see
+	// [BrowserContext.Metadata].
+	ContextsWithMetadata(metadata map[string]string) []BrowserContext
+
 	// Indicates that the browser is connected.
 	IsConnected() bool
 
@@ -218,6 +243,14 @@ type Browser interface {
 // contexts don't write any browsing data to disk.
 type BrowserContext interface {
 	EventEmitter
+	// **NOTE** Only works with Chromium browser's persistent context, and with browsers connected to with
+	// [BrowserType.ConnectOverCDP].
+	// Emitted when new background page is created in the context, such as the page of an extension.
+	OnBackgroundPage(fn func(Page))
+
+	// Emitted when the context blocks a request exceeding its budget, see [BrowserNewContextOptions.NetworkBudget].
+	OnBudgetExceeded(fn func(BudgetViolation))
+
 	// Emitted when Browser context gets closed. This might happen because of one of the following:
 	//  - Browser context is closed.
 	//  - Browser application is closed or crashed.
@@ -236,6 +269,17 @@ type BrowserContext interface {
 	// [freeze]: https://developer.mozilla.org/en-US/docs/Web/JavaScript/EventLoop#never_blocking
 	OnDialog(fn func(Dialog))
 
+	// Emitted when a context in dry-run mode blocks a request that changes state, or replaces it with a no-op, see
+	// [BrowserNewContextOptions.DryRun].
+	OnDryRun(fn func(DryRunAction))
+
+	// Emitted before the context is closed by an [IdleReaper] because it was not used for a while.
+	OnIdle(fn func(BrowserContext))
+
+	// Emitted when a request goes to an origin outside of the allowlist of the context, see
+	// [BrowserNewContextOptions.AllowedOrigins].
+	OnOriginViolation(fn func(OriginViolation))
+
 	// The event is emitted when a new Page is created in the BrowserContext. The page may still be loading. The event
 	// will also fire for popup pages. See also [Page.OnPopup] to receive events about popups relevant to a specific page.
 	// The earliest moment that page is available is when it has navigated to the initial url. For example, when opening a
@@ -270,8 +314,18 @@ type BrowserContext interface {
 	// [Page.OnResponse].
 	OnResponse(fn func(Response))
 
+	// **NOTE** Service workers are only supported on Chromium-based browsers.
+	// Emitted when new service worker is created in the context.
+	OnServiceWorker(fn func(Worker))
+
+	// **NOTE** Service workers are only supported on Chromium-based browsers.
+	// Emitted when a service worker of the context is closed, for example after its registration was removed with
+	// [Page.UnregisterServiceWorkers] or replaced by an update.
+	OnServiceWorkerClose(fn func(Worker))
+
 	// Adds cookies into this browser context. All pages within this context will have these cookies installed. Cookies
-	// can be obtained via [BrowserContext.Cookies].
+	// can be obtained via [BrowserContext.Cookies]. Partitioned cookies, with a PartitionKey, are added with the
+	// DevTools protocol of the browser.
 	//
 	//  cookies: Adds cookies to the browser context.
 	//
@@ -288,6 +342,22 @@ type BrowserContext interface {
 	//  script: Script to be evaluated in all pages in the browser context.
 	AddInitScript(script Script) error
 
+	// Masks sensitive elements and regions, such as personal data, in the screenshots and the videos of the pages of
+	// the context. The elements matching the selectors, in all frames, the elements matching the locators, in the main
+	// frames, and the regions are covered by overlays, so they are hidden in videos too. The overlays are drawn again
+	// when the DOM changes, the document scrolls or is resized and when animations and transitions end, elements moved
+	// by other means may be uncovered until then. They are fixed elements in a closed shadow root, added to the DOM of
+	// the documents, which don't receive pointer events.
+	// Masks apply to existing pages and to the documents loaded afterwards, until [BrowserContext.ClearPrivacyMasks].
+	AddPrivacyMask(options BrowserContextAddPrivacyMaskOptions) error
+
+	// Returns the actions performed in the context so far, in the order they were sent, when the context was created
+	// with the `AuditLog` option. An entry has the time and duration of the action, the API called, its selector and
+	// URL, and the goroutine and the stack that performed it. The traces saved by [Tracing.Stop] and
+	// [Tracing.StopChunk] include the audit log in an `audit-log.jsonl` file. Secrets registered with [RedactSecrets]
+	// are masked.
+	AuditLog() []AuditLogEntry
+
 	// **NOTE** Background pages are only supported on Chromium-based browsers.
 	// All existing background pages in the context.
 	BackgroundPages() []Page
@@ -295,20 +365,57 @@ type BrowserContext interface {
 	// Returns the browser instance of the context. If it was launched as a persistent context null gets returned.
 	Browser() Browser
 
+	// Starts capturing the violations of the Content Security Policy of the documents of the pages of the context,
+	// emitted by [Page.OnCSPViolation]. The documents already loaded are captured as well. Calling it again does
+	// nothing.
+	CaptureCSPViolations() error
+
+	// **Chromium-only** Clears the HTTP cache of the browser. The cache is shared by the contexts of the browser, except
+	// persistent contexts and contexts of other browser instances.
+	ClearCache() error
+
+	// Removes all init scripts added with [BrowserContext.AddInitScript]. Documents that are already loaded are not
+	// affected, the scripts are not evaluated in the documents created afterwards.
+	ClearInitScripts() error
+
 	// Removes cookies from context. Accepts optional filter.
 	ClearCookies(options ...BrowserContextClearCookiesOptions) error
 
 	// Clears all permission overrides for the browser context.
 	ClearPermissions() error
 
+	// Removes the masks added with [BrowserContext.AddPrivacyMask].
+	ClearPrivacyMasks() error
+
+	// Playwright has ability to mock clock and passage of time.
+	Clock() Clock
+
 	// Closes the browser context. All the pages that belong to the browser context will be closed.
 	// **NOTE** The default browser context cannot be closed.
 	Close(options ...BrowserContextCloseOptions) error
 
+	// Returns a subscription delivering the console messages of all pages in the context on a channel, until it is
+	// unsubscribed or the context is closed. See [SubscribeEvent].
+	ConsoleMessages() *EventSubscription[ConsoleMessage]
+
 	// If no URLs are specified, this method returns all cookies. If URLs are specified, only cookies that affect those
-	// URLs are returned.
+	// URLs are returned. The partition keys of partitioned cookies are reported in Chromium when they can be looked up
+	// with the DevTools protocol, see [Cookie.PartitionKey].
 	Cookies(urls ...string) ([]Cookie, error)
 
+	// Returns the requests that the context blocked or replaced with no-ops so far, when it is in dry-run mode, see
+	// [BrowserNewContextOptions.DryRun].
+	DryRunActions() []DryRunAction
+
+	// Reports the close code and reason of the WebSockets created by the documents of the context afterwards, see
+	// [WebSocket.CloseStatus]. The `WebSocket` constructor of the documents is wrapped to report them to the client.
+	// The documents that are already loaded are affected as well.
+	EnableWebSocketCloseStatus() error
+
+	// Writes the audit log of the context to path as JSON Lines, an entry per line, see [BrowserContext.AuditLog].
+	// Returns an error if the context was not created with the `AuditLog` option.
+	ExportAuditLog(path string) error
+
 	// The method adds a function called “name” on the `window` object of every frame in every page in the context. When
 	// called, the function executes “callback” and returns a [Promise] which resolves to the return value of “callback”.
 	// If the “callback” returns a [Promise], it will be awaited.
@@ -350,11 +457,36 @@ type BrowserContext interface {
 	//    - `'payment-handler'`
 	GrantPermissions(permissions []string, options ...BrowserContextGrantPermissionsOptions) error
 
+	// Returns the init scripts added with [BrowserContext.AddInitScript] that were not removed, in the order they were
+	// added.
+	InitScripts() []Script
+
+	// Returns the names of the functions exposed with [BrowserContext.ExposeFunction] or [BrowserContext.ExposeBinding]
+	// that were not removed, sorted.
+	ListExposedFunctions() []string
+
+	// Returns the routes registered on the context whose pattern matches “url”, in the order they are tried for a request
+	// to it: the first one which doesn't call [Route.Fallback] handles the request. See [Page.ListRoutes] to include the
+	// routes of a page.
+	//
+	//  url: URL of the request, matched as by [BrowserContext.Route].
+	ListRoutes(url string) []RouteInfo
+
+	// Returns a copy of the metadata of the context, set with the Metadata option of [Browser.NewContext] or with
+	// [BrowserContext.SetMetadata].
+	Metadata() map[string]string
+
+	// Returns the name of the context, set with the Name option of [Browser.NewContext] or with
+	// [BrowserContext.SetName]. The name and the metadata of the context are included in the messages it logs and, as
+	// their default title, in the traces it records.
+	Name() string
+
 	// **NOTE** CDP sessions are only supported on Chromium-based browsers.
 	// Returns the newly created session.
 	//
 	//  page: Target to create new session for. For backwards-compatibility, this parameter is named `page`, but it can be a
-	//    `Page` or `Frame` type.
+	//    `Page`, `Frame` or `Worker` type. Sessions of workers, including service workers, are attached to through a
+	//    browser session, see [Browser.NewBrowserCDPSession].
 	NewCDPSession(page interface{}) (CDPSession, error)
 
 	// Creates a new page in the browser context.
@@ -366,11 +498,30 @@ type BrowserContext interface {
 	// API testing helper associated with this context. Requests made with this API will use context cookies.
 	Request() APIRequestContext
 
+	// Removes the init scripts added with [BrowserContext.AddInitScript] that have the same source and isolated world as
+	// “script”. Documents that are already loaded are not affected, the script is not evaluated in the documents created
+	// afterwards.
+	//
+	//  script: Script to remove, matched by its content or the content of the file at its path.
+	RemoveInitScript(script Script) error
+
+	// Returns the routes registered with [BrowserContext.Route] and [BrowserContext.RouteFromHAR] in the order they are
+	// matched against requests, with the number of requests each of them handled.
+	Routes() []RouteInfo
+
+	// Removes a header set with [BrowserContext.SetExtraHTTPHeaders] or the ExtraHttpHeaders option of the context. The
+	// other extra HTTP headers are kept. Does nothing if the header is not set.
+	//
+	//  name: Name of the header, case-insensitive.
+	RemoveExtraHTTPHeader(name string) error
+
 	// Routing provides the capability to modify network requests that are made by any page in the browser context. Once
 	// route is enabled, every request matching the url pattern will stall unless it's continued, fulfilled or aborted.
 	// **NOTE** [BrowserContext.Route] will not intercept requests intercepted by Service Worker. See
 	// [this] issue. We recommend disabling Service Workers when
 	// using request interception by setting “Browser.newContext.serviceWorkers” to `block`.
+	// In Chromium, the requests made by the Service Workers themselves are routed when the driver runs with
+	// [RunOptions.ServiceWorkerNetworkEvents]. They have no page, and [Request.ServiceWorker] returns their worker.
 	//
 	// 1. url: A glob pattern, regex pattern or predicate receiving [URL] to match while routing. When a “baseURL” via the context
 	//    options was provided and the passed URL is a path, it gets merged via the
@@ -393,6 +544,28 @@ type BrowserContext interface {
 	// [this]: https://github.com/microsoft/playwright/issues/1090
 	RouteFromHAR(har string, options ...BrowserContextRouteFromHAROptions) error
 
+	// This method allows to modify websocket connections that are made by the context.
+	// Note that only `WebSocket`s created after this method was called will be routed. It is recommended to call this
+	// method before navigating the page.
+	// Connections matching “url” are passed to “handler” as a [WebSocketRoute]. Unless the handler calls
+	// [WebSocketRoute.ConnectToServer], the connection is mocked and never reaches the server. When routes from
+	// both the page and its browser context match, page routes take precedence.
+	//
+	// 1. url: Only WebSockets with the url matching this pattern will be routed. A string pattern can be relative to the
+	//    “Browser.newContext.baseURL” context option.
+	// 2. handler: Handler function to route the WebSocket.
+	RouteWebSocket(url interface{}, handler func(WebSocketRoute)) error
+
+	// Routes network requests like [BrowserContext.Route], with a priority. The routes of the context and of its pages are
+	// tried by decreasing priority, the latest first for the same priority, and the routes of a page before the routes of
+	// the context. [BrowserContext.Route] registers routes with the priority 0, so that a route of the context with a
+	// positive priority runs before the routes of the pages registered with [Page.Route].
+	//
+	// 1. url: A glob pattern, regex pattern or predicate receiving [URL] to match while routing.
+	// 2. handler: handler function to route the request.
+	// 3. priority: Priority of the route, can be negative.
+	RouteWithPriority(url interface{}, handler routeHandler, priority int, times ...int) error
+
 	// **NOTE** Service workers are only supported on Chromium-based browsers.
 	// All existing service workers in the context.
 	ServiceWorkers() []Worker
@@ -425,9 +598,22 @@ type BrowserContext interface {
 	//  headers: An object containing additional HTTP headers to be sent with every request. All header values must be strings.
 	SetExtraHTTPHeaders(headers map[string]string) error
 
+	// Enables or disables the HTTP cache of the context. The cache is disabled by routing all the requests, which
+	// Playwright does without the cache, so routes added with [BrowserContext.Route] and [Page.Route] keep working and
+	// take precedence. [BrowserContext.UnrouteAll] enables the cache again.
+	//
+	//  enabled: Whether to use the HTTP cache.
+	SetCacheEnabled(enabled bool) error
+
 	// Sets the context's geolocation. Passing `null` or `undefined` emulates position unavailable.
 	SetGeolocation(geolocation *Geolocation) error
 
+	// Sets the metadata entry key of the context, an empty value removes it.
+	SetMetadata(key string, value string)
+
+	// Sets the name of the context, see [BrowserContext.Name].
+	SetName(name string)
+
 	//
 	//  offline: Whether to emulate network being offline for the browser context.
 	SetOffline(offline bool) error
@@ -437,6 +623,13 @@ type BrowserContext interface {
 
 	Tracing() Tracing
 
+	// Removes a function added with [BrowserContext.ExposeFunction] or [BrowserContext.ExposeBinding]. Calls from the
+	// pages are no longer dispatched and the function is removed from every page of the context, including the
+	// documents created later. The function can be exposed again with the same name.
+	//
+	//  name: Name of the function.
+	UnexposeFunction(name string) error
+
 	// Removes all routes created with [BrowserContext.Route] and [BrowserContext.RouteFromHAR].
 	UnrouteAll(options ...BrowserContextUnrouteAllOptions) error
 
@@ -471,6 +664,27 @@ type BrowserContext interface {
 	//
 	//  event: Event name, same one typically passed into `*.on(event)`.
 	WaitForEvent(event string, options ...BrowserContextWaitForEventOptions) (interface{}, error)
+
+	// **NOTE** Service workers are only supported on Chromium-based browsers.
+	// Returns the first running service worker of the context matching the options, or waits for one to be created. Will
+	// throw an error if the context closes before.
+	WaitForServiceWorker(options ...BrowserContextWaitForServiceWorkerOptions) (Worker, error)
+}
+
+// BrowserServer is a browser launched by [BrowserType.LaunchServer], which other processes can connect to.
+type BrowserServer interface {
+	// Closes the browser gracefully and makes sure the process is terminated.
+	Close() error
+
+	// Kills the browser process and waits for the process to exit.
+	Kill() error
+
+	// Driver process running the browser server.
+	Process() *os.Process
+
+	// Browser websocket endpoint which can be used as an argument to [BrowserType.Connect] to establish connection to
+	// the browser.
+	WSEndpoint() string
 }
 
 // BrowserType provides methods to launch a specific browser instance or connect to an existing one. The following is
@@ -505,6 +719,10 @@ type BrowserType interface {
 	// Returns the persistent browser context instance.
 	// Launches browser that uses persistent storage located at “userDataDir” and returns the only context. Closing this
 	// context will automatically close the browser.
+	// Chrome extensions can only be loaded in a persistent context, with the Extensions option. Their pages are driven
+	// like the other pages of the context: the background pages are returned by [BrowserContext.BackgroundPages] and the
+	// Manifest V3 service workers by [BrowserContext.ServiceWorkers], whose URL contains the ID of the extension, such
+	// as `chrome-extension://<id>/background.js`, to open its pages with [Page.Goto].
 	//
 	//  userDataDir: Path to a User Data Directory, which stores browser session data like cookies and local storage. More details for
 	//    [Chromium](https://chromium.googlesource.com/chromium/src/+/master/docs/user_data_dir.md#introduction) and
@@ -513,6 +731,14 @@ type BrowserType interface {
 	//    string to use a temporary directory instead.
 	LaunchPersistentContext(userDataDir string, options ...BrowserTypeLaunchPersistentContextOptions) (BrowserContext, error)
 
+	// Returns the browser app instance. You can connect to it via [BrowserType.Connect], which requires the major/minor
+	// client/server version to match (1.2.3 → is compatible with 1.2.x).
+	//
+	// The server runs in a separate driver process, so it outlives the Playwright instance that launched it and other
+	// processes can connect to its [BrowserServer.WSEndpoint]. Only supported by Playwright instances started with
+	// [Run].
+	LaunchServer(options ...BrowserTypeLaunchServerOptions) (BrowserServer, error)
+
 	// Returns browser name. For example: `chromium`, `webkit` or `firefox`.
 	Name() string
 }
@@ -530,16 +756,82 @@ type BrowserType interface {
 // [DevTools Protocol Viewer]: https://chromedevtools.github.io/devtools-protocol/
 type CDPSession interface {
 	EventEmitter
+	// Emitted when the session is detached, by [CDPSession.Detach] or because its target was closed.
+	OnDetached(fn func(CDPSession))
+
 	// Detaches the CDPSession from the target. Once detached, the CDPSession object won't emit any events and can't be
 	// used to send messages.
 	Detach() error
 
+	// Returns `true` if the session was detached, by [CDPSession.Detach] or because its target was closed. Messages
+	// can't be sent on a detached session.
+	IsDetached() bool
+
 	//
 	// 1. method: Protocol method name.
 	// 2. params: Optional method parameters.
 	Send(method string, params map[string]interface{}) (interface{}, error)
 }
 
+// Accurately simulating time-dependent behavior is essential for verifying the correctness of applications. Learn
+// more about [clock emulation].
+// Note that clock is installed for the entire [BrowserContext], so the time in all the pages and iframes is
+// controlled by the same clock. Pages created or navigated later start from the current time and mode of the clock.
+// Times given as strings are parsed by the `Date` of the first page of the context, or as RFC 3339 date-times and dates
+// when the context has no page.
+//
+// [clock emulation]: https://playwright.dev/docs/clock
+type Clock interface {
+	// Advance the clock by jumping forward in time. Only fires due timers at most once. This is equivalent to user closing
+	// the laptop lid for a while and reopening it later, after given time.
+	//
+	//  ticks: Time may be the number of milliseconds to advance the clock by, a `time.Duration` or a human-readable string.
+	//    Valid string formats are "08" for eight seconds, "01:00" for one minute and "02:34:10" for two hours, 34
+	//    minutes and ten seconds.
+	FastForward(ticks interface{}) error
+
+	// Install fake implementations for the following time-related functions:
+	//  - `Date`
+	//  - `setTimeout`
+	//  - `clearTimeout`
+	//  - `setInterval`
+	//  - `clearInterval`
+	//  - `requestAnimationFrame`
+	//  - `cancelAnimationFrame`
+	//  - `performance`
+	// Fake timers are used to manually control the flow of time in tests, for example to test long-running
+	// animations or to simulate a user returning after a while.
+	Install(options ...ClockInstallOptions) error
+
+	// Advance the clock by jumping forward in time and pause the time. Once this method is called, no timers are fired
+	// unless [Clock.RunFor], [Clock.FastForward], [Clock.PauseAt] or [Clock.Resume] is called.
+	// Only fires due timers at most once. This is equivalent to user closing the laptop lid for a while and reopening it
+	// at the specified time and pausing.
+	//
+	//  time: Time to pause at: milliseconds since the epoch, a `time.Time` or a string parsed by the browser's `Date`.
+	PauseAt(time interface{}) error
+
+	// Resumes timers. Once this method is called, time resumes flowing, timers are fired as usual.
+	Resume() error
+
+	// Advance the clock, firing all the time-related callbacks.
+	//
+	//  ticks: Time may be the number of milliseconds to advance the clock by, a `time.Duration` or a human-readable string.
+	//    Valid string formats are "08" for eight seconds, "01:00" for one minute and "02:34:10" for two hours, 34
+	//    minutes and ten seconds.
+	RunFor(ticks interface{}) error
+
+	// Makes `Date.now` and `new Date()` return fixed fake time at all times, keeps all the timers running.
+	//
+	//  time: Time to be set: milliseconds since the epoch, a `time.Time` or a string parsed by the browser's `Date`.
+	SetFixedTime(time interface{}) error
+
+	// Sets current system time but does not trigger any timers.
+	//
+	//  time: Time to be set: milliseconds since the epoch, a `time.Time` or a string parsed by the browser's `Date`.
+	SetSystemTime(time interface{}) error
+}
+
 // [ConsoleMessage] objects are dispatched by page via the [Page.OnConsole] event. For each console messages logged in
 // the page there will be corresponding event in the Playwright context.
 type ConsoleMessage interface {
@@ -563,6 +855,25 @@ type ConsoleMessage interface {
 	Type() string
 }
 
+// Coverage gathers information about parts of JavaScript and CSS that were used by the page, see [Page.Coverage].
+// The coverage can be converted to the formats of other coverage tools with [JSCoverageToIstanbul],
+// [JSCoverageToLcov] and [CSSCoverageToLcov].
+// **NOTE** Coverage APIs are only supported on Chromium-based browsers.
+type Coverage interface {
+	// Starts collecting the CSS coverage.
+	StartCSSCoverage(options ...CoverageStartCSSCoverageOptions) error
+
+	// Starts collecting the JS coverage. Anonymous scripts, such as the ones run with `eval`, are not reported unless
+	// `reportAnonymousScripts` is set. The scripts evaluated by Playwright are never reported.
+	StartJSCoverage(options ...CoverageStartJSCoverageOptions) error
+
+	// Stops collecting the CSS coverage and returns the coverage of the style sheets.
+	StopCSSCoverage() ([]CSSCoverageEntry, error)
+
+	// Stops collecting the JS coverage and returns the coverage of the scripts.
+	StopJSCoverage() ([]JSCoverageEntry, error)
+}
+
 // [Dialog] objects are dispatched by page via the [Page.OnDialog] event.
 // An example of using `Dialog` class:
 // **NOTE** Dialogs are dismissed automatically, unless there is a [Page.OnDialog] listener. When listener is present,
@@ -599,6 +910,11 @@ type Download interface {
 	// `download.failure()` would resolve to `canceled`.
 	Cancel() error
 
+	// Returns a reader for the downloaded content. The content is streamed over the transport as it is read, so large
+	// downloads don't need to be saved to disk first. Will wait for the download to finish if necessary. The reader
+	// must be closed when it is no longer needed.
+	CreateReadStream() (io.ReadCloser, error)
+
 	// Deletes the downloaded file. Will wait for the download to finish if necessary.
 	Delete() error
 
@@ -1561,6 +1877,13 @@ type Frame interface {
 	// [locators]: https://playwright.dev/docs/locators
 	IsVisible(selector string, options ...FrameIsVisibleOptions) (bool, error)
 
+	// Returns the isolated world with the given name in the frame. Scripts evaluated in an isolated world share the DOM
+	// with the page, but page scripts can't see or tamper with their globals, and worlds with different names don't see
+	// each other either. Only supported in Chromium.
+	//
+	//  name: Name of the isolated world.
+	IsolatedWorld(name string) IsolatedWorld
+
 	// The method returns an element locator that can be used to perform actions on this page / frame. Locator is resolved
 	// to the element immediately before performing an action, so a series of actions on the same locator can in fact be
 	// performed on different DOM elements. That would happen if the DOM structure between those actions has changed.
@@ -1579,6 +1902,11 @@ type Frame interface {
 	// later.
 	Name() string
 
+	// Returns a new CDPSession attached to the frame, see [BrowserContext.NewCDPSession].
+	//
+	// **NOTE** CDP Sessions are only supported on Chromium-based browsers.
+	NewCDPSession() (CDPSession, error)
+
 	// Returns the page containing this frame.
 	Page() Page
 
@@ -1652,6 +1980,12 @@ type Frame interface {
 	// [locators]: https://playwright.dev/docs/locators
 	SelectOption(selector string, values SelectOptionValues, options ...FrameSelectOptionOptions) ([]string, error)
 
+	// Removes a header set with [Frame.SetExtraHTTPHeaders]. The other extra HTTP headers of the frame are kept. Does
+	// nothing if the header is not set.
+	//
+	//  name: Name of the header, case-insensitive.
+	RemoveExtraHTTPHeader(name string) error
+
 	// This method checks or unchecks an element matching “selector” by performing the following steps:
 	//  1. Find an element matching “selector”. If there is none, wait until a matching element is attached to the DOM.
 	//  2. Ensure that matched element is a checkbox or a radio input. If not, this method throws.
@@ -1683,6 +2017,15 @@ type Frame interface {
 	// [document.Write()]: https://developer.mozilla.org/en-US/docs/Web/API/Document/write
 	SetContent(html string, options ...FrameSetContentOptions) error
 
+	// The extra HTTP headers will be sent with every request the frame initiates. They are not inherited by child frames.
+	// Headers are merged in a fixed order, each one overriding the previous ones: headers of the browser context, of the
+	// page, of the frame, then the headers set by route handlers.
+	// **NOTE** While a frame has extra HTTP headers, the page intercepts all requests, which disables the HTTP
+	// cache like [Page.Route] does.
+	//
+	//  headers: An object containing additional HTTP headers to be sent with every request. All header values must be strings.
+	SetExtraHTTPHeaders(headers map[string]string) error
+
 	// Sets the value of the file input to these file paths or files. If some of the `filePaths` are relative paths, then
 	// they are resolved relative to the current working directory. For empty array, clears the selected files.
 	// This method expects “selector” to point to an
@@ -1934,6 +2277,67 @@ type FrameLocator interface {
 	Owner() Locator
 }
 
+// Gamepad is a simulated game controller connected to a page with [Page.ConnectGamepad]. The page sees it through the
+// [Gamepad API]: `navigator.getGamepads()` returns its state and the `gamepadconnected` and `gamepaddisconnected`
+// events are dispatched on `window` when it is connected and disconnected. Its state is kept across navigations of the
+// main frame, the page sees it connected again once the new document is loaded.
+//
+// [Gamepad API]: https://developer.mozilla.org/en-US/docs/Web/API/Gamepad_API
+type Gamepad interface {
+	// Returns the values of the axes of the gamepad.
+	Axes() []float64
+
+	// Returns the values of the buttons of the gamepad.
+	Buttons() []float64
+
+	// Disconnects the gamepad from the page. Its index can then be used by another gamepad.
+	Disconnect() error
+
+	// Returns the id of the gamepad, as reported by `Gamepad.id`.
+	ID() string
+
+	// Returns the index of the gamepad in `navigator.getGamepads()`.
+	Index() int
+
+	// Returns whether the gamepad is connected.
+	IsConnected() bool
+
+	// Presses a button of the gamepad and releases it, optionally after a delay.
+	//
+	//  button: Index of the button, such as `0` for the bottom button of the right cluster with the standard mapping.
+	PressButton(button int, options ...GamepadPressButtonOptions) error
+
+	// Sets the value of an axis of the gamepad.
+	//
+	// 1. axis: Index of the axis, such as `0` for the horizontal axis of the left stick with the standard mapping.
+	// 2. value: Value of the axis, from `-1` to `1`.
+	SetAxis(axis int, value float64) error
+
+	// Sets the value of a button of the gamepad. A button is pressed when its value is greater than `0`.
+	//
+	// 1. button: Index of the button.
+	// 2. value: Value of the button, from `0` to `1`.
+	SetButton(button int, value float64) error
+}
+
+// IsolatedWorld is a named JavaScript world of a frame, see [Frame.IsolatedWorld]. Isolated worlds share the DOM with
+// the page, but page scripts can't see, detect or tamper with the globals defined in them, which makes them a safe place
+// for helper scripts. Use [Script.IsolatedWorld] to add init scripts to an isolated world.
+type IsolatedWorld interface {
+	// Returns the value of the “expression” evaluated in the isolated world.
+	// If the “expression” evaluates to a function, it is invoked with “arg” and its return value is returned. If the
+	// function returns a [Promise], this method waits for the promise to resolve. The result and “arg” are passed by
+	// value, so they must be JSON serializable.
+	//
+	// 1. expression: JavaScript expression to be evaluated in the isolated world. If the expression evaluates to a function,
+	//    the function is automatically invoked.
+	// 2. arg: Optional argument to pass to “expression”.
+	Evaluate(expression string, arg ...interface{}) (interface{}, error)
+
+	// Name of the isolated world.
+	Name() string
+}
+
 // JSHandle represents an in-page JavaScript object. JSHandles can be created with the [Page.EvaluateHandle] method.
 // JSHandle prevents the referenced JavaScript object being garbage collected unless the handle is exposed with
 // [JSHandle.Dispose]. JSHandles are auto-disposed when their origin frame gets navigated or the parent context gets
@@ -2046,6 +2450,17 @@ type Keyboard interface {
 	// [here]: https://developer.mozilla.org/en-US/docs/Web/API/KeyboardEvent/key/Key_Values
 	Press(key string, options ...KeyboardPressOptions) error
 
+	// Sets the layout of the keyboard, such as `de-DE` or `fr-FR`, so that the keys and the characters pressed by
+	// [Keyboard.Down], [Keyboard.Up], [Keyboard.Press] and [Keyboard.Type], and by the press and type actions of the
+	// page, its frames, locators and element handles, have the key, the text and the key code of that layout: `KeyY`
+	// is `z` in `de-DE` and typing `a` presses `KeyQ` in `fr-FR`. Characters that are not on the layout are inserted
+	// as with [Keyboard.InsertText]. The modifier keys are held by the page as with the default layout, so that they
+	// also apply to the actions of [Page.Mouse]. Layouts other than `en-US`, the default, are only supported in
+	// Chromium.
+	//
+	//  layout: Name of the layout, one of `en-US`, `de-DE` and `fr-FR`. An empty name restores the default layout.
+	SetLayout(layout string) error
+
 	// **NOTE** In most cases, you should use [Locator.Fill] instead. You only need to press keys one by one if there is
 	// special keyboard handling on the page - in this case use [Locator.PressSequentially].
 	// Sends a `keydown`, `keypress`/`input`, and `keyup` event for each character in the text.
@@ -2081,6 +2496,21 @@ type Locator interface {
 	// [assertions guide]: https://playwright.dev/docs/test-assertions
 	AllInnerTexts() ([]string, error)
 
+	// Returns an iterator over locators pointing to the elements matching the locator, like [Locator.All], to range over
+	// with Go 1.23 or later:
+	//
+	//	for item, err := range page.GetByRole("listitem").AllSeq() {
+	//		if err != nil {
+	//			return err
+	//		}
+	//		// ...
+	//	}
+	//
+	// The number of elements is checked again before each element after the first, and the iterator yields
+	// [ErrLocatorCountChanged] and stops if it changed, rather than skipping or repeating elements. Stop ranging to
+	// stop the iteration.
+	AllSeq() func(yield func(Locator, error) bool)
+
 	// Returns an array of `node.textContent` values for all matching nodes.
 	// **NOTE** If you need to assert text on the page, prefer [LocatorAssertions.ToHaveText] to avoid flakiness. See
 	// [assertions guide] for more details.
@@ -2093,6 +2523,15 @@ type Locator interface {
 	//  locator: Additional locator to match.
 	And(locator Locator) Locator
 
+	// Captures the aria snapshot of the given element. Read more about [aria snapshots] and
+	// [LocatorAssertions.ToMatchAriaSnapshot] for the corresponding assertion.
+	// An aria snapshot is a YAML-like representation of the accessibility tree of the element: every line is a node
+	// with its role, accessible name and states like `[checked]` or `[level=1]`, children are indented. Text is listed
+	// as `text` nodes, or inline after the role if it is the only child.
+	//
+	// [aria snapshots]: https://playwright.dev/docs/aria-snapshots
+	AriaSnapshot(options ...LocatorAriaSnapshotOptions) (string, error)
+
 	// Calls [blur] on the element.
 	//
 	// [blur]: https://developer.mozilla.org/en-US/docs/Web/API/HTMLElement/blur
@@ -2580,6 +3019,21 @@ type Locator interface {
 	// [actionability]: https://playwright.dev/docs/actionability
 	Screenshot(options ...LocatorScreenshotOptions) ([]byte, error)
 
+	// Take a screenshot of the element matching the locator once it stops changing. Screenshots are taken until
+	// “stableFrames” consecutive ones match and the element keeps the same position and size, so that animated or
+	// late-loading elements are not captured halfway. Unlike [Locator.Screenshot], it fails with a timeout error if the
+	// element keeps changing.
+	ScreenshotStabilized(options ...LocatorScreenshotStabilizedOptions) ([]byte, error)
+
+	// Scrolls the element matching the locator by a delta, as [Element.ScrollBy], and waits for the scrolling to end.
+	// The element must be scrollable, use [Locator.ScrollIntoViewIfNeeded] to scroll the element itself into view.
+	//
+	// 1. deltaX: Distance in pixels to scroll horizontally.
+	// 2. deltaY: Distance in pixels to scroll vertically.
+	//
+	// [Element.ScrollBy]: https://developer.mozilla.org/en-US/docs/Web/API/Element/scrollBy
+	ScrollBy(deltaX float64, deltaY float64, options ...LocatorScrollByOptions) (*Position, error)
+
 	// This method waits for [actionability] checks, then tries to scroll element into view, unless
 	// it is completely visible as defined by
 	// [IntersectionObserver]'s `ratio`.
@@ -2588,6 +3042,18 @@ type Locator interface {
 	// [IntersectionObserver]: https://developer.mozilla.org/en-US/docs/Web/API/Intersection_Observer_API
 	ScrollIntoViewIfNeeded(options ...LocatorScrollIntoViewIfNeededOptions) error
 
+	// Returns the scroll position of the element matching the locator, its `scrollLeft` and `scrollTop`.
+	ScrollPosition(options ...LocatorScrollPositionOptions) (*Position, error)
+
+	// Scrolls the element matching the locator to a position, as [Element.ScrollTo], and waits for the scrolling to
+	// end. Returns the position it is scrolled to, which is clamped to the scrollable area.
+	//
+	// 1. x: Horizontal position in pixels.
+	// 2. y: Vertical position in pixels.
+	//
+	// [Element.ScrollTo]: https://developer.mozilla.org/en-US/docs/Web/API/Element/scrollTo
+	ScrollTo(x float64, y float64, options ...LocatorScrollToOptions) (*Position, error)
+
 	// Selects option or options in `<select>`.
 	//
 	// # Details
@@ -2709,6 +3175,11 @@ type Locator interface {
 	// “timeout” milliseconds until the condition is met.
 	WaitFor(options ...LocatorWaitForOptions) error
 
+	// Waits for the scrolling of the element matching the locator to end, e.g. after a smooth scroll or a
+	// [Mouse.Wheel]. Scrolling ends when the `scrollend` event is fired, or in browsers without it, when the position
+	// stays the same for “settle” milliseconds. Returns the position once the scrolling ended.
+	WaitForScrollEnd(options ...LocatorWaitForScrollEndOptions) (*Position, error)
+
 	Err() error
 }
 
@@ -2801,6 +3272,13 @@ type LocatorAssertions interface {
 	// 2. value: CSS property value.
 	ToHaveCSS(name string, value interface{}, options ...LocatorAssertionsToHaveCSSOptions) error
 
+	// Ensures the [Locator] points to an element whose text, or value for form controls, is a date between from and
+	// to inclusive. The date is parsed as written in the locale of the options, e.g. "21/03/2024" in `en-GB`.
+	//
+	// 1. from: Earliest expected date.
+	// 2. to: Latest expected date.
+	ToHaveDateBetween(from time.Time, to time.Time, options ...LocatorAssertionsToHaveDateBetweenOptions) error
+
 	// Ensures the [Locator] points to an element with the given DOM Node ID.
 	//
 	//  id: Element id.
@@ -2813,6 +3291,13 @@ type LocatorAssertions interface {
 	// 2. value: Property value.
 	ToHaveJSProperty(name string, value interface{}, options ...LocatorAssertionsToHaveJSPropertyOptions) error
 
+	// Ensures the [Locator] points to an element whose screenshot matches the snapshot stored under “name”. Screenshots
+	// are retried until one matches or the timeout is reached. Missing snapshots are written, see [SnapshotUpdateEnv] for
+	// how to update them.
+	//
+	//  name: Snapshot file name inside the per-platform snapshot directory. `.png` is appended if it has no extension.
+	ToHaveScreenshot(name string, options ...LocatorAssertionsToHaveScreenshotOptions) error
+
 	// Ensures the [Locator] points to an element with the given text. All nested elements will be considered when
 	// computing the text content of the element. You can use regular expressions for the value as well.
 	//
@@ -2830,11 +3315,48 @@ type LocatorAssertions interface {
 	//  value: Expected value.
 	ToHaveValue(value interface{}, options ...LocatorAssertionsToHaveValueOptions) error
 
+	// Ensures the [Locator] points to an element whose text, or value for form controls, is a number between min and
+	// max inclusive. The first number of the text is parsed as written in the locale of the options, ignoring grouping
+	// separators, currency symbols and units, e.g. "$1,234.50" or "1.234,50 €" in `de-DE`.
+	//
+	// 1. min: Minimum expected number.
+	// 2. max: Maximum expected number.
+	ToHaveValueBetween(min float64, max float64, options ...LocatorAssertionsToHaveValueBetweenOptions) error
+
+	// Ensures the [Locator] points to an element whose text, or value for form controls, is a number differing from
+	// value by at most tolerance, see [LocatorAssertions.ToHaveValueBetween] for how the number is parsed.
+	//
+	// 1. value: Expected number.
+	// 2. tolerance: Maximum difference with the expected number.
+	ToHaveValueCloseTo(value float64, tolerance float64, options ...LocatorAssertionsToHaveValueCloseToOptions) error
+
+	// Ensures the [Locator] points to an element whose text, or value for form controls, is a number greater than
+	// value, see [LocatorAssertions.ToHaveValueBetween] for how the number is parsed.
+	//
+	//  value: Number to compare with.
+	ToHaveValueGreaterThan(value float64, options ...LocatorAssertionsToHaveValueGreaterThanOptions) error
+
+	// Ensures the [Locator] points to an element whose text, or value for form controls, is a number less than value,
+	// see [LocatorAssertions.ToHaveValueBetween] for how the number is parsed.
+	//
+	//  value: Number to compare with.
+	ToHaveValueLessThan(value float64, options ...LocatorAssertionsToHaveValueLessThanOptions) error
+
 	// Ensures the [Locator] points to multi-select/combobox (i.e. a `select` with the `multiple` attribute) and the
 	// specified values are selected.
 	//
 	//  values: Expected options currently selected.
 	ToHaveValues(values []interface{}, options ...LocatorAssertionsToHaveValuesOptions) error
+
+	// Asserts that the target element matches the given [accessibility snapshot].
+	// The expected snapshot is a template in the format returned by [Locator.AriaSnapshot]. Names and text can be
+	// regular expressions like `/Item \d+/`, and omitted names and states match any value. Template nodes must appear in
+	// the same order in the actual tree, but the actual tree can contain other nodes in between.
+	//
+	//  expected: Expected accessibility snapshot template.
+	//
+	// [accessibility snapshot]: https://playwright.dev/docs/aria-snapshots
+	ToMatchAriaSnapshot(expected string, options ...LocatorAssertionsToMatchAriaSnapshotOptions) error
 }
 
 // The Mouse class operates in main-frame CSS pixels relative to the top-left corner of the viewport.
@@ -2891,6 +3413,14 @@ type Page interface {
 	// The most common way to deal with crashes is to catch an exception:
 	OnCrash(fn func(Page))
 
+	// Emitted when a document of the page violates its Content Security Policy, from the `securitypolicyviolation`
+	// events of the documents and from the reports sent to the `report-uri` and `report-to` endpoints of the policy.
+	// The browser uploads the reports of `report-to` endpoints in batches, outside of the pages: they are emitted by
+	// the pages showing their document, if the context sees their requests.
+	// **NOTE** Nothing is emitted until [BrowserContext.CaptureCSPViolations] is called. Adding a listener doesn't start
+	// the capture, which takes calls to the driver that can't be made from the event handlers.
+	OnCSPViolation(fn func(CSPViolation))
+
 	// Emitted when a JavaScript dialog appears, such as `alert`, `prompt`, `confirm` or `beforeunload`. Listener **must**
 	// either [Dialog.Accept] or [Dialog.Dismiss] the dialog - otherwise the page will
 	// [freeze] waiting for the dialog,
@@ -2922,6 +3452,9 @@ type Page interface {
 	// Emitted when a frame is navigated to a new url.
 	OnFrameNavigated(fn func(Frame))
 
+	// Emitted before the page is closed by an [IdleReaper] because it was not used for a while.
+	OnIdle(fn func(Page))
+
 	// Emitted when the JavaScript [`load`] event is dispatched.
 	//
 	// [`load`]: https://developer.mozilla.org/en-US/docs/Web/Events/load
@@ -2939,6 +3472,14 @@ type Page interface {
 	// most cases).
 	OnPopup(fn func(Page))
 
+	// Emitted when the status of a page prerendered by the page with the [Speculation Rules API] changes, until it
+	// is activated with the [PrerenderStatusSuccess] status or discarded with the [PrerenderStatusFailure] status.
+	// The status is captured once [Page.CapturePrerenders] or [Page.ExpectPrerenderActivation] is called. Only emitted
+	// in Chromium.
+	//
+	// [Speculation Rules API]: https://developer.mozilla.org/en-US/docs/Web/API/Speculation_Rules_API
+	OnPrerender(fn func(PrerenderAttempt))
+
 	// Emitted when a page issues a request. The [request] object is read-only. In order to intercept and mutate requests,
 	// see [Page.Route] or [BrowserContext.Route].
 	OnRequest(fn func(Request))
@@ -2958,6 +3499,10 @@ type Page interface {
 	// events is `request`, `response` and `requestfinished`.
 	OnResponse(fn func(Response))
 
+	// Emitted when the viewport size of the page is changed with [Page.SetViewportSize], including while running
+	// [Page.ForEachBreakpoint].
+	OnViewportSizeChange(fn func(*Size))
+
 	// Emitted when [WebSocket] request is sent.
 	OnWebSocket(fn func(WebSocket))
 
@@ -2967,6 +3512,16 @@ type Page interface {
 	// [WebWorker]: https://developer.mozilla.org/en-US/docs/Web/API/Web_Workers_API
 	OnWorker(fn func(Worker))
 
+	// Returns the [Accessibility] of the page, to inspect its accessibility tree.
+	Accessibility() Accessibility
+
+	// Gives the page transient user activation, as if the user interacted with it, so that calls requiring a user
+	// gesture such as `navigator.clipboard.writeText()`, `HTMLMediaElement.play()` with sound or `element.requestFullscreen()`
+	// are allowed right after. The activation is given by clicking on a transparent element covering the top left pixel
+	// of the page for the duration of the click, so event listeners of the window and the document see a click at
+	// `(0, 0)`.
+	ActivateUser() error
+
 	// Adds a script which would be evaluated in one of the following scenarios:
 	//  - Whenever the page is navigated.
 	//  - Whenever the child frame is attached or navigated. In this case, the script is evaluated in the context of the
@@ -2985,9 +3540,29 @@ type Page interface {
 	// content. Returns the added tag when the stylesheet's onload fires or when the CSS content was injected into frame.
 	AddStyleTag(options PageAddStyleTagOptions) (ElementHandle, error)
 
+	// Audits the page in the categories performance, accessibility, best practices and SEO, and returns the scores of
+	// the categories and the results of their audits. The audits run in the page as it is, with its session, so pages
+	// behind a login can be audited. The performance metrics are those of the last navigation of the page, and the
+	// main-thread work is only measured when the page is reloaded, see the “reload” option.
+	// **NOTE** The audits are simple checks computed from the DOM, the performance timeline and the DevTools protocol.
+	// They are named after the Lighthouse audits they resemble, but their scores are not those of Lighthouse. Audits
+	// are only supported in Chromium.
+	Audit(options ...PageAuditOptions) (*AuditReport, error)
+
+	// Returns the bytes sent and received by the finished and failed requests of the page since it was created, in total
+	// and by resource type. The headers are included, except for the requests that failed before receiving a response.
+	// It can be called at any time, while the page is loading or after it was closed, and waits for the sizes of the
+	// requests already done to be fetched. It must not be called from an event handler.
+	// **NOTE** The traffic is only recorded in the contexts created with [BrowserNewContextOptions.RecordBandwidth].
+	Bandwidth() PageBandwidth
+
 	// Brings page to front (activates tab).
 	BringToFront() error
 
+	// Starts capturing the status of the pages prerendered by the page, emitted by [Page.OnPrerender]. Calling it again
+	// does nothing. Only supported in Chromium.
+	CapturePrerenders() error
+
 	// This method checks an element matching “selector” by performing the following steps:
 	//  1. Find an element matching “selector”. If there is none, wait until a matching element is attached to the DOM.
 	//  2. Ensure that matched element is a checkbox or a radio input. If not, this method throws. If the element is
@@ -3029,6 +3604,13 @@ type Page interface {
 	// [locators]: https://playwright.dev/docs/locators
 	Click(selector string, options ...PageClickOptions) error
 
+	// Removes all init scripts added with [Page.AddInitScript]. Documents that are already loaded are not affected, the
+	// scripts are not evaluated in the documents created afterwards.
+	ClearInitScripts() error
+
+	// Playwright has ability to mock clock and passage of time.
+	Clock() Clock
+
 	// If “runBeforeUnload” is `false`, does not run any unload handlers and waits for the page to be closed. If
 	// “runBeforeUnload” is `true` the method will run unload handlers, but will **not** wait for the page to close.
 	// By default, `page.close()` **does not** run `beforeunload` handlers.
@@ -3036,12 +3618,25 @@ type Page interface {
 	// manually via [Page.OnDialog] event.
 	Close(options ...PageCloseOptions) error
 
+	// Connects a simulated [Gamepad] to the page, at the lowest free index of `navigator.getGamepads()`, and dispatches
+	// the `gamepadconnected` event. The Gamepad API of the page is replaced by the simulation, so real gamepads are
+	// not seen by the page anymore. Only the main frame sees the gamepads.
+	ConnectGamepad(options ...PageConnectGamepadOptions) (Gamepad, error)
+
+	// Returns a subscription delivering the console messages of the page on a channel, until it is unsubscribed or the
+	// page is closed. See [SubscribeEvent].
+	ConsoleMessages() *EventSubscription[ConsoleMessage]
+
 	// Gets the full HTML contents of the page, including the doctype.
 	Content() (string, error)
 
 	// Get the browser context that the page belongs to.
 	Context() BrowserContext
 
+	// Returns the [Coverage] of the page.
+	// **NOTE** Coverage APIs are only supported on Chromium-based browsers.
+	Coverage() Coverage
+
 	// This method double clicks an element matching “selector” by performing the following steps:
 	//  1. Find an element matching “selector”. If there is none, wait until a matching element is attached to the DOM.
 	//  2. Wait for [actionability] checks on the matched element, unless “force” option is set. If
@@ -3063,6 +3658,16 @@ type Page interface {
 	// [locators]: https://playwright.dev/docs/locators
 	Dblclick(selector string, options ...PageDblclickOptions) error
 
+	// Dispatches a `devicemotion` event with the motion on the window of the frames of the page, to drive pages reacting
+	// to the motion of the device in any browser. Unlike [Page.EmulateSensor], the event is synthetic: it is dispatched
+	// once, and the sensors of the browser are not affected.
+	DispatchDeviceMotion(motion DeviceMotion) error
+
+	// Dispatches a `deviceorientation` event with the orientation on the window of the frames of the page, to drive
+	// pages reacting to the orientation of the device in any browser. Unlike [Page.EmulateDeviceOrientation], the event
+	// is synthetic: it is dispatched once, and the orientation reported by the browser is not affected.
+	DispatchDeviceOrientation(orientation DeviceOrientation) error
+
 	// The snippet below dispatches the `click` event on the element. Regardless of the visibility state of the element,
 	// `click` is dispatched. This is equivalent to calling
 	// [element.Click()].
@@ -3088,6 +3693,13 @@ type Page interface {
 	// [locators]: https://playwright.dev/docs/locators
 	DispatchEvent(selector string, typ string, eventInit interface{}, options ...PageDispatchEventOptions) error
 
+	// Returns the metadata of the document of the main frame: its title, description and canonical URL, its resolved
+	// favicons, and its [Open Graph] and Twitter card metadata, as needed by crawlers and link previews. The metadata
+	// is read from the current DOM, so it includes the tags added by scripts.
+	//
+	// [Open Graph]: https://ogp.me/
+	DocumentMetadata() (*DocumentMetadata, error)
+
 	// This method drags the source element to the target element. It will first move to the source element, perform a
 	// `mousedown`, then move to the target element and perform a `mouseup`.
 	//
@@ -3097,10 +3709,45 @@ type Page interface {
 	//    will be used.
 	DragAndDrop(source string, target string, options ...PageDragAndDropOptions) error
 
+	// Slows down the CPU of the page by rate, e.g. `4` for a 4x slowdown, to test it on slow devices. Pass `1` to remove
+	// the throttling. Only supported in Chromium.
+	//
+	//  rate: Throttling rate as a slowdown factor, `1` is no throttling.
+	EmulateCPUThrottling(rate float64) error
+
+	// Overrides the orientation of the device reported to the page with `deviceorientation` events, as if the device
+	// was rotated. Calling it without an orientation removes the override. Only supported in Chromium.
+	EmulateDeviceOrientation(options ...PageEmulateDeviceOrientationOptions) error
+
+	// Emulates the user and screen state reported to the page by the [Idle Detection API], to test features reacting
+	// to the user being idle or the screen being locked. Unset states default to an active user and an unlocked
+	// screen, and calling it without states removes the emulation. The page still needs the `idle-detection`
+	// permission, see [BrowserContext.GrantPermissions]. Only supported in Chromium.
+	//
+	// [Idle Detection API]: https://developer.mozilla.org/en-US/docs/Web/API/Idle_Detection_API
+	EmulateIdleState(options ...PageEmulateIdleStateOptions) error
+
 	// This method changes the `CSS media type` through the `media` argument, and/or the `prefers-colors-scheme` media
 	// feature, using the `colorScheme` argument.
 	EmulateMedia(options ...PageEmulateMediaOptions) error
 
+	// Emulates the latency, the throughput, the connection type and the offline state of the network of the page, to
+	// test it on slow networks, e.g. with [NetworkConditionsSlow3G]. Unset options default to no throttling, and
+	// calling it without options removes the emulation. Unlike [BrowserContext.SetOffline], it only applies to the
+	// requests of the page and its same-process frames, and not to the requests of its service workers. Only supported
+	// in Chromium.
+	EmulateNetworkConditions(options ...PageEmulateNetworkConditionsOptions) error
+
+	// Replaces a sensor of the device by a virtual sensor, whose readings are reported to the [Generic Sensor API] and
+	// to the `devicemotion` and `deviceorientation` events derived from it, until it is called again. The reading has
+	// one value for the ambient light sensor, the x, y and z values for the motion sensors and a quaternion for the
+	// orientation sensors. Calling it without options removes the virtual sensor. Only supported in Chromium.
+	//
+	//  sensor: Sensor to emulate.
+	//
+	// [Generic Sensor API]: https://developer.mozilla.org/en-US/docs/Web/API/Sensor_APIs
+	EmulateSensor(sensor *SensorType, options ...PageEmulateSensorOptions) error
+
 	// The method finds an element matching the specified selector within the page and passes it as a first argument to
 	// “expression”. If no elements match the selector, the method throws an error. Returns the value of “expression”.
 	// If “expression” returns a [Promise], then [Page.EvalOnSelector] would wait for the promise to resolve and return
@@ -3203,6 +3850,13 @@ type Page interface {
 	// [locators]: https://playwright.dev/docs/locators
 	Focus(selector string, options ...PageFocusOptions) error
 
+	// Resizes the viewport to each breakpoint in order, waits for the layout to settle and calls “callback”. The
+	// original viewport size is restored afterwards. Iteration stops at the first error returned by “callback”.
+	//
+	// 1. breakpoints: Viewport sizes to visit.
+	// 2. callback: Function called at each breakpoint.
+	ForEachBreakpoint(breakpoints []Breakpoint, callback func(Breakpoint) error) error
+
 	// Returns frame matching the specified criteria. Either `name` or `url` must be specified.
 	Frame(options ...PageFrameOptions) Frame
 
@@ -3215,6 +3869,9 @@ type Page interface {
 	// An array of all frames attached to the page.
 	Frames() []Frame
 
+	// Returns the gamepads connected to the page with [Page.ConnectGamepad], by index.
+	Gamepads() []Gamepad
+
 	// Returns element attribute value.
 	//
 	// Deprecated: Use locator-based [Locator.GetAttribute] instead. Read more about [locators].
@@ -3303,6 +3960,17 @@ type Page interface {
 	// Navigate to the next page in history.
 	GoForward(options ...PageGoForwardOptions) (Response, error)
 
+	// Returns the main resource response. In case of multiple redirects, the navigation will resolve with the response of
+	// the last redirect. Returns a nil [Response] and no error for the current entry, which is not navigated to, and for
+	// the entries of the same document, such as the ones added with `history.pushState()`, whose navigation loads no
+	// response.
+	// Navigate to the entry of the session history at “index”, see [Page.History]. Unlike [Page.GoBack] and
+	// [Page.GoForward], it moves over several entries at once, without loading the entries in between. Only supported in
+	// Chromium.
+	//
+	//  index: Index of the entry in [NavigationHistory.Entries].
+	GoToHistoryEntry(index int, options ...PageGoToHistoryEntryOptions) (Response, error)
+
 	// Returns the main resource response. In case of multiple redirects, the navigation will resolve with the first
 	// non-redirect response.
 	// The method will throw an error if:
@@ -3326,6 +3994,10 @@ type Page interface {
 	// [upstream issue]: https://bugs.chromium.org/p/chromium/issues/detail?id=761295
 	Goto(url string, options ...PageGotoOptions) (Response, error)
 
+	// Returns the session history of the page: its entries, including the ones added with `history.pushState()`, and the
+	// index of the current one. Only supported in Chromium.
+	History() (*NavigationHistory, error)
+
 	// This method hovers over an element matching “selector” by performing the following steps:
 	//  1. Find an element matching “selector”. If there is none, wait until a matching element is attached to the DOM.
 	//  2. Wait for [actionability] checks on the matched element, unless “force” option is set. If
@@ -3365,6 +4037,9 @@ type Page interface {
 	// [locators]: https://playwright.dev/docs/locators
 	InnerText(selector string, options ...PageInnerTextOptions) (string, error)
 
+	// Returns the init scripts added with [Page.AddInitScript] that were not removed, in the order they were added.
+	InitScripts() []Script
+
 	// Returns `input.value` for the selected `<input>` or `<textarea>` or `<select>` element.
 	// Throws for non-input elements. However, if the element is inside the `<label>` element that has an associated
 	// [control], returns the value of the
@@ -3449,6 +4124,13 @@ type Page interface {
 	// [locators]: https://playwright.dev/docs/locators
 	IsVisible(selector string, options ...PageIsVisibleOptions) (bool, error)
 
+	// Returns the isolated world with the given name in the main frame of the page. Scripts evaluated in an isolated world share the DOM
+	// with the page, but page scripts can't see or tamper with their globals, and worlds with different names don't see
+	// each other either. Only supported in Chromium.
+	//
+	//  name: Name of the isolated world.
+	IsolatedWorld(name string) IsolatedWorld
+
 	Keyboard() Keyboard
 
 	// The method returns an element locator that can be used to perform actions on this page / frame. Locator is resolved
@@ -3461,14 +4143,41 @@ type Page interface {
 	// [Learn more about locators]: https://playwright.dev/docs/locators
 	Locator(selector string, options ...PageLocatorOptions) Locator
 
+	// Returns the names of the functions exposed with [Page.ExposeFunction] or [Page.ExposeBinding] that were not removed,
+	// sorted. Functions exposed on the browser context are not included.
+	ListExposedFunctions() []string
+
+	// Returns the routes of the page and of its browser context whose pattern matches “url”, in the order they are tried
+	// for a request of the page to it: the first one which doesn't call [Route.Fallback] handles the request, see
+	// [Page.RouteWithPriority]. The routes of the context have [RouteInfo.Context] set.
+	//
+	//  url: URL of the request, matched as by [Page.Route].
+	ListRoutes(url string) []RouteInfo
+
 	// The page's main frame. Page is guaranteed to have a main frame which persists during navigations.
 	MainFrame() Frame
 
+	// Returns a copy of the metadata of the page, set with [Page.SetMetadata].
+	Metadata() map[string]string
+
 	Mouse() Mouse
 
+	// Returns the name of the page, set with [Page.SetName]. The name and the metadata of the page are included, after
+	// those of its context, in the messages it logs.
+	Name() string
+
+	// Returns a new CDPSession attached to the page, see [BrowserContext.NewCDPSession].
+	//
+	// **NOTE** CDP Sessions are only supported on Chromium-based browsers.
+	NewCDPSession() (CDPSession, error)
+
 	// Returns the opener for popup pages and `null` for others. If the opener has been closed already the returns `null`.
 	Opener() (Page, error)
 
+	// Returns the origin trials of the main frame of the page, with their status and the status of the tokens provided
+	// by the page, such as with the OriginTrialTokens option. Only supported in Chromium.
+	OriginTrials() ([]OriginTrial, error)
+
 	// Pauses script execution. Playwright will stop executing the script and wait for the user to either press 'Resume'
 	// button in the page overlay or to call `playwright.resume()` in the DevTools console.
 	// User can inspect selectors or perform manual steps while paused. Resume will continue running the original script
@@ -3477,7 +4186,7 @@ type Page interface {
 	// [BrowserType.Launch].
 	Pause() error
 
-	// Returns the PDF buffer.
+	// Returns the PDF buffer, or streams it to the “writer” option.
 	// **NOTE** Generating a pdf is currently only supported in Chromium headless.
 	// `page.pdf()` generates a pdf of the page with `print` css media. To generate a pdf with `screen` media, call
 	// [Page.EmulateMedia] before calling `page.pdf()`:
@@ -3514,6 +4223,17 @@ type Page interface {
 	// [locators]: https://playwright.dev/docs/locators
 	Press(selector string, key string, options ...PagePressOptions) error
 
+	// Lays the page out for printing, with the `print` media type and in the width of the pages, and returns how it is
+	// paginated, to test the page breaks of print style sheets beyond the PDF of [Page.PDF]. The paper size, margins
+	// and scale are the same as [Page.PDF], and the elements matching the selectors are reported with the pages they
+	// are on. The media type and the viewport are restored afterwards, also when the layout fails, and an error
+	// restoring them is returned.
+	// **NOTE** The pagination is a heuristic, computed from the layout of the page with the forced breaks and the breaks
+	// avoided inside elements of its style sheets, not read from the output of [Page.PDF]: CSS `@page` rules are not
+	// supported, and lines of text and images may be reported split across pages where the browser would move them to
+	// the next page.
+	PrintLayout(options ...PagePrintLayoutOptions) (*PrintLayout, error)
+
 	// The method finds an element matching the specified selector within the page. If no elements match the selector, the
 	// return value resolves to `null`. To wait for an element on the page, use [Locator.WaitFor].
 	//
@@ -3567,7 +4287,32 @@ type Page interface {
 	//    like click.
 	//
 	// [actionability check]: https://playwright.dev/docs/actionability
-	AddLocatorHandler(locator Locator, handler func()) error
+	AddLocatorHandler(locator Locator, handler func(), options ...PageAddLocatorHandlerOptions) error
+
+	// Removes all locator handlers added by [Page.AddLocatorHandler] for a specific locator. Actions that are already
+	// waiting for the handler to finish are not affected.
+	// **NOTE** The bundled driver has no call to unregister a handler, so the handler stays registered with the driver:
+	// when its locator is visible before an action, the action still waits for the client, which lets it continue
+	// without running the handler. The same applies to the handlers that ran the number of times of the “times”
+	// option.
+	//
+	//  locator: Locator passed to [Page.AddLocatorHandler].
+	RemoveLocatorHandler(locator Locator) error
+
+	// Removes the init scripts added with [Page.AddInitScript] that have the same source and isolated world as “script”.
+	// Documents that are already loaded are not affected, the script is not evaluated in the documents created
+	// afterwards.
+	//
+	//  script: Script to remove, matched by its content or the content of the file at its path.
+	RemoveInitScript(script Script) error
+
+	// Records the requests of the page into a [HAR] file at path, unlike the RecordHarPath option which records those of
+	// all the pages of the context. Make sure to call [BrowserContext.Close] for the HAR to be saved.
+	//
+	//  path: Path of the HAR file, a zip archive if it ends with `.zip`.
+	//
+	// [HAR]: http://www.softwareishard.com/blog/har-12-spec
+	RecordHar(path string, options ...PageRecordHarOptions) error
 
 	// This method reloads the current page, in the same way as if the user had triggered a browser refresh. Returns the
 	// main resource response. In case of multiple redirects, the navigation will resolve with the response of the last
@@ -3578,6 +4323,17 @@ type Page interface {
 	// the page's context. See [BrowserContext.Request] for more details.
 	Request() APIRequestContext
 
+	// Returns the routes registered with [Page.Route] and [Page.RouteFromHAR] in the order they are matched against
+	// requests, with the number of requests each of them handled. Routes registered on the browser context are not
+	// included.
+	Routes() []RouteInfo
+
+	// Removes a header set with [Page.SetExtraHTTPHeaders]. The other extra HTTP headers are kept. Does nothing if the
+	// header is not set.
+	//
+	//  name: Name of the header, case-insensitive.
+	RemoveExtraHTTPHeader(name string) error
+
 	// Routing provides the capability to modify network requests that are made by a page.
 	// Once routing is enabled, every request matching the url pattern will stall unless it's continued, fulfilled or
 	// aborted.
@@ -3607,9 +4363,52 @@ type Page interface {
 	// [this]: https://github.com/microsoft/playwright/issues/1090
 	RouteFromHAR(har string, options ...PageRouteFromHAROptions) error
 
+	// This method allows to modify websocket connections that are made by the page.
+	// Note that only `WebSocket`s created after this method was called will be routed. It is recommended to call this
+	// method before navigating the page.
+	// Connections matching “url” are passed to “handler” as a [WebSocketRoute]. Unless the handler calls
+	// [WebSocketRoute.ConnectToServer], the connection is mocked and never reaches the server. When routes from
+	// both the page and its browser context match, page routes take precedence.
+	//
+	// 1. url: Only WebSockets with the url matching this pattern will be routed. A string pattern can be relative to the
+	//    “Browser.newContext.baseURL” context option.
+	// 2. handler: Handler function to route the WebSocket.
+	RouteWebSocket(url interface{}, handler func(WebSocketRoute)) error
+
+	// Routes network requests like [Page.Route], with a priority. The routes of the page and of its browser context are
+	// tried by decreasing priority, the latest first for the same priority, and the routes of the page before the routes
+	// of the context. [Page.Route] registers routes with the priority 0, so that a route of the page with a negative
+	// priority runs after the routes of the context registered with [BrowserContext.Route].
+	//
+	// 1. url: A glob pattern, regex pattern or predicate receiving [URL] to match while routing.
+	// 2. handler: handler function to route the request.
+	// 3. priority: Priority of the route, can be negative.
+	RouteWithPriority(url interface{}, handler routeHandler, priority int, times ...int) error
+
 	// Returns the buffer with the captured screenshot.
 	Screenshot(options ...PageScreenshotOptions) ([]byte, error)
 
+	// Scrolls the page by a delta, as [Window.ScrollBy], and waits for the scrolling to end. Returns the position the
+	// page is scrolled to.
+	//
+	// 1. deltaX: Distance in pixels to scroll horizontally.
+	// 2. deltaY: Distance in pixels to scroll vertically.
+	//
+	// [Window.ScrollBy]: https://developer.mozilla.org/en-US/docs/Web/API/Window/scrollBy
+	ScrollBy(deltaX float64, deltaY float64, options ...PageScrollByOptions) (*Position, error)
+
+	// Returns the scroll position of the page, its `scrollX` and `scrollY`.
+	ScrollPosition() (*Position, error)
+
+	// Scrolls the page to a position, as [Window.ScrollTo], and waits for the scrolling to end. Returns the position
+	// the page is scrolled to, which is clamped to the scrollable area.
+	//
+	// 1. x: Horizontal position in pixels.
+	// 2. y: Vertical position in pixels.
+	//
+	// [Window.ScrollTo]: https://developer.mozilla.org/en-US/docs/Web/API/Window/scrollTo
+	ScrollTo(x float64, y float64, options ...PageScrollToOptions) (*Position, error)
+
 	// This method waits for an element matching “selector”, waits for [actionability] checks, waits
 	// until all specified options are present in the `<select>` element and selects these options.
 	// If the target element is not a `<select>` element, this method throws an error. However, if the element is inside
@@ -3703,6 +4502,12 @@ type Page interface {
 	// [locators]: https://playwright.dev/docs/locators
 	SetInputFiles(selector string, files interface{}, options ...PageSetInputFilesOptions) error
 
+	// Sets the metadata entry key of the page, an empty value removes it.
+	SetMetadata(key string, value string)
+
+	// Sets the name of the page, see [Page.Name].
+	SetName(name string)
+
 	// In the case of multiple pages in a single browser, each page can have its own viewport size. However,
 	// [Browser.NewContext] allows to set viewport size (and more) for all pages in the context at once.
 	// [Page.SetViewportSize] will resize the page. A lot of websites don't expect phones to change size, so you should
@@ -3710,6 +4515,17 @@ type Page interface {
 	// [Browser.NewContext] with `screen` and `viewport` parameters if you need better control of these properties.
 	SetViewportSize(width int, height int) error
 
+	// Returns the [Speech] stubs of the page.
+	Speech() Speech
+
+	// Extracts the [schema.org] structured data of the main frame, from its JSON-LD scripts and its microdata, and
+	// validates it. Invalid JSON, items without type or outside of schema.org, and top level items missing the
+	// properties search engines require for rich results are reported in [StructuredData.Errors] rather than
+	// returned as an error.
+	//
+	// [schema.org]: https://schema.org/
+	StructuredData() (*StructuredData, error)
+
 	// This method taps an element matching “selector” by performing the following steps:
 	//  1. Find an element matching “selector”. If there is none, wait until a matching element is attached to the DOM.
 	//  2. Wait for [actionability] checks on the matched element, unless “force” option is set. If
@@ -3778,6 +4594,18 @@ type Page interface {
 	// [locators]: https://playwright.dev/docs/locators
 	Uncheck(selector string, options ...PageUncheckOptions) error
 
+	// Removes a function added with [Page.ExposeFunction] or [Page.ExposeBinding]. Calls from the page are no longer
+	// dispatched and the function is removed from the frames of the page, including the documents created later. The
+	// function can be exposed again with the same name.
+	//
+	//  name: Name of the function.
+	UnexposeFunction(name string) error
+
+	// Unregisters the service workers of the origin of the page, with `ServiceWorkerRegistration.unregister()`. Their
+	// workers keep running until the pages they control are closed or navigate away. Does nothing if service workers
+	// are not available in the page, e.g. in an insecure context.
+	UnregisterServiceWorkers() error
+
 	// Removes all routes created with [Page.Route] and [Page.RouteFromHAR].
 	UnrouteAll(options ...PageUnrouteAllOptions) error
 
@@ -3789,11 +4617,28 @@ type Page interface {
 
 	URL() string
 
+	// Checks for updates of the service workers of the origin of the page, with `ServiceWorkerRegistration.update()`.
+	// An updated worker is reported by [BrowserContext.OnServiceWorker] when it is installed. Does nothing if service
+	// workers are not available in the page, e.g. in an insecure context.
+	UpdateServiceWorkers() error
+
 	// Video object associated with this page.
 	Video() Video
 
 	ViewportSize() *Size
 
+	// Returns whether the document of the main frame was prerendered before the page navigated to it, with
+	// `PerformanceNavigationTiming.activationStart`.
+	WasPrerendered() (bool, error)
+
+	// Performs action, such as [Page.GoBack], and waits for the main frame to navigate. Returns whether the page was
+	// restored from the back/forward cache and, if not, the reasons reported by the browser, see [BFCacheReport.Err]
+	// to assert that it was. Only supported in Chromium.
+	// **NOTE** Playwright disables the back/forward cache in Chromium by default, so that every navigation can be
+	// intercepted. Launch the browser with `--disable-back-forward-cache` in the IgnoreDefaultArgs option to enable
+	// it.
+	ExpectBFCacheNavigation(cb func() error, options ...PageExpectBFCacheNavigationOptions) (*BFCacheReport, error)
+
 	// Performs action and waits for a [ConsoleMessage] to be logged by in the page. If predicate is provided, it passes
 	// [ConsoleMessage] value into the `predicate` function and waits for `predicate(message)` to return a truthy value.
 	// Will throw an error if the page is closed before the [Page.OnConsole] event is fired.
@@ -3842,6 +4687,11 @@ type Page interface {
 	// closed before the popup event is fired.
 	ExpectPopup(cb func() error, options ...PageExpectPopupOptions) (Page, error)
 
+	// Performs action and waits for a page prerendered by the page to be activated, and returns the attempt. The pages being
+	// prerendered are hidden and not reported by [BrowserContext.Pages], so the activation is reported with the status
+	// of the prerendering, see [Page.OnPrerender]. Only supported in Chromium.
+	ExpectPrerenderActivation(cb func() error, options ...PageExpectPrerenderActivationOptions) (*PrerenderAttempt, error)
+
 	// Waits for the matching request and returns it. See [waiting for event] for more
 	// details about events.
 	//
@@ -3867,6 +4717,11 @@ type Page interface {
 	// [waiting for event]: https://playwright.dev/docs/events#waiting-for-event
 	ExpectResponse(urlOrPredicate interface{}, cb func() error, options ...PageExpectResponseOptions) (Response, error)
 
+	// Waits for the scrolling of the page to end, e.g. after a smooth scroll or a [Mouse.Wheel]. Scrolling ends when the
+	// `scrollend` event is fired, or in browsers without it, when the position stays the same for “settle”
+	// milliseconds. Returns the position once the scrolling ended.
+	WaitForScrollEnd(options ...PageWaitForScrollEndOptions) (*Position, error)
+
 	// Returns when element specified by selector satisfies “state” option. Returns `null` if waiting for `hidden` or
 	// `detached`.
 	// **NOTE** Playwright automatically waits for element to be ready before performing an action. Using [Locator]
@@ -3931,6 +4786,12 @@ type PageAssertions interface {
 	// contain `"error"`:
 	Not() PageAssertions
 
+	// Ensures the page's screenshot matches the snapshot stored under “name”. Screenshots are retried until one matches
+	// or the timeout is reached. Missing snapshots are written, see [SnapshotUpdateEnv] for how to update them.
+	//
+	//  name: Snapshot file name inside the per-platform snapshot directory. `.png` is appended if it has no extension.
+	ToHaveScreenshot(name string, options ...PageAssertionsToHaveScreenshotOptions) error
+
 	// Ensures the page has the given title.
 	//
 	//  titleOrRegExp: Expected title or RegExp.
@@ -3964,6 +4825,11 @@ type PlaywrightAssertions interface {
 	//
 	//  page: [Page] object to use for assertions.
 	Page(page Page) PageAssertions
+
+	// Creates a [SnapshotAssertions] object for the given value, e.g. a screenshot.
+	//
+	//  actual: Value to use for assertions.
+	Snapshot(actual []byte) SnapshotAssertions
 }
 
 // Whenever the page sends a request for a network resource the following sequence of events are emitted by [Page]:
@@ -4028,6 +4894,13 @@ type Request interface {
 	// Otherwise it will be parsed as JSON.
 	PostDataJSON(v interface{}) error
 
+	// Returns the parts of request's `multipart/form-data` post body, if any, with their content unaltered. Fails if the
+	// body is not multipart.
+	PostDataParts() ([]PostDataPart, error)
+
+	// Request's post body as a reader over its binary form, or nil if there is none.
+	PostDataReader() (io.Reader, error)
+
 	// Request that was redirected by the server to this one, if any.
 	// When the server responds with a redirect, Playwright creates a new [Request] object. The two requests are connected
 	// by `redirectedFrom()` and `redirectedTo()` methods. When multiple server redirects has happened, it is possible to
@@ -4045,6 +4918,11 @@ type Request interface {
 	// Returns the matching [Response] object, or `null` if the response was not received due to error.
 	Response() (Response, error)
 
+	// The Service [Worker] that is performing the request.
+	// **NOTE** This field is Chromium only. It's safe to call when using other browsers, but it will always be `null`.
+	// Requests originated in a Service Worker do not have a [Request.Frame] available.
+	ServiceWorker() Worker
+
 	// Returns resource size information for given request.
 	Sizes() (*RequestSizesResult, error)
 
@@ -4079,6 +4957,14 @@ type Response interface {
 	// [FetchEvent.RespondWith]: https://developer.mozilla.org/en-US/docs/Web/API/FetchEvent/respondWith)
 	FromServiceWorker() bool
 
+	// Returns whether the response was served from the HTTP cache of the browser, without going to the network. It
+	// reads the [Resource Timing] of the response in its frame, so it must be called before the frame navigates away
+	// and fails for cross-origin responses without a `Timing-Allow-Origin` header, and for responses without a frame
+	// such as those of service workers.
+	//
+	// [Resource Timing]: https://developer.mozilla.org/en-US/docs/Web/API/PerformanceResourceTiming
+	FromCache() (bool, error)
+
 	// An object with the response HTTP headers. The header names are lower-cased. Note that this method does not return
 	// security-related headers, including cookie-related ones. You can use [Response.AllHeaders] for complete list of
 	// headers that include `cookie` information.
@@ -4147,6 +5033,17 @@ type Route interface {
 	// through redirects, use the combination of [Route.Fetch] and [Route.Fulfill] instead.
 	Continue(options ...RouteContinueOptions) error
 
+	// Performs the request with [Route.Fetch] and calls “handler” with the response, then fulfills the request with it.
+	// The driver downloads the whole response before the handler is called, but keeps the body unless the handler
+	// reads it or replaces it. The handler decides what happens to the request:
+	//  - return `nil` to pass the response through unchanged;
+	//  - return [RouteFulfillOptions] to override parts of it, e.g. “headers” to modify only the headers while the
+	//   body is passed through;
+	//  - return [ErrAbortResponse] to abort the request, e.g. to reject a response too large to be handled by the page.
+	// If the handler returns another error, the request is aborted and the error is returned. The response is disposed
+	// in both cases.
+	ContinueWithResponse(handler func(response APIResponse) (*RouteFulfillOptions, error), options ...RouteFetchOptions) error
+
 	// When several routes match the given pattern, they run in the order opposite to their registration. That way the
 	// last registered route can always override all the previous ones. In the example below, request will be handled by
 	// the bottom-most handler first, then it'll fall back to the previous one and in the end will be aborted by the first
@@ -4169,6 +5066,15 @@ type Route interface {
 	Request() Request
 }
 
+// The [SnapshotAssertions] class provides assertion methods that compare a value with a snapshot stored on disk.
+type SnapshotAssertions interface {
+	// Ensures the value matches the snapshot stored under “name”. PNG images are compared pixel by pixel, other values
+	// must be equal. Missing snapshots are written, see [SnapshotUpdateEnv] for how to update them.
+	//
+	//  name: Snapshot file name inside the per-platform snapshot directory.
+	ToMatchSnapshot(name string, options ...SnapshotAssertionsToMatchSnapshotOptions) error
+}
+
 // Selectors can be used to install custom selector engines. See [extensibility] for more
 // information.
 //
@@ -4187,6 +5093,36 @@ type Selectors interface {
 	SetTestIdAttribute(attributeName string)
 }
 
+// Speech stubs the Web Speech API of a page, see [Page.Speech]. Once installed, `speechSynthesis` reports the
+// utterances the page speaks instead of speaking them, and `SpeechRecognition` delivers the results passed to
+// [Speech.Recognize] instead of listening to a microphone, so that voice-enabled pages can be tested.
+type Speech interface {
+	// Fails the speech recognitions that are listening in the page with an `error` event, then ends them.
+	//
+	//  errorCode: Error of the event, such as `no-speech`, `network` or `not-allowed`.
+	FailRecognition(errorCode string) error
+
+	// Replaces `speechSynthesis`, `SpeechSynthesisUtterance`, `SpeechRecognition` and `webkitSpeechRecognition` in the
+	// frames of the page and in the documents it navigates to. Utterances are spoken instantly: their `start` and `end`
+	// events are dispatched right away.
+	Install(options ...SpeechInstallOptions) error
+
+	// Emitted when a speech recognition is started in the page.
+	OnRecognitionStart(fn func(SpeechRecognitionStart))
+
+	// Emitted when the page speaks an utterance.
+	OnSpeak(fn func(SpeechUtterance))
+
+	// Delivers a result to the speech recognitions that are listening in the page. A final result ends the
+	// recognitions that are not continuous. Fails if no recognition is listening.
+	//
+	//  transcript: Transcript of the result.
+	Recognize(transcript string, options ...SpeechRecognizeOptions) error
+
+	// Returns the utterances spoken by the page since the stubs were installed.
+	Utterances() []SpeechUtterance
+}
+
 // The Touchscreen class operates in main-frame CSS pixels relative to the top-left corner of the viewport. Methods on
 // the touchscreen can only be used in browser contexts that have been initialized with `hasTouch` set to true.
 type Touchscreen interface {
@@ -4246,6 +5182,13 @@ type WebSocket interface {
 	// Fired when the websocket closes.
 	OnClose(fn func(WebSocket))
 
+	// Fired when the close status of the websocket is reported by the page, see [WebSocket.CloseStatus].
+	OnCloseStatus(fn func(WebSocketCloseStatus))
+
+	// Fired when the websocket sends or receives a frame, with its direction and whether the payload is text or
+	// binary.
+	OnFrame(fn func(WebSocketFrame))
+
 	// Fired when the websocket receives a frame.
 	OnFrameReceived(fn func([]byte))
 
@@ -4255,6 +5198,11 @@ type WebSocket interface {
 	// Fired when the websocket has an error.
 	OnSocketError(fn func(string))
 
+	// Returns the close code and reason of the web socket, nil until the page reports them. Only the WebSockets created
+	// by the documents of the page after [BrowserContext.EnableWebSocketCloseStatus] was called are reported, the ones
+	// of the workers are not.
+	CloseStatus() *WebSocketCloseStatus
+
 	// Indicates that the web socket has been closed.
 	IsClosed() bool
 
@@ -4276,6 +5224,60 @@ type WebSocket interface {
 	WaitForEvent(event string, options ...WebSocketWaitForEventOptions) (interface{}, error)
 }
 
+// Whenever a [WebSocket] route is set up with [Page.RouteWebSocket] or [BrowserContext.RouteWebSocket], the
+// `WebSocketRoute` object allows to handle the WebSocket, like an actual server would do.
+// By default, the routed WebSocket will not connect to the server. This way, you can mock entire communication over
+// the WebSocket. Call [WebSocketRoute.ConnectToServer] to connect to the actual server and intercept or modify
+// frames in both directions.
+type WebSocketRoute interface {
+	// Closes one side of the WebSocket connection.
+	Close(options ...WebSocketRouteCloseOptions) error
+
+	// By default, routed WebSocket does not connect to the server, so you can mock entire WebSocket communication. This
+	// method connects to the actual WebSocket server, and returns the server-side [WebSocketRoute] instance, giving the
+	// ability to send and receive messages from the server.
+	// Once connected to the server:
+	//  - Messages received from the server will be **automatically forwarded** to the WebSocket in the page, unless
+	//   [WebSocketRoute.OnMessage] is called on the server-side `WebSocketRoute`.
+	//  - Messages sent by the [`WebSocket.send()`] call in the page will be **automatically forwarded** to the server,
+	//   unless [WebSocketRoute.OnMessage] is called on the original `WebSocketRoute`.
+	//
+	// [`WebSocket.send()`]: https://developer.mozilla.org/en-US/docs/Web/API/WebSocket/send
+	ConnectToServer() (WebSocketRoute, error)
+
+	// Allows to handle [`WebSocket.close`].
+	// By default, closing one side of the connection, either in the page or on the server, will close the other side.
+	// However, when [WebSocketRoute.OnClose] handler is set up, the default forwarding of closure is disabled, and
+	// handler should take care of it.
+	//
+	//  handler: Function that will handle WebSocket closure. Received an optional
+	//    [close code](https://developer.mozilla.org/en-US/docs/Web/API/WebSocket/close#code) and an optional
+	//    [close reason](https://developer.mozilla.org/en-US/docs/Web/API/WebSocket/close#reason).
+	//
+	// [`WebSocket.close`]: https://developer.mozilla.org/en-US/docs/Web/API/WebSocket/close
+	OnClose(handler func(*int, *string))
+
+	// This method allows to handle messages that are sent by the WebSocket, either from the page or from the server.
+	// When called on the original WebSocket route, this method handles messages sent from the page. You can handle this
+	// messages by responding to them with [WebSocketRoute.Send], forwarding them to the server-side connection returned
+	// by [WebSocketRoute.ConnectToServer] or do something else.
+	// Once this method is called, messages are not automatically forwarded to the server or to the page - you should do
+	// that manually by calling [WebSocketRoute.Send].
+	// Calling this method again will override the handler with a new one.
+	//
+	//  handler: Function that will handle messages. Messages are either a `string` or a `[]byte`.
+	OnMessage(handler func(interface{}))
+
+	// Sends a message to the WebSocket. When called on the original WebSocket, sends the message to the page. When called
+	// on the result of [WebSocketRoute.ConnectToServer], sends the message to the server.
+	//
+	//  message: Message to send, either a `string` or a `[]byte`.
+	Send(message interface{}) error
+
+	// URL of the WebSocket created in the page.
+	URL() string
+}
+
 // The Worker class represents a [WebWorker].
 // `worker` event is emitted on the page object to signal a worker creation. `close` event is emitted on the worker
 // object when the worker is gone.
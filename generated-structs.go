@@ -1,5 +1,7 @@
 package playwright
 
+import "io"
+
 type APIRequestNewContextOptions struct {
 	// Methods like [APIRequestContext.Get] take the base URL into consideration by using the
 	// [`URL()`] constructor for building the corresponding URL.
@@ -23,6 +25,8 @@ type APIRequestNewContextOptions struct {
 	IgnoreHttpsErrors *bool `json:"ignoreHTTPSErrors"`
 	// Network proxy settings.
 	Proxy *Proxy `json:"proxy"`
+	// Retries the requests made with the context when they fail or get a retryable status code.
+	RetryPolicy *APIRequestRetryPolicy `json:"retryPolicy"`
 	// Populates context with given storage state. This option can be used to initialize context with logged-in
 	// information obtained via [BrowserContext.StorageState] or [APIRequestContext.StorageState]. Either a path to the
 	// file with saved storage, or the value returned by one of [BrowserContext.StorageState] or
@@ -57,16 +61,20 @@ type APIRequestContextDeleteOptions struct {
 	// Maximum number of request redirects that will be followed automatically. An error will be thrown if the number is
 	// exceeded. Defaults to `20`. Pass `0` to not follow redirects.
 	MaxRedirects *int `json:"maxRedirects"`
+	// Maximum number of times network errors should be retried. Currently only `ECONNRESET` error is retried. Does not
+	// retry based on HTTP response codes. An error will be thrown if the limit is exceeded. Defaults to `0` - no
+	// retries. Cannot be combined with `RetryPolicy`.
+	MaxRetries *int `json:"maxRetries"`
 	// Provides an object that will be serialized as html form using `multipart/form-data` encoding and sent as this
 	// request body. If this parameter is specified `content-type` header will be set to `multipart/form-data` unless
-	// explicitly provided. File values can be passed either as
-	// [`fs.ReadStream`] or as file-like object containing file
-	// name, mime-type and its content.
-	//
-	// [`fs.ReadStream`]: https://nodejs.org/api/fs.html#fs_class_fs_readstream
+	// explicitly provided. File values can be passed either as [InputFile] holding the file name, mime-type and its
+	// content, as [MultipartPart] streaming the content from a reader with a custom content type, or as [io.Reader].
+	// Other values are sent as text fields.
 	Multipart interface{} `json:"multipart"`
 	// Query parameters to be sent with the URL.
 	Params map[string]interface{} `json:"params"`
+	// Retries the request when it fails or gets a retryable status code. Overrides the policy of the request context.
+	RetryPolicy *APIRequestRetryPolicy `json:"retryPolicy"`
 	// Request timeout in milliseconds. Defaults to `30000` (30 seconds). Pass `0` to disable timeout.
 	Timeout *float64 `json:"timeout"`
 }
@@ -90,6 +98,10 @@ type APIRequestContextFetchOptions struct {
 	// Maximum number of request redirects that will be followed automatically. An error will be thrown if the number is
 	// exceeded. Defaults to `20`. Pass `0` to not follow redirects.
 	MaxRedirects *int `json:"maxRedirects"`
+	// Maximum number of times network errors should be retried. Currently only `ECONNRESET` error is retried. Does not
+	// retry based on HTTP response codes. An error will be thrown if the limit is exceeded. Defaults to `0` - no
+	// retries. Cannot be combined with `RetryPolicy`.
+	MaxRetries *int `json:"maxRetries"`
 	// If set changes the fetch method (e.g. [PUT] or
 	// [POST]. If not specified, GET method is used.
 	//
@@ -98,14 +110,14 @@ type APIRequestContextFetchOptions struct {
 	Method *string `json:"method"`
 	// Provides an object that will be serialized as html form using `multipart/form-data` encoding and sent as this
 	// request body. If this parameter is specified `content-type` header will be set to `multipart/form-data` unless
-	// explicitly provided. File values can be passed either as
-	// [`fs.ReadStream`] or as file-like object containing file
-	// name, mime-type and its content.
-	//
-	// [`fs.ReadStream`]: https://nodejs.org/api/fs.html#fs_class_fs_readstream
+	// explicitly provided. File values can be passed either as [InputFile] holding the file name, mime-type and its
+	// content, as [MultipartPart] streaming the content from a reader with a custom content type, or as [io.Reader].
+	// Other values are sent as text fields.
 	Multipart interface{} `json:"multipart"`
 	// Query parameters to be sent with the URL.
 	Params map[string]interface{} `json:"params"`
+	// Retries the request when it fails or gets a retryable status code. Overrides the policy of the request context.
+	RetryPolicy *APIRequestRetryPolicy `json:"retryPolicy"`
 	// Request timeout in milliseconds. Defaults to `30000` (30 seconds). Pass `0` to disable timeout.
 	Timeout *float64 `json:"timeout"`
 }
@@ -129,16 +141,20 @@ type APIRequestContextGetOptions struct {
 	// Maximum number of request redirects that will be followed automatically. An error will be thrown if the number is
 	// exceeded. Defaults to `20`. Pass `0` to not follow redirects.
 	MaxRedirects *int `json:"maxRedirects"`
+	// Maximum number of times network errors should be retried. Currently only `ECONNRESET` error is retried. Does not
+	// retry based on HTTP response codes. An error will be thrown if the limit is exceeded. Defaults to `0` - no
+	// retries. Cannot be combined with `RetryPolicy`.
+	MaxRetries *int `json:"maxRetries"`
 	// Provides an object that will be serialized as html form using `multipart/form-data` encoding and sent as this
 	// request body. If this parameter is specified `content-type` header will be set to `multipart/form-data` unless
-	// explicitly provided. File values can be passed either as
-	// [`fs.ReadStream`] or as file-like object containing file
-	// name, mime-type and its content.
-	//
-	// [`fs.ReadStream`]: https://nodejs.org/api/fs.html#fs_class_fs_readstream
+	// explicitly provided. File values can be passed either as [InputFile] holding the file name, mime-type and its
+	// content, as [MultipartPart] streaming the content from a reader with a custom content type, or as [io.Reader].
+	// Other values are sent as text fields.
 	Multipart interface{} `json:"multipart"`
 	// Query parameters to be sent with the URL.
 	Params map[string]interface{} `json:"params"`
+	// Retries the request when it fails or gets a retryable status code. Overrides the policy of the request context.
+	RetryPolicy *APIRequestRetryPolicy `json:"retryPolicy"`
 	// Request timeout in milliseconds. Defaults to `30000` (30 seconds). Pass `0` to disable timeout.
 	Timeout *float64 `json:"timeout"`
 }
@@ -162,16 +178,20 @@ type APIRequestContextHeadOptions struct {
 	// Maximum number of request redirects that will be followed automatically. An error will be thrown if the number is
 	// exceeded. Defaults to `20`. Pass `0` to not follow redirects.
 	MaxRedirects *int `json:"maxRedirects"`
+	// Maximum number of times network errors should be retried. Currently only `ECONNRESET` error is retried. Does not
+	// retry based on HTTP response codes. An error will be thrown if the limit is exceeded. Defaults to `0` - no
+	// retries. Cannot be combined with `RetryPolicy`.
+	MaxRetries *int `json:"maxRetries"`
 	// Provides an object that will be serialized as html form using `multipart/form-data` encoding and sent as this
 	// request body. If this parameter is specified `content-type` header will be set to `multipart/form-data` unless
-	// explicitly provided. File values can be passed either as
-	// [`fs.ReadStream`] or as file-like object containing file
-	// name, mime-type and its content.
-	//
-	// [`fs.ReadStream`]: https://nodejs.org/api/fs.html#fs_class_fs_readstream
+	// explicitly provided. File values can be passed either as [InputFile] holding the file name, mime-type and its
+	// content, as [MultipartPart] streaming the content from a reader with a custom content type, or as [io.Reader].
+	// Other values are sent as text fields.
 	Multipart interface{} `json:"multipart"`
 	// Query parameters to be sent with the URL.
 	Params map[string]interface{} `json:"params"`
+	// Retries the request when it fails or gets a retryable status code. Overrides the policy of the request context.
+	RetryPolicy *APIRequestRetryPolicy `json:"retryPolicy"`
 	// Request timeout in milliseconds. Defaults to `30000` (30 seconds). Pass `0` to disable timeout.
 	Timeout *float64 `json:"timeout"`
 }
@@ -195,16 +215,20 @@ type APIRequestContextPatchOptions struct {
 	// Maximum number of request redirects that will be followed automatically. An error will be thrown if the number is
 	// exceeded. Defaults to `20`. Pass `0` to not follow redirects.
 	MaxRedirects *int `json:"maxRedirects"`
+	// Maximum number of times network errors should be retried. Currently only `ECONNRESET` error is retried. Does not
+	// retry based on HTTP response codes. An error will be thrown if the limit is exceeded. Defaults to `0` - no
+	// retries. Cannot be combined with `RetryPolicy`.
+	MaxRetries *int `json:"maxRetries"`
 	// Provides an object that will be serialized as html form using `multipart/form-data` encoding and sent as this
 	// request body. If this parameter is specified `content-type` header will be set to `multipart/form-data` unless
-	// explicitly provided. File values can be passed either as
-	// [`fs.ReadStream`] or as file-like object containing file
-	// name, mime-type and its content.
-	//
-	// [`fs.ReadStream`]: https://nodejs.org/api/fs.html#fs_class_fs_readstream
+	// explicitly provided. File values can be passed either as [InputFile] holding the file name, mime-type and its
+	// content, as [MultipartPart] streaming the content from a reader with a custom content type, or as [io.Reader].
+	// Other values are sent as text fields.
 	Multipart interface{} `json:"multipart"`
 	// Query parameters to be sent with the URL.
 	Params map[string]interface{} `json:"params"`
+	// Retries the request when it fails or gets a retryable status code. Overrides the policy of the request context.
+	RetryPolicy *APIRequestRetryPolicy `json:"retryPolicy"`
 	// Request timeout in milliseconds. Defaults to `30000` (30 seconds). Pass `0` to disable timeout.
 	Timeout *float64 `json:"timeout"`
 }
@@ -228,16 +252,20 @@ type APIRequestContextPostOptions struct {
 	// Maximum number of request redirects that will be followed automatically. An error will be thrown if the number is
 	// exceeded. Defaults to `20`. Pass `0` to not follow redirects.
 	MaxRedirects *int `json:"maxRedirects"`
+	// Maximum number of times network errors should be retried. Currently only `ECONNRESET` error is retried. Does not
+	// retry based on HTTP response codes. An error will be thrown if the limit is exceeded. Defaults to `0` - no
+	// retries. Cannot be combined with `RetryPolicy`.
+	MaxRetries *int `json:"maxRetries"`
 	// Provides an object that will be serialized as html form using `multipart/form-data` encoding and sent as this
 	// request body. If this parameter is specified `content-type` header will be set to `multipart/form-data` unless
-	// explicitly provided. File values can be passed either as
-	// [`fs.ReadStream`] or as file-like object containing file
-	// name, mime-type and its content.
-	//
-	// [`fs.ReadStream`]: https://nodejs.org/api/fs.html#fs_class_fs_readstream
+	// explicitly provided. File values can be passed either as [InputFile] holding the file name, mime-type and its
+	// content, as [MultipartPart] streaming the content from a reader with a custom content type, or as [io.Reader].
+	// Other values are sent as text fields.
 	Multipart interface{} `json:"multipart"`
 	// Query parameters to be sent with the URL.
 	Params map[string]interface{} `json:"params"`
+	// Retries the request when it fails or gets a retryable status code. Overrides the policy of the request context.
+	RetryPolicy *APIRequestRetryPolicy `json:"retryPolicy"`
 	// Request timeout in milliseconds. Defaults to `30000` (30 seconds). Pass `0` to disable timeout.
 	Timeout *float64 `json:"timeout"`
 }
@@ -261,16 +289,20 @@ type APIRequestContextPutOptions struct {
 	// Maximum number of request redirects that will be followed automatically. An error will be thrown if the number is
 	// exceeded. Defaults to `20`. Pass `0` to not follow redirects.
 	MaxRedirects *int `json:"maxRedirects"`
+	// Maximum number of times network errors should be retried. Currently only `ECONNRESET` error is retried. Does not
+	// retry based on HTTP response codes. An error will be thrown if the limit is exceeded. Defaults to `0` - no
+	// retries. Cannot be combined with `RetryPolicy`.
+	MaxRetries *int `json:"maxRetries"`
 	// Provides an object that will be serialized as html form using `multipart/form-data` encoding and sent as this
 	// request body. If this parameter is specified `content-type` header will be set to `multipart/form-data` unless
-	// explicitly provided. File values can be passed either as
-	// [`fs.ReadStream`] or as file-like object containing file
-	// name, mime-type and its content.
-	//
-	// [`fs.ReadStream`]: https://nodejs.org/api/fs.html#fs_class_fs_readstream
+	// explicitly provided. File values can be passed either as [InputFile] holding the file name, mime-type and its
+	// content, as [MultipartPart] streaming the content from a reader with a custom content type, or as [io.Reader].
+	// Other values are sent as text fields.
 	Multipart interface{} `json:"multipart"`
 	// Query parameters to be sent with the URL.
 	Params map[string]interface{} `json:"params"`
+	// Retries the request when it fails or gets a retryable status code. Overrides the policy of the request context.
+	RetryPolicy *APIRequestRetryPolicy `json:"retryPolicy"`
 	// Request timeout in milliseconds. Defaults to `30000` (30 seconds). Pass `0` to disable timeout.
 	Timeout *float64 `json:"timeout"`
 }
@@ -284,6 +316,12 @@ type NameValue struct {
 	// Value of the header.
 	Value string `json:"value"`
 }
+type AccessibilitySnapshotOptions struct {
+	// Prune uninteresting nodes from the tree. Defaults to `true`.
+	InterestingOnly *bool `json:"interestingOnly"`
+	// The root DOM element for the snapshot. Defaults to the whole page.
+	Root ElementHandle `json:"root"`
+}
 type BrowserCloseOptions struct {
 	// The reason to be reported to the operations interrupted by the browser closure.
 	Reason *string `json:"reason"`
@@ -291,6 +329,17 @@ type BrowserCloseOptions struct {
 type BrowserNewContextOptions struct {
 	// Whether to automatically download all the attachments. Defaults to `true` where all the downloads are accepted.
 	AcceptDownloads *bool `json:"acceptDownloads"`
+	// Origins that the pages and the [APIRequestContext] of the context may send requests to, such as
+	// `https://example.com` or `https://*.example.com` for its subdomains. The default port of the scheme is implied.
+	// Navigations and requests to other origins are blocked before the route handlers see them, and reported by
+	// [BrowserContext.OnOriginViolation]; the requests of the [APIRequestContext] return [ErrOriginNotAllowed].
+	// Only the requests the driver routes can be blocked: redirects are reported but not blocked, and the requests of
+	// service workers may not be routed, block them with the `serviceWorkers` option. WebSockets and the redirects of
+	// the [APIRequestContext] are not checked. All origins are allowed if unset.
+	AllowedOrigins []string `json:"allowedOrigins"`
+	// Whether to record the actions performed in the context in its audit log, see [BrowserContext.AuditLog].
+	// Defaults to `false`.
+	AuditLog *bool `json:"auditLog"`
 	// When using [Page.Goto], [Page.Route], [Page.WaitForURL], [Page.ExpectRequest], or [Page.ExpectResponse] it takes
 	// the base URL in consideration by using the [`URL()`]
 	// constructor for building the corresponding URL. Unset by default. Examples:
@@ -313,6 +362,13 @@ type BrowserNewContextOptions struct {
 	//
 	// [emulating devices with device scale factor]: https://playwright.dev/docs/emulation#devices
 	DeviceScaleFactor *float64 `json:"deviceScaleFactor"`
+	// Whether to disable the HTTP cache of the context, see [BrowserContext.SetCacheEnabled]. Defaults to `false`.
+	DisableCache *bool `json:"disableCache"`
+	// Puts the context in dry-run mode, which handles the requests that change state, with the POST, PUT, PATCH and
+	// DELETE methods, before they reach the server and before the route handlers see them, see [DryRunMode]. The form
+	// submissions of the pages are stopped before the page handles them, whatever their method. The requests and the
+	// submissions are reported by [BrowserContext.OnDryRun]. Optional.
+	DryRun *DryRunMode `json:"dryRun"`
 	// An object containing additional HTTP headers to be sent with every request. Defaults to none.
 	ExtraHttpHeaders map[string]string `json:"extraHTTPHeaders"`
 	// Emulates `forced-colors` media feature, supported values are `active`, `none`. See [Page.EmulateMedia] for
@@ -348,6 +404,14 @@ type BrowserNewContextOptions struct {
 	//
 	// [emulation guide]: https://playwright.dev/docs/emulation#locale--timezone
 	Locale *string `json:"locale"`
+	// Metadata of the context, e.g. `{"worker": "3"}`, see [BrowserContext.Metadata].
+	Metadata map[string]string `json:"metadata"`
+	// Name of the context, see [BrowserContext.Name].
+	Name *string `json:"name"`
+	// Limits the network usage of the pages of the context, see [NetworkBudget]. The requests exceeding the budget are
+	// blocked before the route handlers see them, and reported by [BrowserContext.OnBudgetExceeded]. The requests of the
+	// [APIRequestContext] are not counted. Optional.
+	NetworkBudget *NetworkBudget `json:"networkBudget"`
 	// Does not enforce fixed viewport, allows resizing window in the headed mode.
 	NoViewport *bool `json:"noViewport"`
 	// Whether to emulate network being offline. Defaults to `false`. Learn more about
@@ -355,6 +419,12 @@ type BrowserNewContextOptions struct {
 	//
 	// [network emulation]: https://playwright.dev/docs/emulation#offline
 	Offline *bool `json:"offline"`
+	// [Origin trial] tokens to add to the documents of the context, as `<meta http-equiv="origin-trial">` tags, to
+	// enable upcoming web platform features for the origins of the tokens. Only supported in Chromium, see
+	// [Page.OriginTrials] to check the status of the trials.
+	//
+	// [Origin trial]: https://developer.chrome.com/docs/web-platform/origin-trials
+	OriginTrialTokens []string `json:"originTrialTokens"`
 	// A list of permissions to grant to all pages in this context. See [BrowserContext.GrantPermissions] for more
 	// details. Defaults to none.
 	Permissions []string `json:"permissions"`
@@ -363,6 +433,12 @@ type BrowserNewContextOptions struct {
 	// all contexts override the proxy, global proxy will be never used and can be any string, for example `launch({
 	// proxy: { server: 'http://per-context' } })`.
 	Proxy *Proxy `json:"proxy"`
+	// Provider of the proxy of the context, used when “proxy” is not set. The context reports the requests failing
+	// because of the proxy to the provider, see [ProxyProvider].
+	ProxyProvider ProxyProvider `json:"proxyProvider"`
+	// Whether to record the bytes sent and received by each page of the context, see [Page.Bandwidth]. Defaults to
+	// `false`.
+	RecordBandwidth *bool `json:"recordBandwidth"`
 	// Optional setting to control resource content management. If `omit` is specified, content is not persisted. If
 	// `attach` is specified, resources are persisted as separate files and all of these files are archived along with the
 	// HAR file. Defaults to `embed`, which stores content inline the HAR file as per HAR specification.
@@ -377,8 +453,12 @@ type BrowserNewContextOptions struct {
 	// to be saved.
 	//
 	// [HAR]: http://www.softwareishard.com/blog/har-12-spec
-	RecordHarPath      *string     `json:"recordHarPath"`
+	RecordHarPath *string `json:"recordHarPath"`
+	// Glob pattern, regular expression or a slice of those to filter the requests stored in the HAR. Requests matching any
+	// of the patterns are recorded.
 	RecordHarURLFilter interface{} `json:"recordHarUrlFilter"`
+	// HTTP methods of the requests to store in the HAR, e.g. `[]string{"GET", "POST"}`. Defaults to all methods.
+	RecordHarMethodFilter []string `json:"recordHarMethodFilter"`
 	// Enables video recording for all pages into `recordVideo.dir` directory. If not specified videos are not recorded.
 	// Make sure to await [BrowserContext.Close] for videos to be saved.
 	RecordVideo *RecordVideo `json:"recordVideo"`
@@ -422,6 +502,17 @@ type BrowserNewContextOptions struct {
 type BrowserNewPageOptions struct {
 	// Whether to automatically download all the attachments. Defaults to `true` where all the downloads are accepted.
 	AcceptDownloads *bool `json:"acceptDownloads"`
+	// Origins that the pages and the [APIRequestContext] of the context may send requests to, such as
+	// `https://example.com` or `https://*.example.com` for its subdomains. The default port of the scheme is implied.
+	// Navigations and requests to other origins are blocked before the route handlers see them, and reported by
+	// [BrowserContext.OnOriginViolation]; the requests of the [APIRequestContext] return [ErrOriginNotAllowed].
+	// Only the requests the driver routes can be blocked: redirects are reported but not blocked, and the requests of
+	// service workers may not be routed, block them with the `serviceWorkers` option. WebSockets and the redirects of
+	// the [APIRequestContext] are not checked. All origins are allowed if unset.
+	AllowedOrigins []string `json:"allowedOrigins"`
+	// Whether to record the actions performed in the context in its audit log, see [BrowserContext.AuditLog].
+	// Defaults to `false`.
+	AuditLog *bool `json:"auditLog"`
 	// When using [Page.Goto], [Page.Route], [Page.WaitForURL], [Page.ExpectRequest], or [Page.ExpectResponse] it takes
 	// the base URL in consideration by using the [`URL()`]
 	// constructor for building the corresponding URL. Unset by default. Examples:
@@ -444,6 +535,13 @@ type BrowserNewPageOptions struct {
 	//
 	// [emulating devices with device scale factor]: https://playwright.dev/docs/emulation#devices
 	DeviceScaleFactor *float64 `json:"deviceScaleFactor"`
+	// Whether to disable the HTTP cache of the context, see [BrowserContext.SetCacheEnabled]. Defaults to `false`.
+	DisableCache *bool `json:"disableCache"`
+	// Puts the context in dry-run mode, which handles the requests that change state, with the POST, PUT, PATCH and
+	// DELETE methods, before they reach the server and before the route handlers see them, see [DryRunMode]. The form
+	// submissions of the pages are stopped before the page handles them, whatever their method. The requests and the
+	// submissions are reported by [BrowserContext.OnDryRun]. Optional.
+	DryRun *DryRunMode `json:"dryRun"`
 	// An object containing additional HTTP headers to be sent with every request. Defaults to none.
 	ExtraHttpHeaders map[string]string `json:"extraHTTPHeaders"`
 	// Emulates `forced-colors` media feature, supported values are `active`, `none`. See [Page.EmulateMedia] for
@@ -479,6 +577,14 @@ type BrowserNewPageOptions struct {
 	//
 	// [emulation guide]: https://playwright.dev/docs/emulation#locale--timezone
 	Locale *string `json:"locale"`
+	// Metadata of the context, e.g. `{"worker": "3"}`, see [BrowserContext.Metadata].
+	Metadata map[string]string `json:"metadata"`
+	// Name of the context, see [BrowserContext.Name].
+	Name *string `json:"name"`
+	// Limits the network usage of the pages of the context, see [NetworkBudget]. The requests exceeding the budget are
+	// blocked before the route handlers see them, and reported by [BrowserContext.OnBudgetExceeded]. The requests of the
+	// [APIRequestContext] are not counted. Optional.
+	NetworkBudget *NetworkBudget `json:"networkBudget"`
 	// Does not enforce fixed viewport, allows resizing window in the headed mode.
 	NoViewport *bool `json:"noViewport"`
 	// Whether to emulate network being offline. Defaults to `false`. Learn more about
@@ -486,6 +592,12 @@ type BrowserNewPageOptions struct {
 	//
 	// [network emulation]: https://playwright.dev/docs/emulation#offline
 	Offline *bool `json:"offline"`
+	// [Origin trial] tokens to add to the documents of the context, as `<meta http-equiv="origin-trial">` tags, to
+	// enable upcoming web platform features for the origins of the tokens. Only supported in Chromium, see
+	// [Page.OriginTrials] to check the status of the trials.
+	//
+	// [Origin trial]: https://developer.chrome.com/docs/web-platform/origin-trials
+	OriginTrialTokens []string `json:"originTrialTokens"`
 	// A list of permissions to grant to all pages in this context. See [BrowserContext.GrantPermissions] for more
 	// details. Defaults to none.
 	Permissions []string `json:"permissions"`
@@ -494,6 +606,12 @@ type BrowserNewPageOptions struct {
 	// all contexts override the proxy, global proxy will be never used and can be any string, for example `launch({
 	// proxy: { server: 'http://per-context' } })`.
 	Proxy *Proxy `json:"proxy"`
+	// Provider of the proxy of the context, used when “proxy” is not set. The context reports the requests failing
+	// because of the proxy to the provider, see [ProxyProvider].
+	ProxyProvider ProxyProvider `json:"proxyProvider"`
+	// Whether to record the bytes sent and received by each page of the context, see [Page.Bandwidth]. Defaults to
+	// `false`.
+	RecordBandwidth *bool `json:"recordBandwidth"`
 	// Optional setting to control resource content management. If `omit` is specified, content is not persisted. If
 	// `attach` is specified, resources are persisted as separate files and all of these files are archived along with the
 	// HAR file. Defaults to `embed`, which stores content inline the HAR file as per HAR specification.
@@ -508,8 +626,12 @@ type BrowserNewPageOptions struct {
 	// to be saved.
 	//
 	// [HAR]: http://www.softwareishard.com/blog/har-12-spec
-	RecordHarPath      *string     `json:"recordHarPath"`
+	RecordHarPath *string `json:"recordHarPath"`
+	// Glob pattern, regular expression or a slice of those to filter the requests stored in the HAR. Requests matching any
+	// of the patterns are recorded.
 	RecordHarURLFilter interface{} `json:"recordHarUrlFilter"`
+	// HTTP methods of the requests to store in the HAR, e.g. `[]string{"GET", "POST"}`. Defaults to all methods.
+	RecordHarMethodFilter []string `json:"recordHarMethodFilter"`
 	// Enables video recording for all pages into `recordVideo.dir` directory. If not specified videos are not recorded.
 	// Make sure to await [BrowserContext.Close] for videos to be saved.
 	RecordVideo *RecordVideo `json:"recordVideo"`
@@ -577,14 +699,35 @@ type OptionalCookie struct {
 	Secure *bool `json:"secure"`
 	// Optional.
 	SameSite *SameSiteAttribute `json:"sameSite"`
+	// Top-level site of the partition of a [partitioned cookie], such as `https://example.com`. Partitioned cookies
+	// must be secure. Only supported in Chromium. Optional.
+	//
+	// [partitioned cookie]: https://developer.mozilla.org/en-US/docs/Web/Privacy/Privacy_sandbox/Partitioned_cookies
+	PartitionKey *string `json:"partitionKey"`
 }
 type Script struct {
 	// Path to the JavaScript file. If `path` is a relative path, then it is resolved relative to the current working
 	// directory. Optional.
 	Path *string `json:"path"`
+	// Name of an isolated world to evaluate the script in instead of the main world. Isolated worlds share the DOM with
+	// the page, but page scripts can't see or tamper with their globals. Only supported in Chromium. Optional.
+	IsolatedWorld *string `json:"isolatedWorld"`
 	// Raw script content. Optional.
 	Content *string `json:"content"`
 }
+type BrowserContextAddPrivacyMaskOptions struct {
+	// Color of the masks with the `fill` style, in CSS color format. Defaults to `#000000`. Optional.
+	Color *string `json:"color"`
+	// Locators of the elements to mask in screenshots and videos. They are re-rooted at the main frame of each page of
+	// the context, and looked up again when its DOM changes. Optional.
+	Locators []Locator `json:"locators"`
+	// Regions to mask in screenshots and videos, in page coordinates of the main frame. Optional.
+	Regions []Rect `json:"regions"`
+	// CSS selectors of the elements to mask in screenshots and videos, in all frames. Optional.
+	Selectors []string `json:"selectors"`
+	// How the selectors, locators and regions are masked. Defaults to `fill`. Optional.
+	Style *PrivacyMaskStyle `json:"style"`
+}
 type BrowserContextClearCookiesOptions struct {
 	// Only removes cookies with the given domain.
 	Domain interface{} `json:"domain"`
@@ -607,6 +750,9 @@ type Cookie struct {
 	HttpOnly bool               `json:"httpOnly"`
 	Secure   bool               `json:"secure"`
 	SameSite *SameSiteAttribute `json:"sameSite"`
+	// Top-level site of the partition of a partitioned cookie, nil if the cookie is not partitioned or its key couldn't
+	// be looked up. Only reported in Chromium.
+	PartitionKey *string `json:"partitionKey,omitempty"`
 }
 type BrowserContextGrantPermissionsOptions struct {
 	// The [origin] to grant permissions to, e.g. "https://example.com".
@@ -623,12 +769,16 @@ type BrowserContextRouteFromHAROptions struct {
 	// Optional setting to control resource content management. If `attach` is specified, resources are persisted as
 	// separate files or entries in the ZIP archive. If `embed` is specified, content is stored inline the HAR file.
 	UpdateContent *RouteFromHarUpdateContentPolicy `json:"updateContent"`
+	// Whether to keep the entries of the given HAR whose requests are not made again while it is updated, instead of
+	// replacing the HAR. Defaults to `false`.
+	UpdateMerge *bool `json:"updateMerge"`
 	// When set to `minimal`, only record information necessary for routing from HAR. This omits sizes, timing, page,
 	// cookies, security and other types of HAR information that are not used when replaying from HAR. Defaults to
 	// `minimal`.
 	UpdateMode *HarMode `json:"updateMode"`
 	// A glob pattern, regular expression or predicate to match the request URL. Only requests with URL matching the
-	// pattern will be served from the HAR file. If not specified, all requests are served from the HAR file.
+	// pattern will be served from the HAR file. If not specified, all requests are served from the HAR file. In update
+	// mode, a glob pattern, regular expression or a slice of those filters the requests recorded.
 	URL interface{} `json:"url"`
 }
 type Geolocation struct {
@@ -676,7 +826,19 @@ type BrowserContextWaitForEventOptions struct {
 	// default value can be changed by using the [BrowserContext.SetDefaultTimeout].
 	Timeout *float64 `json:"timeout"`
 }
+type BrowserContextWaitForServiceWorkerOptions struct {
+	// Receives the [Worker] and resolves to truthy value when the waiting should resolve.
+	Predicate func(Worker) bool `json:"predicate"`
+	// Maximum time to wait for in milliseconds. Defaults to `30000` (30 seconds). Pass `0` to disable timeout. The
+	// default value can be changed by using the [BrowserContext.SetDefaultTimeout].
+	Timeout *float64 `json:"timeout"`
+	// A glob pattern, regex pattern or predicate receiving [URL] to match the URL of the script of the service worker.
+	URL interface{} `json:"url"`
+}
 type BrowserTypeConnectOptions struct {
+	// Adapter resolves the endpoint to connect to when “wsEndpoint” is the address of a browser farm, such as a
+	// Selenium Grid, Moon or Browserless, see [GridAdapter].
+	Adapter GridAdapter `json:"adapter"`
 	// This option exposes network available on the connecting client to the browser being connected to. Consists of a
 	// list of rules separated by comma.
 	// Available rules:
@@ -691,6 +853,21 @@ type BrowserTypeConnectOptions struct {
 	ExposeNetwork *string `json:"exposeNetwork"`
 	// Additional HTTP headers to be sent with web socket connect request. Optional.
 	Headers map[string]string `json:"headers"`
+	// Interval in milliseconds between the checks that the server still responds. A connection that does not respond
+	// within `HeartbeatTimeout` is closed, instead of waiting for the operating system to notice that it is lost.
+	// Defaults to `0` (no heartbeat).
+	HeartbeatInterval *float64 `json:"heartbeatInterval"`
+	// Maximum time in milliseconds to wait for the server to respond to a heartbeat. Defaults to `30000` (30 seconds).
+	HeartbeatTimeout *float64 `json:"heartbeatTimeout"`
+	// Called with the browser of the new connection when a lost connection is re-established, see `ReconnectAttempts`.
+	OnReconnect func(Browser) `json:"onReconnect"`
+	// Number of times to retry connecting when the connection can not be established or is lost without the browser
+	// being closed. Playwright servers do not resume the sessions of lost connections: the browser, contexts and pages
+	// of a lost connection are disconnected, and the browser of the new connection is passed to `OnReconnect`.
+	// Defaults to `0` (no retry).
+	ReconnectAttempts *int `json:"reconnectAttempts"`
+	// Delay in milliseconds before the first retry, doubled after every failed retry. Defaults to `1000` (1 second).
+	ReconnectBackoff *float64 `json:"reconnectBackoff"`
 	// Slows down Playwright operations by the specified amount of milliseconds. Useful so that you can see what is going
 	// on. Defaults to 0.
 	SlowMo *float64 `json:"slowMo"`
@@ -729,10 +906,17 @@ type BrowserTypeLaunchOptions struct {
 	//
 	// [debugging tools]: https://playwright.dev/docs/debug
 	Devtools *bool `json:"devtools"`
+	// Blink runtime features to disable, such as `WebGPU`, with the `--disable-blink-features` switch. Only supported in
+	// Chromium.
+	DisableBlinkFeatures []string `json:"disableBlinkFeatures"`
 	// If specified, accepted downloads are downloaded into this directory. Otherwise, temporary directory is created and
 	// is deleted when browser is closed. In either case, the downloads are deleted when the browser context they were
 	// created in is closed.
 	DownloadsPath *string `json:"downloadsPath"`
+	// Blink runtime features to enable, which are the web platform features behind flags such as `WebGPU` or
+	// `CSSAnchorPositioning`, with the `--enable-blink-features` switch. Only supported in Chromium, see
+	// [BrowserNewContextOptions.OriginTrialTokens] to enable the features in origin trials instead.
+	EnableBlinkFeatures []string `json:"enableBlinkFeatures"`
 	// Specify environment variables that will be visible to the browser. Defaults to `process.env`.
 	Env map[string]string `json:"env"`
 	// Path to a browser executable to run instead of the bundled one. If “executablePath” is a relative path, then it is
@@ -821,16 +1005,28 @@ type BrowserTypeLaunchPersistentContextOptions struct {
 	//
 	// [debugging tools]: https://playwright.dev/docs/debug
 	Devtools *bool `json:"devtools"`
+	// Blink runtime features to disable, such as `WebGPU`, with the `--disable-blink-features` switch. Only supported in
+	// Chromium.
+	DisableBlinkFeatures []string `json:"disableBlinkFeatures"`
 	// If specified, accepted downloads are downloaded into this directory. Otherwise, temporary directory is created and
 	// is deleted when browser is closed. In either case, the downloads are deleted when the browser context they were
 	// created in is closed.
 	DownloadsPath *string `json:"downloadsPath"`
+	// Blink runtime features to enable, which are the web platform features behind flags such as `WebGPU` or
+	// `CSSAnchorPositioning`, with the `--enable-blink-features` switch. Only supported in Chromium, see
+	// [BrowserNewContextOptions.OriginTrialTokens] to enable the features in origin trials instead.
+	EnableBlinkFeatures []string `json:"enableBlinkFeatures"`
 	// Specify environment variables that will be visible to the browser. Defaults to `process.env`.
 	Env map[string]string `json:"env"`
 	// Path to a browser executable to run instead of the bundled one. If “executablePath” is a relative path, then it is
 	// resolved relative to the current working directory. Note that Playwright only works with the bundled Chromium,
 	// Firefox or WebKit, use at your own risk.
 	ExecutablePath *string `json:"executablePath"`
+	// Directories of unpacked Chrome extensions to load, which contain their `manifest.json`. Their background pages
+	// are reported by [BrowserContext.OnBackgroundPage], and the service workers of the Manifest V3 extensions by
+	// [BrowserContext.OnServiceWorker]. Only supported in Chromium, in headed mode or in the new headless mode of the
+	// `chromium` channel.
+	Extensions []string `json:"extensions"`
 	// An object containing additional HTTP headers to be sent with every request. Defaults to none.
 	ExtraHttpHeaders map[string]string `json:"extraHTTPHeaders"`
 	// Firefox user preferences. Learn more about the Firefox user preferences at
@@ -917,8 +1113,12 @@ type BrowserTypeLaunchPersistentContextOptions struct {
 	// to be saved.
 	//
 	// [HAR]: http://www.softwareishard.com/blog/har-12-spec
-	RecordHarPath      *string     `json:"recordHarPath"`
+	RecordHarPath *string `json:"recordHarPath"`
+	// Glob pattern, regular expression or a slice of those to filter the requests stored in the HAR. Requests matching any
+	// of the patterns are recorded.
 	RecordHarURLFilter interface{} `json:"recordHarUrlFilter"`
+	// HTTP methods of the requests to store in the HAR, e.g. `[]string{"GET", "POST"}`. Defaults to all methods.
+	RecordHarMethodFilter []string `json:"recordHarMethodFilter"`
 	// Enables video recording for all pages into `recordVideo.dir` directory. If not specified videos are not recorded.
 	// Make sure to await [BrowserContext.Close] for videos to be saved.
 	RecordVideo *RecordVideo `json:"recordVideo"`
@@ -963,6 +1163,88 @@ type BrowserTypeLaunchPersistentContextOptions struct {
 	// [viewport emulation]: https://playwright.dev/docs/emulation#viewport
 	Viewport *Size `json:"viewport"`
 }
+type BrowserTypeLaunchServerOptions struct {
+	// **NOTE** Use custom browser args at your own risk, as some of them may break Playwright functionality.
+	// Additional arguments to pass to the browser instance. The list of Chromium flags can be found
+	// [here].
+	//
+	// [here]: https://peter.sh/experiments/chromium-command-line-switches/
+	Args []string `json:"args"`
+	// Browser distribution channel.  Supported values are "chrome", "chrome-beta", "chrome-dev", "chrome-canary",
+	// "msedge", "msedge-beta", "msedge-dev", "msedge-canary". Read more about using
+	// [Google Chrome and Microsoft Edge].
+	//
+	// [Google Chrome and Microsoft Edge]: https://playwright.dev/docs/browsers#google-chrome--microsoft-edge
+	Channel *string `json:"channel"`
+	// Enable Chromium sandboxing. Defaults to `false`.
+	ChromiumSandbox *bool `json:"chromiumSandbox"`
+	// **Chromium-only** Whether to auto-open a Developer Tools panel for each tab. If this option is `true`, the
+	// “headless” option will be set `false`.
+	//
+	// Deprecated: Use [debugging tools] instead.
+	//
+	// [debugging tools]: https://playwright.dev/docs/debug
+	Devtools *bool `json:"devtools"`
+	// If specified, accepted downloads are downloaded into this directory. Otherwise, temporary directory is created and
+	// is deleted when browser is closed. In either case, the downloads are deleted when the browser context they were
+	// created in is closed.
+	DownloadsPath *string `json:"downloadsPath"`
+	// Specify environment variables that will be visible to the browser. Defaults to `process.env`.
+	Env map[string]string `json:"env"`
+	// Path to a browser executable to run instead of the bundled one. If “executablePath” is a relative path, then it is
+	// resolved relative to the current working directory. Note that Playwright only works with the bundled Chromium,
+	// Firefox or WebKit, use at your own risk.
+	ExecutablePath *string `json:"executablePath"`
+	// Firefox user preferences. Learn more about the Firefox user preferences at
+	// [`about:config`].
+	//
+	// [`about:config`]: https://support.mozilla.org/en-US/kb/about-config-editor-firefox
+	FirefoxUserPrefs map[string]interface{} `json:"firefoxUserPrefs"`
+	// Close the browser process on SIGHUP. Defaults to `true`.
+	HandleSIGHUP *bool `json:"handleSIGHUP"`
+	// Close the browser process on Ctrl-C. Defaults to `true`.
+	HandleSIGINT *bool `json:"handleSIGINT"`
+	// Close the browser process on SIGTERM. Defaults to `true`.
+	HandleSIGTERM *bool `json:"handleSIGTERM"`
+	// Whether to run browser in headless mode. More details for
+	// [Chromium] and
+	// [Firefox]. Defaults to `true` unless the
+	// “devtools” option is `true`.
+	//
+	// [Chromium]: https://developers.google.com/web/updates/2017/04/headless-chrome
+	// [Firefox]: https://developer.mozilla.org/en-US/docs/Mozilla/Firefox/Headless_mode
+	Headless *bool `json:"headless"`
+	// Host to use for the web socket. It is optional and if it is omitted, the server will accept connections on the
+	// unspecified IPv6 address (::) when IPv6 is available, or the unspecified IPv4 address (0.0.0.0) otherwise.
+	Host *string `json:"host"`
+	// If `true`, Playwright does not pass its own configurations args and only uses the ones from “args”. Dangerous
+	// option; use with care. Defaults to `false`.
+	IgnoreAllDefaultArgs *bool `json:"ignoreAllDefaultArgs"`
+	// If `true`, Playwright does not pass its own configurations args and only uses the ones from “args”. Dangerous
+	// option; use with care.
+	IgnoreDefaultArgs []string `json:"ignoreDefaultArgs"`
+	// Port to use for the web socket. Defaults to 0 that picks any available port.
+	Port *int `json:"port"`
+	// Network proxy settings.
+	Proxy *Proxy `json:"proxy"`
+	// Slows down Playwright operations by the specified amount of milliseconds. Useful so that you can see what is going
+	// on.
+	SlowMo *float64 `json:"slowMo"`
+	// Maximum time in milliseconds to wait for the browser instance to start. Defaults to `30000` (30 seconds). Pass `0`
+	// to disable timeout.
+	Timeout *float64 `json:"timeout"`
+	// If specified, traces are saved into this directory.
+	TracesDir *string `json:"tracesDir"`
+	// Path at which to serve the Browser Server. For security, this defaults to an unguessable string.
+	// **NOTE** Any process or web page (including those running in Playwright) with knowledge of the `wsPath` can take
+	// control of the OS user. For this reason, you should use an unguessable token when using this option.
+	WsPath *string `json:"wsPath"`
+}
+type ClockInstallOptions struct {
+	// Time to initialize with, current system time by default: milliseconds since the epoch, a `time.Time` or a string
+	// parsed by the browser's `Date`.
+	Time interface{} `json:"time"`
+}
 type ConsoleMessageLocation struct {
 	// URL of the resource.
 	URL string `json:"url"`
@@ -981,6 +1263,16 @@ type Rect struct {
 	// the height of the element in pixels.
 	Height float64 `json:"height"`
 }
+type CoverageStartCSSCoverageOptions struct {
+	// Whether to reset the coverage on every navigation. Defaults to `true`.
+	ResetOnNavigation *bool `json:"resetOnNavigation"`
+}
+type CoverageStartJSCoverageOptions struct {
+	// Whether anonymous scripts generated by the page should be reported. Defaults to `false`.
+	ReportAnonymousScripts *bool `json:"reportAnonymousScripts"`
+	// Whether to reset the coverage on every navigation. Defaults to `true`.
+	ResetOnNavigation *bool `json:"resetOnNavigation"`
+}
 type ElementHandleCheckOptions struct {
 	// Whether to bypass the [actionability] checks. Defaults to `false`.
 	//
@@ -1551,6 +1843,12 @@ type FrameGetByTextOptions struct {
 	// Whether to find an exact match: case-sensitive and whole-string. Default to false. Ignored when locating by a
 	// regular expression. Note that exact match still trims whitespace.
 	Exact *bool `json:"exact"`
+	// Whether to match case-insensitively, with Unicode case folding. Defaults to `true` unless “exact” is set. Ignored
+	// when locating by a regular expression.
+	IgnoreCase *bool `json:"ignoreCase"`
+	// Unicode normalization form to compare text in, so that equivalent characters match. Ignored when locating by a
+	// regular expression.
+	Normalization *UnicodeNormalization `json:"normalization"`
 }
 type FrameGetByTitleOptions struct {
 	// Whether to find an exact match: case-sensitive and whole-string. Default to false. Ignored when locating by a
@@ -1561,6 +1859,8 @@ type FrameGotoOptions struct {
 	// Referer header value. If provided it will take preference over the referer header value set by
 	// [Page.SetExtraHTTPHeaders].
 	Referer *string `json:"referer"`
+	// Retries the navigation when it fails with a recoverable network error or gets a retryable status code.
+	RetryPolicy *GotoRetryPolicy `json:"retryPolicy"`
 	// Maximum operation time in milliseconds, defaults to 30 seconds, pass `0` to disable timeout. The default value can
 	// be changed by using the [BrowserContext.SetDefaultNavigationTimeout], [BrowserContext.SetDefaultTimeout],
 	// [Page.SetDefaultNavigationTimeout] or [Page.SetDefaultTimeout] methods.
@@ -1992,6 +2292,12 @@ type FrameLocatorGetByTextOptions struct {
 	// Whether to find an exact match: case-sensitive and whole-string. Default to false. Ignored when locating by a
 	// regular expression. Note that exact match still trims whitespace.
 	Exact *bool `json:"exact"`
+	// Whether to match case-insensitively, with Unicode case folding. Defaults to `true` unless “exact” is set. Ignored
+	// when locating by a regular expression.
+	IgnoreCase *bool `json:"ignoreCase"`
+	// Unicode normalization form to compare text in, so that equivalent characters match. Ignored when locating by a
+	// regular expression.
+	Normalization *UnicodeNormalization `json:"normalization"`
 }
 type FrameLocatorGetByTitleOptions struct {
 	// Whether to find an exact match: case-sensitive and whole-string. Default to false. Ignored when locating by a
@@ -2019,6 +2325,12 @@ type FrameLocatorLocatorOptions struct {
 	// `<article><div>Playwright</div></article>`.
 	HasText interface{} `json:"hasText"`
 }
+type GamepadPressButtonOptions struct {
+	// Time to wait between pressing and releasing the button in milliseconds. Defaults to 0.
+	Delay *float64 `json:"delay"`
+	// Value of the pressed button, from `0` to `1`. Defaults to `1`.
+	Value *float64 `json:"value"`
+}
 type KeyboardPressOptions struct {
 	// Time to wait between `keydown` and `keyup` in milliseconds. Defaults to 0.
 	Delay *float64 `json:"delay"`
@@ -2027,6 +2339,11 @@ type KeyboardTypeOptions struct {
 	// Time to wait between key presses in milliseconds. Defaults to 0.
 	Delay *float64 `json:"delay"`
 }
+type LocatorAriaSnapshotOptions struct {
+	// Maximum time in milliseconds. Defaults to `30000` (30 seconds). Pass `0` to disable timeout. The default value can
+	// be changed by using the [BrowserContext.SetDefaultTimeout] or [Page.SetDefaultTimeout] methods.
+	Timeout *float64 `json:"timeout"`
+}
 type LocatorBlurOptions struct {
 	// Maximum time in milliseconds. Defaults to `30000` (30 seconds). Pass `0` to disable timeout. The default value can
 	// be changed by using the [BrowserContext.SetDefaultTimeout] or [Page.SetDefaultTimeout] methods.
@@ -2164,10 +2481,20 @@ type LocatorElementHandleOptions struct {
 	Timeout *float64 `json:"timeout"`
 }
 type LocatorEvaluateOptions struct {
+	// Name of an isolated world to evaluate in instead of the main world of the element's owner frame. Isolated worlds
+	// share the DOM with the page, but page scripts can't see or tamper with their globals. In an isolated world
+	// “arg” must be JSON serializable. Only supported in Chromium.
+	IsolatedWorld *string `json:"isolatedWorld"`
 	// Maximum time in milliseconds. Defaults to `30000` (30 seconds). Pass `0` to disable timeout. The default value can
 	// be changed by using the [BrowserContext.SetDefaultTimeout] or [Page.SetDefaultTimeout] methods.
 	Timeout *float64 `json:"timeout"`
 }
+type LocatorEvaluateAllOptions struct {
+	// Name of an isolated world to evaluate in instead of the main world of the elements' owner frame. Isolated worlds
+	// share the DOM with the page, but page scripts can't see or tamper with their globals. In an isolated world
+	// “arg” must be JSON serializable. Only supported in Chromium.
+	IsolatedWorld *string `json:"isolatedWorld"`
+}
 type LocatorEvaluateHandleOptions struct {
 	// Maximum time in milliseconds. Defaults to `30000` (30 seconds). Pass `0` to disable timeout. The default value can
 	// be changed by using the [BrowserContext.SetDefaultTimeout] or [Page.SetDefaultTimeout] methods.
@@ -2206,6 +2533,8 @@ type LocatorFilterOptions struct {
 	// passed a [string], matching is case-insensitive and searches for a substring. For example, `"Playwright"` matches
 	// `<article><div>Playwright</div></article>`.
 	HasText interface{} `json:"hasText"`
+	// Only matches visible or invisible elements.
+	Visible *bool `json:"visible"`
 }
 type LocatorFocusOptions struct {
 	// Maximum time in milliseconds. Defaults to `30000` (30 seconds). Pass `0` to disable timeout. The default value can
@@ -2287,6 +2616,12 @@ type LocatorGetByTextOptions struct {
 	// Whether to find an exact match: case-sensitive and whole-string. Default to false. Ignored when locating by a
 	// regular expression. Note that exact match still trims whitespace.
 	Exact *bool `json:"exact"`
+	// Whether to match case-insensitively, with Unicode case folding. Defaults to `true` unless “exact” is set. Ignored
+	// when locating by a regular expression.
+	IgnoreCase *bool `json:"ignoreCase"`
+	// Unicode normalization form to compare text in, so that equivalent characters match. Ignored when locating by a
+	// regular expression.
+	Normalization *UnicodeNormalization `json:"normalization"`
 }
 type LocatorGetByTitleOptions struct {
 	// Whether to find an exact match: case-sensitive and whole-string. Default to false. Ignored when locating by a
@@ -2447,11 +2782,88 @@ type LocatorScreenshotOptions struct {
 	// Specify screenshot type, defaults to `png`.
 	Type *ScreenshotType `json:"type"`
 }
+type LocatorScreenshotStabilizedOptions struct {
+	// When set to `"disabled"`, stops CSS animations, CSS transitions and Web Animations. Animations get different
+	// treatment depending on their duration:
+	//  - finite animations are fast-forwarded to completion, so they'll fire `transitionend` event.
+	//  - infinite animations are canceled to initial state, and then played over after the screenshot.
+	// Defaults to `"allow"` that leaves animations untouched.
+	Animations *ScreenshotAnimations `json:"animations"`
+	// When set to `"hide"`, screenshot will hide text caret. When set to `"initial"`, text caret behavior will not be
+	// changed.  Defaults to `"hide"`.
+	Caret *ScreenshotCaret `json:"caret"`
+	// Time in milliseconds to wait between the screenshots compared to each other. Defaults to `100`.
+	Interval *float64 `json:"interval"`
+	// Specify locators that should be masked when the screenshot is taken. Masked elements will be overlaid with a pink
+	// box `#FF00FF` (customized by “maskColor”) that completely covers its bounding box.
+	Mask []Locator `json:"mask"`
+	// Specify the color of the overlay box for masked elements, in
+	// [CSS color format]. Default color is pink `#FF00FF`.
+	//
+	// [CSS color format]: https://developer.mozilla.org/en-US/docs/Web/CSS/color_value
+	MaskColor *string `json:"maskColor"`
+	// An acceptable amount of pixels that could be different between consecutive screenshots. Default is `0`.
+	MaxDiffPixels *int `json:"maxDiffPixels"`
+	// Hides default white background and allows capturing screenshots with transparency. Not applicable to `jpeg` images.
+	// Defaults to `false`.
+	OmitBackground *bool `json:"omitBackground"`
+	// The file path to save the image to. The screenshot type will be inferred from file extension. If “path” is a
+	// relative path, then it is resolved relative to the current working directory. If no path is provided, the image
+	// won't be saved to the disk.
+	Path *string `json:"path"`
+	// The quality of the image, between 0-100. Not applicable to `png` images.
+	Quality *int `json:"quality"`
+	// When set to `"css"`, screenshot will have a single pixel per each css pixel on the page. For high-dpi devices, this
+	// will keep screenshots small. Using `"device"` option will produce a single pixel per each device pixel, so
+	// screenshots of high-dpi devices will be twice as large or even larger.
+	// Defaults to `"device"`.
+	Scale *ScreenshotScale `json:"scale"`
+	// Number of consecutive screenshots, with the element at the same position and size, that must match before the
+	// screenshot is returned. Defaults to `2`.
+	StableFrames *int `json:"stableFrames"`
+	// Text of the stylesheet to apply while making the screenshot. This is where you can hide dynamic elements, make
+	// elements invisible or change their properties to help you creating repeatable screenshots. This stylesheet pierces
+	// the Shadow DOM and applies to the inner frames.
+	Style *string `json:"style"`
+	// An acceptable perceived color difference between the same pixel of consecutive screenshots, between zero (strict)
+	// and one (lax). Defaults to `0.2`.
+	Threshold *float64 `json:"threshold"`
+	// Maximum time in milliseconds to wait for the element to stop changing. Defaults to `30000` (30 seconds). Pass `0` to disable timeout. The default value can
+	// be changed by using the [BrowserContext.SetDefaultTimeout] or [Page.SetDefaultTimeout] methods.
+	Timeout *float64 `json:"timeout"`
+	// Specify screenshot type, defaults to `png`.
+	Type *ScreenshotType `json:"type"`
+}
+type LocatorScrollByOptions struct {
+	// Whether to scroll instantly or smoothly, as the “behavior” option of [Element.ScrollTo]. Defaults to `"auto"`,
+	// which follows the `scroll-behavior` CSS property.
+	//
+	// [Element.ScrollTo]: https://developer.mozilla.org/en-US/docs/Web/API/Element/scrollTo
+	Behavior *ScrollBehavior `json:"behavior"`
+	// Maximum time in milliseconds. Defaults to `30000` (30 seconds). Pass `0` to disable timeout. The default value can
+	// be changed by using the [BrowserContext.SetDefaultTimeout] or [Page.SetDefaultTimeout] methods.
+	Timeout *float64 `json:"timeout"`
+}
 type LocatorScrollIntoViewIfNeededOptions struct {
 	// Maximum time in milliseconds. Defaults to `30000` (30 seconds). Pass `0` to disable timeout. The default value can
 	// be changed by using the [BrowserContext.SetDefaultTimeout] or [Page.SetDefaultTimeout] methods.
 	Timeout *float64 `json:"timeout"`
 }
+type LocatorScrollPositionOptions struct {
+	// Maximum time in milliseconds. Defaults to `30000` (30 seconds). Pass `0` to disable timeout. The default value can
+	// be changed by using the [BrowserContext.SetDefaultTimeout] or [Page.SetDefaultTimeout] methods.
+	Timeout *float64 `json:"timeout"`
+}
+type LocatorScrollToOptions struct {
+	// Whether to scroll instantly or smoothly, as the “behavior” option of [Element.ScrollTo]. Defaults to `"auto"`,
+	// which follows the `scroll-behavior` CSS property.
+	//
+	// [Element.ScrollTo]: https://developer.mozilla.org/en-US/docs/Web/API/Element/scrollTo
+	Behavior *ScrollBehavior `json:"behavior"`
+	// Maximum time in milliseconds. Defaults to `30000` (30 seconds). Pass `0` to disable timeout. The default value can
+	// be changed by using the [BrowserContext.SetDefaultTimeout] or [Page.SetDefaultTimeout] methods.
+	Timeout *float64 `json:"timeout"`
+}
 type LocatorSelectOptionOptions struct {
 	// Whether to bypass the [actionability] checks. Defaults to `false`.
 	//
@@ -2578,6 +2990,14 @@ type LocatorWaitForOptions struct {
 	// be changed by using the [BrowserContext.SetDefaultTimeout] or [Page.SetDefaultTimeout] methods.
 	Timeout *float64 `json:"timeout"`
 }
+type LocatorWaitForScrollEndOptions struct {
+	// Time in milliseconds the scroll position must stay the same for scrolling to be considered ended, when no
+	// `scrollend` event is fired. Defaults to `100`.
+	Settle *float64 `json:"settle"`
+	// Maximum time in milliseconds. Defaults to `30000` (30 seconds). Pass `0` to disable timeout. The default value can
+	// be changed by using the [BrowserContext.SetDefaultTimeout] or [Page.SetDefaultTimeout] methods.
+	Timeout *float64 `json:"timeout"`
+}
 type LocatorAssertionsToBeAttachedOptions struct {
 	Attached *bool `json:"attached"`
 	// Time to retry the assertion for in milliseconds. Defaults to `5000`.
@@ -2627,9 +3047,14 @@ type LocatorAssertionsToBeVisibleOptions struct {
 	Visible *bool    `json:"visible"`
 }
 type LocatorAssertionsToContainTextOptions struct {
-	// Whether to perform case-insensitive match. “ignoreCase” option takes precedence over the corresponding regular
-	// expression flag if specified.
+	// Whether to perform case-insensitive match, with Unicode case folding. “ignoreCase” option takes precedence over
+	// the corresponding regular expression flag if specified.
 	IgnoreCase *bool `json:"ignoreCase"`
+	// Whether to collapse runs of whitespace and trim the text before matching. Defaults to `true`.
+	NormalizeWhiteSpace *bool `json:"normalizeWhiteSpace"`
+	// Unicode normalization form to compare text in, so that equivalent characters match. Ignored for regular
+	// expressions.
+	Normalization *UnicodeNormalization `json:"normalization"`
 	// Time to retry the assertion for in milliseconds. Defaults to `5000`.
 	Timeout *float64 `json:"timeout"`
 	// Whether to use `element.innerText` instead of `element.textContent` when retrieving DOM node text.
@@ -2654,6 +3079,17 @@ type LocatorAssertionsToHaveCSSOptions struct {
 	// Time to retry the assertion for in milliseconds. Defaults to `5000`.
 	Timeout *float64 `json:"timeout"`
 }
+type LocatorAssertionsToHaveDateBetweenOptions struct {
+	// Go time layouts to parse the date with, see [time.Layout]. By default, common ISO 8601, RFC 1123, English and
+	// numeric layouts are tried, with numeric dates month first or day first depending on Locale.
+	Layouts []string `json:"layouts"`
+	// Locale the date is written in, e.g. `en-US` for "01/02/2006" or `en-GB` for "02/01/2006". Defaults to `en-US`.
+	Locale *string `json:"locale"`
+	// Timezone of dates written without one, e.g. `Europe/Rome`. Defaults to the local timezone.
+	TimezoneId *string `json:"timezoneId"`
+	// Time to retry the assertion for in milliseconds. Defaults to `5000`.
+	Timeout *float64 `json:"timeout"`
+}
 type LocatorAssertionsToHaveIdOptions struct {
 	// Time to retry the assertion for in milliseconds. Defaults to `5000`.
 	Timeout *float64 `json:"timeout"`
@@ -2662,10 +3098,58 @@ type LocatorAssertionsToHaveJSPropertyOptions struct {
 	// Time to retry the assertion for in milliseconds. Defaults to `5000`.
 	Timeout *float64 `json:"timeout"`
 }
+type LocatorAssertionsToHaveScreenshotOptions struct {
+	// When set to `"disabled"`, stops CSS animations, CSS transitions and Web Animations. Animations get different
+	// treatment depending on their duration:
+	//  - finite animations are fast-forwarded to completion, so they'll fire `transitionend` event.
+	//  - infinite animations are canceled to initial state, and then played over after the screenshot.
+	// Defaults to `"disabled"` that disables animations.
+	Animations *ScreenshotAnimations `json:"animations"`
+	// When set to `"hide"`, screenshot will hide text caret. When set to `"initial"`, text caret behavior will not be
+	// changed.  Defaults to `"hide"`.
+	Caret *ScreenshotCaret `json:"caret"`
+	// Specify locators that should be masked when the screenshot is taken. Masked elements will be overlaid with a pink
+	// box `#FF00FF` (customized by “maskColor”) that completely covers its bounding box.
+	Mask []Locator `json:"mask"`
+	// Specify the color of the overlay box for masked elements, in
+	// [CSS color format]. Default color is pink `#FF00FF`.
+	//
+	// [CSS color format]: https://developer.mozilla.org/en-US/docs/Web/CSS/color_value
+	MaskColor *string `json:"maskColor"`
+	// An acceptable ratio of pixels that are different to the total amount of pixels, between `0` and `1`. Unset by
+	// default.
+	MaxDiffPixelRatio *float64 `json:"maxDiffPixelRatio"`
+	// An acceptable amount of pixels that could be different. Unset by default.
+	MaxDiffPixels *int `json:"maxDiffPixels"`
+	// Hides default white background and allows capturing screenshots with transparency. Not applicable to `jpeg` images.
+	// Defaults to `false`.
+	OmitBackground *bool `json:"omitBackground"`
+	// When set to `"css"`, screenshot will have a single pixel per each css pixel on the page. For high-dpi devices, this
+	// will keep screenshots small. Using `"device"` option will produce a single pixel per each device pixel, so
+	// screenshots of high-dpi devices will be twice as large or even larger.
+	// Defaults to `"css"`.
+	Scale *ScreenshotScale `json:"scale"`
+	// Text of the stylesheet to apply while making the screenshot. This is where you can hide dynamic elements, make
+	// elements invisible or change their properties to help you creating repeatable screenshots. This stylesheet pierces
+	// the Shadow DOM and applies to the inner frames.
+	Style *string `json:"style"`
+	// An acceptable perceived color difference in the [YIQ color space] between the same pixel in compared images,
+	// between zero (strict) and one (lax). Defaults to `0.2`.
+	//
+	// [YIQ color space]: https://en.wikipedia.org/wiki/YIQ
+	Threshold *float64 `json:"threshold"`
+	// Time to retry the assertion for in milliseconds. Defaults to `5000`.
+	Timeout *float64 `json:"timeout"`
+}
 type LocatorAssertionsToHaveTextOptions struct {
-	// Whether to perform case-insensitive match. “ignoreCase” option takes precedence over the corresponding regular
-	// expression flag if specified.
+	// Whether to perform case-insensitive match, with Unicode case folding. “ignoreCase” option takes precedence over
+	// the corresponding regular expression flag if specified.
 	IgnoreCase *bool `json:"ignoreCase"`
+	// Whether to collapse runs of whitespace and trim the text before matching. Defaults to `true`.
+	NormalizeWhiteSpace *bool `json:"normalizeWhiteSpace"`
+	// Unicode normalization form to compare text in, so that equivalent characters match. Ignored for regular
+	// expressions.
+	Normalization *UnicodeNormalization `json:"normalization"`
 	// Time to retry the assertion for in milliseconds. Defaults to `5000`.
 	Timeout *float64 `json:"timeout"`
 	// Whether to use `element.innerText` instead of `element.textContent` when retrieving DOM node text.
@@ -2675,10 +3159,38 @@ type LocatorAssertionsToHaveValueOptions struct {
 	// Time to retry the assertion for in milliseconds. Defaults to `5000`.
 	Timeout *float64 `json:"timeout"`
 }
+type LocatorAssertionsToHaveValueBetweenOptions struct {
+	// Locale the number is written in, e.g. `de-DE` for "1.234,5". Defaults to numbers with a decimal point.
+	Locale *string `json:"locale"`
+	// Time to retry the assertion for in milliseconds. Defaults to `5000`.
+	Timeout *float64 `json:"timeout"`
+}
+type LocatorAssertionsToHaveValueCloseToOptions struct {
+	// Locale the number is written in, e.g. `de-DE` for "1.234,5". Defaults to numbers with a decimal point.
+	Locale *string `json:"locale"`
+	// Time to retry the assertion for in milliseconds. Defaults to `5000`.
+	Timeout *float64 `json:"timeout"`
+}
+type LocatorAssertionsToHaveValueGreaterThanOptions struct {
+	// Locale the number is written in, e.g. `de-DE` for "1.234,5". Defaults to numbers with a decimal point.
+	Locale *string `json:"locale"`
+	// Time to retry the assertion for in milliseconds. Defaults to `5000`.
+	Timeout *float64 `json:"timeout"`
+}
+type LocatorAssertionsToHaveValueLessThanOptions struct {
+	// Locale the number is written in, e.g. `de-DE` for "1.234,5". Defaults to numbers with a decimal point.
+	Locale *string `json:"locale"`
+	// Time to retry the assertion for in milliseconds. Defaults to `5000`.
+	Timeout *float64 `json:"timeout"`
+}
 type LocatorAssertionsToHaveValuesOptions struct {
 	// Time to retry the assertion for in milliseconds. Defaults to `5000`.
 	Timeout *float64 `json:"timeout"`
 }
+type LocatorAssertionsToMatchAriaSnapshotOptions struct {
+	// Time to retry the assertion for in milliseconds. Defaults to `5000`.
+	Timeout *float64 `json:"timeout"`
+}
 type MouseClickOptions struct {
 	// Defaults to `left`.
 	Button *MouseButton `json:"button"`
@@ -2732,6 +3244,21 @@ type PageAddStyleTagOptions struct {
 	// URL of the `<link>` tag.
 	URL *string `json:"url"`
 }
+type PageAddLocatorHandlerOptions struct {
+	// Specifies the maximum number of times this handler should be called, it must be positive. Unlimited by default.
+	Times *int `json:"times"`
+}
+type PageAuditOptions struct {
+	// Categories of the audits to run. Defaults to all of them.
+	Categories []AuditCategory `json:"categories"`
+	// Whether to reload the page before the audits, so that the performance audits measure a fresh load. Defaults to
+	// `false`.
+	Reload *bool `json:"reload"`
+	// Maximum time of the reload in milliseconds, defaults to 30 seconds, pass `0` to disable timeout. The default value
+	// can be changed by using the [BrowserContext.SetDefaultNavigationTimeout], [BrowserContext.SetDefaultTimeout],
+	// [Page.SetDefaultNavigationTimeout] or [Page.SetDefaultTimeout] methods.
+	Timeout *float64 `json:"timeout"`
+}
 type PageCheckOptions struct {
 	// Whether to bypass the [actionability] checks. Defaults to `false`.
 	//
@@ -2798,6 +3325,17 @@ type PageCloseOptions struct {
 	// [before unload]: https://developer.mozilla.org/en-US/docs/Web/Events/beforeunload
 	RunBeforeUnload *bool `json:"runBeforeUnload"`
 }
+type PageConnectGamepadOptions struct {
+	// Number of axes of the gamepad. Defaults to `4`, the axes of the standard mapping.
+	Axes *int `json:"axes"`
+	// Number of buttons of the gamepad. Defaults to `17`, the buttons of the standard mapping.
+	Buttons *int `json:"buttons"`
+	// Id of the gamepad, as reported by `Gamepad.id`. Defaults to `Playwright Gamepad (STANDARD GAMEPAD)`.
+	ID *string `json:"id"`
+	// Mapping of the gamepad, as reported by `Gamepad.mapping`. Defaults to `standard`, pass an empty string for a
+	// gamepad without a known mapping.
+	Mapping *string `json:"mapping"`
+}
 type PageDblclickOptions struct {
 	// Defaults to `left`.
 	Button *MouseButton `json:"button"`
@@ -2864,6 +3402,16 @@ type PageDragAndDropOptions struct {
 	// [actionability]: https://playwright.dev/docs/actionability
 	Trial *bool `json:"trial"`
 }
+type PageEmulateDeviceOrientationOptions struct {
+	// Emulated orientation of the device. The `Absolute` field is ignored.
+	Orientation *DeviceOrientation `json:"orientation"`
+}
+type PageEmulateIdleStateOptions struct {
+	// Emulated user state, `active` or `idle`.
+	UserState *IdleUserState `json:"userState"`
+	// Emulated screen state, `unlocked` or `locked`.
+	ScreenState *IdleScreenState `json:"screenState"`
+}
 type PageEmulateMediaOptions struct {
 	// Emulates `prefers-colors-scheme` media feature, supported values are `light`, `dark`, `no-preference`.
 	// Passing `no-override` disables color scheme emulation.
@@ -2876,6 +3424,25 @@ type PageEmulateMediaOptions struct {
 	// `no-override` disables reduced motion emulation.
 	ReducedMotion *ReducedMotion `json:"reducedMotion"`
 }
+type PageEmulateNetworkConditionsOptions struct {
+	// Emulated connection type, reported by `navigator.connection.type`.
+	ConnectionType *ConnectionType `json:"connectionType"`
+	// Maximum download throughput in bytes per second. Defaults to no limit.
+	DownloadThroughput *float64 `json:"downloadThroughput"`
+	// Minimum latency in milliseconds added to every request, from sending it to receiving the response headers.
+	// Defaults to `0`.
+	Latency *float64 `json:"latency"`
+	// Whether to emulate the network being offline. Defaults to `false`.
+	Offline *bool `json:"offline"`
+	// Maximum upload throughput in bytes per second. Defaults to no limit.
+	UploadThroughput *float64 `json:"uploadThroughput"`
+}
+type PageEmulateSensorOptions struct {
+	// Whether the sensor is available to the page. Defaults to `true`. Unavailable sensors fail to start.
+	Available *bool `json:"available"`
+	// Values of the reading of the sensor, see [Page.EmulateSensor].
+	Reading []float64 `json:"reading"`
+}
 type PageEvalOnSelectorOptions struct {
 	// When true, the call requires selector to resolve to a single element. If given selector resolves to more than one
 	// element, the call throws an exception.
@@ -2989,6 +3556,12 @@ type PageGetByTextOptions struct {
 	// Whether to find an exact match: case-sensitive and whole-string. Default to false. Ignored when locating by a
 	// regular expression. Note that exact match still trims whitespace.
 	Exact *bool `json:"exact"`
+	// Whether to match case-insensitively, with Unicode case folding. Defaults to `true` unless “exact” is set. Ignored
+	// when locating by a regular expression.
+	IgnoreCase *bool `json:"ignoreCase"`
+	// Unicode normalization form to compare text in, so that equivalent characters match. Ignored when locating by a
+	// regular expression.
+	Normalization *UnicodeNormalization `json:"normalization"`
 }
 type PageGetByTitleOptions struct {
 	// Whether to find an exact match: case-sensitive and whole-string. Default to false. Ignored when locating by a
@@ -3023,10 +3596,26 @@ type PageGoForwardOptions struct {
 	//   loading.
 	WaitUntil *WaitUntilState `json:"waitUntil"`
 }
+type PageGoToHistoryEntryOptions struct {
+	// Maximum operation time in milliseconds, defaults to 30 seconds, pass `0` to disable timeout. The default value can
+	// be changed by using the [BrowserContext.SetDefaultNavigationTimeout], [BrowserContext.SetDefaultTimeout],
+	// [Page.SetDefaultNavigationTimeout] or [Page.SetDefaultTimeout] methods.
+	Timeout *float64 `json:"timeout"`
+	// When to consider operation succeeded, defaults to `load`. Events can be either:
+	//  - `domcontentloaded` - consider operation to be finished when the `DOMContentLoaded` event is fired.
+	//  - `load` - consider operation to be finished when the `load` event is fired.
+	//  - `networkidle` - **DISCOURAGED** consider operation to be finished when there are no network connections for
+	//   at least `500` ms. Don't use this method for testing, rely on web assertions to assess readiness instead.
+	//  - `commit` - consider operation to be finished when network response is received and the document started
+	//   loading.
+	WaitUntil *WaitUntilState `json:"waitUntil"`
+}
 type PageGotoOptions struct {
 	// Referer header value. If provided it will take preference over the referer header value set by
 	// [Page.SetExtraHTTPHeaders].
 	Referer *string `json:"referer"`
+	// Retries the navigation when it fails with a recoverable network error or gets a retryable status code.
+	RetryPolicy *GotoRetryPolicy `json:"retryPolicy"`
 	// Maximum operation time in milliseconds, defaults to 30 seconds, pass `0` to disable timeout. The default value can
 	// be changed by using the [BrowserContext.SetDefaultNavigationTimeout], [BrowserContext.SetDefaultTimeout],
 	// [Page.SetDefaultNavigationTimeout] or [Page.SetDefaultTimeout] methods.
@@ -3199,6 +3788,9 @@ type PagePdfOptions struct {
 	Tagged *bool `json:"tagged"`
 	// Paper width, accepts values labeled with units.
 	Width *string `json:"width"`
+	// Writer to stream the PDF to, in chunks as it is read from the browser, instead of returning it. The returned
+	// buffer is then `nil`. The PDF is also saved to “path” if it is set.
+	Writer io.Writer `json:"writer"`
 }
 type PagePressOptions struct {
 	// Time to wait between `keydown` and `keyup` in milliseconds. Defaults to 0.
@@ -3214,11 +3806,44 @@ type PagePressOptions struct {
 	// be changed by using the [BrowserContext.SetDefaultTimeout] or [Page.SetDefaultTimeout] methods.
 	Timeout *float64 `json:"timeout"`
 }
+type PagePrintLayoutOptions struct {
+	// Paper format. If set, takes priority over `width` or `height` options. Defaults to 'Letter'.
+	Format *string `json:"format"`
+	// Paper height, accepts values labeled with units.
+	Height *string `json:"height"`
+	// Paper orientation. Defaults to `false`.
+	Landscape *bool `json:"landscape"`
+	// Paper margins, defaults to none.
+	Margin *Margin `json:"margin"`
+	// Scale of the webpage rendering. Defaults to `1`.
+	Scale *float64 `json:"scale"`
+	// CSS selectors of the elements to report in the layout, see [PrintLayout.Elements].
+	Selectors []string `json:"selectors"`
+	// Paper width, accepts values labeled with units.
+	Width *string `json:"width"`
+}
 type PageQuerySelectorOptions struct {
 	// When true, the call requires selector to resolve to a single element. If given selector resolves to more than one
 	// element, the call throws an exception.
 	Strict *bool `json:"strict"`
 }
+type PageRecordHarOptions struct {
+	// Optional setting to control resource content management. If `omit` is specified, content is not persisted. If
+	// `attach` is specified, resources are persisted as separate files and all of these files are archived along with the
+	// HAR file. Defaults to `embed`, which stores content inline the HAR file as per HAR specification.
+	Content *HarContentPolicy `json:"content"`
+	// Whether to keep the entries of the HAR at the path whose requests are not made again by the page, instead of
+	// replacing the HAR. Defaults to `false`.
+	Merge *bool `json:"merge"`
+	// HTTP methods of the requests to store in the HAR, e.g. `[]string{"GET", "POST"}`. Defaults to all methods.
+	MethodFilter []string `json:"methodFilter"`
+	// When set to `minimal`, only record information necessary for routing from HAR. This omits sizes, timing, page,
+	// cookies, security and other types of HAR information that are not used when replaying from HAR. Defaults to `full`.
+	Mode *HarMode `json:"mode"`
+	// Glob pattern, regular expression or a slice of those to filter the requests stored in the HAR. Requests matching any
+	// of the patterns are recorded.
+	URLFilter interface{} `json:"urlFilter"`
+}
 type PageReloadOptions struct {
 	// Maximum operation time in milliseconds, defaults to 30 seconds, pass `0` to disable timeout. The default value can
 	// be changed by using the [BrowserContext.SetDefaultNavigationTimeout], [BrowserContext.SetDefaultTimeout],
@@ -3244,11 +3869,15 @@ type PageRouteFromHAROptions struct {
 	// Optional setting to control resource content management. If `attach` is specified, resources are persisted as
 	// separate files or entries in the ZIP archive. If `embed` is specified, content is stored inline the HAR file.
 	UpdateContent *RouteFromHarUpdateContentPolicy `json:"updateContent"`
+	// Whether to keep the entries of the given HAR whose requests are not made again while it is updated, instead of
+	// replacing the HAR. Defaults to `false`.
+	UpdateMerge *bool `json:"updateMerge"`
 	// When set to `minimal`, only record information necessary for routing from HAR. This omits sizes, timing, page,
 	// cookies, security and other types of HAR information that are not used when replaying from HAR. Defaults to `full`.
 	UpdateMode *HarMode `json:"updateMode"`
 	// A glob pattern, regular expression or predicate to match the request URL. Only requests with URL matching the
-	// pattern will be served from the HAR file. If not specified, all requests are served from the HAR file.
+	// pattern will be served from the HAR file. If not specified, all requests are served from the HAR file. In update
+	// mode, a glob pattern, regular expression or a slice of those filters the requests recorded.
 	URL interface{} `json:"url"`
 }
 type PageScreenshotOptions struct {
@@ -3298,6 +3927,26 @@ type PageScreenshotOptions struct {
 	// Specify screenshot type, defaults to `png`.
 	Type *ScreenshotType `json:"type"`
 }
+type PageScrollByOptions struct {
+	// Whether to scroll instantly or smoothly, as the “behavior” option of [Element.ScrollTo]. Defaults to `"auto"`,
+	// which follows the `scroll-behavior` CSS property.
+	//
+	// [Element.ScrollTo]: https://developer.mozilla.org/en-US/docs/Web/API/Element/scrollTo
+	Behavior *ScrollBehavior `json:"behavior"`
+	// Maximum time in milliseconds. Defaults to `30000` (30 seconds). Pass `0` to disable timeout. The default value can
+	// be changed by using the [BrowserContext.SetDefaultTimeout] or [Page.SetDefaultTimeout] methods.
+	Timeout *float64 `json:"timeout"`
+}
+type PageScrollToOptions struct {
+	// Whether to scroll instantly or smoothly, as the “behavior” option of [Element.ScrollTo]. Defaults to `"auto"`,
+	// which follows the `scroll-behavior` CSS property.
+	//
+	// [Element.ScrollTo]: https://developer.mozilla.org/en-US/docs/Web/API/Element/scrollTo
+	Behavior *ScrollBehavior `json:"behavior"`
+	// Maximum time in milliseconds. Defaults to `30000` (30 seconds). Pass `0` to disable timeout. The default value can
+	// be changed by using the [BrowserContext.SetDefaultTimeout] or [Page.SetDefaultTimeout] methods.
+	Timeout *float64 `json:"timeout"`
+}
 type PageSelectOptionOptions struct {
 	// Whether to bypass the [actionability] checks. Defaults to `false`.
 	//
@@ -3452,6 +4101,12 @@ type Size struct {
 	// page height in pixels.
 	Height int `json:"height"`
 }
+type PageExpectBFCacheNavigationOptions struct {
+	// Maximum operation time in milliseconds, defaults to 30 seconds, pass `0` to disable timeout. The default value can
+	// be changed by using the [BrowserContext.SetDefaultNavigationTimeout], [BrowserContext.SetDefaultTimeout],
+	// [Page.SetDefaultNavigationTimeout] or [Page.SetDefaultTimeout] methods.
+	Timeout *float64 `json:"timeout"`
+}
 type PageExpectConsoleMessageOptions struct {
 	// Receives the [ConsoleMessage] object and resolves to truthy value when the waiting should resolve.
 	Predicate func(ConsoleMessage) bool `json:"predicate"`
@@ -3527,6 +4182,13 @@ type PageExpectPopupOptions struct {
 	// default value can be changed by using the [BrowserContext.SetDefaultTimeout].
 	Timeout *float64 `json:"timeout"`
 }
+type PageExpectPrerenderActivationOptions struct {
+	// Maximum time to wait for in milliseconds. Defaults to `30000` (30 seconds). Pass `0` to disable timeout. The
+	// default value can be changed by using the [BrowserContext.SetDefaultTimeout].
+	Timeout *float64 `json:"timeout"`
+	// A glob pattern, regex pattern or predicate receiving [URL] to match the URL of the prerendered page.
+	URL interface{} `json:"url"`
+}
 type PageExpectRequestOptions struct {
 	// Maximum wait time in milliseconds, defaults to 30 seconds, pass `0` to disable the timeout. The default value can
 	// be changed by using the [Page.SetDefaultTimeout] method.
@@ -3544,6 +4206,14 @@ type PageExpectResponseOptions struct {
 	// be changed by using the [BrowserContext.SetDefaultTimeout] or [Page.SetDefaultTimeout] methods.
 	Timeout *float64 `json:"timeout"`
 }
+type PageWaitForScrollEndOptions struct {
+	// Time in milliseconds the scroll position must stay the same for scrolling to be considered ended, when no
+	// `scrollend` event is fired. Defaults to `100`.
+	Settle *float64 `json:"settle"`
+	// Maximum time in milliseconds. Defaults to `30000` (30 seconds). Pass `0` to disable timeout. The default value can
+	// be changed by using the [BrowserContext.SetDefaultTimeout] or [Page.SetDefaultTimeout] methods.
+	Timeout *float64 `json:"timeout"`
+}
 type PageWaitForSelectorOptions struct {
 	// Defaults to `visible`. Can be either:
 	//  - `attached` - wait for element to be present in DOM.
@@ -3595,6 +4265,54 @@ type PageWaitForEventOptions struct {
 	// default value can be changed by using the [BrowserContext.SetDefaultTimeout].
 	Timeout *float64 `json:"timeout"`
 }
+type PageAssertionsToHaveScreenshotOptions struct {
+	// When set to `"disabled"`, stops CSS animations, CSS transitions and Web Animations. Animations get different
+	// treatment depending on their duration:
+	//  - finite animations are fast-forwarded to completion, so they'll fire `transitionend` event.
+	//  - infinite animations are canceled to initial state, and then played over after the screenshot.
+	// Defaults to `"disabled"` that disables animations.
+	Animations *ScreenshotAnimations `json:"animations"`
+	// When set to `"hide"`, screenshot will hide text caret. When set to `"initial"`, text caret behavior will not be
+	// changed.  Defaults to `"hide"`.
+	Caret *ScreenshotCaret `json:"caret"`
+	// An object which specifies clipping of the resulting image.
+	Clip *Rect `json:"clip"`
+	// When true, takes a screenshot of the full scrollable page, instead of the currently visible viewport. Defaults to
+	// `false`.
+	FullPage *bool `json:"fullPage"`
+	// Specify locators that should be masked when the screenshot is taken. Masked elements will be overlaid with a pink
+	// box `#FF00FF` (customized by “maskColor”) that completely covers its bounding box.
+	Mask []Locator `json:"mask"`
+	// Specify the color of the overlay box for masked elements, in
+	// [CSS color format]. Default color is pink `#FF00FF`.
+	//
+	// [CSS color format]: https://developer.mozilla.org/en-US/docs/Web/CSS/color_value
+	MaskColor *string `json:"maskColor"`
+	// An acceptable ratio of pixels that are different to the total amount of pixels, between `0` and `1`. Unset by
+	// default.
+	MaxDiffPixelRatio *float64 `json:"maxDiffPixelRatio"`
+	// An acceptable amount of pixels that could be different. Unset by default.
+	MaxDiffPixels *int `json:"maxDiffPixels"`
+	// Hides default white background and allows capturing screenshots with transparency. Not applicable to `jpeg` images.
+	// Defaults to `false`.
+	OmitBackground *bool `json:"omitBackground"`
+	// When set to `"css"`, screenshot will have a single pixel per each css pixel on the page. For high-dpi devices, this
+	// will keep screenshots small. Using `"device"` option will produce a single pixel per each device pixel, so
+	// screenshots of high-dpi devices will be twice as large or even larger.
+	// Defaults to `"css"`.
+	Scale *ScreenshotScale `json:"scale"`
+	// Text of the stylesheet to apply while making the screenshot. This is where you can hide dynamic elements, make
+	// elements invisible or change their properties to help you creating repeatable screenshots. This stylesheet pierces
+	// the Shadow DOM and applies to the inner frames.
+	Style *string `json:"style"`
+	// An acceptable perceived color difference in the [YIQ color space] between the same pixel in compared images,
+	// between zero (strict) and one (lax). Defaults to `0.2`.
+	//
+	// [YIQ color space]: https://en.wikipedia.org/wiki/YIQ
+	Threshold *float64 `json:"threshold"`
+	// Time to retry the assertion for in milliseconds. Defaults to `5000`.
+	Timeout *float64 `json:"timeout"`
+}
 type PageAssertionsToHaveTitleOptions struct {
 	// Time to retry the assertion for in milliseconds. Defaults to `5000`.
 	Timeout *float64 `json:"timeout"`
@@ -3603,6 +4321,18 @@ type PageAssertionsToHaveURLOptions struct {
 	// Time to retry the assertion for in milliseconds. Defaults to `5000`.
 	Timeout *float64 `json:"timeout"`
 }
+type SnapshotAssertionsToMatchSnapshotOptions struct {
+	// An acceptable ratio of pixels that are different to the total amount of pixels, between `0` and `1`. Unset by
+	// default.
+	MaxDiffPixelRatio *float64 `json:"maxDiffPixelRatio"`
+	// An acceptable amount of pixels that could be different. Unset by default.
+	MaxDiffPixels *int `json:"maxDiffPixels"`
+	// An acceptable perceived color difference in the [YIQ color space] between the same pixel in compared images,
+	// between zero (strict) and one (lax). Defaults to `0.2`.
+	//
+	// [YIQ color space]: https://en.wikipedia.org/wiki/YIQ
+	Threshold *float64 `json:"threshold"`
+}
 type RequestSizesResult struct {
 	// Size of the request body (POST data payload) in bytes. Set to 0 if there was no body.
 	RequestBodySize int `json:"requestBodySize"`
@@ -3690,6 +4420,10 @@ type RouteFetchOptions struct {
 	// Maximum number of request redirects that will be followed automatically. An error will be thrown if the number is
 	// exceeded. Defaults to `20`. Pass `0` to not follow redirects.
 	MaxRedirects *int `json:"maxRedirects"`
+	// Maximum number of times network errors should be retried. Currently only `ECONNRESET` error is retried. Does not
+	// retry based on HTTP response codes. An error will be thrown if the limit is exceeded. Defaults to `0` - no
+	// retries.
+	MaxRetries *int `json:"maxRetries"`
 	// If set changes the request method (e.g. GET or POST).
 	Method *string `json:"method"`
 	// If set changes the post data of request.
@@ -3700,7 +4434,8 @@ type RouteFetchOptions struct {
 	URL *string `json:"url"`
 }
 type RouteFulfillOptions struct {
-	// Response body.
+	// Response body, a string, a []byte or an [io.Reader]. A reader is read to its end, and closed if it is an
+	// [io.Closer].
 	Body interface{} `json:"body"`
 	// If set, equals to setting `Content-Type` response header.
 	ContentType *string `json:"contentType"`
@@ -3721,6 +4456,19 @@ type SelectorsRegisterOptions struct {
 	// script is not guaranteed when this engine is used together with other registered engines.
 	ContentScript *bool `json:"contentScript"`
 }
+type SpeechInstallOptions struct {
+	// Voices returned by `speechSynthesis.getVoices()`. Defaults to a single `en-US` voice.
+	Voices []SpeechVoice `json:"voices"`
+}
+type SpeechRecognizeOptions struct {
+	// Alternative transcripts of the result, after “transcript”, up to the `maxAlternatives` of the recognition.
+	Alternatives []string `json:"alternatives"`
+	// Confidence of the transcripts, from `0` to `1`. Defaults to `1`.
+	Confidence *float64 `json:"confidence"`
+	// Whether the result is final. Interim results are only delivered to recognitions with `interimResults`. Defaults
+	// to `true`.
+	IsFinal *bool `json:"isFinal"`
+}
 type TracingStartOptions struct {
 	// If specified, intermediate trace files are going to be saved into the files with the given name prefix inside the
 	// “tracesDir” folder specified in [BrowserType.Launch]. To specify the final trace zip file name, you need to pass
@@ -3759,6 +4507,16 @@ type WebSocketWaitForEventOptions struct {
 	// default value can be changed by using the [BrowserContext.SetDefaultTimeout].
 	Timeout *float64 `json:"timeout"`
 }
+type WebSocketRouteCloseOptions struct {
+	// Optional [close code].
+	//
+	// [close code]: https://developer.mozilla.org/en-US/docs/Web/API/WebSocket/close#code
+	Code *int `json:"code"`
+	// Optional [close reason].
+	//
+	// [close reason]: https://developer.mozilla.org/en-US/docs/Web/API/WebSocket/close#reason
+	Reason *string `json:"reason"`
+}
 type HttpCredentials struct {
 	Username string `json:"username"`
 	Password string `json:"password"`
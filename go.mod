@@ -14,6 +14,7 @@ require (
 	github.com/tidwall/gjson v1.17.0
 	go.uber.org/multierr v1.11.0
 	golang.org/x/exp v0.0.0-20240103183307-be819d1f06fc
+	golang.org/x/text v0.14.0
 )
 
 require (
@@ -0,0 +1,58 @@
+package playwright
+
+import (
+	"time"
+)
+
+// GotoRetryPolicy configures how [Page.Goto] and [Frame.Goto] retry navigations that fail because of flaky
+// infrastructure. A navigation is retried when it fails with one of the retryable network errors or when the main
+// resource response has one of the retryable status codes, waiting longer after each attempt. Each attempt gets the
+// full navigation timeout.
+type GotoRetryPolicy struct {
+	// Maximum number of attempts, including the first one. Defaults to `3`.
+	MaxAttempts *int
+	// Network errors that are retried, e.g. `net::ERR_CONNECTION_REFUSED`. Defaults to `net::ERR_NETWORK_CHANGED` and
+	// `net::ERR_CONNECTION_RESET`.
+	RetryableErrors []string
+	// Status codes of the responses that are retried. Defaults to 502, 503 and 504.
+	RetryableStatusCodes []int
+	// Delay before the first retry. Defaults to 100 milliseconds.
+	InitialBackoff *time.Duration
+	// Maximum delay between two attempts. Defaults to 5 seconds.
+	MaxBackoff *time.Duration
+	// Factor by which the delay grows after each retry, at least `1`. Defaults to `2`.
+	Multiplier *float64
+	// Fraction of the delay, between `0` and `1`, that is randomized. Defaults to `0`.
+	Jitter *float64
+}
+
+var (
+	defaultRetryableNavigationErrors      = []string{"net::ERR_NETWORK_CHANGED", "net::ERR_CONNECTION_RESET"}
+	defaultRetryableNavigationStatusCodes = []int{502, 503, 504}
+)
+
+func newGotoRetryPolicy(policy *GotoRetryPolicy) (*retryPolicy, error) {
+	if policy == nil {
+		return nil, nil
+	}
+	statusCodes := policy.RetryableStatusCodes
+	if statusCodes == nil {
+		statusCodes = defaultRetryableNavigationStatusCodes
+	}
+	p, err := newRetryPolicy(&APIRequestRetryPolicy{
+		MaxAttempts:          policy.MaxAttempts,
+		RetryableStatusCodes: statusCodes,
+		InitialBackoff:       policy.InitialBackoff,
+		MaxBackoff:           policy.MaxBackoff,
+		Multiplier:           policy.Multiplier,
+		Jitter:               policy.Jitter,
+	})
+	if err != nil {
+		return nil, err
+	}
+	p.errorCodes = policy.RetryableErrors
+	if p.errorCodes == nil {
+		p.errorCodes = defaultRetryableNavigationErrors
+	}
+	return p, nil
+}
@@ -0,0 +1,56 @@
+package playwright
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGotoRetryPolicy(t *testing.T) {
+	policy, err := newGotoRetryPolicy(nil)
+	require.NoError(t, err)
+	require.Nil(t, policy)
+
+	policy, err = newGotoRetryPolicy(&GotoRetryPolicy{})
+	require.NoError(t, err)
+	require.Equal(t, 3, policy.maxAttempts)
+	require.True(t, policy.shouldRetry(502, nil))
+	require.False(t, policy.shouldRetry(500, nil))
+	require.False(t, policy.shouldRetry(200, nil))
+	require.True(t, policy.shouldRetry(0, errors.New("net::ERR_CONNECTION_RESET at http://localhost/")))
+	require.True(t, policy.shouldRetry(0, errors.New("net::ERR_NETWORK_CHANGED at http://localhost/")))
+	require.False(t, policy.shouldRetry(0, errors.New("net::ERR_NAME_NOT_RESOLVED at http://localhost/")))
+	require.False(t, policy.shouldRetry(0, targetClosedError(nil)))
+
+	policy, err = newGotoRetryPolicy(&GotoRetryPolicy{
+		RetryableErrors:      []string{"ERR_CONNECTION_REFUSED"},
+		RetryableStatusCodes: []int{500},
+	})
+	require.NoError(t, err)
+	require.True(t, policy.shouldRetry(500, nil))
+	require.False(t, policy.shouldRetry(502, nil))
+	require.True(t, policy.shouldRetry(0, errors.New("net::ERR_CONNECTION_REFUSED at http://localhost/")))
+	require.False(t, policy.shouldRetry(0, errors.New("net::ERR_CONNECTION_RESET at http://localhost/")))
+
+	_, err = newGotoRetryPolicy(&GotoRetryPolicy{MaxAttempts: Int(0)})
+	require.ErrorContains(t, err, "maxAttempts")
+}
+
+func TestRetryStopsOnSuccess(t *testing.T) {
+	policy, err := newGotoRetryPolicy(&GotoRetryPolicy{InitialBackoff: Duration(time.Millisecond)})
+	require.NoError(t, err)
+	statuses := []int{503, 502, 200}
+	var discarded []int
+	result, err := retry(policy, func() (int, int, error) {
+		status := statuses[0]
+		statuses = statuses[1:]
+		return status, status, nil
+	}, func(status int) {
+		discarded = append(discarded, status)
+	})
+	require.NoError(t, err)
+	require.Equal(t, 200, result)
+	require.Equal(t, []int{503, 502}, discarded)
+}
@@ -0,0 +1,289 @@
+package playwright
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// GridAdapter resolves the endpoint of a browser farm, so that [BrowserType.Connect] can connect to farms which do
+// not expose a Playwright server directly, see [BrowserTypeConnectOptions.Adapter].
+type GridAdapter interface {
+	// Resolve returns the endpoint to connect to for a browser named browserName, e.g. `chromium`, given the address
+	// of the farm passed to [BrowserType.Connect].
+	Resolve(address string, browserName string) (*GridEndpoint, error)
+}
+
+// GridEndpoint is an endpoint resolved by a [GridAdapter].
+type GridEndpoint struct {
+	// WSEndpoint is the websocket endpoint to connect to.
+	WSEndpoint string
+	// Headers are sent with the websocket connect request, in addition to [BrowserTypeConnectOptions.Headers].
+	Headers map[string]string
+	// CDP is whether the endpoint speaks the Chrome DevTools Protocol instead of the Playwright protocol, it is then
+	// connected to as with [BrowserType.ConnectOverCDP].
+	CDP bool
+	// Release is called once the browser is disconnected, to free the resources held by the farm, such as a session.
+	Release func() error
+}
+
+func (b *browserTypeImpl) connectWithAdapter(address string, option BrowserTypeConnectOptions) (Browser, error) {
+	endpoint, err := option.Adapter.Resolve(address, b.Name())
+	if err != nil {
+		return nil, fmt.Errorf("could not resolve endpoint of %s: %w", address, err)
+	}
+	headers := make(map[string]string, len(option.Headers)+len(endpoint.Headers))
+	for name, value := range option.Headers {
+		headers[name] = value
+	}
+	for name, value := range endpoint.Headers {
+		headers[name] = value
+	}
+	var browser Browser
+	if endpoint.CDP {
+		browser, err = b.ConnectOverCDP(endpoint.WSEndpoint, BrowserTypeConnectOverCDPOptions{
+			Headers: headers,
+			SlowMo:  option.SlowMo,
+			Timeout: option.Timeout,
+		})
+	} else {
+		option.Headers = headers
+		var remote *browserImpl
+		if remote, err = newRemoteConnection(b, endpoint.WSEndpoint, option).connect(); err == nil {
+			browser = remote
+		}
+	}
+	if err != nil {
+		if endpoint.Release != nil {
+			_ = endpoint.Release()
+		}
+		return nil, err
+	}
+	if endpoint.Release != nil {
+		browser.OnDisconnected(func(Browser) {
+			if err := endpoint.Release(); err != nil {
+				logger.Printf("could not release %s: %v\n", endpoint.WSEndpoint, err)
+			}
+		})
+	}
+	return browser, nil
+}
+
+// SeleniumGridAdapterOptions are the options of [NewSeleniumGridAdapter].
+type SeleniumGridAdapterOptions struct {
+	// Capabilities requested for the session, in addition to the browser name, e.g. `{"browserVersion": "124"}`.
+	Capabilities map[string]interface{}
+	// Headers sent to the grid, e.g. for authentication.
+	Headers map[string]string
+	// Maximum time in milliseconds to wait for the grid to create the session, which may be queued until a node is
+	// free. Defaults to `0` (no timeout).
+	Timeout *float64
+}
+
+type seleniumGridAdapter struct {
+	options SeleniumGridAdapterOptions
+	client  *http.Client
+}
+
+// NewSeleniumGridAdapter returns a [GridAdapter] for Selenium Grid 4. It creates a WebDriver session on the grid and
+// connects to its browser over the Chrome DevTools Protocol, so only Chromium based browsers are supported. The
+// session is deleted once the browser is disconnected. The address is the URL of the grid, e.g.
+// `http://localhost:4444`.
+func NewSeleniumGridAdapter(options ...SeleniumGridAdapterOptions) GridAdapter {
+	a := &seleniumGridAdapter{client: &http.Client{}}
+	if len(options) == 1 {
+		a.options = options[0]
+	}
+	if a.options.Timeout != nil {
+		a.client.Timeout = time.Duration(*a.options.Timeout * float64(time.Millisecond))
+	}
+	return a
+}
+
+func (a *seleniumGridAdapter) Resolve(address string, browserName string) (*GridEndpoint, error) {
+	if browserName != "chromium" {
+		return nil, fmt.Errorf("Selenium Grid only supports chromium, not %s", browserName)
+	}
+	grid, err := url.Parse(strings.TrimSuffix(address, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid grid URL: %w", err)
+	}
+	capabilities := map[string]interface{}{"browserName": "chrome"}
+	for name, value := range a.options.Capabilities {
+		capabilities[name] = value
+	}
+	body, err := json.Marshal(map[string]interface{}{
+		"capabilities": map[string]interface{}{"alwaysMatch": capabilities},
+	})
+	if err != nil {
+		return nil, err
+	}
+	data, err := a.do(http.MethodPost, grid.String()+"/session", body)
+	if err != nil {
+		return nil, fmt.Errorf("could not create session: %w", err)
+	}
+	var session struct {
+		Value struct {
+			SessionID    string                 `json:"sessionId"`
+			Capabilities map[string]interface{} `json:"capabilities"`
+		} `json:"value"`
+	}
+	if err := json.Unmarshal(data, &session); err != nil {
+		return nil, fmt.Errorf("could not parse session: %w", err)
+	}
+	release := func() error {
+		_, err := a.do(http.MethodDelete, grid.String()+"/session/"+session.Value.SessionID, nil)
+		return err
+	}
+	cdp, _ := session.Value.Capabilities["se:cdp"].(string)
+	if cdp == "" {
+		_ = release()
+		return nil, fmt.Errorf("session %s has no se:cdp capability, the grid must be Selenium Grid 4 or newer", session.Value.SessionID)
+	}
+	return &GridEndpoint{
+		WSEndpoint: seleniumCDPEndpoint(grid, cdp),
+		Headers:    a.options.Headers,
+		CDP:        true,
+		Release:    release,
+	}, nil
+}
+
+// seleniumCDPEndpoint makes the CDP endpoint of a session reachable when a standalone grid reports it on the loopback
+// interface of its host.
+func seleniumCDPEndpoint(grid *url.URL, cdp string) string {
+	endpoint, err := url.Parse(cdp)
+	if err != nil {
+		return cdp
+	}
+	host := endpoint.Hostname()
+	if ip := net.ParseIP(host); host != "localhost" && (ip == nil || !ip.IsLoopback()) {
+		return cdp
+	}
+	if gridHost := grid.Hostname(); gridHost != host {
+		if port := endpoint.Port(); port != "" {
+			endpoint.Host = net.JoinHostPort(gridHost, port)
+		} else {
+			endpoint.Host = gridHost
+		}
+	}
+	return endpoint.String()
+}
+
+func (a *seleniumGridAdapter) do(method, url string, body []byte) ([]byte, error) {
+	request, err := http.NewRequest(method, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/json; charset=utf-8")
+	for name, value := range a.options.Headers {
+		request.Header.Set(name, value)
+	}
+	response, err := a.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	data, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode >= 400 {
+		return nil, fmt.Errorf("%s %s: %s: %s", method, url, response.Status, strings.TrimSpace(string(data)))
+	}
+	return data, nil
+}
+
+// MoonAdapterOptions are the options of [NewMoonAdapter].
+type MoonAdapterOptions struct {
+	// Query parameters of the session, e.g. `{"headless": "false", "enableVideo": "true"}`.
+	Params map[string]string
+}
+
+type moonAdapter struct {
+	options MoonAdapterOptions
+}
+
+// NewMoonAdapter returns a [GridAdapter] for Aerokube Moon, connecting to the Playwright endpoint of the browser with
+// the version of the driver. The address is the URL of Moon, e.g. `ws://moon.example.com:4444`.
+func NewMoonAdapter(options ...MoonAdapterOptions) GridAdapter {
+	a := &moonAdapter{}
+	if len(options) == 1 {
+		a.options = options[0]
+	}
+	return a
+}
+
+func (a *moonAdapter) Resolve(address string, browserName string) (*GridEndpoint, error) {
+	endpoint, err := gridEndpointURL(address, "/playwright/"+browserName+"/playwright-"+playwrightCliVersion, a.options.Params)
+	if err != nil {
+		return nil, err
+	}
+	return &GridEndpoint{WSEndpoint: endpoint}, nil
+}
+
+// BrowserlessAdapterOptions are the options of [NewBrowserlessAdapter].
+type BrowserlessAdapterOptions struct {
+	// API token of the account.
+	Token *string
+	// Additional query parameters, e.g. `{"timeout": "60000"}`.
+	Params map[string]string
+}
+
+type browserlessAdapter struct {
+	options BrowserlessAdapterOptions
+}
+
+// NewBrowserlessAdapter returns a [GridAdapter] for Browserless, connecting to the Playwright endpoint of the browser.
+// The address is the URL of the Browserless region or instance, e.g. `wss://production-sfo.browserless.io`.
+func NewBrowserlessAdapter(options ...BrowserlessAdapterOptions) GridAdapter {
+	a := &browserlessAdapter{}
+	if len(options) == 1 {
+		a.options = options[0]
+	}
+	return a
+}
+
+func (a *browserlessAdapter) Resolve(address string, browserName string) (*GridEndpoint, error) {
+	params := make(map[string]string, len(a.options.Params)+1)
+	for name, value := range a.options.Params {
+		params[name] = value
+	}
+	if a.options.Token != nil {
+		params["token"] = *a.options.Token
+	}
+	endpoint, err := gridEndpointURL(address, "/"+browserName+"/playwright", params)
+	if err != nil {
+		return nil, err
+	}
+	return &GridEndpoint{WSEndpoint: endpoint}, nil
+}
+
+// gridEndpointURL appends path and the query params to address, switching HTTP schemes to websocket ones.
+func gridEndpointURL(address string, path string, params map[string]string) (string, error) {
+	endpoint, err := url.Parse(address)
+	if err != nil {
+		return "", fmt.Errorf("invalid address: %w", err)
+	}
+	switch endpoint.Scheme {
+	case "http":
+		endpoint.Scheme = "ws"
+	case "https":
+		endpoint.Scheme = "wss"
+	case "ws", "wss":
+	default:
+		return "", fmt.Errorf("invalid address %q: the scheme must be ws, wss, http or https", address)
+	}
+	endpoint.Path = strings.TrimSuffix(endpoint.Path, "/") + path
+	query := endpoint.Query()
+	for name, value := range params {
+		query.Set(name, value)
+	}
+	endpoint.RawQuery = query.Encode()
+	return endpoint.String(), nil
+}
@@ -0,0 +1,81 @@
+package playwright
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeleniumGridAdapter(t *testing.T) {
+	var created map[string]interface{}
+	deleted := ""
+	grid := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Basic dXNlcjpwYXNz", r.Header.Get("Authorization"))
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/wd/hub/session":
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+			_, _ = w.Write([]byte(`{"value":{"sessionId":"abc","capabilities":{"se:cdp":"ws://localhost:4444/session/abc/se/cdp"}}}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/wd/hub/session/abc":
+			deleted = "abc"
+			_, _ = w.Write([]byte(`{"value":null}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer grid.Close()
+
+	adapter := NewSeleniumGridAdapter(SeleniumGridAdapterOptions{
+		Capabilities: map[string]interface{}{"browserVersion": "124"},
+		Headers:      map[string]string{"Authorization": "Basic dXNlcjpwYXNz"},
+	})
+	endpoint, err := adapter.Resolve(grid.URL+"/wd/hub/", "chromium")
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{
+		"capabilities": map[string]interface{}{
+			"alwaysMatch": map[string]interface{}{"browserName": "chrome", "browserVersion": "124"},
+		},
+	}, created)
+	require.True(t, endpoint.CDP)
+	// The grid reports the CDP endpoint on its loopback interface, which is the same as the grid here.
+	require.Equal(t, "ws://127.0.0.1:4444/session/abc/se/cdp", endpoint.WSEndpoint)
+	require.NoError(t, endpoint.Release())
+	require.Equal(t, "abc", deleted)
+
+	_, err = adapter.Resolve(grid.URL, "firefox")
+	require.ErrorContains(t, err, "only supports chromium")
+	_, err = adapter.Resolve(grid.URL, "chromium")
+	require.ErrorContains(t, err, "404 Not Found")
+}
+
+func TestSeleniumCDPEndpoint(t *testing.T) {
+	grid, err := url.Parse("http://grid.example.com:4444")
+	require.NoError(t, err)
+	require.Equal(t, "ws://grid.example.com:4444/session/abc/se/cdp", seleniumCDPEndpoint(grid, "ws://127.0.0.1:4444/session/abc/se/cdp"))
+	require.Equal(t, "ws://node-1:5555/session/abc/se/cdp", seleniumCDPEndpoint(grid, "ws://node-1:5555/session/abc/se/cdp"))
+}
+
+func TestMoonAdapter(t *testing.T) {
+	endpoint, err := NewMoonAdapter(MoonAdapterOptions{
+		Params: map[string]string{"headless": "false"},
+	}).Resolve("http://moon.example.com:4444/", "firefox")
+	require.NoError(t, err)
+	require.Equal(t, "ws://moon.example.com:4444/playwright/firefox/playwright-"+playwrightCliVersion+"?headless=false", endpoint.WSEndpoint)
+	require.False(t, endpoint.CDP)
+	require.Nil(t, endpoint.Release)
+
+	_, err = NewMoonAdapter().Resolve("ftp://moon.example.com", "firefox")
+	require.ErrorContains(t, err, "the scheme must be ws, wss, http or https")
+}
+
+func TestBrowserlessAdapter(t *testing.T) {
+	endpoint, err := NewBrowserlessAdapter(BrowserlessAdapterOptions{
+		Token:  String("secret"),
+		Params: map[string]string{"timeout": "60000"},
+	}).Resolve("wss://production-sfo.browserless.io", "webkit")
+	require.NoError(t, err)
+	require.Equal(t, "wss://production-sfo.browserless.io/webkit/playwright?timeout=60000&token=secret", endpoint.WSEndpoint)
+}
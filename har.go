@@ -0,0 +1,118 @@
+package playwright
+
+import (
+	"archive/zip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/playwright-community/playwright-go/har"
+)
+
+// Har is an HTTP Archive recorded with the RecordHarPath option or [Page.RouteFromHAR] in update mode. Use
+// [ReadHar] to load one. Its types are those of the har subpackage, which also reads and writes archives entry by
+// entry, and filters them.
+type Har struct {
+	har.HAR
+	// path is the file the archive was read from.
+	path string
+	// archive is set if the file is a zip archive, in which case attached content is stored next to the HAR in the
+	// archive instead of next to the file.
+	archive bool
+}
+
+type (
+	// HarLog is the root of the recorded data.
+	HarLog = har.Log
+	// HarCreator describes the application that recorded the HAR.
+	HarCreator = har.Creator
+	// HarEntry is a recorded request and its response.
+	HarEntry = har.Entry
+	// HarRequest describes a recorded request.
+	HarRequest = har.Request
+	// HarPostData is the body of a recorded request.
+	HarPostData = har.PostData
+	// HarResponse describes a recorded response.
+	HarResponse = har.Response
+	// HarContent is the body of a recorded response.
+	HarContent = har.Content
+)
+
+// ReadHar reads the HAR at path. The file is either a HAR, whose attached content files are stored next to it, or a
+// zip archive containing the HAR and its attached content, as recorded when the path ends with ".zip".
+func ReadHar(path string) (*Har, error) {
+	archive := &Har{path: path}
+	var data []byte
+	var err error
+	if strings.HasSuffix(strings.ToLower(path), ".zip") {
+		archive.archive = true
+		data, err = readArchivedHar(path)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read HAR %s: %w", path, err)
+	}
+	if err := json.Unmarshal(data, &archive.HAR); err != nil {
+		return nil, fmt.Errorf("could not parse HAR %s: %w", path, err)
+	}
+	return archive, nil
+}
+
+// ResponseBody returns the response body of entry, reading it from the attached file if the content was recorded
+// with [HarContentPolicyAttach]. The body is empty if the content was omitted.
+func (h *Har) ResponseBody(entry HarEntry) ([]byte, error) {
+	content := entry.Response.Content
+	if content.File != "" {
+		return h.attachment(content.File)
+	}
+	if content.Encoding == "base64" {
+		return base64.StdEncoding.DecodeString(content.Text)
+	}
+	return []byte(content.Text), nil
+}
+
+// RequestBody returns the post data of entry, nil if the request had none.
+func (h *Har) RequestBody(entry HarEntry) ([]byte, error) {
+	postData := entry.Request.PostData
+	if postData == nil {
+		return nil, nil
+	}
+	if postData.File != "" {
+		return h.attachment(postData.File)
+	}
+	return []byte(postData.Text), nil
+}
+
+func (h *Har) attachment(name string) ([]byte, error) {
+	if filepath.Base(name) != name {
+		return nil, fmt.Errorf("invalid HAR attachment name %q", name)
+	}
+	if !h.archive {
+		return os.ReadFile(filepath.Join(filepath.Dir(h.path), name))
+	}
+	reader, err := zip.OpenReader(h.path)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	for _, file := range reader.File {
+		if file.Name == name {
+			return readZipFile(file)
+		}
+	}
+	return nil, fmt.Errorf("attachment %s not found in HAR archive %s", name, h.path)
+}
+
+// readArchivedHar returns the content of the HAR in the zip archive at path.
+func readArchivedHar(path string) ([]byte, error) {
+	reader, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return readArchivedHarFile(&reader.Reader)
+}
@@ -0,0 +1,242 @@
+package har
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// knownFields are the names of the JSON fields of the types, by type.
+var knownFields sync.Map
+
+// fieldNames returns the names of the JSON fields of the struct type t.
+func fieldNames(t reflect.Type) map[string]bool {
+	if names, ok := knownFields.Load(t); ok {
+		return names.(map[string]bool)
+	}
+	names := make(map[string]bool)
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name != "-" && name != "" {
+			names[name] = true
+		}
+	}
+	knownFields.Store(t, names)
+	return names
+}
+
+// unmarshalWithExtra decodes data into fields, a pointer to a struct, and adds the fields of data that it doesn't
+// have to extra.
+func unmarshalWithExtra(data []byte, fields interface{}, extra *map[string]json.RawMessage) error {
+	if err := json.Unmarshal(data, fields); err != nil {
+		return err
+	}
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(data, &all); err != nil {
+		return err
+	}
+	known := fieldNames(reflect.TypeOf(fields).Elem())
+	for name, value := range all {
+		if known[name] {
+			continue
+		}
+		// The value is compacted, as the archive may be indented.
+		var compacted bytes.Buffer
+		if err := json.Compact(&compacted, value); err != nil {
+			return err
+		}
+		if *extra == nil {
+			*extra = make(map[string]json.RawMessage)
+		}
+		(*extra)[name] = compacted.Bytes()
+	}
+	return nil
+}
+
+// marshalWithExtra encodes fields, a struct, with the fields of extra it doesn't have before its own, so that the
+// entries stay the last field of a [Log].
+func marshalWithExtra(fields interface{}, extra map[string]json.RawMessage) ([]byte, error) {
+	data, err := json.Marshal(fields)
+	if err != nil || len(extra) == 0 {
+		return data, err
+	}
+	known := fieldNames(reflect.TypeOf(fields))
+	names := make([]string, 0, len(extra))
+	for name := range extra {
+		if !known[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	var b bytes.Buffer
+	b.WriteByte('{')
+	for _, name := range names {
+		key, err := json.Marshal(name)
+		if err != nil {
+			return nil, err
+		}
+		b.Write(key)
+		b.WriteByte(':')
+		b.Write(extra[name])
+		b.WriteByte(',')
+	}
+	if len(names) > 0 && data[1] == '}' {
+		// The struct has no fields of its own, so no comma follows the extra fields.
+		b.Truncate(b.Len() - 1)
+	}
+	b.Write(data[1:])
+	return b.Bytes(), nil
+}
+
+type logFields Log
+
+func (l *Log) UnmarshalJSON(data []byte) error {
+	return unmarshalWithExtra(data, (*logFields)(l), &l.Extra)
+}
+
+func (l Log) MarshalJSON() ([]byte, error) {
+	return marshalWithExtra(logFields(l), l.Extra)
+}
+
+type creatorFields Creator
+
+func (c *Creator) UnmarshalJSON(data []byte) error {
+	return unmarshalWithExtra(data, (*creatorFields)(c), &c.Extra)
+}
+
+func (c Creator) MarshalJSON() ([]byte, error) {
+	return marshalWithExtra(creatorFields(c), c.Extra)
+}
+
+type pageFields Page
+
+func (p *Page) UnmarshalJSON(data []byte) error {
+	return unmarshalWithExtra(data, (*pageFields)(p), &p.Extra)
+}
+
+func (p Page) MarshalJSON() ([]byte, error) {
+	return marshalWithExtra(pageFields(p), p.Extra)
+}
+
+type pageTimingsFields PageTimings
+
+func (p *PageTimings) UnmarshalJSON(data []byte) error {
+	return unmarshalWithExtra(data, (*pageTimingsFields)(p), &p.Extra)
+}
+
+func (p PageTimings) MarshalJSON() ([]byte, error) {
+	return marshalWithExtra(pageTimingsFields(p), p.Extra)
+}
+
+type entryFields Entry
+
+func (e *Entry) UnmarshalJSON(data []byte) error {
+	return unmarshalWithExtra(data, (*entryFields)(e), &e.Extra)
+}
+
+func (e Entry) MarshalJSON() ([]byte, error) {
+	return marshalWithExtra(entryFields(e), e.Extra)
+}
+
+type requestFields Request
+
+func (r *Request) UnmarshalJSON(data []byte) error {
+	return unmarshalWithExtra(data, (*requestFields)(r), &r.Extra)
+}
+
+func (r Request) MarshalJSON() ([]byte, error) {
+	return marshalWithExtra(requestFields(r), r.Extra)
+}
+
+type responseFields Response
+
+func (r *Response) UnmarshalJSON(data []byte) error {
+	return unmarshalWithExtra(data, (*responseFields)(r), &r.Extra)
+}
+
+func (r Response) MarshalJSON() ([]byte, error) {
+	return marshalWithExtra(responseFields(r), r.Extra)
+}
+
+type cookieFields Cookie
+
+func (c *Cookie) UnmarshalJSON(data []byte) error {
+	return unmarshalWithExtra(data, (*cookieFields)(c), &c.Extra)
+}
+
+func (c Cookie) MarshalJSON() ([]byte, error) {
+	return marshalWithExtra(cookieFields(c), c.Extra)
+}
+
+type nameValueFields NameValue
+
+func (n *NameValue) UnmarshalJSON(data []byte) error {
+	return unmarshalWithExtra(data, (*nameValueFields)(n), &n.Extra)
+}
+
+func (n NameValue) MarshalJSON() ([]byte, error) {
+	return marshalWithExtra(nameValueFields(n), n.Extra)
+}
+
+type postDataFields PostData
+
+func (p *PostData) UnmarshalJSON(data []byte) error {
+	return unmarshalWithExtra(data, (*postDataFields)(p), &p.Extra)
+}
+
+func (p PostData) MarshalJSON() ([]byte, error) {
+	return marshalWithExtra(postDataFields(p), p.Extra)
+}
+
+type paramFields Param
+
+func (p *Param) UnmarshalJSON(data []byte) error {
+	return unmarshalWithExtra(data, (*paramFields)(p), &p.Extra)
+}
+
+func (p Param) MarshalJSON() ([]byte, error) {
+	return marshalWithExtra(paramFields(p), p.Extra)
+}
+
+type contentFields Content
+
+func (c *Content) UnmarshalJSON(data []byte) error {
+	return unmarshalWithExtra(data, (*contentFields)(c), &c.Extra)
+}
+
+func (c Content) MarshalJSON() ([]byte, error) {
+	return marshalWithExtra(contentFields(c), c.Extra)
+}
+
+type cacheFields Cache
+
+func (c *Cache) UnmarshalJSON(data []byte) error {
+	return unmarshalWithExtra(data, (*cacheFields)(c), &c.Extra)
+}
+
+func (c Cache) MarshalJSON() ([]byte, error) {
+	return marshalWithExtra(cacheFields(c), c.Extra)
+}
+
+type cacheEntryFields CacheEntry
+
+func (c *CacheEntry) UnmarshalJSON(data []byte) error {
+	return unmarshalWithExtra(data, (*cacheEntryFields)(c), &c.Extra)
+}
+
+func (c CacheEntry) MarshalJSON() ([]byte, error) {
+	return marshalWithExtra(cacheEntryFields(c), c.Extra)
+}
+
+type timingsFields Timings
+
+func (t *Timings) UnmarshalJSON(data []byte) error {
+	return unmarshalWithExtra(data, (*timingsFields)(t), &t.Extra)
+}
+
+func (t Timings) MarshalJSON() ([]byte, error) {
+	return marshalWithExtra(timingsFields(t), t.Extra)
+}
@@ -0,0 +1,98 @@
+package har
+
+import (
+	"mime"
+	"regexp"
+	"strings"
+)
+
+// Filter reports whether an entry matches, see [HAR.Filter].
+type Filter func(entry *Entry) bool
+
+// URL matches the entries whose request URL matches pattern.
+func URL(pattern *regexp.Regexp) Filter {
+	return func(entry *Entry) bool {
+		return pattern.MatchString(entry.Request.URL)
+	}
+}
+
+// Method matches the entries whose request method is one of methods, case insensitively.
+func Method(methods ...string) Filter {
+	return func(entry *Entry) bool {
+		for _, method := range methods {
+			if strings.EqualFold(entry.Request.Method, method) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Status matches the entries whose response status is one of statuses.
+func Status(statuses ...int) Filter {
+	return func(entry *Entry) bool {
+		for _, status := range statuses {
+			if entry.Response.Status == status {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// StatusRange matches the entries whose response status is between min and max, inclusive, such as 400 and 599 for
+// the errors.
+func StatusRange(min, max int) Filter {
+	return func(entry *Entry) bool {
+		return entry.Response.Status >= min && entry.Response.Status <= max
+	}
+}
+
+// MimeType matches the entries whose response content has one of types, ignoring its parameters. A type ending with
+// a slash, such as `image/`, matches all its subtypes.
+func MimeType(types ...string) Filter {
+	return func(entry *Entry) bool {
+		mimeType, _, err := mime.ParseMediaType(entry.Response.Content.MimeType)
+		if err != nil {
+			mimeType = strings.ToLower(strings.TrimSpace(entry.Response.Content.MimeType))
+		}
+		for _, t := range types {
+			t = strings.ToLower(t)
+			if mimeType == t || (strings.HasSuffix(t, "/") && strings.HasPrefix(mimeType, t)) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// And matches the entries matching all the filters.
+func And(filters ...Filter) Filter {
+	return func(entry *Entry) bool {
+		for _, filter := range filters {
+			if !filter(entry) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Or matches the entries matching any of the filters.
+func Or(filters ...Filter) Filter {
+	return func(entry *Entry) bool {
+		for _, filter := range filters {
+			if filter(entry) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Not matches the entries not matching filter.
+func Not(filter Filter) Filter {
+	return func(entry *Entry) bool {
+		return !filter(entry)
+	}
+}
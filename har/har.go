@@ -0,0 +1,262 @@
+// Package har reads and writes HTTP Archives in the [HAR 1.2] format, such as those recorded with the RecordHarPath
+// option of playwright.BrowserNewContextOptions, and filters their entries.
+//
+// Archives are read and written as a whole with [ReadFile] and [WriteFile], or entry by entry with a [Decoder] and an
+// [Encoder], so that large archives don't have to fit in memory:
+//
+//	archive, err := har.ReadFile("recording.har")
+//	images := archive.Filter(har.And(har.MimeType("image/"), har.Status(200)))
+//
+// The content recorded in attached files is referenced by [Content.File] and [PostData.File], relative to the
+// archive. Zip archives are not supported, see playwright.ReadHar for those.
+//
+// The fields that are not part of the format, such as the `_transferSize` and `_securityDetails` recorded by
+// Playwright, are kept by name in the Extra field of the types that have them and written back before the others.
+//
+// [HAR 1.2]: http://www.softwareishard.com/blog/har-12-spec
+package har
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// HAR is an HTTP Archive.
+type HAR struct {
+	Log Log `json:"log"`
+}
+
+// Log is the root of the recorded data.
+type Log struct {
+	Version string                     `json:"version"`
+	Creator Creator                    `json:"creator"`
+	Browser *Creator                   `json:"browser,omitempty"`
+	Pages   []Page                     `json:"pages,omitempty"`
+	Comment string                     `json:"comment,omitempty"`
+	Extra   map[string]json.RawMessage `json:"-"`
+	// Entries is the last field, so that the [Encoder] can stream the entries after the other fields.
+	Entries []Entry `json:"entries"`
+}
+
+// Creator is the application that recorded the archive, or the browser it recorded.
+type Creator struct {
+	Name    string                     `json:"name"`
+	Version string                     `json:"version"`
+	Comment string                     `json:"comment,omitempty"`
+	Extra   map[string]json.RawMessage `json:"-"`
+}
+
+// Page is a page whose requests were recorded.
+type Page struct {
+	StartedDateTime time.Time                  `json:"startedDateTime"`
+	ID              string                     `json:"id"`
+	Title           string                     `json:"title"`
+	PageTimings     PageTimings                `json:"pageTimings"`
+	Comment         string                     `json:"comment,omitempty"`
+	Extra           map[string]json.RawMessage `json:"-"`
+}
+
+// PageTimings are the times of the events of a page, in milliseconds since it started loading, -1 if not available.
+type PageTimings struct {
+	OnContentLoad *float64                   `json:"onContentLoad,omitempty"`
+	OnLoad        *float64                   `json:"onLoad,omitempty"`
+	Comment       string                     `json:"comment,omitempty"`
+	Extra         map[string]json.RawMessage `json:"-"`
+}
+
+// Entry is a recorded request and its response.
+type Entry struct {
+	Pageref         string    `json:"pageref,omitempty"`
+	StartedDateTime time.Time `json:"startedDateTime"`
+	// Time is the duration of the request in milliseconds, the sum of its timings.
+	Time            float64                    `json:"time"`
+	Request         Request                    `json:"request"`
+	Response        Response                   `json:"response"`
+	Cache           Cache                      `json:"cache"`
+	Timings         Timings                    `json:"timings"`
+	ServerIPAddress string                     `json:"serverIPAddress,omitempty"`
+	Connection      string                     `json:"connection,omitempty"`
+	Comment         string                     `json:"comment,omitempty"`
+	Extra           map[string]json.RawMessage `json:"-"`
+}
+
+// Request is a recorded request.
+type Request struct {
+	Method      string      `json:"method"`
+	URL         string      `json:"url"`
+	HTTPVersion string      `json:"httpVersion"`
+	Cookies     []Cookie    `json:"cookies"`
+	Headers     []NameValue `json:"headers"`
+	QueryString []NameValue `json:"queryString"`
+	PostData    *PostData   `json:"postData,omitempty"`
+	// HeadersSize is the size of the headers in bytes, -1 if not available.
+	HeadersSize int64 `json:"headersSize"`
+	// BodySize is the size of the body in bytes, -1 if not available.
+	BodySize int64                      `json:"bodySize"`
+	Comment  string                     `json:"comment,omitempty"`
+	Extra    map[string]json.RawMessage `json:"-"`
+}
+
+// Response is a recorded response.
+type Response struct {
+	Status      int         `json:"status"`
+	StatusText  string      `json:"statusText"`
+	HTTPVersion string      `json:"httpVersion"`
+	Cookies     []Cookie    `json:"cookies"`
+	Headers     []NameValue `json:"headers"`
+	Content     Content     `json:"content"`
+	RedirectURL string      `json:"redirectURL"`
+	// HeadersSize is the size of the headers in bytes, -1 if not available.
+	HeadersSize int64 `json:"headersSize"`
+	// BodySize is the size of the received body in bytes, -1 if not available.
+	BodySize int64                      `json:"bodySize"`
+	Comment  string                     `json:"comment,omitempty"`
+	Extra    map[string]json.RawMessage `json:"-"`
+}
+
+// Cookie is a cookie of a request or a response.
+type Cookie struct {
+	Name     string                     `json:"name"`
+	Value    string                     `json:"value"`
+	Path     string                     `json:"path,omitempty"`
+	Domain   string                     `json:"domain,omitempty"`
+	Expires  *time.Time                 `json:"expires,omitempty"`
+	HTTPOnly bool                       `json:"httpOnly,omitempty"`
+	Secure   bool                       `json:"secure,omitempty"`
+	Comment  string                     `json:"comment,omitempty"`
+	Extra    map[string]json.RawMessage `json:"-"`
+}
+
+// NameValue is a header or a query string parameter.
+type NameValue struct {
+	Name    string                     `json:"name"`
+	Value   string                     `json:"value"`
+	Comment string                     `json:"comment,omitempty"`
+	Extra   map[string]json.RawMessage `json:"-"`
+}
+
+// PostData is the body of a request.
+type PostData struct {
+	MimeType string  `json:"mimeType"`
+	Params   []Param `json:"params,omitempty"`
+	Text     string  `json:"text"`
+	Comment  string  `json:"comment,omitempty"`
+	// File is the name of the attached file holding the body, when Playwright recorded it with the `attach` content
+	// policy.
+	File  string                     `json:"_file,omitempty"`
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// Param is a parameter of a form posted by a request.
+type Param struct {
+	Name        string                     `json:"name"`
+	Value       string                     `json:"value,omitempty"`
+	FileName    string                     `json:"fileName,omitempty"`
+	ContentType string                     `json:"contentType,omitempty"`
+	Comment     string                     `json:"comment,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+// Content is the body of a response.
+type Content struct {
+	// Size is the size of the decoded body in bytes.
+	Size        int64  `json:"size"`
+	Compression *int64 `json:"compression,omitempty"`
+	MimeType    string `json:"mimeType"`
+	// Text is the body, base64 encoded if Encoding is `base64`, empty if it was omitted or attached.
+	Text     string `json:"text,omitempty"`
+	Encoding string `json:"encoding,omitempty"`
+	Comment  string `json:"comment,omitempty"`
+	// File is the name of the attached file holding the body, when Playwright recorded it with the `attach` content
+	// policy.
+	File  string                     `json:"_file,omitempty"`
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// Cache is the state of the cache entry of a request before and after it.
+type Cache struct {
+	BeforeRequest *CacheEntry                `json:"beforeRequest,omitempty"`
+	AfterRequest  *CacheEntry                `json:"afterRequest,omitempty"`
+	Comment       string                     `json:"comment,omitempty"`
+	Extra         map[string]json.RawMessage `json:"-"`
+}
+
+// CacheEntry is an entry of the cache.
+type CacheEntry struct {
+	Expires    *time.Time                 `json:"expires,omitempty"`
+	LastAccess time.Time                  `json:"lastAccess"`
+	ETag       string                     `json:"eTag"`
+	HitCount   int                        `json:"hitCount"`
+	Comment    string                     `json:"comment,omitempty"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+// Timings are the durations of the phases of a request in milliseconds, -1 if not available.
+type Timings struct {
+	Blocked float64                    `json:"blocked,omitempty"`
+	DNS     float64                    `json:"dns,omitempty"`
+	Connect float64                    `json:"connect,omitempty"`
+	Send    float64                    `json:"send"`
+	Wait    float64                    `json:"wait"`
+	Receive float64                    `json:"receive"`
+	SSL     float64                    `json:"ssl,omitempty"`
+	Comment string                     `json:"comment,omitempty"`
+	Extra   map[string]json.RawMessage `json:"-"`
+}
+
+// Decode reads a whole archive from r.
+func Decode(r io.Reader) (*HAR, error) {
+	var archive HAR
+	if err := json.NewDecoder(r).Decode(&archive); err != nil {
+		return nil, fmt.Errorf("could not decode HAR: %w", err)
+	}
+	return &archive, nil
+}
+
+// Encode writes the archive to w as indented JSON.
+func (h *HAR) Encode(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(h)
+}
+
+// ReadFile reads the archive at path.
+func ReadFile(path string) (*HAR, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	archive, err := Decode(file)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return archive, nil
+}
+
+// WriteFile writes the archive to path.
+func (h *HAR) WriteFile(path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := h.Encode(file); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// Filter returns the entries of the archive matching filter.
+func (h *HAR) Filter(filter Filter) []Entry {
+	entries := make([]Entry, 0)
+	for i := range h.Log.Entries {
+		if filter(&h.Log.Entries[i]) {
+			entries = append(entries, h.Log.Entries[i])
+		}
+	}
+	return entries
+}
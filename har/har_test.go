@@ -0,0 +1,148 @@
+package har
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testArchive = `{"log":{"version":"1.2","creator":{"name":"Playwright","version":"1.43.0"},
+"browser":{"name":"chromium","version":"124.0"},
+"entries":[
+{"startedDateTime":"2024-04-01T10:00:00.000Z","time":12.5,"_monotonicTime":103.2,"_securityDetails":{"protocol":"TLS 1.3"},
+ "request":{"method":"GET","url":"http://localhost/index.html","httpVersion":"HTTP/1.1","cookies":[],"headers":[{"name":"Accept","value":"text/html"}],"queryString":[],"headersSize":-1,"bodySize":0},
+ "response":{"status":200,"statusText":"OK","httpVersion":"HTTP/1.1","cookies":[],"headers":[],"content":{"size":5,"mimeType":"text/html; charset=utf-8","text":"hello"},"redirectURL":"","headersSize":-1,"bodySize":5,"_transferSize":120},
+ "cache":{},"timings":{"send":1,"wait":10,"receive":1.5}},
+{"startedDateTime":"2024-04-01T10:00:01.000Z","time":3,
+ "request":{"method":"POST","url":"http://localhost/api","httpVersion":"HTTP/1.1","cookies":[],"headers":[],"queryString":[],"postData":{"mimeType":"text/plain","text":"","_file":"post.txt"},"headersSize":-1,"bodySize":4},
+ "response":{"status":500,"statusText":"Internal Server Error","httpVersion":"HTTP/1.1","cookies":[],"headers":[],"content":{"size":4,"mimeType":"application/json","_file":"body.json"},"redirectURL":"","headersSize":-1,"bodySize":4},
+ "cache":{},"timings":{"send":1,"wait":1,"receive":1}},
+{"startedDateTime":"2024-04-01T10:00:02.000Z","time":1,
+ "request":{"method":"GET","url":"http://localhost/logo.png","httpVersion":"HTTP/1.1","cookies":[],"headers":[],"queryString":[],"headersSize":-1,"bodySize":0},
+ "response":{"status":200,"statusText":"OK","httpVersion":"HTTP/1.1","cookies":[],"headers":[],"content":{"size":3,"mimeType":"image/png","text":"AAEC","encoding":"base64"},"redirectURL":"","headersSize":-1,"bodySize":3},
+ "cache":{},"timings":{"send":0,"wait":1,"receive":0}}],
+"pages":[{"startedDateTime":"2024-04-01T10:00:00.000Z","id":"page@1","title":"Index","pageTimings":{"onLoad":20}}]}}`
+
+func TestDecode(t *testing.T) {
+	archive, err := Decode(strings.NewReader(testArchive))
+	require.NoError(t, err)
+	require.Equal(t, "Playwright", archive.Log.Creator.Name)
+	require.Equal(t, "chromium", archive.Log.Browser.Name)
+	require.Len(t, archive.Log.Entries, 3)
+	require.Len(t, archive.Log.Pages, 1)
+	require.Equal(t, 20.0, *archive.Log.Pages[0].PageTimings.OnLoad)
+	entry := archive.Log.Entries[1]
+	require.Equal(t, "post.txt", entry.Request.PostData.File)
+	require.Equal(t, "body.json", entry.Response.Content.File)
+	require.Equal(t, int64(-1), entry.Request.HeadersSize)
+	require.Equal(t, 2024, entry.StartedDateTime.Year())
+}
+
+func TestExtraFields(t *testing.T) {
+	archive, err := Decode(strings.NewReader(testArchive))
+	require.NoError(t, err)
+	entry := archive.Log.Entries[0]
+	require.JSONEq(t, `{"protocol":"TLS 1.3"}`, string(entry.Extra["_securityDetails"]))
+	require.JSONEq(t, `103.2`, string(entry.Extra["_monotonicTime"]))
+	require.JSONEq(t, `120`, string(entry.Response.Extra["_transferSize"]))
+	require.Nil(t, entry.Request.Extra)
+	require.Nil(t, archive.Log.Entries[1].Extra)
+
+	data, err := json.Marshal(entry.Response)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(data), `{"_transferSize":120,"status":200,`), string(data))
+	data, err = json.Marshal(Cache{Extra: map[string]json.RawMessage{"_hit": json.RawMessage(`true`)}})
+	require.NoError(t, err)
+	require.Equal(t, `{"_hit":true}`, string(data))
+}
+
+func TestReadWriteFile(t *testing.T) {
+	archive, err := Decode(strings.NewReader(testArchive))
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "archive.har")
+	require.NoError(t, archive.WriteFile(path))
+	read, err := ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, archive, read)
+
+	_, err = ReadFile(filepath.Join(t.TempDir(), "missing.har"))
+	require.Error(t, err)
+}
+
+func TestDecoder(t *testing.T) {
+	decoder := NewDecoder(strings.NewReader(testArchive))
+	urls := []string{}
+	for {
+		entry, err := decoder.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		urls = append(urls, entry.Request.URL)
+	}
+	require.Equal(t, []string{"http://localhost/index.html", "http://localhost/api", "http://localhost/logo.png"}, urls)
+	log := decoder.Log()
+	require.Equal(t, "1.2", log.Version)
+	require.Equal(t, "Playwright", log.Creator.Name)
+	// The pages are after the entries.
+	require.Len(t, log.Pages, 1)
+	require.Nil(t, log.Entries)
+	_, err := decoder.Next()
+	require.ErrorIs(t, err, io.EOF)
+
+	_, err = NewDecoder(strings.NewReader(`{"other": 1}`)).Next()
+	require.ErrorContains(t, err, "no log")
+	_, err = NewDecoder(strings.NewReader(`[]`)).Next()
+	require.Error(t, err)
+}
+
+func TestEncoder(t *testing.T) {
+	archive, err := Decode(strings.NewReader(testArchive))
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	encoder, err := NewEncoder(&buf, archive.Log)
+	require.NoError(t, err)
+	for i := range archive.Log.Entries {
+		require.NoError(t, encoder.Encode(&archive.Log.Entries[i]))
+	}
+	require.NoError(t, encoder.Close())
+	encoded, err := Decode(&buf)
+	require.NoError(t, err)
+	require.Equal(t, archive, encoded)
+
+	buf.Reset()
+	encoder, err = NewEncoder(&buf, Log{Version: "1.2", Creator: Creator{Name: "test"}})
+	require.NoError(t, err)
+	require.NoError(t, encoder.Close())
+	encoded, err = Decode(&buf)
+	require.NoError(t, err)
+	require.Empty(t, encoded.Log.Entries)
+}
+
+func TestFilters(t *testing.T) {
+	archive, err := Decode(strings.NewReader(testArchive))
+	require.NoError(t, err)
+	urls := func(filter Filter) []string {
+		urls := []string{}
+		for _, entry := range archive.Filter(filter) {
+			urls = append(urls, entry.Request.URL)
+		}
+		return urls
+	}
+	require.Equal(t, []string{"http://localhost/logo.png"}, urls(URL(regexp.MustCompile(`\.png$`))))
+	require.Equal(t, []string{"http://localhost/api"}, urls(Method("post")))
+	require.Equal(t, []string{"http://localhost/api"}, urls(StatusRange(400, 599)))
+	require.Equal(t, []string{"http://localhost/index.html", "http://localhost/logo.png"}, urls(Status(200)))
+	require.Equal(t, []string{"http://localhost/index.html"}, urls(MimeType("text/html")))
+	require.Equal(t, []string{"http://localhost/logo.png"}, urls(MimeType("IMAGE/")))
+	require.Equal(t, []string{"http://localhost/index.html"}, urls(And(Status(200), Not(MimeType("image/")))))
+	require.Equal(t, []string{"http://localhost/api", "http://localhost/logo.png"}, urls(Or(Method("POST"), MimeType("image/png"))))
+	require.Empty(t, urls(And(Status(404))))
+}
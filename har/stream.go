@@ -0,0 +1,179 @@
+package har
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Decoder reads the entries of an archive one at a time.
+//
+//	decoder := har.NewDecoder(file)
+//	for {
+//		entry, err := decoder.Next()
+//		if errors.Is(err, io.EOF) {
+//			break
+//		}
+//		...
+//	}
+type Decoder struct {
+	decoder *json.Decoder
+	log     Log
+	// inEntries is whether the decoder is in the entries array.
+	inEntries bool
+	started   bool
+	done      bool
+}
+
+// NewDecoder returns a decoder reading the archive from r.
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{decoder: json.NewDecoder(r)}
+}
+
+// Log returns the fields of the log other than the entries. Those after the entries in the archive are only set once
+// [Decoder.Next] returned io.EOF.
+func (d *Decoder) Log() Log {
+	return d.log
+}
+
+// Next returns the next entry of the archive, or io.EOF once all the entries were read.
+func (d *Decoder) Next() (*Entry, error) {
+	if d.done {
+		return nil, io.EOF
+	}
+	if !d.started {
+		d.started = true
+		if err := d.enterLog(); err != nil {
+			return nil, err
+		}
+	}
+	for {
+		if d.inEntries {
+			if d.decoder.More() {
+				var entry Entry
+				if err := d.decoder.Decode(&entry); err != nil {
+					return nil, fmt.Errorf("could not decode HAR entry: %w", err)
+				}
+				return &entry, nil
+			}
+			// The end of the entries.
+			if _, err := d.decoder.Token(); err != nil {
+				return nil, err
+			}
+			d.inEntries = false
+		}
+		if !d.decoder.More() {
+			d.done = true
+			return nil, io.EOF
+		}
+		key, err := d.decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		if key == "entries" {
+			if err := d.expectDelim('['); err != nil {
+				return nil, err
+			}
+			d.inEntries = true
+			continue
+		}
+		if err := d.decodeLogField(key.(string)); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// enterLog moves the decoder into the log object of the archive.
+func (d *Decoder) enterLog() error {
+	if err := d.expectDelim('{'); err != nil {
+		return err
+	}
+	for d.decoder.More() {
+		key, err := d.decoder.Token()
+		if err != nil {
+			return err
+		}
+		if key == "log" {
+			return d.expectDelim('{')
+		}
+		var skipped json.RawMessage
+		if err := d.decoder.Decode(&skipped); err != nil {
+			return err
+		}
+	}
+	return errors.New("could not decode HAR: no log")
+}
+
+// decodeLogField decodes the value of the field of the log named key.
+func (d *Decoder) decodeLogField(key string) error {
+	var value json.RawMessage
+	if err := d.decoder.Decode(&value); err != nil {
+		return err
+	}
+	// The field is decoded in an object holding it alone, so that the fields of the log already read are kept.
+	data, err := json.Marshal(map[string]json.RawMessage{key: value})
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, &d.log); err != nil {
+		return fmt.Errorf("could not decode HAR log field %s: %w", key, err)
+	}
+	return nil
+}
+
+func (d *Decoder) expectDelim(delim json.Delim) error {
+	token, err := d.decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return fmt.Errorf("could not decode HAR: expected %s, got %v", delim, token)
+	}
+	return nil
+}
+
+// Encoder writes an archive one entry at a time. [Encoder.Close] must be called to complete the archive.
+type Encoder struct {
+	w       io.Writer
+	entries int
+}
+
+// NewEncoder writes the fields of log other than its entries to w, and returns an encoder writing the entries after
+// them.
+func NewEncoder(w io.Writer, log Log) (*Encoder, error) {
+	log.Entries = []Entry{}
+	data, err := json.Marshal(HAR{Log: log})
+	if err != nil {
+		return nil, err
+	}
+	// The entries are the last field of the log, so that the archive ends with the empty entries `[]}}`.
+	header := bytes.TrimSuffix(data, []byte("]}}"))
+	if _, err := w.Write(header); err != nil {
+		return nil, err
+	}
+	return &Encoder{w: w}, nil
+}
+
+// Encode writes entry to the archive.
+func (e *Encoder) Encode(entry *Entry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if e.entries > 0 {
+		data = append([]byte(","), data...)
+	}
+	if _, err := e.w.Write(data); err != nil {
+		return err
+	}
+	e.entries++
+	return nil
+}
+
+// Close completes the archive. It doesn't close the underlying writer.
+func (e *Encoder) Close() error {
+	_, err := e.w.Write([]byte("]}}"))
+	return err
+}
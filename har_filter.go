@@ -0,0 +1,246 @@
+package playwright
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// harEntryFilter drops the entries of a recorded HAR that don't match any of its URL
+// patterns or methods. The server only filters by a single URL pattern, so recordings with
+// several patterns or a method filter are filtered when the HAR is exported.
+type harEntryFilter struct {
+	urls    []*urlMatcher
+	methods []string
+}
+
+// harURLPatterns flattens a URL filter into its patterns, a glob string or a regexp each.
+func harURLPatterns(urlFilter interface{}) ([]interface{}, error) {
+	switch v := urlFilter.(type) {
+	case nil:
+		return nil, nil
+	case string, *regexp.Regexp:
+		return []interface{}{v}, nil
+	case []string:
+		patterns := make([]interface{}, 0, len(v))
+		for _, pattern := range v {
+			patterns = append(patterns, pattern)
+		}
+		return patterns, nil
+	case []*regexp.Regexp:
+		patterns := make([]interface{}, 0, len(v))
+		for _, pattern := range v {
+			patterns = append(patterns, pattern)
+		}
+		return patterns, nil
+	case []interface{}:
+		for _, pattern := range v {
+			switch pattern.(type) {
+			case string, *regexp.Regexp:
+			default:
+				return nil, fmt.Errorf("invalid HAR URL filter %T: expected a string or *regexp.Regexp", pattern)
+			}
+		}
+		return v, nil
+	}
+	return nil, fmt.Errorf("invalid HAR URL filter %T: expected a string, *regexp.Regexp or a slice of those", urlFilter)
+}
+
+// serverHarURLFilter returns the pattern the server can filter by, or nil if the filter
+// needs several patterns.
+func serverHarURLFilter(urlFilter interface{}) interface{} {
+	patterns, err := harURLPatterns(urlFilter)
+	if err != nil || len(patterns) != 1 {
+		return nil
+	}
+	return patterns[0]
+}
+
+// newHarEntryFilter returns the filter to apply to the exported HAR, or nil if the server
+// filters the recording on its own.
+func newHarEntryFilter(urlFilter interface{}, methods []string, baseURL *string) (*harEntryFilter, error) {
+	patterns, err := harURLPatterns(urlFilter)
+	if err != nil {
+		return nil, err
+	}
+	if len(patterns) <= 1 && len(methods) == 0 {
+		return nil, nil
+	}
+	filter := &harEntryFilter{}
+	for _, pattern := range patterns {
+		filter.urls = append(filter.urls, newURLMatcher(pattern, baseURL))
+	}
+	for _, method := range methods {
+		filter.methods = append(filter.methods, strings.ToUpper(method))
+	}
+	return filter, nil
+}
+
+func (f *harEntryFilter) matches(method string, url string) bool {
+	if len(f.methods) > 0 {
+		found := false
+		for _, m := range f.methods {
+			if m == strings.ToUpper(method) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(f.urls) == 0 {
+		return true
+	}
+	for _, matcher := range f.urls {
+		if matcher.Matches(url) {
+			return true
+		}
+	}
+	return false
+}
+
+// apply filters the HAR file at path, which is either a HAR or a zip archive containing one.
+func (f *harEntryFilter) apply(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if strings.HasSuffix(strings.ToLower(path), ".zip") {
+		data, err = f.filterArchive(data)
+	} else {
+		data, err = f.filterHar(data)
+	}
+	if err != nil {
+		return fmt.Errorf("could not filter HAR %s: %w", path, err)
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+func (f *harEntryFilter) filterHar(data []byte) ([]byte, error) {
+	data, _, err := f.filterHarEntries(data)
+	return data, err
+}
+
+// filterHarEntries filters the entries of the HAR, and returns it with the names of the attached files its kept
+// entries reference.
+func (f *harEntryFilter) filterHarEntries(data []byte) ([]byte, map[string]bool, error) {
+	var har map[string]json.RawMessage
+	if err := json.Unmarshal(data, &har); err != nil {
+		return nil, nil, err
+	}
+	var log map[string]json.RawMessage
+	if err := json.Unmarshal(har["log"], &log); err != nil {
+		return nil, nil, err
+	}
+	var entries []json.RawMessage
+	if err := json.Unmarshal(log["entries"], &entries); err != nil {
+		return nil, nil, err
+	}
+	kept := make([]json.RawMessage, 0, len(entries))
+	attachments := make(map[string]bool)
+	for _, entry := range entries {
+		var parsed struct {
+			Request struct {
+				Method   string `json:"method"`
+				URL      string `json:"url"`
+				PostData *struct {
+					File string `json:"_file"`
+				} `json:"postData"`
+			} `json:"request"`
+			Response struct {
+				Content struct {
+					File string `json:"_file"`
+				} `json:"content"`
+			} `json:"response"`
+		}
+		if err := json.Unmarshal(entry, &parsed); err != nil {
+			return nil, nil, err
+		}
+		if !f.matches(parsed.Request.Method, parsed.Request.URL) {
+			continue
+		}
+		kept = append(kept, entry)
+		if parsed.Request.PostData != nil && parsed.Request.PostData.File != "" {
+			attachments[parsed.Request.PostData.File] = true
+		}
+		if parsed.Response.Content.File != "" {
+			attachments[parsed.Response.Content.File] = true
+		}
+	}
+	var err error
+	if log["entries"], err = json.Marshal(kept); err != nil {
+		return nil, nil, err
+	}
+	if har["log"], err = json.Marshal(log); err != nil {
+		return nil, nil, err
+	}
+	data, err = json.Marshal(har)
+	return data, attachments, err
+}
+
+// filterArchive filters the HAR of the zip archive, and drops the attached files that only the dropped entries
+// referenced.
+func (f *harEntryFilter) filterArchive(data []byte) ([]byte, error) {
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	hars := make(map[string][]byte)
+	attachments := make(map[string]bool)
+	for _, file := range reader.File {
+		if !strings.HasSuffix(file.Name, ".har") {
+			continue
+		}
+		content, err := readZipFile(file)
+		if err != nil {
+			return nil, err
+		}
+		content, referenced, err := f.filterHarEntries(content)
+		if err != nil {
+			return nil, err
+		}
+		hars[file.Name] = content
+		for name := range referenced {
+			attachments[name] = true
+		}
+	}
+	var out bytes.Buffer
+	writer := zip.NewWriter(&out)
+	for _, file := range reader.File {
+		content, ok := hars[file.Name]
+		if !ok {
+			if !attachments[file.Name] {
+				continue
+			}
+			if content, err = readZipFile(file); err != nil {
+				return nil, err
+			}
+		}
+		w, err := writer.Create(file.Name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write(content); err != nil {
+			return nil, err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+func readZipFile(file *zip.File) ([]byte, error) {
+	r, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
@@ -0,0 +1,104 @@
+package playwright
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testHar = `{"log":{"version":"1.2","entries":[
+{"request":{"method":"GET","url":"http://localhost/api/users"}},
+{"request":{"method":"POST","url":"http://localhost/api/users"}},
+{"request":{"method":"GET","url":"http://localhost/index.html"}},
+{"request":{"method":"GET","url":"http://localhost/style.css"}}]}}`
+
+func harEntryURLs(t *testing.T, data []byte) []string {
+	var har struct {
+		Log struct {
+			Version string `json:"version"`
+			Entries []struct {
+				Request struct {
+					Method string `json:"method"`
+					URL    string `json:"url"`
+				} `json:"request"`
+			} `json:"entries"`
+		} `json:"log"`
+	}
+	require.NoError(t, json.Unmarshal(data, &har))
+	require.Equal(t, "1.2", har.Log.Version)
+	urls := []string{}
+	for _, entry := range har.Log.Entries {
+		urls = append(urls, entry.Request.Method+" "+entry.Request.URL)
+	}
+	return urls
+}
+
+func TestNewHarEntryFilter(t *testing.T) {
+	filter, err := newHarEntryFilter("**/api/*", nil, nil)
+	require.NoError(t, err)
+	require.Nil(t, filter)
+	require.Equal(t, "**/api/*", serverHarURLFilter("**/api/*"))
+	require.Nil(t, serverHarURLFilter([]string{"**/api/*", "**/*.css"}))
+
+	filter, err = newHarEntryFilter([]string{"**/api/*"}, []string{"get"}, nil)
+	require.NoError(t, err)
+	require.True(t, filter.matches("GET", "http://localhost/api/users"))
+	require.False(t, filter.matches("POST", "http://localhost/api/users"))
+	require.False(t, filter.matches("GET", "http://localhost/index.html"))
+
+	_, err = newHarEntryFilter(42, nil, nil)
+	require.Error(t, err)
+	_, err = newHarEntryFilter([]interface{}{"**/api/*", 42}, nil, nil)
+	require.Error(t, err)
+}
+
+func TestHarEntryFilterApply(t *testing.T) {
+	filter, err := newHarEntryFilter([]interface{}{"**/api/*", regexp.MustCompile(`\.css$`)}, []string{"GET"}, nil)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "log.har")
+	require.NoError(t, os.WriteFile(path, []byte(testHar), 0o644))
+	require.NoError(t, filter.apply(path))
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, []string{"GET http://localhost/api/users", "GET http://localhost/style.css"}, harEntryURLs(t, data))
+}
+
+func TestHarEntryFilterApplyArchive(t *testing.T) {
+	filter, err := newHarEntryFilter(nil, []string{"POST"}, nil)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	archived := `{"log":{"version":"1.2","entries":[
+{"request":{"method":"GET","url":"http://localhost/api/users"},"response":{"content":{"_file":"dropped.txt"}}},
+{"request":{"method":"POST","url":"http://localhost/api/users","postData":{"_file":"post.txt"}},"response":{"content":{"_file":"kept.txt"}}}]}}`
+	for name, content := range map[string]string{"har.har": archived, "kept.txt": "body", "post.txt": "post", "dropped.txt": "dropped"} {
+		w, err := writer.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+	path := filepath.Join(t.TempDir(), "log.zip")
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0o644))
+	require.NoError(t, filter.apply(path))
+
+	reader, err := zip.OpenReader(path)
+	require.NoError(t, err)
+	defer reader.Close()
+	files := map[string][]byte{}
+	for _, file := range reader.File {
+		content, err := readZipFile(file)
+		require.NoError(t, err)
+		files[file.Name] = content
+	}
+	require.Equal(t, "body", string(files["kept.txt"]))
+	require.Equal(t, "post", string(files["post.txt"]))
+	require.NotContains(t, files, "dropped.txt")
+	require.Equal(t, []string{"POST http://localhost/api/users"}, harEntryURLs(t, files["har.har"]))
+}
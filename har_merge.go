@@ -0,0 +1,180 @@
+package playwright
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// readPreviousHar returns the content of the HAR at path, to merge into the HAR recorded in update mode, or nil if
+// there is none yet.
+func readPreviousHar(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read HAR %s to update: %w", path, err)
+	}
+	return data, nil
+}
+
+// mergeHar adds the entries of the previous content of the HAR at path which were not recorded again, so that updating
+// a HAR only replaces the responses of the requests made while recording. The attachments of a zip archive are kept
+// as well.
+func mergeHar(path string, previous []byte) error {
+	current, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if strings.HasSuffix(strings.ToLower(path), ".zip") {
+		current, err = mergeHarArchives(previous, current)
+	} else {
+		current, err = mergeHarEntries(previous, current)
+	}
+	if err != nil {
+		return fmt.Errorf("could not merge HAR %s: %w", path, err)
+	}
+	return os.WriteFile(path, current, 0o644)
+}
+
+// harLogEntries returns the decoded HAR, its log and the entries of the log.
+func harLogEntries(data []byte) (map[string]json.RawMessage, map[string]json.RawMessage, []json.RawMessage, error) {
+	var har map[string]json.RawMessage
+	if err := json.Unmarshal(data, &har); err != nil {
+		return nil, nil, nil, err
+	}
+	var log map[string]json.RawMessage
+	if err := json.Unmarshal(har["log"], &log); err != nil {
+		return nil, nil, nil, err
+	}
+	var entries []json.RawMessage
+	if err := json.Unmarshal(log["entries"], &entries); err != nil {
+		return nil, nil, nil, err
+	}
+	return har, log, entries, nil
+}
+
+// harEntryKey identifies the request of a HAR entry by its method and URL.
+func harEntryKey(entry json.RawMessage) (string, error) {
+	var parsed struct {
+		Request struct {
+			Method string `json:"method"`
+			URL    string `json:"url"`
+		} `json:"request"`
+	}
+	if err := json.Unmarshal(entry, &parsed); err != nil {
+		return "", err
+	}
+	return parsed.Request.Method + " " + parsed.Request.URL, nil
+}
+
+func mergeHarEntries(previous, current []byte) ([]byte, error) {
+	_, _, previousEntries, err := harLogEntries(previous)
+	if err != nil {
+		return nil, err
+	}
+	har, log, entries, err := harLogEntries(current)
+	if err != nil {
+		return nil, err
+	}
+	recorded := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		key, err := harEntryKey(entry)
+		if err != nil {
+			return nil, err
+		}
+		recorded[key] = true
+	}
+	merged := make([]json.RawMessage, 0, len(previousEntries)+len(entries))
+	for _, entry := range previousEntries {
+		key, err := harEntryKey(entry)
+		if err != nil {
+			return nil, err
+		}
+		if !recorded[key] {
+			merged = append(merged, entry)
+		}
+	}
+	merged = append(merged, entries...)
+	if log["entries"], err = json.Marshal(merged); err != nil {
+		return nil, err
+	}
+	if har["log"], err = json.Marshal(log); err != nil {
+		return nil, err
+	}
+	return json.Marshal(har)
+}
+
+func mergeHarArchives(previous, current []byte) ([]byte, error) {
+	previousReader, err := zip.NewReader(bytes.NewReader(previous), int64(len(previous)))
+	if err != nil {
+		return nil, err
+	}
+	reader, err := zip.NewReader(bytes.NewReader(current), int64(len(current)))
+	if err != nil {
+		return nil, err
+	}
+	previousHar, err := readArchivedHarFile(previousReader)
+	if err != nil {
+		return nil, err
+	}
+	var out bytes.Buffer
+	writer := zip.NewWriter(&out)
+	written := make(map[string]bool)
+	for _, file := range reader.File {
+		content, err := readZipFile(file)
+		if err != nil {
+			return nil, err
+		}
+		if strings.HasSuffix(file.Name, ".har") {
+			if content, err = mergeHarEntries(previousHar, content); err != nil {
+				return nil, err
+			}
+		}
+		if err := writeZipFile(writer, file.Name, content); err != nil {
+			return nil, err
+		}
+		written[file.Name] = true
+	}
+	// The attachments are named after their content, so that those of the previous entries are only missing if the
+	// entries were not recorded again.
+	for _, file := range previousReader.File {
+		if written[file.Name] || strings.HasSuffix(file.Name, ".har") {
+			continue
+		}
+		content, err := readZipFile(file)
+		if err != nil {
+			return nil, err
+		}
+		if err := writeZipFile(writer, file.Name, content); err != nil {
+			return nil, err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+func readArchivedHarFile(reader *zip.Reader) ([]byte, error) {
+	for _, file := range reader.File {
+		if strings.HasSuffix(file.Name, ".har") {
+			return readZipFile(file)
+		}
+	}
+	return nil, errors.New("no .har file in archive")
+}
+
+func writeZipFile(writer *zip.Writer, name string, content []byte) error {
+	w, err := writer.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(content)
+	return err
+}
@@ -0,0 +1,67 @@
+package playwright
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testUpdatedHar = `{"log":{"version":"1.2","entries":[
+{"request":{"method":"GET","url":"http://localhost/index.html"}},
+{"request":{"method":"GET","url":"http://localhost/script.js"}}]}}`
+
+func testHarArchive(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	for name, content := range files {
+		require.NoError(t, writeZipFile(writer, name, []byte(content)))
+	}
+	require.NoError(t, writer.Close())
+	return buf.Bytes()
+}
+
+func TestMergeHar(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "log.har")
+	require.NoError(t, os.WriteFile(path, []byte(testUpdatedHar), 0o644))
+	require.NoError(t, mergeHar(path, []byte(testHar)))
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"GET http://localhost/api/users",
+		"POST http://localhost/api/users",
+		"GET http://localhost/style.css",
+		"GET http://localhost/index.html",
+		"GET http://localhost/script.js",
+	}, harEntryURLs(t, data))
+}
+
+func TestMergeHarArchive(t *testing.T) {
+	previous := testHarArchive(t, map[string]string{"har.har": testHar, "users.json": "[]", "index.html": "old"})
+	path := filepath.Join(t.TempDir(), "log.zip")
+	require.NoError(t, os.WriteFile(path, testHarArchive(t, map[string]string{"har.har": testUpdatedHar, "index.html": "new"}), 0o644))
+	require.NoError(t, mergeHar(path, previous))
+
+	reader, err := zip.OpenReader(path)
+	require.NoError(t, err)
+	defer reader.Close()
+	files := map[string][]byte{}
+	for _, file := range reader.File {
+		content, err := readZipFile(file)
+		require.NoError(t, err)
+		files[file.Name] = content
+	}
+	require.Len(t, files, 3)
+	require.Equal(t, "new", string(files["index.html"]))
+	require.Equal(t, "[]", string(files["users.json"]))
+	require.Len(t, harEntryURLs(t, files["har.har"]), 5)
+}
+
+func TestReadPreviousHar(t *testing.T) {
+	data, err := readPreviousHar(filepath.Join(t.TempDir(), "missing.har"))
+	require.NoError(t, err)
+	require.Nil(t, data)
+}
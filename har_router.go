@@ -110,3 +110,17 @@ func newHarRouter(localUtils *localUtilsImpl, file string, notFoundAction HarNot
 		err:            err,
 	}
 }
+
+// toHarContentPolicy converts the content policy of RouteFromHAR into the one used for recording.
+func toHarContentPolicy(policy *RouteFromHarUpdateContentPolicy) *HarContentPolicy {
+	if policy == nil {
+		return nil
+	}
+	switch *policy {
+	case *RouteFromHarUpdateContentPolicyAttach:
+		return HarContentPolicyAttach
+	case *RouteFromHarUpdateContentPolicyEmbed:
+		return HarContentPolicyEmbed
+	}
+	return nil
+}
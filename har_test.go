@@ -0,0 +1,80 @@
+package playwright
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testAttachedHar = `{"log":{"version":"1.2","creator":{"name":"Playwright","version":"1.43.0"},"entries":[
+{"request":{"method":"POST","url":"http://localhost/api","postData":{"mimeType":"text/plain","text":"","_file":"post.txt"}},
+ "response":{"status":200,"content":{"size":4,"mimeType":"text/plain","_file":"body.txt"}}},
+{"request":{"method":"GET","url":"http://localhost/embedded"},
+ "response":{"status":200,"content":{"size":5,"mimeType":"text/plain","text":"aGVsbG8=","encoding":"base64"}}},
+{"request":{"method":"GET","url":"http://localhost/omitted"},
+ "response":{"status":200,"content":{"size":5,"mimeType":"text/plain"}}}]}}`
+
+var testHarFiles = map[string]string{
+	"har.har":  testAttachedHar,
+	"body.txt": "body",
+	"post.txt": "post",
+}
+
+func requireHarContent(t *testing.T, har *Har) {
+	require.Equal(t, "Playwright", har.Log.Creator.Name)
+	require.Len(t, har.Log.Entries, 3)
+	body, err := har.ResponseBody(har.Log.Entries[0])
+	require.NoError(t, err)
+	require.Equal(t, "body", string(body))
+	post, err := har.RequestBody(har.Log.Entries[0])
+	require.NoError(t, err)
+	require.Equal(t, "post", string(post))
+	body, err = har.ResponseBody(har.Log.Entries[1])
+	require.NoError(t, err)
+	require.Equal(t, "hello", string(body))
+	post, err = har.RequestBody(har.Log.Entries[1])
+	require.NoError(t, err)
+	require.Nil(t, post)
+	body, err = har.ResponseBody(har.Log.Entries[2])
+	require.NoError(t, err)
+	require.Empty(t, body)
+}
+
+func TestReadHarWithAttachedFiles(t *testing.T) {
+	dir := t.TempDir()
+	for name, content := range testHarFiles {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+	}
+	har, err := ReadHar(filepath.Join(dir, "har.har"))
+	require.NoError(t, err)
+	requireHarContent(t, har)
+}
+
+func TestReadHarArchive(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "har.zip")
+	file, err := os.Create(path)
+	require.NoError(t, err)
+	writer := zip.NewWriter(file)
+	for name, content := range testHarFiles {
+		w, err := writer.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+	require.NoError(t, file.Close())
+
+	har, err := ReadHar(path)
+	require.NoError(t, err)
+	requireHarContent(t, har)
+
+	har.Log.Entries[0].Response.Content.File = "missing.txt"
+	_, err = har.ResponseBody(har.Log.Entries[0])
+	require.ErrorContains(t, err, "not found")
+	har.Log.Entries[0].Response.Content.File = "../body.txt"
+	_, err = har.ResponseBody(har.Log.Entries[0])
+	require.ErrorContains(t, err, "invalid HAR attachment name")
+}
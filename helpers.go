@@ -10,6 +10,7 @@ import (
 	"sync/atomic"
 
 	mapset "github.com/deckarep/golang-set/v2"
+	"golang.org/x/exp/slices"
 )
 
 type (
@@ -21,7 +22,8 @@ func skipFieldSerialization(val reflect.Value) bool {
 	return (typ.Kind() == reflect.Ptr ||
 		typ.Kind() == reflect.Interface ||
 		typ.Kind() == reflect.Map ||
-		typ.Kind() == reflect.Slice) && val.IsNil() || (val.Kind() == reflect.Interface && val.Elem().Kind() == reflect.Ptr && val.Elem().IsNil())
+		typ.Kind() == reflect.Slice ||
+		typ.Kind() == reflect.Func) && val.IsNil() || (val.Kind() == reflect.Interface && val.Elem().Kind() == reflect.Ptr && val.Elem().IsNil())
 }
 
 func transformStructValues(in interface{}) interface{} {
@@ -84,7 +86,8 @@ func transformStructIntoMapIfNeeded(inStruct interface{}) map[string]interface{}
 	return out
 }
 
-// transformOptions handles the parameter data transformation
+// transformOptions handles the parameter data transformation. Options wrapped with
+// optionsOf are serialized by their cached encoder, everything else is walked with reflection.
 func transformOptions(options ...interface{}) map[string]interface{} {
 	var base map[string]interface{}
 	var option interface{}
@@ -100,13 +103,21 @@ func transformOptions(options ...interface{}) map[string]interface{} {
 		// Case 3: two values are given. The first one needs to be transformed
 		// to a map, the sencond one will be then get merged into the first
 		// base map.
-		if reflect.ValueOf(options[0]).Kind() != reflect.Map {
+		if m, ok := options[0].(optionsMarshaler); ok {
+			base = m.Marshal()
+		} else if reflect.ValueOf(options[0]).Kind() != reflect.Map {
 			base = transformOptions(options[0])
 		} else {
 			base = transformStructIntoMapIfNeeded(options[0])
 		}
 		option = options[1]
 	}
+	if m, ok := option.(optionsMarshaler); ok {
+		for key, value := range m.Marshal() {
+			base[key] = value
+		}
+		return base
+	}
 	v := reflect.ValueOf(option)
 	if v.Kind() == reflect.Slice {
 		if v.Len() == 0 {
@@ -232,6 +243,7 @@ type routeHandlerEntry struct {
 	matcher           *urlMatcher
 	handler           routeHandler
 	times             int
+	priority          int
 	count             int32
 	ignoreErrors      *atomic.Bool
 	activeInvocations mapset.Set[*routeHandlerInvocation]
@@ -241,13 +253,20 @@ func (r *routeHandlerEntry) Matches(url string) bool {
 	return r.matcher.Matches(url)
 }
 
-func (r *routeHandlerEntry) Handle(route Route) chan bool {
+// start registers an invocation of the handler for route before it runs, so that unrouting the handler with
+// [UnrouteBehaviorWait] waits for it. It is called while holding the lock of the routes, which the handler is still
+// part of.
+func (r *routeHandlerEntry) start(route Route) *routeHandlerInvocation {
 	handlerInvocation := &routeHandlerInvocation{
 		route:    route,
 		complete: make(chan bool, 1),
 	}
 	r.activeInvocations.Add(handlerInvocation)
+	return handlerInvocation
+}
 
+func (r *routeHandlerEntry) Handle(handlerInvocation *routeHandlerInvocation) chan bool {
+	route := handlerInvocation.route
 	defer func() {
 		handlerInvocation.complete <- true
 		r.activeInvocations.Remove(handlerInvocation)
@@ -286,6 +305,23 @@ func (r *routeHandlerEntry) Stop(behavior string) {
 	}
 }
 
+// stopRouteHandlers stops the removed route handlers according to behavior. It must be called without holding the
+// lock of the routes, since the running handlers it waits for may use them.
+func stopRouteHandlers(removed []*routeHandlerEntry, behavior *UnrouteBehavior) {
+	if behavior == nil || *behavior == *UnrouteBehaviorDefault {
+		return
+	}
+	wg := &sync.WaitGroup{}
+	for _, entry := range removed {
+		wg.Add(1)
+		go func(entry *routeHandlerEntry) {
+			defer wg.Done()
+			entry.Stop(string(*behavior))
+		}(entry)
+	}
+	wg.Wait()
+}
+
 func (r *routeHandlerEntry) handleInternal(route Route) chan bool {
 	handled := route.(*routeImpl).startHandling()
 	atomic.AddInt32(&r.count, 1)
@@ -315,6 +351,18 @@ func newRouteHandlerEntry(matcher *urlMatcher, handler routeHandler, times ...in
 	}
 }
 
+// insertRouteHandlerEntry inserts entry in routes, which are in the order they are matched against requests: by
+// priority, then the latest first.
+func insertRouteHandlerEntry(routes []*routeHandlerEntry, entry *routeHandlerEntry) []*routeHandlerEntry {
+	index := slices.IndexFunc(routes, func(r *routeHandlerEntry) bool {
+		return r.priority <= entry.priority
+	})
+	if index == -1 {
+		index = len(routes)
+	}
+	return slices.Insert(routes, index, entry)
+}
+
 func prepareInterceptionPatterns(handlers []*routeHandlerEntry) []map[string]interface{} {
 	patterns := []map[string]interface{}{}
 	all := false
@@ -578,20 +626,23 @@ type recordHarInputOptions struct {
 type harRecordingMetadata struct {
 	Path    string
 	Content *HarContentPolicy
+	Filter  *harEntryFilter
+	// Previous is the content of the HAR before it was updated, whose entries not recorded again are merged into it.
+	Previous []byte
 }
 
 func prepareRecordHarOptions(option recordHarInputOptions) recordHarOptions {
 	out := recordHarOptions{
 		Path: option.Path,
 	}
-	if option.URL != nil {
-		switch option.URL.(type) {
+	if url := serverHarURLFilter(option.URL); url != nil {
+		switch url.(type) {
 		case *regexp.Regexp:
-			pattern, flags := convertRegexp(option.URL.(*regexp.Regexp))
+			pattern, flags := convertRegexp(url.(*regexp.Regexp))
 			out.UrlRegexSource = String(pattern)
 			out.UrlRegexFlags = String(flags)
 		case string:
-			out.UrlGlob = String(option.URL.(string))
+			out.UrlGlob = String(url.(string))
 		}
 	}
 	if option.Mode != nil {
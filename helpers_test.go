@@ -2,6 +2,7 @@ package playwright
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -264,3 +265,34 @@ func TestAssignFields(t *testing.T) {
 		})
 	}
 }
+
+func TestTimeoutHelper(t *testing.T) {
+	require.Equal(t, 1500.0, *Timeout(1500 * time.Millisecond))
+	require.Equal(t, 0.5, *Timeout(500 * time.Microsecond))
+}
+
+func TestStopRouteHandlersShouldWaitForStartedInvocations(t *testing.T) {
+	entry := newRouteHandlerEntry(newURLMatcher("**/*", nil), func(Route) {})
+	invocation := entry.start(&routeImpl{})
+
+	stopped := make(chan struct{})
+	go func() {
+		stopRouteHandlers([]*routeHandlerEntry{entry}, UnrouteBehaviorWait)
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+		t.Fatal("handlers stopped before their invocation completed")
+	case <-time.After(50 * time.Millisecond):
+	}
+	invocation.complete <- true
+	<-stopped
+
+	// The default behavior compares by value and does not wait.
+	entry.start(&routeImpl{})
+	stopRouteHandlers([]*routeHandlerEntry{entry}, (*UnrouteBehavior)(String("default")))
+	stopRouteHandlers([]*routeHandlerEntry{entry}, nil)
+	require.False(t, entry.ignoreErrors.Load())
+	stopRouteHandlers([]*routeHandlerEntry{entry}, UnrouteBehaviorIgnoreErrors)
+	require.True(t, entry.ignoreErrors.Load())
+}
@@ -0,0 +1,84 @@
+package playwright
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrHistoryNotSupported is returned when the session history is inspected in a browser other than Chromium.
+var ErrHistoryNotSupported = errors.New("session history is only supported in Chromium")
+
+// HistoryEntry is an entry of the session history of a page, see [Page.History].
+type HistoryEntry struct {
+	// URL of the entry.
+	URL string
+	// Title of the document of the entry.
+	Title string
+}
+
+// NavigationHistory is the session history of a page, the entries [Page.GoBack] and [Page.GoForward] move between.
+type NavigationHistory struct {
+	// Entries of the history, the oldest first.
+	Entries []HistoryEntry
+	// Index of the current entry in Entries.
+	CurrentIndex int
+}
+
+// navigationHistory returns the session history of the page, with the IDs of its entries in the DevTools protocol.
+func (p *pageImpl) navigationHistory() (*NavigationHistory, []interface{}, error) {
+	session, err := p.cdpSession()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrHistoryNotSupported, err)
+	}
+	result, err := session.Send("Page.getNavigationHistory", map[string]interface{}{})
+	if err != nil {
+		return nil, nil, err
+	}
+	history := &NavigationHistory{Entries: make([]HistoryEntry, 0)}
+	ids := make([]interface{}, 0)
+	ret := result.(map[string]interface{})
+	if index, ok := ret["currentIndex"].(float64); ok {
+		history.CurrentIndex = int(index)
+	}
+	entries, _ := ret["entries"].([]interface{})
+	for _, e := range entries {
+		entry := e.(map[string]interface{})
+		url, _ := entry["url"].(string)
+		title, _ := entry["title"].(string)
+		history.Entries = append(history.Entries, HistoryEntry{URL: url, Title: title})
+		ids = append(ids, entry["id"])
+	}
+	return history, ids, nil
+}
+
+func (p *pageImpl) History() (*NavigationHistory, error) {
+	history, _, err := p.navigationHistory()
+	return history, err
+}
+
+func (p *pageImpl) GoToHistoryEntry(index int, options ...PageGoToHistoryEntryOptions) (Response, error) {
+	history, ids, err := p.navigationHistory()
+	if err != nil {
+		return nil, err
+	}
+	if index < 0 || index >= len(ids) {
+		return nil, fmt.Errorf("history entry %d out of range [0, %d)", index, len(ids))
+	}
+	if index == history.CurrentIndex {
+		// The page stays where it is, there is no navigation and so no response.
+		return nil, nil
+	}
+	option := FrameExpectNavigationOptions{}
+	if len(options) == 1 {
+		option.Timeout = options[0].Timeout
+		option.WaitUntil = options[0].WaitUntil
+	}
+	return p.mainFrame.ExpectNavigation(func() error {
+		session, err := p.cdpSession()
+		if err != nil {
+			return err
+		}
+		_, err = session.Send("Page.navigateToHistoryEntry", map[string]interface{}{"entryId": ids[index]})
+		return err
+	}, option)
+}
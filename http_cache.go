@@ -0,0 +1,81 @@
+package playwright
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrClearCacheNotSupported is returned when the HTTP cache is cleared in a browser other than Chromium.
+var ErrClearCacheNotSupported = errors.New("clearing the HTTP cache is only supported in Chromium")
+
+// resourceCacheExpression reports how the last resource fetched from a URL was transferred, or null if the frame has no
+// timing of it.
+const resourceCacheExpression = `url => {
+  const entries = performance.getEntriesByName(url).filter(e => e.entryType === 'resource' || e.entryType === 'navigation');
+  if (!entries.length)
+    return null;
+  const entry = entries[entries.length - 1];
+  return { transferSize: entry.transferSize, decodedBodySize: entry.decodedBodySize, responseStart: entry.responseStart };
+}`
+
+func (b *browserContextImpl) SetCacheEnabled(enabled bool) error {
+	b.Lock()
+	defer b.Unlock()
+	if b.cacheDisabled == !enabled {
+		return nil
+	}
+	b.cacheDisabled = !enabled
+	return b.updateInterceptionPatterns()
+}
+
+func (b *browserContextImpl) ClearCache() error {
+	pages := b.Pages()
+	var page Page
+	if len(pages) > 0 {
+		page = pages[0]
+	} else {
+		var err error
+		if page, err = b.NewPage(); err != nil {
+			return fmt.Errorf("could not clear cache: %w", err)
+		}
+		defer page.Close()
+	}
+	session, err := page.(*pageImpl).cdpSession()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrClearCacheNotSupported, err)
+	}
+	_, err = session.Send("Network.clearBrowserCache", map[string]interface{}{})
+	return err
+}
+
+func (r *responseImpl) FromCache() (bool, error) {
+	frame := r.Frame()
+	if frame == nil {
+		return false, fmt.Errorf("no frame to read the resource timing of %s from", r.URL())
+	}
+	result, err := frame.Evaluate(resourceCacheExpression, r.URL())
+	if err != nil {
+		return false, fmt.Errorf("could not read resource timing: %w", err)
+	}
+	timing, ok := result.(map[string]interface{})
+	if !ok {
+		return false, fmt.Errorf("no resource timing for %s", r.URL())
+	}
+	transferSize, decodedBodySize := evaluatedNumber(timing["transferSize"]), evaluatedNumber(timing["decodedBodySize"])
+	if transferSize == 0 && decodedBodySize == 0 && evaluatedNumber(timing["responseStart"]) == 0 {
+		// Cross-origin resources without Timing-Allow-Origin report zero sizes and timings.
+		return false, fmt.Errorf("resource timing of %s is not exposed to its frame", r.URL())
+	}
+	return transferSize == 0 && decodedBodySize > 0, nil
+}
+
+// evaluatedNumber converts a number of an evaluation result, which is an int when it is integral.
+func evaluatedNumber(v interface{}) float64 {
+	switch n := v.(type) {
+	case int:
+		return float64(n)
+	case float64:
+		return n
+	}
+	return 0
+}
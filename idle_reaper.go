@@ -0,0 +1,175 @@
+package playwright
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// activity records when a [BrowserContext] or a [Page] was last used, see [IdleReaper].
+type activity struct {
+	lastActivity atomic.Int64
+}
+
+func (a *activity) touch() {
+	a.lastActivity.Store(time.Now().UnixNano())
+}
+
+// idleSince returns when the object was last used, or since if it was not used after since.
+func (a *activity) idleSince(since time.Time) time.Time {
+	last := time.Unix(0, a.lastActivity.Load())
+	if last.Before(since) {
+		return since
+	}
+	return last
+}
+
+// touchActivity marks the page and the context object belongs to as used, e.g. when a message is sent to object.
+func touchActivity(object *channelOwner) {
+	for o := object; o != nil; o = o.parent {
+		if a, ok := o.channel.object.(interface{ touch() }); ok {
+			a.touch()
+		}
+	}
+}
+
+// IdleReaperOptions are the options of [NewIdleReaper].
+type IdleReaperOptions struct {
+	// Time without actions and navigations after which a context is closed.
+	Timeout time.Duration
+	// Whether pages idle for Timeout are closed too, while other pages keep their context active. Defaults to `false`.
+	ClosePages bool
+	// Interval between two checks for idle contexts. Defaults to a tenth of Timeout, and at least 100ms.
+	Interval time.Duration
+}
+
+// IdleReaper closes the contexts, and optionally the pages, that were not used for a while, to stop leaked contexts
+// from accumulating in long-lived services. A context or a page is used when an action is performed on it, on one of
+// its frames, locators or handles, and when one of its frames navigates. Before closing them, it emits the idle event
+// of the context, see [BrowserContext.OnIdle], or of the page, see [Page.OnIdle].
+//
+//	reaper := playwright.NewIdleReaper(playwright.IdleReaperOptions{Timeout: 10 * time.Minute})
+//	defer reaper.Stop()
+//	if err := reaper.Watch(browser); err != nil {
+//		log.Fatal(err)
+//	}
+type IdleReaper struct {
+	options  IdleReaperOptions
+	mu       sync.Mutex
+	browsers map[*browserImpl]time.Time
+	contexts map[*browserContextImpl]time.Time
+	stopped  chan struct{}
+	stopOnce sync.Once
+}
+
+// NewIdleReaper returns an [IdleReaper] checking the contexts it watches until [IdleReaper.Stop] is called.
+func NewIdleReaper(options IdleReaperOptions) *IdleReaper {
+	if options.Interval <= 0 {
+		options.Interval = options.Timeout / 10
+		if options.Interval < 100*time.Millisecond {
+			options.Interval = 100 * time.Millisecond
+		}
+	}
+	r := &IdleReaper{
+		options:  options,
+		browsers: make(map[*browserImpl]time.Time),
+		contexts: make(map[*browserContextImpl]time.Time),
+		stopped:  make(chan struct{}),
+	}
+	go r.run()
+	return r
+}
+
+// Watch closes the idle contexts of target, a [Browser], whose current and future contexts are watched, or a
+// [BrowserContext]. Contexts are idle for the time since they were last used, or since Watch was called.
+func (r *IdleReaper) Watch(target interface{}) error {
+	if r.options.Timeout <= 0 {
+		return errors.New("the timeout of the idle reaper must be positive")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	switch t := target.(type) {
+	case *browserImpl:
+		r.browsers[t] = now
+	case *browserContextImpl:
+		r.contexts[t] = now
+		t.OnClose(func(BrowserContext) {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			delete(r.contexts, t)
+		})
+	default:
+		return fmt.Errorf("not a browser or a browser context: %v", target)
+	}
+	return nil
+}
+
+// Stop stops checking for idle contexts. It is safe to call Stop more than once.
+func (r *IdleReaper) Stop() {
+	r.stopOnce.Do(func() { close(r.stopped) })
+}
+
+func (r *IdleReaper) run() {
+	ticker := time.NewTicker(r.options.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.stopped:
+			return
+		case now := <-ticker.C:
+			r.reap(now)
+		}
+	}
+}
+
+// watched returns the contexts to check, by the time they are watched since.
+func (r *IdleReaper) watched() map[*browserContextImpl]time.Time {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	contexts := make(map[*browserContextImpl]time.Time, len(r.contexts))
+	for browser, since := range r.browsers {
+		if !browser.IsConnected() {
+			delete(r.browsers, browser)
+			continue
+		}
+		for _, context := range browser.Contexts() {
+			contexts[context.(*browserContextImpl)] = since
+		}
+	}
+	for context, since := range r.contexts {
+		contexts[context] = since
+	}
+	return contexts
+}
+
+func (r *IdleReaper) reap(now time.Time) {
+	for context, since := range r.watched() {
+		if now.Sub(context.idleSince(since)) >= r.options.Timeout {
+			context.Emit("idle", context)
+			if err := context.Close(BrowserContextCloseOptions{
+				Reason: String(fmt.Sprintf("Context was idle for more than %s", r.options.Timeout)),
+			}); err != nil {
+				context.logf("could not close idle context: %v\n", err)
+			}
+			continue
+		}
+		if !r.options.ClosePages {
+			continue
+		}
+		for _, page := range context.Pages() {
+			p := page.(*pageImpl)
+			if now.Sub(p.idleSince(since)) < r.options.Timeout {
+				continue
+			}
+			p.Emit("idle", p)
+			if err := p.Close(PageCloseOptions{
+				Reason: String(fmt.Sprintf("Page was idle for more than %s", r.options.Timeout)),
+			}); err != nil {
+				p.logf("could not close idle page: %v\n", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package playwright
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTouchActivity(t *testing.T) {
+	context := &browserContextImpl{}
+	context.channel = &channel{object: context}
+	page := &pageImpl{}
+	page.channel = &channel{object: page}
+	page.parent = &context.channelOwner
+	frame := &frameImpl{}
+	frame.channel = &channel{object: frame}
+	frame.parent = &page.channelOwner
+
+	since := time.Now()
+	require.Equal(t, since, context.idleSince(since))
+	touchActivity(&frame.channelOwner)
+	require.True(t, page.idleSince(since).After(since))
+	require.True(t, context.idleSince(since).After(since))
+	// Activity before the reaper watches the object is ignored.
+	later := time.Now().Add(time.Minute)
+	require.Equal(t, later, page.idleSince(later))
+}
+
+func TestIdleReaperWatchShouldRejectInvalidTargets(t *testing.T) {
+	reaper := NewIdleReaper(IdleReaperOptions{Timeout: time.Minute})
+	defer reaper.Stop()
+	require.ErrorContains(t, reaper.Watch(&pageImpl{}), "not a browser or a browser context")
+	reaper.Stop()
+
+	reaper = NewIdleReaper(IdleReaperOptions{})
+	defer reaper.Stop()
+	require.ErrorContains(t, reaper.Watch(&browserImpl{}), "timeout of the idle reaper must be positive")
+}
@@ -0,0 +1,80 @@
+package playwright
+
+import (
+	"errors"
+	"fmt"
+)
+
+func getIdleUserState(in string) *IdleUserState {
+	v := IdleUserState(in)
+	return &v
+}
+
+// IdleUserState is the user state reported by the Idle Detection API, see [Page.EmulateIdleState].
+type IdleUserState string
+
+var (
+	IdleUserStateActive *IdleUserState = getIdleUserState("active")
+	IdleUserStateIdle                  = getIdleUserState("idle")
+)
+
+func getIdleScreenState(in string) *IdleScreenState {
+	v := IdleScreenState(in)
+	return &v
+}
+
+// IdleScreenState is the screen state reported by the Idle Detection API, see [Page.EmulateIdleState].
+type IdleScreenState string
+
+var (
+	IdleScreenStateUnlocked *IdleScreenState = getIdleScreenState("unlocked")
+	IdleScreenStateLocked                    = getIdleScreenState("locked")
+)
+
+// ErrIdleStateNotSupported is returned when the idle state is emulated in a browser other than Chromium.
+var ErrIdleStateNotSupported = errors.New("idle state emulation is only supported in Chromium")
+
+// userActivationScript covers the top left pixel of the page with a transparent element to click on, so that clicking
+// on it activates the page without acting on its own elements.
+const userActivationScript = `() => {
+  const target = document.createElement('div');
+  target.setAttribute('style', 'position: fixed; left: 0; top: 0; width: 1px; height: 1px; z-index: 2147483647; opacity: 0; pointer-events: auto;');
+  (document.body || document.documentElement).appendChild(target);
+  window.__pwUserActivationTarget = target;
+}`
+
+func (p *pageImpl) EmulateIdleState(options ...PageEmulateIdleStateOptions) error {
+	session, err := p.cdpSession()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrIdleStateNotSupported, err)
+	}
+	if len(options) == 0 || (options[0].UserState == nil && options[0].ScreenState == nil) {
+		_, err := session.Send("Emulation.clearIdleOverride", map[string]interface{}{})
+		return err
+	}
+	userState, screenState := IdleUserStateActive, IdleScreenStateUnlocked
+	if options[0].UserState != nil {
+		userState = options[0].UserState
+	}
+	if options[0].ScreenState != nil {
+		screenState = options[0].ScreenState
+	}
+	_, err = session.Send("Emulation.setIdleOverride", map[string]interface{}{
+		"isUserActive":     *userState == *IdleUserStateActive,
+		"isScreenUnlocked": *screenState == *IdleScreenStateUnlocked,
+	})
+	return err
+}
+
+func (p *pageImpl) ActivateUser() error {
+	if _, err := p.Evaluate(userActivationScript); err != nil {
+		return fmt.Errorf("could not prepare user activation: %w", err)
+	}
+	defer func() {
+		_, _ = p.Evaluate(`() => { window.__pwUserActivationTarget?.remove(); delete window.__pwUserActivationTarget; }`)
+	}()
+	if err := p.Mouse().Click(0, 0); err != nil {
+		return fmt.Errorf("could not activate user: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,222 @@
+package playwright
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/playwright-community/playwright-go/internal/multierror"
+)
+
+// The driver can't remove init scripts, so scripts are added with a guard on their id, and
+// removing a script adds a marker script marking the id as removed in every new document. The
+// markers are added after the scripts they remove, so the guarded scripts are queued when they
+// are evaluated and run once all the init scripts of the document were evaluated: on the first
+// change of the document, which is before its first script runs, or on the next task for
+// documents that don't change, like about:blank.
+const initScriptState = `(globalThis.__pwInitScriptState || (globalThis.__pwInitScriptState = (() => {
+  const state = { removed: new Set(), pending: [] };
+  const setTimeout = globalThis.setTimeout.bind(globalThis);
+  const flush = () => {
+    observer.disconnect();
+    for (const [id, run] of state.pending.splice(0)) {
+      if (state.removed.has(id))
+        continue;
+      try {
+        run.call(globalThis);
+      } catch (e) {
+        setTimeout(() => { throw e; }, 0);
+      }
+    }
+  };
+  const observer = new MutationObserver(flush);
+  observer.observe(document, { childList: true, subtree: true });
+  setTimeout(flush, 0);
+  return state;
+})()))`
+
+var lastInitScriptID atomic.Int64
+
+// initScript is an init script added to a page or a browser context.
+type initScript struct {
+	id     string
+	script Script
+	source string
+}
+
+func (s *initScript) world() string {
+	if s.script.IsolatedWorld == nil {
+		return ""
+	}
+	return *s.script.IsolatedWorld
+}
+
+// guardedSource wraps the script so that it is queued, and skipped once removed.
+func (s *initScript) guardedSource() string {
+	return fmt.Sprintf("%s.pending.push([%q, function () {\n%s\n}]);", initScriptState, s.id, s.source)
+}
+
+// removedMarkerSource returns the marker script of the removal of the given scripts.
+func removedMarkerSource(scripts []*initScript) string {
+	var source strings.Builder
+	source.WriteString("(() => {\n  const state = " + initScriptState + ";\n")
+	for _, script := range scripts {
+		fmt.Fprintf(&source, "  state.removed.add(%q);\n", script.id)
+	}
+	source.WriteString("})();")
+	return source.String()
+}
+
+// initScripts tracks the init scripts added to a page or a browser context.
+type initScripts struct {
+	sync.Mutex
+	scripts []*initScript
+}
+
+func newInitScript(script Script) (*initScript, error) {
+	source, err := readInitScript(script)
+	if err != nil {
+		return nil, err
+	}
+	if script.IsolatedWorld != nil && *script.IsolatedWorld == "" {
+		return nil, errors.New("isolated world name must not be empty")
+	}
+	return &initScript{
+		id:     fmt.Sprintf("init-script-%d", lastInitScriptID.Add(1)),
+		script: script,
+		source: source,
+	}, nil
+}
+
+func (s *initScripts) add(script *initScript) {
+	s.Lock()
+	defer s.Unlock()
+	s.scripts = append(s.scripts, script)
+}
+
+// remove removes the scripts with the same source and world as script. If script is nil,
+// all scripts are removed.
+func (s *initScripts) remove(script *initScript) []*initScript {
+	s.Lock()
+	defer s.Unlock()
+	removed := make([]*initScript, 0)
+	kept := make([]*initScript, 0, len(s.scripts))
+	for _, entry := range s.scripts {
+		if script == nil || (entry.source == script.source && entry.world() == script.world()) {
+			removed = append(removed, entry)
+		} else {
+			kept = append(kept, entry)
+		}
+	}
+	s.scripts = kept
+	return removed
+}
+
+func (s *initScripts) list() []Script {
+	s.Lock()
+	defer s.Unlock()
+	scripts := make([]Script, 0, len(s.scripts))
+	for _, entry := range s.scripts {
+		scripts = append(scripts, entry.script)
+	}
+	return scripts
+}
+
+// filter returns the scripts evaluated in an isolated world if isolated is set, or the ones
+// evaluated in the main world otherwise.
+func (s *initScripts) filter(isolated bool) []*initScript {
+	s.Lock()
+	defer s.Unlock()
+	scripts := make([]*initScript, 0)
+	for _, entry := range s.scripts {
+		if (entry.world() != "") == isolated {
+			scripts = append(scripts, entry)
+		}
+	}
+	return scripts
+}
+
+func (p *pageImpl) InitScripts() []Script {
+	return p.initScripts.list()
+}
+
+func (p *pageImpl) RemoveInitScript(script Script) error {
+	entry, err := newInitScript(script)
+	if err != nil {
+		return err
+	}
+	return p.removeInitScripts(p.initScripts.remove(entry))
+}
+
+func (p *pageImpl) ClearInitScripts() error {
+	return p.removeInitScripts(p.initScripts.remove(nil))
+}
+
+func (p *pageImpl) removeInitScripts(scripts []*initScript) error {
+	var errs []error
+	removed := make([]*initScript, 0)
+	for _, script := range scripts {
+		if script.world() == "" {
+			removed = append(removed, script)
+		} else if err := p.removeIsolatedInitScript(script.id); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(removed) > 0 {
+		if _, err := p.channel.Send("addInitScript", map[string]interface{}{
+			"source": removedMarkerSource(removed),
+		}); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return multierror.Join(errs...)
+}
+
+func (b *browserContextImpl) InitScripts() []Script {
+	return b.initScripts.list()
+}
+
+func (b *browserContextImpl) RemoveInitScript(script Script) error {
+	entry, err := newInitScript(script)
+	if err != nil {
+		return err
+	}
+	return b.removeInitScripts(b.initScripts.remove(entry))
+}
+
+func (b *browserContextImpl) ClearInitScripts() error {
+	return b.removeInitScripts(b.initScripts.remove(nil))
+}
+
+func (b *browserContextImpl) removeInitScripts(scripts []*initScript) error {
+	var errs []error
+	removed := make([]*initScript, 0)
+	for _, script := range scripts {
+		if script.world() == "" {
+			removed = append(removed, script)
+			continue
+		}
+		for _, page := range b.Pages() {
+			if err := page.(*pageImpl).removeIsolatedInitScript(script.id); err != nil && !errors.Is(err, ErrTargetClosed) {
+				errs = append(errs, err)
+			}
+		}
+	}
+	if len(removed) > 0 {
+		if err := b.addUntrackedInitScript(removedMarkerSource(removed)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return multierror.Join(errs...)
+}
+
+// addUntrackedInitScript adds an init script that can't be listed or removed, it is used for
+// the scripts the library relies on.
+func (b *browserContextImpl) addUntrackedInitScript(source string) error {
+	_, err := b.channel.Send("addInitScript", map[string]interface{}{
+		"source": source,
+	})
+	return err
+}
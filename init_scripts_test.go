@@ -0,0 +1,48 @@
+package playwright
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitScriptsRemoveMatchesSourceAndWorld(t *testing.T) {
+	scripts := &initScripts{}
+	for _, script := range []Script{
+		{Content: String("window.a = 1")},
+		{Content: String("window.a = 1"), IsolatedWorld: String("utility")},
+		{Content: String("window.b = 2")},
+		{Content: String("window.a = 1")},
+	} {
+		entry, err := newInitScript(script)
+		require.NoError(t, err)
+		scripts.add(entry)
+	}
+	entry, err := newInitScript(Script{Content: String("window.a = 1")})
+	require.NoError(t, err)
+	require.Len(t, scripts.remove(entry), 2)
+	require.Equal(t, []Script{
+		{Content: String("window.a = 1"), IsolatedWorld: String("utility")},
+		{Content: String("window.b = 2")},
+	}, scripts.list())
+	require.Len(t, scripts.filter(true), 1)
+	require.Len(t, scripts.remove(nil), 2)
+	require.Empty(t, scripts.list())
+}
+
+func TestInitScriptIDsAreUnique(t *testing.T) {
+	a, err := newInitScript(Script{Content: String("1")})
+	require.NoError(t, err)
+	b, err := newInitScript(Script{Content: String("1")})
+	require.NoError(t, err)
+	require.NotEqual(t, a.id, b.id)
+	require.Contains(t, a.guardedSource(), a.id)
+	marker := removedMarkerSource([]*initScript{a, b})
+	require.Contains(t, marker, a.id)
+	require.Contains(t, marker, b.id)
+}
+
+func TestNewInitScriptRejectsEmptyWorld(t *testing.T) {
+	_, err := newInitScript(Script{Content: String("1"), IsolatedWorld: String("")})
+	require.Error(t, err)
+}
@@ -1,5 +1,7 @@
 package playwright
 
+import "sync"
+
 type mouseImpl struct {
 	channel *channel
 }
@@ -19,12 +21,12 @@ func (m *mouseImpl) Move(x float64, y float64, options ...MouseMoveOptions) erro
 }
 
 func (m *mouseImpl) Down(options ...MouseDownOptions) error {
-	_, err := m.channel.Send("mouseDown", options)
+	_, err := m.channel.Send("mouseDown", optionsOf(options))
 	return err
 }
 
 func (m *mouseImpl) Up(options ...MouseUpOptions) error {
-	_, err := m.channel.Send("mouseUp", options)
+	_, err := m.channel.Send("mouseUp", optionsOf(options))
 	return err
 }
 
@@ -57,16 +59,26 @@ func (m *mouseImpl) Wheel(deltaX, deltaY float64) error {
 }
 
 type keyboardImpl struct {
-	channel *channel
+	channel   *channel
+	page      *pageImpl
+	mu        sync.Mutex
+	layout    *keyboardLayout
+	modifiers int
+	pressed   map[string]bool
 }
 
-func newKeyboard(channel *channel) *keyboardImpl {
+func newKeyboard(channel *channel, page *pageImpl) *keyboardImpl {
 	return &keyboardImpl{
 		channel: channel,
+		page:    page,
+		pressed: make(map[string]bool),
 	}
 }
 
 func (m *keyboardImpl) Down(key string) error {
+	if layout := m.currentLayout(); layout != nil {
+		return m.downWithLayout(layout, key)
+	}
 	_, err := m.channel.Send("keyboardDown", map[string]interface{}{
 		"key": key,
 	})
@@ -74,6 +86,9 @@ func (m *keyboardImpl) Down(key string) error {
 }
 
 func (m *keyboardImpl) Up(key string) error {
+	if layout := m.currentLayout(); layout != nil {
+		return m.upWithLayout(layout, key)
+	}
 	_, err := m.channel.Send("keyboardUp", map[string]interface{}{
 		"key": key,
 	})
@@ -88,6 +103,13 @@ func (m *keyboardImpl) InsertText(text string) error {
 }
 
 func (m *keyboardImpl) Type(text string, options ...KeyboardTypeOptions) error {
+	if layout := m.currentLayout(); layout != nil {
+		var delay *float64
+		if len(options) == 1 {
+			delay = options[0].Delay
+		}
+		return m.typeWithLayout(layout, text, delay)
+	}
 	_, err := m.channel.Send("keyboardInsertText", map[string]interface{}{
 		"text": text,
 	}, options)
@@ -95,6 +117,13 @@ func (m *keyboardImpl) Type(text string, options ...KeyboardTypeOptions) error {
 }
 
 func (m *keyboardImpl) Press(key string, options ...KeyboardPressOptions) error {
+	if layout := m.currentLayout(); layout != nil {
+		var delay *float64
+		if len(options) == 1 {
+			delay = options[0].Delay
+		}
+		return m.pressWithLayout(layout, key, delay)
+	}
 	_, err := m.channel.Send("keyboardPress", map[string]interface{}{
 		"key": key,
 	}, options)
@@ -0,0 +1,216 @@
+package playwright
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// installableBrowsers are the browsers and tools the driver can install.
+var installableBrowsers = map[string]bool{
+	"chromium": true, "chromium-headless-shell": true, "firefox": true, "webkit": true, "ffmpeg": true,
+	"chrome": true, "chrome-beta": true, "chrome-dev": true, "chrome-canary": true,
+	"msedge": true, "msedge-beta": true, "msedge-dev": true, "msedge-canary": true,
+}
+
+// InstallBrowsersOptions are the options of [InstallBrowsers].
+type InstallBrowsersOptions struct {
+	// Browsers to install: `chromium`, `firefox`, `webkit`, the `chrome` and `msedge` channels, or `ffmpeg`. Defaults
+	// to the browsers installed by default by the driver.
+	Browsers []string
+	// Whether to install the system dependencies of the browsers as well, as `playwright install --with-deps`. Usually
+	// requires root privileges.
+	WithDeps *bool
+	// Whether to reinstall the browsers even if they are already installed.
+	Force *bool
+	// ExpectedRevisions are the build revisions or the versions the browsers must have, by name, e.g.
+	// `{"chromium": "1112"}` or `{"firefox": "124.0"}`. They are only checked: the revisions are determined by the
+	// driver version, so installing fails before downloading anything if one of them is not the revision of the
+	// driver, instead of silently installing another build.
+	ExpectedRevisions map[string]string
+	// OnProgress is called with the progress of the downloads.
+	OnProgress func(progress InstallProgress)
+}
+
+// InstallProgress is the progress of a browser download, see [InstallBrowsersOptions.OnProgress].
+type InstallProgress struct {
+	// Browser being downloaded, as named by the driver, e.g. "Chromium 124.0.6367.29 (playwright build v1112)".
+	Browser string
+	// Percentage of the download completed, from 0 to 100.
+	Percent int
+	// Size of the download, e.g. "150.1 Mb", or empty if it is not known yet.
+	Total string
+	// Whether the browser is downloaded and extracted.
+	Done bool
+}
+
+// InstallBrowsers installs browsers with the driver, downloading the driver first if needed.
+func InstallBrowsers(options ...InstallBrowsersOptions) error {
+	driver, err := NewDriver(transformRunOptions(nil))
+	if err != nil {
+		return fmt.Errorf("could not get driver instance: %w", err)
+	}
+	if err := driver.DownloadDriver(); err != nil {
+		return fmt.Errorf("could not install driver: %w", err)
+	}
+	return driver.InstallBrowsers(options...)
+}
+
+// InstallBrowsers installs browsers with the driver, see [InstallBrowsers].
+func (d *PlaywrightDriver) InstallBrowsers(options ...InstallBrowsersOptions) error {
+	option := InstallBrowsersOptions{}
+	if len(options) == 1 {
+		option = options[0]
+	}
+	args, err := installBrowsersArgs(option)
+	if err != nil {
+		return err
+	}
+	if len(option.ExpectedRevisions) > 0 {
+		if err := d.checkRevisions(option.ExpectedRevisions); err != nil {
+			return err
+		}
+	}
+
+	cmd := d.Command(args...)
+	d.addDownloadEnv(cmd)
+	cmd.Stderr = d.options.Stderr
+	var progress *installProgressParser
+	if option.OnProgress != nil {
+		reader, writer := io.Pipe()
+		progress = newInstallProgressParser(reader, option.OnProgress)
+		cmd.Stdout = io.MultiWriter(d.options.Stdout, writer)
+		defer progress.wait()
+		defer writer.Close()
+	} else {
+		cmd.Stdout = d.options.Stdout
+	}
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("could not install browsers: %w", err)
+	}
+	return nil
+}
+
+func installBrowsersArgs(option InstallBrowsersOptions) ([]string, error) {
+	args := []string{"install"}
+	if option.WithDeps != nil && *option.WithDeps {
+		args = append(args, "--with-deps")
+	}
+	if option.Force != nil && *option.Force {
+		args = append(args, "--force")
+	}
+	for _, browser := range option.Browsers {
+		if !installableBrowsers[browser] {
+			return nil, fmt.Errorf("unknown browser %q", browser)
+		}
+	}
+	for browser := range option.ExpectedRevisions {
+		if !installableBrowsers[browser] {
+			return nil, fmt.Errorf("unknown browser %q", browser)
+		}
+	}
+	return append(args, option.Browsers...), nil
+}
+
+type driverBrowsers struct {
+	Browsers []struct {
+		Name           string `json:"name"`
+		Revision       string `json:"revision"`
+		BrowserVersion string `json:"browserVersion"`
+	} `json:"browsers"`
+}
+
+// checkRevisions checks that the driver installs the expected revisions.
+func (d *PlaywrightDriver) checkRevisions(revisions map[string]string) error {
+	data, err := os.ReadFile(filepath.Join(d.driverDirectory, "package", "browsers.json"))
+	if err != nil {
+		return fmt.Errorf("could not read browser revisions of the driver: %w", err)
+	}
+	var browsers driverBrowsers
+	if err := json.Unmarshal(data, &browsers); err != nil {
+		return fmt.Errorf("could not parse browser revisions of the driver: %w", err)
+	}
+	for name, revision := range revisions {
+		found := false
+		for _, browser := range browsers.Browsers {
+			if browser.Name != name {
+				continue
+			}
+			found = true
+			if revision != browser.Revision && revision != browser.BrowserVersion {
+				return fmt.Errorf("%s %s is not available with driver v%s, which installs %s (revision %s)", name, revision, d.Version, browser.BrowserVersion, browser.Revision)
+			}
+		}
+		if !found {
+			return fmt.Errorf("%s has no revision in driver v%s", name, d.Version)
+		}
+	}
+	return nil
+}
+
+var (
+	installDownloadingPattern = regexp.MustCompile(`^Downloading (.+?) from `)
+	installProgressPattern    = regexp.MustCompile(`(\d+)% of ([\d.]+ ?[A-Za-z]+)`)
+	installDonePattern        = regexp.MustCompile(`^(.+?) downloaded to `)
+)
+
+// installProgressParser parses the progress of the downloads from the output of the install command.
+type installProgressParser struct {
+	wg sync.WaitGroup
+}
+
+func newInstallProgressParser(output io.Reader, onProgress func(InstallProgress)) *installProgressParser {
+	p := &installProgressParser{}
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		current := InstallProgress{}
+		scanner := bufio.NewScanner(output)
+		for scanner.Scan() {
+			if progress, ok := parseInstallProgress(&current, scanner.Text()); ok {
+				onProgress(progress)
+			}
+		}
+		// Drains the output if the scanner stops on a too long line.
+		_, _ = io.Copy(io.Discard, output)
+	}()
+	return p
+}
+
+func (p *installProgressParser) wait() {
+	p.wg.Wait()
+}
+
+// parseInstallProgress updates current with a line of output and returns it if the line reports progress.
+func parseInstallProgress(current *InstallProgress, line string) (InstallProgress, bool) {
+	line = strings.TrimSpace(line)
+	if m := installDownloadingPattern.FindStringSubmatch(line); m != nil {
+		*current = InstallProgress{Browser: m[1]}
+		return *current, true
+	}
+	if m := installProgressPattern.FindStringSubmatch(line); m != nil && current.Browser != "" {
+		percent, _ := strconv.Atoi(m[1])
+		if percent == current.Percent && m[2] == current.Total {
+			return InstallProgress{}, false
+		}
+		current.Percent, current.Total = percent, m[2]
+		return *current, true
+	}
+	if m := installDonePattern.FindStringSubmatch(line); m != nil {
+		done := *current
+		if done.Browser == "" {
+			done.Browser = m[1]
+		}
+		done.Percent, done.Done = 100, true
+		*current = InstallProgress{}
+		return done, true
+	}
+	return InstallProgress{}, false
+}
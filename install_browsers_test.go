@@ -0,0 +1,64 @@
+package playwright
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstallBrowsersArgs(t *testing.T) {
+	args, err := installBrowsersArgs(InstallBrowsersOptions{
+		Browsers: []string{"chromium", "msedge"},
+		WithDeps: Bool(true),
+		Force:    Bool(true),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"install", "--with-deps", "--force", "chromium", "msedge"}, args)
+
+	_, err = installBrowsersArgs(InstallBrowsersOptions{Browsers: []string{"opera"}})
+	require.ErrorContains(t, err, `unknown browser "opera"`)
+}
+
+func TestInstallBrowsersCheckRevisions(t *testing.T) {
+	driverDirectory := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(driverDirectory, "package"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(driverDirectory, "package", "browsers.json"), []byte(`{
+		"browsers": [
+			{"name": "chromium", "revision": "1112", "browserVersion": "124.0.6367.29"},
+			{"name": "firefox", "revision": "1447", "browserVersion": "124.0"}
+		]
+	}`), 0o644))
+	driver := &PlaywrightDriver{driverDirectory: driverDirectory, Version: playwrightCliVersion, options: &RunOptions{}}
+
+	require.NoError(t, driver.checkRevisions(map[string]string{"chromium": "1112", "firefox": "124.0"}))
+	err := driver.checkRevisions(map[string]string{"chromium": "1100"})
+	require.ErrorContains(t, err, "chromium 1100 is not available with driver v"+playwrightCliVersion+", which installs 124.0.6367.29 (revision 1112)")
+	err = driver.checkRevisions(map[string]string{"webkit": "1992"})
+	require.ErrorContains(t, err, "webkit has no revision")
+}
+
+func TestParseInstallProgress(t *testing.T) {
+	output := []string{
+		"Downloading Chromium 124.0.6367.29 (playwright build v1112) from https://playwright.azureedge.net/builds/chromium/1112/chromium-linux.zip",
+		"|                                                                                |   0% of 150.1 Mb",
+		"|■■■■■■■■                                                                        |  10% of 150.1 Mb",
+		"|■■■■■■■■                                                                        |  10% of 150.1 Mb",
+		"Chromium 124.0.6367.29 (playwright build v1112) downloaded to /root/.cache/ms-playwright/chromium-1112",
+	}
+	browser := "Chromium 124.0.6367.29 (playwright build v1112)"
+	var current InstallProgress
+	var reported []InstallProgress
+	for _, line := range output {
+		if progress, ok := parseInstallProgress(&current, line); ok {
+			reported = append(reported, progress)
+		}
+	}
+	require.Equal(t, []InstallProgress{
+		{Browser: browser},
+		{Browser: browser, Percent: 0, Total: "150.1 Mb"},
+		{Browser: browser, Percent: 10, Total: "150.1 Mb"},
+		{Browser: browser, Percent: 100, Total: "150.1 Mb", Done: true},
+	}, reported)
+}
@@ -0,0 +1,410 @@
+package playwright
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/playwright-community/playwright-go/internal/multierror"
+)
+
+// isolatedWorldCollector collects the elements an event named token is dispatched to, before any listener of the
+// page sees it. Isolated worlds share the DOM but not JavaScript objects with the page, so the main world dispatches
+// the event to the elements and the isolated world collects them, without marking them in the DOM. The page can't
+// listen to the event as it doesn't know its name.
+const isolatedWorldCollector = `function(token) {
+  const collected = globalThis.__pwCollectedElements || (globalThis.__pwCollectedElements = new Map());
+  const elements = [];
+  const listener = event => {
+    elements.push(event.composedPath()[0]);
+    event.stopImmediatePropagation();
+  };
+  window.addEventListener(token, listener, true);
+  collected.set(token, { elements, listener });
+}`
+
+// isolatedWorldCollected returns the elements collected for token and stops collecting them.
+const isolatedWorldCollected = `function isolatedWorldCollected(token) {
+  const collected = globalThis.__pwCollectedElements.get(token);
+  globalThis.__pwCollectedElements.delete(token);
+  window.removeEventListener(token, collected.listener, true);
+  return collected.elements;
+}`
+
+// isolatedWorldCollectedCount returns the number of elements collected for token and stops collecting them.
+const isolatedWorldCollectedCount = `function(token) {
+  ` + isolatedWorldCollected + `
+  return isolatedWorldCollected(token).length;
+}`
+
+// isolatedWorldFunction evaluates expression with the collected elements, or the first of
+// them if single is set, as the first argument.
+const isolatedWorldFunction = `async function(expression, token, single, arg) {
+  ` + isolatedWorldCollected + `
+  const elements = token ? isolatedWorldCollected(token) : [];
+  let fn;
+  try {
+    fn = (0, eval)('(' + expression + ')');
+  } catch (e) {
+    fn = (0, eval)(expression);
+  }
+  if (typeof fn !== 'function')
+    return fn;
+  if (!token)
+    return await fn(arg);
+  return await fn(single ? elements[0] : elements, arg);
+}`
+
+// isolatedWorldDispatch dispatches the event named token to an element, or to each of the elements.
+const isolatedWorldDispatch = `(elements, token) => {
+  for (const element of [].concat(elements))
+    element.dispatchEvent(new CustomEvent(token, { bubbles: true, composed: true }));
+}`
+
+// frameProbeWorld is the isolated world the frames are probed in to find their CDP id.
+const frameProbeWorld = "__playwright_go_frame_probe__"
+
+// newIsolatedWorldToken returns a random name for the events collecting elements.
+func newIsolatedWorldToken() (string, error) {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return "pw-" + hex.EncodeToString(buf), nil
+}
+
+// ErrIsolatedWorldNotSupported is returned when an isolated world is requested in a browser
+// other than Chromium.
+var ErrIsolatedWorldNotSupported = errors.New("isolated worlds are only supported in Chromium")
+
+// isolatedWorld evaluates scripts in a named isolated world of a frame. Worlds with the same
+// name share their globals, worlds with different names and the page's main world don't.
+type isolatedWorld struct {
+	frame *frameImpl
+	name  string
+}
+
+func newIsolatedWorld(frame *frameImpl, name string) (*isolatedWorld, error) {
+	if name == "" {
+		return nil, errors.New("isolated world name must not be empty")
+	}
+	return &isolatedWorld{frame: frame, name: name}, nil
+}
+
+func (w *isolatedWorld) Name() string {
+	return w.name
+}
+
+func (w *isolatedWorld) Evaluate(expression string, arg ...interface{}) (interface{}, error) {
+	if w.name == "" {
+		return nil, errors.New("isolated world name must not be empty")
+	}
+	var value interface{}
+	if len(arg) == 1 {
+		value = arg[0]
+	}
+	return w.evaluate(expression, nil, false, value)
+}
+
+// evaluate calls expression in the isolated world. arg is passed by value, so it must be JSON serializable. If
+// dispatch is set, it is called to dispatch the event named after its token to the elements passed as well.
+func (w *isolatedWorld) evaluate(expression string, dispatch func(token string) error, single bool, arg interface{}) (interface{}, error) {
+	if w.frame.page == nil {
+		return nil, errors.New("frame is not attached to a page")
+	}
+	session, err := w.frame.page.isolatedWorldSession()
+	if err != nil {
+		return nil, err
+	}
+	frameID, err := w.frame.page.cdpFrameID(session, w.frame)
+	if err != nil {
+		return nil, err
+	}
+	contextID, err := createIsolatedWorld(session, frameID, w.name)
+	if err != nil {
+		return nil, err
+	}
+	token := ""
+	if dispatch != nil {
+		if token, err = newIsolatedWorldToken(); err != nil {
+			return nil, err
+		}
+		if _, err := callInIsolatedWorld(session, contextID, isolatedWorldCollector, token); err != nil {
+			return nil, err
+		}
+		if err := dispatch(token); err != nil {
+			_, _ = callInIsolatedWorld(session, contextID, isolatedWorldCollected, token)
+			return nil, err
+		}
+	}
+	return callInIsolatedWorld(session, contextID, isolatedWorldFunction, expression, token, single, arg)
+}
+
+// createIsolatedWorld returns the id of the execution context of the isolated world of the frame.
+func createIsolatedWorld(session CDPSession, frameID string, name string) (interface{}, error) {
+	world, err := session.Send("Page.createIsolatedWorld", map[string]interface{}{
+		"frameId":             frameID,
+		"worldName":           name,
+		"grantUniveralAccess": true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return world.(map[string]interface{})["executionContextId"], nil
+}
+
+// callInIsolatedWorld calls fn with args, passed by value, in the execution context of an isolated world.
+func callInIsolatedWorld(session CDPSession, contextID interface{}, fn string, args ...interface{}) (interface{}, error) {
+	arguments := make([]map[string]interface{}, 0, len(args))
+	for _, arg := range args {
+		arguments = append(arguments, map[string]interface{}{"value": arg})
+	}
+	result, err := session.Send("Runtime.callFunctionOn", map[string]interface{}{
+		"functionDeclaration": fn,
+		"executionContextId":  contextID,
+		"arguments":           arguments,
+		"awaitPromise":        true,
+		"returnByValue":       true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return parseCDPEvaluationResult(result)
+}
+
+// cdpFrameID returns the CDP id of a frame of the page, which is kept for the lifetime of the frame.
+func (p *pageImpl) cdpFrameID(session CDPSession, frame *frameImpl) (string, error) {
+	p.isolatedWorldMu.Lock()
+	id, ok := p.cdpFrameIDs[frame]
+	p.isolatedWorldMu.Unlock()
+	if ok {
+		return id, nil
+	}
+	id, err := probeCDPFrameID(session, frame)
+	if err != nil {
+		return "", err
+	}
+	p.isolatedWorldMu.Lock()
+	p.cdpFrameIDs[frame] = id
+	p.isolatedWorldMu.Unlock()
+	return id, nil
+}
+
+// forgetCDPFrameID drops the CDP id of a detached frame.
+func (p *pageImpl) forgetCDPFrameID(frame *frameImpl) {
+	p.isolatedWorldMu.Lock()
+	delete(p.cdpFrameIDs, frame)
+	p.isolatedWorldMu.Unlock()
+}
+
+// probeCDPFrameID finds the CDP id of a child frame: an event is dispatched from the frame and the frame whose probe
+// world collects it is the one.
+func probeCDPFrameID(session CDPSession, frame *frameImpl) (string, error) {
+	result, err := session.Send("Page.getFrameTree", map[string]interface{}{})
+	if err != nil {
+		return "", err
+	}
+	tree, _ := result.(map[string]interface{})["frameTree"].(map[string]interface{})
+	ids := cdpFrameTreeIDs(tree)
+	if len(ids) == 0 {
+		return "", errors.New("could not read the frame tree")
+	}
+	if frame.ParentFrame() == nil {
+		return ids[0], nil
+	}
+	token, err := newIsolatedWorldToken()
+	if err != nil {
+		return "", err
+	}
+	contexts := make(map[string]interface{})
+	for _, id := range ids[1:] {
+		contextID, err := createIsolatedWorld(session, id, frameProbeWorld)
+		if err != nil {
+			continue
+		}
+		if _, err := callInIsolatedWorld(session, contextID, isolatedWorldCollector, token); err == nil {
+			contexts[id] = contextID
+		}
+	}
+	_, dispatchErr := frame.Evaluate(`token => document.dispatchEvent(new CustomEvent(token))`, token)
+	found := ""
+	for id, contextID := range contexts {
+		elements, err := callInIsolatedWorld(session, contextID, isolatedWorldCollectedCount, token)
+		if err == nil && evaluatedNumber(elements) > 0 {
+			found = id
+		}
+	}
+	if dispatchErr != nil {
+		return "", dispatchErr
+	}
+	if found == "" {
+		return "", fmt.Errorf("could not find frame %s", frame.URL())
+	}
+	return found, nil
+}
+
+// cdpFrameTreeIDs returns the ids of the frames of a CDP frame tree, the root first.
+func cdpFrameTreeIDs(tree map[string]interface{}) []string {
+	if tree == nil {
+		return nil
+	}
+	info, _ := tree["frame"].(map[string]interface{})
+	id, _ := info["id"].(string)
+	ids := []string{id}
+	children, _ := tree["childFrames"].([]interface{})
+	for _, child := range children {
+		node, _ := child.(map[string]interface{})
+		ids = append(ids, cdpFrameTreeIDs(node)...)
+	}
+	return ids
+}
+
+// isolatedWorldSession returns the CDP session used for the isolated worlds of the page.
+func (p *pageImpl) isolatedWorldSession() (CDPSession, error) {
+	session, err := p.cdpSession()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrIsolatedWorldNotSupported, err)
+	}
+	return session, nil
+}
+
+// cdpSession returns the CDP session of the page. The session is kept for the lifetime of the
+// page, because init scripts and overrides set through it are removed when it is detached.
+func (p *pageImpl) cdpSession() (CDPSession, error) {
+	p.isolatedWorldMu.Lock()
+	defer p.isolatedWorldMu.Unlock()
+	if p.isolatedSession != nil {
+		return p.isolatedSession, nil
+	}
+	session, err := p.browserContext.NewCDPSession(p)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := session.Send("Page.enable", map[string]interface{}{}); err != nil {
+		return nil, err
+	}
+	p.isolatedSession = session
+	return session, nil
+}
+
+// addIsolatedInitScript adds a script evaluated in its isolated world in every document the
+// page navigates to, and in the current document too if runImmediately is set.
+func (p *pageImpl) addIsolatedInitScript(script *initScript, runImmediately bool) error {
+	session, err := p.isolatedWorldSession()
+	if err != nil {
+		return err
+	}
+	result, err := session.Send("Page.addScriptToEvaluateOnNewDocument", map[string]interface{}{
+		"source":         script.source,
+		"worldName":      script.world(),
+		"runImmediately": runImmediately,
+	})
+	if err != nil {
+		return err
+	}
+	identifier, _ := result.(map[string]interface{})["identifier"].(string)
+	p.isolatedWorldMu.Lock()
+	p.isolatedScripts[script.id] = identifier
+	p.isolatedWorldMu.Unlock()
+	return nil
+}
+
+func (p *pageImpl) removeIsolatedInitScript(id string) error {
+	p.isolatedWorldMu.Lock()
+	identifier, ok := p.isolatedScripts[id]
+	delete(p.isolatedScripts, id)
+	session := p.isolatedSession
+	p.isolatedWorldMu.Unlock()
+	if !ok || session == nil {
+		return nil
+	}
+	_, err := session.Send("Page.removeScriptToEvaluateOnNewDocument", map[string]interface{}{
+		"identifier": identifier,
+	})
+	return err
+}
+
+func (b *browserContextImpl) addIsolatedInitScript(script *initScript) error {
+	b.initScripts.add(script)
+	var errs []error
+	for _, page := range b.Pages() {
+		if err := page.(*pageImpl).addIsolatedInitScript(script, false); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return multierror.Join(errs...)
+}
+
+// applyIsolatedInitScripts adds the isolated world init scripts of the context to a new page. They are run in its
+// current document as well, which may have been created before the page was reported.
+func (b *browserContextImpl) applyIsolatedInitScripts(page *pageImpl, scripts []*initScript) {
+	var errs []error
+	for _, script := range scripts {
+		if err := page.addIsolatedInitScript(script, true); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := multierror.Join(errs...); err != nil {
+		b.logf("could not add isolated world init scripts: %v\n", err)
+	}
+}
+
+// readInitScript returns the source of script, reading it from Path if set.
+func readInitScript(script Script) (string, error) {
+	var source string
+	if script.Content != nil {
+		source = *script.Content
+	}
+	if script.Path != nil {
+		content, err := os.ReadFile(*script.Path)
+		if err != nil {
+			return "", err
+		}
+		source = string(content)
+	}
+	return source, nil
+}
+
+func parseCDPEvaluationResult(result interface{}) (interface{}, error) {
+	response, _ := result.(map[string]interface{})
+	if details, ok := response["exceptionDetails"].(map[string]interface{}); ok {
+		message, _ := details["text"].(string)
+		if exception, ok := details["exception"].(map[string]interface{}); ok {
+			if description, ok := exception["description"].(string); ok {
+				message = description
+			}
+		}
+		return nil, fmt.Errorf("evaluation failed: %s", message)
+	}
+	remote, _ := response["result"].(map[string]interface{})
+	return remote["value"], nil
+}
+
+// dispatchToElements dispatches the event named token to the elements matched by the locator. If single is set, the
+// event is only dispatched to the first element, waiting for it like [Locator.Evaluate].
+func (l *locatorImpl) dispatchToElements(token string, single bool, options ...LocatorEvaluateOptions) error {
+	if !single {
+		_, err := l.frame.EvalOnSelectorAll(l.selector, isolatedWorldDispatch, token)
+		return err
+	}
+	var option FrameWaitForSelectorOptions
+	if len(options) == 1 {
+		option.Timeout = options[0].Timeout
+	}
+	_, err := l.withElement(func(handle ElementHandle) (interface{}, error) {
+		return handle.Evaluate(isolatedWorldDispatch, token)
+	}, option)
+	return err
+}
+
+func (l *locatorImpl) evaluateInIsolatedWorld(world string, expression string, arg interface{}, single bool, options ...LocatorEvaluateOptions) (interface{}, error) {
+	isolated, err := newIsolatedWorld(l.frame, world)
+	if err != nil {
+		return nil, err
+	}
+	return isolated.evaluate(expression, func(token string) error {
+		return l.dispatchToElements(token, single, options...)
+	}, single, arg)
+}
@@ -0,0 +1,457 @@
+package playwright
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ErrKeyboardLayoutNotSupported is returned when a keyboard layout other than `en-US` is set in a browser other than
+// Chromium.
+var ErrKeyboardLayoutNotSupported = errors.New("keyboard layouts are only supported in Chromium")
+
+const defaultKeyboardLayout = "en-US"
+
+// Bits of the modifiers of Input.dispatchKeyEvent.
+const (
+	modifierAlt     = 1
+	modifierControl = 2
+	modifierMeta    = 4
+	modifierShift   = 8
+)
+
+// keyDefinition is a key of a layout, by its code. Dead keys have the key `Dead`.
+type keyDefinition struct {
+	key      string
+	shiftKey string
+	keyCode  int
+	location int
+	text     string
+}
+
+// keyDescription is a key as it is dispatched, with the modifiers that are pressed.
+type keyDescription struct {
+	code     string
+	key      string
+	text     string
+	keyCode  int
+	location int
+}
+
+// keyboardLayout is a layout of a keyboard, by the codes of the keys, with the codes of the keys and the characters
+// they generate.
+type keyboardLayout struct {
+	keys  map[string]keyDefinition
+	codes map[string]string
+}
+
+var keyboardLayouts = map[string]*keyboardLayout{
+	defaultKeyboardLayout: newKeyboardLayout(usKeyDefinitions()),
+	"de-DE":               newKeyboardLayout(usKeyDefinitions(), deKeyDefinitions()),
+	"fr-FR":               newKeyboardLayout(usKeyDefinitions(), frKeyDefinitions()),
+}
+
+// newKeyboardLayout returns the layout of the definitions, the later ones overriding the earlier ones.
+func newKeyboardLayout(definitions ...map[string]keyDefinition) *keyboardLayout {
+	layout := &keyboardLayout{
+		keys:  make(map[string]keyDefinition),
+		codes: make(map[string]string),
+	}
+	for _, keys := range definitions {
+		for code, definition := range keys {
+			layout.keys[code] = definition
+		}
+	}
+	codes := make([]string, 0, len(layout.keys))
+	for code := range layout.keys {
+		codes = append(codes, code)
+	}
+	// The left keys come first, so that `Shift` is `ShiftLeft`.
+	sort.Strings(codes)
+	for _, code := range codes {
+		definition := layout.keys[code]
+		for _, key := range []string{definition.key, definition.shiftKey} {
+			if _, ok := layout.codes[key]; key != "" && key != "Dead" && !ok {
+				layout.codes[key] = code
+			}
+		}
+	}
+	layout.codes["\n"] = "Enter"
+	layout.codes["\r"] = "Enter"
+	if runtime.GOOS == "darwin" {
+		layout.codes["ControlOrMeta"] = "MetaLeft"
+	} else {
+		layout.codes["ControlOrMeta"] = "ControlLeft"
+	}
+	return layout
+}
+
+func usKeyDefinitions() map[string]keyDefinition {
+	keys := map[string]keyDefinition{
+		"Minus":        {key: "-", shiftKey: "_", keyCode: 189},
+		"Equal":        {key: "=", shiftKey: "+", keyCode: 187},
+		"BracketLeft":  {key: "[", shiftKey: "{", keyCode: 219},
+		"BracketRight": {key: "]", shiftKey: "}", keyCode: 221},
+		"Backslash":    {key: "\\", shiftKey: "|", keyCode: 220},
+		"Semicolon":    {key: ";", shiftKey: ":", keyCode: 186},
+		"Quote":        {key: "'", shiftKey: "\"", keyCode: 222},
+		"Backquote":    {key: "`", shiftKey: "~", keyCode: 192},
+		"Comma":        {key: ",", shiftKey: "<", keyCode: 188},
+		"Period":       {key: ".", shiftKey: ">", keyCode: 190},
+		"Slash":        {key: "/", shiftKey: "?", keyCode: 191},
+		"Space":        {key: " ", keyCode: 32},
+		"Enter":        {key: "Enter", keyCode: 13, text: "\r"},
+		"Tab":          {key: "Tab", keyCode: 9},
+		"Backspace":    {key: "Backspace", keyCode: 8},
+		"Delete":       {key: "Delete", keyCode: 46},
+		"Escape":       {key: "Escape", keyCode: 27},
+		"Insert":       {key: "Insert", keyCode: 45},
+		"Home":         {key: "Home", keyCode: 36},
+		"End":          {key: "End", keyCode: 35},
+		"PageUp":       {key: "PageUp", keyCode: 33},
+		"PageDown":     {key: "PageDown", keyCode: 34},
+		"ArrowLeft":    {key: "ArrowLeft", keyCode: 37},
+		"ArrowUp":      {key: "ArrowUp", keyCode: 38},
+		"ArrowRight":   {key: "ArrowRight", keyCode: 39},
+		"ArrowDown":    {key: "ArrowDown", keyCode: 40},
+		"ShiftLeft":    {key: "Shift", keyCode: 16, location: 1},
+		"ShiftRight":   {key: "Shift", keyCode: 16, location: 2},
+		"ControlLeft":  {key: "Control", keyCode: 17, location: 1},
+		"ControlRight": {key: "Control", keyCode: 17, location: 2},
+		"AltLeft":      {key: "Alt", keyCode: 18, location: 1},
+		"AltRight":     {key: "Alt", keyCode: 18, location: 2},
+		"MetaLeft":     {key: "Meta", keyCode: 91, location: 1},
+		"MetaRight":    {key: "Meta", keyCode: 92, location: 2},
+	}
+	for c := 'A'; c <= 'Z'; c++ {
+		keys["Key"+string(c)] = keyDefinition{key: strings.ToLower(string(c)), shiftKey: string(c), keyCode: int(c)}
+	}
+	for i, shiftKey := range ")!@#$%^&*(" {
+		keys[fmt.Sprintf("Digit%d", i)] = keyDefinition{key: fmt.Sprint(i), shiftKey: string(shiftKey), keyCode: 48 + i}
+	}
+	for i := 1; i <= 12; i++ {
+		keys[fmt.Sprintf("F%d", i)] = keyDefinition{key: fmt.Sprintf("F%d", i), keyCode: 111 + i}
+	}
+	return keys
+}
+
+// deKeyDefinitions are the keys of the German layout that differ from the US layout, with the key codes of Windows.
+func deKeyDefinitions() map[string]keyDefinition {
+	return map[string]keyDefinition{
+		"KeyY":          {key: "z", shiftKey: "Z", keyCode: 90},
+		"KeyZ":          {key: "y", shiftKey: "Y", keyCode: 89},
+		"Digit2":        {key: "2", shiftKey: "\"", keyCode: 50},
+		"Digit3":        {key: "3", shiftKey: "§", keyCode: 51},
+		"Digit6":        {key: "6", shiftKey: "&", keyCode: 54},
+		"Digit7":        {key: "7", shiftKey: "/", keyCode: 55},
+		"Digit8":        {key: "8", shiftKey: "(", keyCode: 56},
+		"Digit9":        {key: "9", shiftKey: ")", keyCode: 57},
+		"Digit0":        {key: "0", shiftKey: "=", keyCode: 48},
+		"Minus":         {key: "ß", shiftKey: "?", keyCode: 219},
+		"Equal":         {key: "Dead", shiftKey: "Dead", keyCode: 221},
+		"BracketLeft":   {key: "ü", shiftKey: "Ü", keyCode: 186},
+		"BracketRight":  {key: "+", shiftKey: "*", keyCode: 187},
+		"Backslash":     {key: "#", shiftKey: "'", keyCode: 191},
+		"Semicolon":     {key: "ö", shiftKey: "Ö", keyCode: 192},
+		"Quote":         {key: "ä", shiftKey: "Ä", keyCode: 222},
+		"Backquote":     {key: "Dead", shiftKey: "°", keyCode: 220},
+		"Comma":         {key: ",", shiftKey: ";", keyCode: 188},
+		"Period":        {key: ".", shiftKey: ":", keyCode: 190},
+		"Slash":         {key: "-", shiftKey: "_", keyCode: 189},
+		"IntlBackslash": {key: "<", shiftKey: ">", keyCode: 226},
+	}
+}
+
+// frKeyDefinitions are the keys of the French AZERTY layout that differ from the US layout, with the key codes of
+// Windows.
+func frKeyDefinitions() map[string]keyDefinition {
+	keys := map[string]keyDefinition{
+		"KeyQ":          {key: "a", shiftKey: "A", keyCode: 65},
+		"KeyA":          {key: "q", shiftKey: "Q", keyCode: 81},
+		"KeyW":          {key: "z", shiftKey: "Z", keyCode: 90},
+		"KeyZ":          {key: "w", shiftKey: "W", keyCode: 87},
+		"KeyM":          {key: ",", shiftKey: "?", keyCode: 188},
+		"Semicolon":     {key: "m", shiftKey: "M", keyCode: 77},
+		"Minus":         {key: ")", shiftKey: "°", keyCode: 219},
+		"Equal":         {key: "=", shiftKey: "+", keyCode: 187},
+		"BracketLeft":   {key: "Dead", shiftKey: "Dead", keyCode: 221},
+		"BracketRight":  {key: "$", shiftKey: "£", keyCode: 186},
+		"Backslash":     {key: "*", shiftKey: "µ", keyCode: 220},
+		"Quote":         {key: "ù", shiftKey: "%", keyCode: 192},
+		"Backquote":     {key: "²", keyCode: 222},
+		"Comma":         {key: ";", shiftKey: ".", keyCode: 190},
+		"Period":        {key: ":", shiftKey: "/", keyCode: 191},
+		"Slash":         {key: "!", shiftKey: "§", keyCode: 223},
+		"IntlBackslash": {key: "<", shiftKey: ">", keyCode: 226},
+	}
+	for i, key := range []string{"à", "&", "é", "\"", "'", "(", "-", "è", "_", "ç"} {
+		keys[fmt.Sprintf("Digit%d", i)] = keyDefinition{key: key, shiftKey: fmt.Sprint(i), keyCode: 48 + i}
+	}
+	return keys
+}
+
+// describe returns the description of key, a code, a key or a character, when modifiers are pressed. A character
+// that is the shifted key of its code is described as it is, whether `Shift` is pressed or not.
+func (l *keyboardLayout) describe(key string, modifiers int) (keyDescription, error) {
+	code := key
+	definition, ok := l.keys[code]
+	if !ok {
+		if code, ok = l.codes[key]; !ok {
+			return keyDescription{}, fmt.Errorf("unknown key: %q", key)
+		}
+		definition = l.keys[code]
+	}
+	description := keyDescription{
+		code:     code,
+		key:      definition.key,
+		keyCode:  definition.keyCode,
+		location: definition.location,
+	}
+	if definition.shiftKey != "" && (key == definition.shiftKey || modifiers&modifierShift != 0) {
+		description.key = definition.shiftKey
+	}
+	switch {
+	case modifiers&^modifierShift != 0 || description.key == "Dead":
+	case definition.text != "":
+		description.text = definition.text
+	case utf8.RuneCountInString(description.key) == 1:
+		description.text = description.key
+	}
+	return description, nil
+}
+
+// hasCharacter returns whether a key of the layout generates the character.
+func (l *keyboardLayout) hasCharacter(char string) bool {
+	_, ok := l.codes[char]
+	return ok
+}
+
+func modifierOf(key string) int {
+	switch key {
+	case "Alt":
+		return modifierAlt
+	case "Control":
+		return modifierControl
+	case "Meta":
+		return modifierMeta
+	case "Shift":
+		return modifierShift
+	}
+	return 0
+}
+
+// splitKeyCombination splits a combination such as `Control+Shift+T` or `Control++` into its keys.
+func splitKeyCombination(key string) []string {
+	keys := make([]string, 0)
+	var b strings.Builder
+	for _, r := range key {
+		if r == '+' && b.Len() > 0 {
+			keys = append(keys, b.String())
+			b.Reset()
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return append(keys, b.String())
+}
+
+func (m *keyboardImpl) SetLayout(name string) error {
+	if name == "" {
+		name = defaultKeyboardLayout
+	}
+	layout, ok := keyboardLayouts[name]
+	if !ok {
+		return fmt.Errorf("unknown keyboard layout: %q", name)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if name == defaultKeyboardLayout {
+		m.layout = nil
+	} else {
+		if _, err := m.page.cdpSession(); err != nil {
+			return fmt.Errorf("%w: %v", ErrKeyboardLayoutNotSupported, err)
+		}
+		m.layout = layout
+	}
+	m.modifiers = 0
+	m.pressed = make(map[string]bool)
+	return nil
+}
+
+// currentLayout returns the layout of the keyboard, nil for the default layout, whose keys are pressed by the driver.
+func (m *keyboardImpl) currentLayout() *keyboardLayout {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.layout
+}
+
+func (m *keyboardImpl) downWithLayout(layout *keyboardLayout, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	description, err := layout.describe(key, m.modifiers)
+	if err != nil {
+		return err
+	}
+	autoRepeat := m.pressed[description.code]
+	m.pressed[description.code] = true
+	if modifier := modifierOf(description.key); modifier != 0 {
+		// Modifiers are pressed by the driver, which applies them to the mouse actions too.
+		if _, err := m.channel.Send("keyboardDown", map[string]interface{}{"key": description.code}); err != nil {
+			return err
+		}
+		m.modifiers |= modifier
+		return nil
+	}
+	eventType := "keyDown"
+	if description.text == "" {
+		eventType = "rawKeyDown"
+	}
+	return m.dispatchKeyEvent(eventType, description, autoRepeat)
+}
+
+func (m *keyboardImpl) upWithLayout(layout *keyboardLayout, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	description, err := layout.describe(key, m.modifiers)
+	if err != nil {
+		return err
+	}
+	delete(m.pressed, description.code)
+	if modifier := modifierOf(description.key); modifier != 0 {
+		m.modifiers &^= modifier
+		_, err := m.channel.Send("keyboardUp", map[string]interface{}{"key": description.code})
+		return err
+	}
+	return m.dispatchKeyEvent("keyUp", description, false)
+}
+
+func (m *keyboardImpl) dispatchKeyEvent(eventType string, description keyDescription, autoRepeat bool) error {
+	session, err := m.page.cdpSession()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrKeyboardLayoutNotSupported, err)
+	}
+	params := map[string]interface{}{
+		"type":                  eventType,
+		"modifiers":             m.modifiers,
+		"windowsVirtualKeyCode": description.keyCode,
+		"code":                  description.code,
+		"key":                   description.key,
+		"location":              description.location,
+		"autoRepeat":            autoRepeat,
+	}
+	if eventType == "keyDown" {
+		params["text"] = description.text
+		params["unmodifiedText"] = description.text
+	}
+	_, err = session.Send("Input.dispatchKeyEvent", params)
+	return err
+}
+
+func (m *keyboardImpl) pressWithLayout(layout *keyboardLayout, key string, delay *float64) error {
+	keys := splitKeyCombination(key)
+	for _, k := range keys {
+		if err := m.downWithLayout(layout, k); err != nil {
+			return err
+		}
+	}
+	sleepDelay(delay)
+	for i := len(keys) - 1; i >= 0; i-- {
+		if err := m.upWithLayout(layout, keys[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *keyboardImpl) typeWithLayout(layout *keyboardLayout, text string, delay *float64) error {
+	for _, r := range text {
+		char := string(r)
+		if layout.hasCharacter(char) {
+			if err := m.pressWithLayout(layout, char, delay); err != nil {
+				return err
+			}
+			continue
+		}
+		sleepDelay(delay)
+		if err := m.InsertText(char); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sleepDelay(delay *float64) {
+	if delay != nil && *delay > 0 {
+		time.Sleep(time.Duration(*delay * float64(time.Millisecond)))
+	}
+}
+
+// pressWithLayout focuses the element of selector and presses key with the layout of the keyboard of the page.
+func (f *frameImpl) pressWithLayout(layout *keyboardLayout, selector, key string, options ...FramePressOptions) error {
+	var option FramePressOptions
+	if len(options) == 1 {
+		option = options[0]
+	}
+	if err := f.Focus(selector, FrameFocusOptions{Strict: option.Strict, Timeout: option.Timeout}); err != nil {
+		return err
+	}
+	return f.page.keyboard.pressWithLayout(layout, key, option.Delay)
+}
+
+// typeWithLayout focuses the element of selector and types text with the layout of the keyboard of the page.
+func (f *frameImpl) typeWithLayout(layout *keyboardLayout, selector, text string, options ...FrameTypeOptions) error {
+	var option FrameTypeOptions
+	if len(options) == 1 {
+		option = options[0]
+	}
+	if err := f.Focus(selector, FrameFocusOptions{Strict: option.Strict, Timeout: option.Timeout}); err != nil {
+		return err
+	}
+	return f.page.keyboard.typeWithLayout(layout, text, option.Delay)
+}
+
+// pressWithLayout focuses the element and presses key with the layout of the keyboard of its page.
+func (e *elementHandleImpl) pressWithLayout(page *pageImpl, layout *keyboardLayout, key string, options ...ElementHandlePressOptions) error {
+	var delay *float64
+	if len(options) == 1 {
+		delay = options[0].Delay
+	}
+	if err := e.Focus(); err != nil {
+		return err
+	}
+	return page.keyboard.pressWithLayout(layout, key, delay)
+}
+
+// typeWithLayout focuses the element and types text with the layout of the keyboard of its page.
+func (e *elementHandleImpl) typeWithLayout(page *pageImpl, layout *keyboardLayout, text string, options ...ElementHandleTypeOptions) error {
+	var delay *float64
+	if len(options) == 1 {
+		delay = options[0].Delay
+	}
+	if err := e.Focus(); err != nil {
+		return err
+	}
+	return page.keyboard.typeWithLayout(layout, text, delay)
+}
+
+// keyboardLayout returns the page of the element and the layout of its keyboard, nil for the default layout.
+func (e *elementHandleImpl) keyboardLayout() (*pageImpl, *keyboardLayout) {
+	frame, err := e.OwnerFrame()
+	if err != nil || frame == nil || frame.(*frameImpl).page == nil {
+		return nil, nil
+	}
+	page := frame.(*frameImpl).page
+	return page, page.keyboard.currentLayout()
+}
+
+// keyboardLayout returns the layout of the keyboard of the page of the frame, nil for the default layout.
+func (f *frameImpl) keyboardLayout() *keyboardLayout {
+	if f.page == nil {
+		return nil
+	}
+	return f.page.keyboard.currentLayout()
+}
@@ -0,0 +1,73 @@
+package playwright
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitKeyCombination(t *testing.T) {
+	require.Equal(t, []string{"a"}, splitKeyCombination("a"))
+	require.Equal(t, []string{"+"}, splitKeyCombination("+"))
+	require.Equal(t, []string{"Control", "Shift", "T"}, splitKeyCombination("Control+Shift+T"))
+	require.Equal(t, []string{"Control", "+"}, splitKeyCombination("Control++"))
+}
+
+func TestKeyboardLayoutDescribe(t *testing.T) {
+	de := keyboardLayouts["de-DE"]
+	description, err := de.describe("KeyY", 0)
+	require.NoError(t, err)
+	require.Equal(t, keyDescription{code: "KeyY", key: "z", text: "z", keyCode: 90}, description)
+
+	description, err = de.describe("y", 0)
+	require.NoError(t, err)
+	require.Equal(t, keyDescription{code: "KeyZ", key: "y", text: "y", keyCode: 89}, description)
+
+	description, err = de.describe("KeyY", modifierShift)
+	require.NoError(t, err)
+	require.Equal(t, "Z", description.text)
+
+	description, err = de.describe("KeyY", modifierControl)
+	require.NoError(t, err)
+	require.Equal(t, "z", description.key)
+	require.Empty(t, description.text)
+
+	description, err = de.describe("ß", 0)
+	require.NoError(t, err)
+	require.Equal(t, "Minus", description.code)
+
+	description, err = de.describe("Equal", 0)
+	require.NoError(t, err)
+	require.Equal(t, "Dead", description.key)
+	require.Empty(t, description.text)
+
+	description, err = de.describe("Shift", 0)
+	require.NoError(t, err)
+	require.Equal(t, keyDescription{code: "ShiftLeft", key: "Shift", keyCode: 16, location: 1}, description)
+
+	description, err = de.describe("\n", 0)
+	require.NoError(t, err)
+	require.Equal(t, keyDescription{code: "Enter", key: "Enter", text: "\r", keyCode: 13}, description)
+
+	_, err = de.describe("Foo", 0)
+	require.EqualError(t, err, `unknown key: "Foo"`)
+}
+
+func TestKeyboardLayoutFrench(t *testing.T) {
+	fr := keyboardLayouts["fr-FR"]
+	description, err := fr.describe("a", 0)
+	require.NoError(t, err)
+	require.Equal(t, keyDescription{code: "KeyQ", key: "a", text: "a", keyCode: 65}, description)
+
+	description, err = fr.describe("1", 0)
+	require.NoError(t, err)
+	require.Equal(t, keyDescription{code: "Digit1", key: "1", text: "1", keyCode: 49}, description)
+
+	description, err = fr.describe("Digit2", 0)
+	require.NoError(t, err)
+	require.Equal(t, "é", description.text)
+
+	require.True(t, fr.hasCharacter("ù"))
+	require.False(t, fr.hasCharacter("ü"))
+	require.False(t, keyboardLayouts[defaultKeyboardLayout].hasCharacter("ù"))
+}
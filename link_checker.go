@@ -0,0 +1,476 @@
+package playwright
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// linksScript returns the links and the resources of the document, with their URLs resolved by the browser.
+const linksScript = `() => {
+  const attributes = [
+    ['a[href]', 'href'], ['area[href]', 'href'], ['link[href]', 'href'], ['img[src]', 'src'], ['script[src]', 'src'],
+    ['iframe[src]', 'src'], ['source[src]', 'src'], ['video[src]', 'src'], ['audio[src]', 'src'], ['track[src]', 'src'],
+    ['embed[src]', 'src'], ['object[data]', 'data'],
+  ];
+  const links = [];
+  for (const [selector, attribute] of attributes) {
+    for (const element of document.querySelectorAll(selector)) {
+      links.push({
+        url: element[attribute] || '',
+        tag: element.tagName.toLowerCase(),
+        attribute,
+        rel: element.getAttribute('rel') || '',
+        text: (element.textContent || element.getAttribute('alt') || '').trim().replace(/\s+/g, ' ').slice(0, 100),
+      });
+    }
+  }
+  return links;
+}`
+
+// LinkCheckerOptions configures a [LinkChecker].
+type LinkCheckerOptions struct {
+	// Maximum number of links checked at the same time. Defaults to `8`.
+	Concurrency *int
+	// Maximum number of redirects followed for each link. Defaults to `10`.
+	MaxRedirects *int
+	// Timeout of each request in milliseconds. Defaults to the timeout of the request context.
+	Timeout *float64
+	// Reports whether a link is checked. All the http and https links are checked when nil.
+	Filter func(link string) bool
+	// Reports whether a page is visited by [LinkChecker.Crawl]. Defaults to the pages of the origin of the start page.
+	Scope func(page string) bool
+	// Maximum number of pages visited by [LinkChecker.Crawl]. Defaults to `50`.
+	MaxPages *int
+	// Maximum number of links followed from the start page by [LinkChecker.Crawl]. Unlimited when nil.
+	MaxDepth *int
+}
+
+// LinkRedirect is a redirect of a checked link.
+type LinkRedirect struct {
+	// URL that redirected.
+	URL string
+	// Status code of the redirect.
+	Status int
+}
+
+// LinkSource is an element of a page linking to a checked URL.
+type LinkSource struct {
+	// URL of the page.
+	Page string
+	// Tag and attribute of the element, such as `a` and `href`.
+	Tag       string
+	Attribute string
+	// Text of the link, or alternative text of an image, shortened.
+	Text string
+	// Whether the element loads the URL over http in a page served over https, which browsers block or warn about.
+	MixedContent bool
+}
+
+// LinkResult is the result of checking a URL.
+type LinkResult struct {
+	// URL checked, without fragment.
+	URL string
+	// Status code of the final response, `0` if the request failed.
+	Status int
+	// URL of the final response, after the redirects.
+	FinalURL string
+	// Redirects followed, in order.
+	Redirects []LinkRedirect
+	// Error of the request, if it failed or the redirects could not be followed.
+	Error string
+	// Elements linking to the URL.
+	Sources []LinkSource
+}
+
+// IsBroken returns whether the link failed or has an error status code.
+func (r LinkResult) IsBroken() bool {
+	return r.Error != "" || r.Status >= 400
+}
+
+// HasMixedContent returns whether an element loads the link as mixed content.
+func (r LinkResult) HasMixedContent() bool {
+	for _, source := range r.Sources {
+		if source.MixedContent {
+			return true
+		}
+	}
+	return false
+}
+
+// LinkReport is the result of checking the links of pages, see [LinkChecker].
+type LinkReport struct {
+	// Pages whose links were checked, in the order they were visited.
+	Pages []string
+	// Results of the links, sorted by URL.
+	Links []LinkResult
+}
+
+// Broken returns the results of the broken links.
+func (r *LinkReport) Broken() []LinkResult {
+	return r.filter(LinkResult.IsBroken)
+}
+
+// Redirected returns the results of the links that redirect.
+func (r *LinkReport) Redirected() []LinkResult {
+	return r.filter(func(result LinkResult) bool {
+		return len(result.Redirects) > 0
+	})
+}
+
+// MixedContent returns the results of the links loaded as mixed content.
+func (r *LinkReport) MixedContent() []LinkResult {
+	return r.filter(LinkResult.HasMixedContent)
+}
+
+func (r *LinkReport) filter(match func(LinkResult) bool) []LinkResult {
+	results := make([]LinkResult, 0)
+	for _, result := range r.Links {
+		if match(result) {
+			results = append(results, result)
+		}
+	}
+	return results
+}
+
+// LinkChecker checks the links and the resources of pages with an [APIRequestContext], following the redirects and
+// reporting the broken links and the mixed content. Links are requested with HEAD, and with GET when HEAD fails, as
+// some servers don't support it. Each URL is only checked once by a checker:
+//
+//	checker := playwright.NewLinkChecker(pw.Request.NewContext())
+//	report, err := checker.Crawl(page, "https://example.com")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, link := range report.Broken() {
+//		fmt.Println(link.URL, link.Status, link.Sources[0].Page)
+//	}
+type LinkChecker struct {
+	request      APIRequestContext
+	concurrency  int
+	maxRedirects int
+	timeout      *float64
+	filter       func(string) bool
+	scope        func(string) bool
+	maxPages     int
+	maxDepth     *int
+	mu           sync.Mutex
+	results      map[string]*LinkResult
+}
+
+// NewLinkChecker creates a link checker sending its requests with request.
+func NewLinkChecker(request APIRequestContext, options ...LinkCheckerOptions) *LinkChecker {
+	c := &LinkChecker{
+		request:      request,
+		concurrency:  8,
+		maxRedirects: 10,
+		maxPages:     50,
+		results:      make(map[string]*LinkResult),
+	}
+	if len(options) == 1 {
+		option := options[0]
+		if option.Concurrency != nil && *option.Concurrency > 0 {
+			c.concurrency = *option.Concurrency
+		}
+		if option.MaxRedirects != nil && *option.MaxRedirects >= 0 {
+			c.maxRedirects = *option.MaxRedirects
+		}
+		if option.MaxPages != nil && *option.MaxPages > 0 {
+			c.maxPages = *option.MaxPages
+		}
+		c.timeout = option.Timeout
+		c.filter = option.Filter
+		c.scope = option.Scope
+		c.maxDepth = option.MaxDepth
+	}
+	return c
+}
+
+// pageLink is a link found in a page by [linksScript].
+type pageLink struct {
+	URL       string `json:"url"`
+	Tag       string `json:"tag"`
+	Attribute string `json:"attribute"`
+	Rel       string `json:"rel"`
+	Text      string `json:"text"`
+}
+
+// CheckPage checks the links and the resources of the current document of page.
+func (c *LinkChecker) CheckPage(page Page) (*LinkReport, error) {
+	report, _, err := c.checkPage(page)
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// Crawl navigates page to startURL, and then to the pages it links to in the scope, and checks their links and
+// resources. Only the pages linked with `a` and `area` elements are visited, breadth first.
+func (c *LinkChecker) Crawl(page Page, startURL string) (*LinkReport, error) {
+	start, err := url.Parse(startURL)
+	if err != nil {
+		return nil, err
+	}
+	scope := c.scope
+	if scope == nil {
+		scope = func(page string) bool {
+			u, err := url.Parse(page)
+			return err == nil && u.Scheme == start.Scheme && u.Host == start.Host
+		}
+	}
+	type queued struct {
+		url   string
+		depth int
+	}
+	start.Fragment = ""
+	queue := []queued{{url: start.String()}}
+	seen := map[string]bool{start.String(): true}
+	report := &LinkReport{Pages: make([]string, 0)}
+	checked := make(map[string]bool)
+	for len(queue) > 0 && len(report.Pages) < c.maxPages {
+		next := queue[0]
+		queue = queue[1:]
+		if _, err := page.Goto(next.url); err != nil {
+			return nil, fmt.Errorf("could not visit %s: %w", next.url, err)
+		}
+		pageReport, links, err := c.checkPage(page)
+		if err != nil {
+			return nil, err
+		}
+		for _, result := range pageReport.Links {
+			checked[result.URL] = true
+		}
+		report.Pages = append(report.Pages, page.URL())
+		if c.maxDepth != nil && next.depth >= *c.maxDepth {
+			continue
+		}
+		for _, link := range links {
+			if link.Tag != "a" && link.Tag != "area" {
+				continue
+			}
+			target, ok := normalizeLink(link.URL)
+			if !ok || seen[target] || !scope(target) {
+				continue
+			}
+			// Only the pages that are not broken are visited.
+			if result := c.result(target); result != nil && result.IsBroken() {
+				continue
+			}
+			seen[target] = true
+			queue = append(queue, queued{url: target, depth: next.depth + 1})
+		}
+	}
+	report.Links = c.report(checked)
+	return report, nil
+}
+
+// checkPage checks the links of the current document of page, and returns the report of the page and its links.
+func (c *LinkChecker) checkPage(page Page) (*LinkReport, []pageLink, error) {
+	result, err := page.MainFrame().Evaluate(linksScript)
+	if err != nil {
+		return nil, nil, err
+	}
+	links, err := decodeCDPValue[[]pageLink](result)
+	if err != nil {
+		return nil, nil, err
+	}
+	pageURL := page.URL()
+	secure := strings.HasPrefix(pageURL, "https:")
+	checked := make(map[string]bool)
+	toCheck := make([]string, 0)
+	for _, link := range links {
+		target, ok := normalizeLink(link.URL)
+		if !ok || isHintLink(link) || (c.filter != nil && !c.filter(target)) {
+			continue
+		}
+		if !checked[target] {
+			checked[target] = true
+			toCheck = append(toCheck, target)
+		}
+		c.addSource(target, LinkSource{
+			Page:      pageURL,
+			Tag:       link.Tag,
+			Attribute: link.Attribute,
+			Text:      link.Text,
+			// Navigations to http pages are not mixed content, unlike the resources the page loads.
+			MixedContent: secure && strings.HasPrefix(target, "http:") && isSubresourceLink(link),
+		})
+	}
+	c.checkAll(toCheck)
+	return &LinkReport{Pages: []string{pageURL}, Links: c.report(checked)}, links, nil
+}
+
+// isSubresourceLink returns whether the page loads the link, rather than navigating to it.
+func isSubresourceLink(link pageLink) bool {
+	switch link.Tag {
+	case "a", "area":
+		return false
+	case "link":
+		for _, rel := range strings.Fields(strings.ToLower(link.Rel)) {
+			if rel == "stylesheet" || rel == "icon" || rel == "preload" || rel == "modulepreload" || rel == "manifest" {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// isHintLink returns whether the link is a resource hint to an origin, such as `preconnect`, rather than a resource.
+func isHintLink(link pageLink) bool {
+	if link.Tag != "link" {
+		return false
+	}
+	for _, rel := range strings.Fields(strings.ToLower(link.Rel)) {
+		if rel == "preconnect" || rel == "dns-prefetch" {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeLink returns the URL of a link without fragment, if it is an http or https URL.
+func normalizeLink(link string) (string, bool) {
+	u, err := url.Parse(link)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", false
+	}
+	u.Fragment = ""
+	u.RawFragment = ""
+	return u.String(), true
+}
+
+func (c *LinkChecker) addSource(target string, source LinkSource) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	result, ok := c.results[target]
+	if !ok {
+		result = &LinkResult{URL: target, Redirects: make([]LinkRedirect, 0)}
+		c.results[target] = result
+	}
+	for _, s := range result.Sources {
+		if s == source {
+			return
+		}
+	}
+	result.Sources = append(result.Sources, source)
+}
+
+func (c *LinkChecker) result(target string) *LinkResult {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if result, ok := c.results[target]; ok {
+		copied := *result
+		return &copied
+	}
+	return nil
+}
+
+// report returns the results of the URLs, sorted by URL.
+func (c *LinkChecker) report(urls map[string]bool) []LinkResult {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	results := make([]LinkResult, 0)
+	for target, result := range c.results {
+		if urls[target] {
+			copied := *result
+			copied.Redirects = append([]LinkRedirect{}, result.Redirects...)
+			copied.Sources = append([]LinkSource{}, result.Sources...)
+			results = append(results, copied)
+		}
+	}
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].URL < results[j].URL
+	})
+	return results
+}
+
+// checkAll checks the URLs that were not checked yet, at most concurrency at a time.
+func (c *LinkChecker) checkAll(urls []string) {
+	slots := make(chan struct{}, c.concurrency)
+	var wg sync.WaitGroup
+	for _, target := range urls {
+		c.mu.Lock()
+		result := c.results[target]
+		done := result.Status != 0 || result.Error != ""
+		c.mu.Unlock()
+		if done {
+			continue
+		}
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(target string) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			status, finalURL, redirects, err := c.check(target)
+			c.mu.Lock()
+			defer c.mu.Unlock()
+			result := c.results[target]
+			result.Status, result.FinalURL, result.Redirects = status, finalURL, redirects
+			if err != nil {
+				result.Error = err.Error()
+			}
+		}(target)
+	}
+	wg.Wait()
+}
+
+// check requests target and follows its redirects.
+func (c *LinkChecker) check(target string) (int, string, []LinkRedirect, error) {
+	redirects := make([]LinkRedirect, 0)
+	visited := map[string]bool{}
+	current := target
+	for {
+		if visited[current] {
+			return 0, current, redirects, errors.New("redirect loop")
+		}
+		visited[current] = true
+		status, location, err := c.fetchOnce(current)
+		if err != nil {
+			return 0, current, redirects, err
+		}
+		if status < 300 || status >= 400 || location == "" {
+			return status, current, redirects, nil
+		}
+		redirects = append(redirects, LinkRedirect{URL: current, Status: status})
+		if len(redirects) > c.maxRedirects {
+			return status, current, redirects, fmt.Errorf("too many redirects, more than %d", c.maxRedirects)
+		}
+		base, _ := url.Parse(current)
+		next, err := base.Parse(location)
+		if err != nil {
+			return status, current, redirects, fmt.Errorf("invalid redirect location %q: %w", location, err)
+		}
+		next.Fragment = ""
+		current = next.String()
+	}
+}
+
+// fetchOnce sends a single request to target without following redirects, with HEAD and then with GET if HEAD fails,
+// and returns the status and the location of the response.
+func (c *LinkChecker) fetchOnce(target string) (int, string, error) {
+	var status int
+	var location string
+	for _, method := range []string{"HEAD", "GET"} {
+		response, err := c.request.Fetch(target, APIRequestContextFetchOptions{
+			Method:       String(method),
+			MaxRedirects: Int(0),
+			Timeout:      c.timeout,
+		})
+		if err != nil {
+			if method == "HEAD" {
+				continue
+			}
+			return 0, "", err
+		}
+		status, location = response.Status(), response.Headers()["location"]
+		_ = response.Dispose()
+		if status < 400 {
+			break
+		}
+	}
+	return status, location, nil
+}
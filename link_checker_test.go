@@ -0,0 +1,39 @@
+package playwright
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeLink(t *testing.T) {
+	link, ok := normalizeLink("https://example.com/a?b=c#section")
+	require.True(t, ok)
+	require.Equal(t, "https://example.com/a?b=c", link)
+	for _, link := range []string{"mailto:a@example.com", "javascript:void(0)", "data:text/plain,a", "about:blank", ""} {
+		_, ok := normalizeLink(link)
+		require.False(t, ok, link)
+	}
+}
+
+func TestLinkKinds(t *testing.T) {
+	require.False(t, isSubresourceLink(pageLink{Tag: "a"}))
+	require.False(t, isSubresourceLink(pageLink{Tag: "link", Rel: "canonical"}))
+	require.True(t, isSubresourceLink(pageLink{Tag: "link", Rel: "Stylesheet"}))
+	require.True(t, isSubresourceLink(pageLink{Tag: "img"}))
+	require.True(t, isHintLink(pageLink{Tag: "link", Rel: "preconnect"}))
+	require.False(t, isHintLink(pageLink{Tag: "a", Rel: "preconnect"}))
+}
+
+func TestLinkReport(t *testing.T) {
+	report := &LinkReport{Links: []LinkResult{
+		{URL: "http://a.test/ok", Status: 200},
+		{URL: "http://a.test/missing", Status: 404},
+		{URL: "http://a.test/down", Error: "connect ECONNREFUSED"},
+		{URL: "http://a.test/moved", Status: 200, Redirects: []LinkRedirect{{URL: "http://a.test/moved", Status: 301}}},
+		{URL: "http://a.test/image.png", Status: 200, Sources: []LinkSource{{Tag: "img", MixedContent: true}}},
+	}}
+	require.Equal(t, []LinkResult{report.Links[1], report.Links[2]}, report.Broken())
+	require.Equal(t, []LinkResult{report.Links[3]}, report.Redirected())
+	require.Equal(t, []LinkResult{report.Links[4]}, report.MixedContent())
+}
@@ -1,16 +1,23 @@
 package playwright
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/playwright-community/playwright-go/internal/multierror"
 )
 
+const defaultTestIdAttributeName = "data-testid"
+
 var (
-	testIdAttributeName    = "data-testid"
-	ErrLocatorNotSameFrame = errors.New("inner 'has' or 'hasNot' locator must belong to the same frame")
+	testIdAttributeName    = defaultTestIdAttributeName
+	ErrLocatorNotSameFrame = errors.New("inner 'has', 'hasNot', 'and' or 'or' locator must belong to the same frame")
+	// ErrLocatorCountChanged is yielded by [Locator.AllSeq] when the number of elements matching the locator changes
+	// during the iteration.
+	ErrLocatorCountChanged = errors.New("number of elements matching the locator changed during the iteration")
 )
 
 type locatorImpl struct {
@@ -69,6 +76,32 @@ func (l *locatorImpl) All() ([]Locator, error) {
 	return result, nil
 }
 
+func (l *locatorImpl) AllSeq() func(yield func(Locator, error) bool) {
+	return func(yield func(Locator, error) bool) {
+		count, err := l.Count()
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		for i := 0; i < count; i++ {
+			if i > 0 {
+				current, err := l.Count()
+				if err != nil {
+					yield(nil, err)
+					return
+				}
+				if current != count {
+					yield(nil, fmt.Errorf("%w: %d elements, then %d", ErrLocatorCountChanged, count, current))
+					return
+				}
+			}
+			if !yield(l.Nth(i), nil) {
+				return
+			}
+		}
+	}
+}
+
 func (l *locatorImpl) AllInnerTexts() ([]string, error) {
 	if l.err != nil {
 		return nil, l.err
@@ -102,11 +135,23 @@ func (l *locatorImpl) AllTextContents() ([]string, error) {
 }
 
 func (l *locatorImpl) And(locator Locator) Locator {
-	return newLocator(l.frame, l.selector+` >> internal:and=`+escapeText(locator.(*locatorImpl).selector))
+	return l.combine("internal:and", locator)
 }
 
 func (l *locatorImpl) Or(locator Locator) Locator {
-	return newLocator(l.frame, l.selector+` >> internal:or=`+escapeText(locator.(*locatorImpl).selector))
+	return l.combine("internal:or", locator)
+}
+
+// combine chains the selector of locator to this one with the given engine. Both locators
+// must belong to the same frame.
+func (l *locatorImpl) combine(engine string, locator Locator) Locator {
+	other := locator.(*locatorImpl)
+	combined := newLocator(l.frame, l.selector+` >> `+engine+`=`+escapeText(other.selector))
+	combined.err = multierror.Join(l.err, other.err)
+	if l.frame != other.frame {
+		combined.err = multierror.Join(combined.err, ErrLocatorNotSameFrame)
+	}
+	return combined
 }
 
 func (l *locatorImpl) Blur(options ...LocatorBlurOptions) error {
@@ -191,7 +236,9 @@ func (l *locatorImpl) Click(options ...LocatorClickOptions) error {
 }
 
 func (l *locatorImpl) ContentFrame() FrameLocator {
-	return newFrameLocator(l.frame, l.selector)
+	frameLocator := newFrameLocator(l.frame, l.selector)
+	frameLocator.err = l.err
+	return frameLocator
 }
 
 func (l *locatorImpl) Count() (int, error) {
@@ -273,6 +320,9 @@ func (l *locatorImpl) Evaluate(expression string, arg interface{}, options ...Lo
 	if l.err != nil {
 		return nil, l.err
 	}
+	if len(options) == 1 && options[0].IsolatedWorld != nil {
+		return l.evaluateInIsolatedWorld(*options[0].IsolatedWorld, expression, arg, true, options...)
+	}
 	var option FrameWaitForSelectorOptions
 	if len(options) == 1 {
 		option.Timeout = options[0].Timeout
@@ -308,6 +358,71 @@ func (l *locatorImpl) EvaluateHandle(expression string, arg interface{}, options
 	return h.(JSHandle), nil
 }
 
+// LocatorEvaluate is like [Locator.Evaluate], but unmarshals the result into T, e.g. a
+// struct with json tags or a slice.
+func LocatorEvaluate[T any](locator Locator, expression string, arg interface{}, options ...LocatorEvaluateOptions) (T, error) {
+	var typed T
+	result, err := locator.Evaluate(expression, arg, options...)
+	if err != nil {
+		return typed, err
+	}
+	return typed, convertEvaluationResult(result, &typed)
+}
+
+// LocatorEvaluateAll is like [Locator.EvaluateAll], but unmarshals the result into T, e.g. a
+// slice of structs with json tags.
+func LocatorEvaluateAll[T any](locator Locator, expression string, arg interface{}, options ...LocatorEvaluateAllOptions) (T, error) {
+	var typed T
+	var result interface{}
+	var err error
+	if len(options) == 1 && options[0].IsolatedWorld != nil {
+		if l, ok := locator.(*locatorImpl); ok {
+			if l.err != nil {
+				return typed, l.err
+			}
+			result, err = l.evaluateInIsolatedWorld(*options[0].IsolatedWorld, expression, arg, false)
+		} else {
+			err = errors.New("isolated worlds are not supported by this Locator implementation")
+		}
+	} else {
+		result, err = locator.EvaluateAll(expression, arg)
+	}
+	if err != nil {
+		return typed, err
+	}
+	return typed, convertEvaluationResult(result, &typed)
+}
+
+// LocatorEvaluateEach evaluates expression with each element matching the locator as the first argument and arg as
+// the second one, and unmarshals the results into a slice of T. The elements are evaluated in a single call, so the
+// results are consistent with each other, unlike evaluations of the locators of [Locator.All] one by one. Promises
+// returned by expression are awaited. Like in [Locator.Evaluate], an expression that doesn't evaluate to a function,
+// such as "document.title", is evaluated as is, once for all the elements.
+func LocatorEvaluateEach[T any](locator Locator, expression string, arg interface{}, options ...LocatorEvaluateAllOptions) ([]T, error) {
+	value := strings.TrimRight(strings.TrimSpace(expression), ";")
+	each := "(elements, arg) => { const value = (" + value + "\n); " +
+		"return Promise.all(elements.map(element => typeof value === 'function' ? value(element, arg) : value)); }"
+	results, err := LocatorEvaluateAll[[]T](locator, each, arg, options...)
+	if err != nil {
+		return nil, err
+	}
+	if results == nil {
+		results = make([]T, 0)
+	}
+	return results, nil
+}
+
+func convertEvaluationResult(result interface{}, v interface{}) error {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("could not convert evaluation result: %w", err)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("could not convert evaluation result: %w", err)
+	}
+	return nil
+}
+
 func (l *locatorImpl) Fill(value string, options ...LocatorFillOptions) error {
 	if l.err != nil {
 		return l.err
@@ -325,7 +440,17 @@ func (l *locatorImpl) Fill(value string, options ...LocatorFillOptions) error {
 
 func (l *locatorImpl) Filter(options ...LocatorFilterOptions) Locator {
 	if len(options) == 1 {
-		return newLocator(l.frame, l.selector, LocatorLocatorOptions(options[0]))
+		option := options[0]
+		locator := newLocator(l.frame, l.selector, LocatorLocatorOptions{
+			Has:        option.Has,
+			HasNot:     option.HasNot,
+			HasNotText: option.HasNotText,
+			HasText:    option.HasText,
+		})
+		if option.Visible != nil {
+			locator.selector += fmt.Sprintf(` >> visible=%t`, *option.Visible)
+		}
+		return locator
 	}
 	return newLocator(l.frame, l.selector)
 }
@@ -350,7 +475,9 @@ func (l *locatorImpl) Focus(options ...LocatorFocusOptions) error {
 }
 
 func (l *locatorImpl) FrameLocator(selector string) FrameLocator {
-	return newFrameLocator(l.frame, l.selector+" >> "+selector)
+	frameLocator := newFrameLocator(l.frame, l.selector+" >> "+selector)
+	frameLocator.err = l.err
+	return frameLocator
 }
 
 func (l *locatorImpl) GetAttribute(name string, options ...LocatorGetAttributeOptions) (string, error) {
@@ -407,13 +534,7 @@ func (l *locatorImpl) GetByTestId(testId interface{}) Locator {
 }
 
 func (l *locatorImpl) GetByText(text interface{}, options ...LocatorGetByTextOptions) Locator {
-	exact := false
-	if len(options) == 1 {
-		if *options[0].Exact {
-			exact = true
-		}
-	}
-	return l.Locator(getByTextSelector(text, exact))
+	return l.Locator(getByTextSelector(text, options...))
 }
 
 func (l *locatorImpl) GetByTitle(text interface{}, options ...LocatorGetByTitleOptions) Locator {
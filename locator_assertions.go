@@ -1,19 +1,26 @@
 package playwright
 
 import (
+	"fmt"
 	"regexp"
+	"strings"
 )
 
+// maxDescribedElements is the number of matched elements listed by failed count assertions.
+const maxDescribedElements = 20
+
 type locatorAssertionsImpl struct {
 	assertionsBase
 }
 
-func newLocatorAssertions(locator Locator, isNot bool, defaultTimeout *float64) *locatorAssertionsImpl {
+func newLocatorAssertions(locator Locator, isNot bool, defaultTimeout, pollInterval *float64, soft *softErrors) *locatorAssertionsImpl {
 	return &locatorAssertionsImpl{
 		assertionsBase: assertionsBase{
 			actualLocator:  locator,
 			isNot:          isNot,
 			defaultTimeout: defaultTimeout,
+			pollInterval:   pollInterval,
+			soft:           soft,
 		},
 	}
 }
@@ -165,22 +172,29 @@ func (la *locatorAssertionsImpl) ToBeVisible(options ...LocatorAssertionsToBeVis
 
 func (la *locatorAssertionsImpl) ToContainText(expected interface{}, options ...LocatorAssertionsToContainTextOptions) error {
 	var (
-		timeout      *float64
-		useInnerText *bool
-		ignoreCase   *bool
+		timeout             *float64
+		useInnerText        *bool
+		ignoreCase          *bool
+		normalizeWhiteSpace = true
+		normalization       *UnicodeNormalization
 	)
 	if len(options) == 1 {
 		timeout = options[0].Timeout
 		useInnerText = options[0].UseInnerText
 		ignoreCase = options[0].IgnoreCase
+		if options[0].NormalizeWhiteSpace != nil {
+			normalizeWhiteSpace = *options[0].NormalizeWhiteSpace
+		}
+		normalization = options[0].Normalization
 	}
 
 	switch expected.(type) {
 	case []string, []*regexp.Regexp:
-		expectedText, err := toExpectedTextValues(convertToInterfaceList(expected), true, true, ignoreCase)
+		expectedText, err := toExpectedTextValues(convertToInterfaceList(expected), true, normalizeWhiteSpace, ignoreCase)
 		if err != nil {
 			return err
 		}
+		prepareExpectedText(expectedText, ignoreCase, normalization)
 		return la.expect(
 			"to.contain.text.array",
 			frameExpectOptions{
@@ -192,10 +206,11 @@ func (la *locatorAssertionsImpl) ToContainText(expected interface{}, options ...
 			"Locator expected to contain text",
 		)
 	default:
-		expectedText, err := toExpectedTextValues([]interface{}{expected}, true, true, ignoreCase)
+		expectedText, err := toExpectedTextValues([]interface{}{expected}, true, normalizeWhiteSpace, ignoreCase)
 		if err != nil {
 			return err
 		}
+		prepareExpectedText(expectedText, ignoreCase, normalization)
 		return la.expect(
 			"to.have.text",
 			frameExpectOptions{
@@ -229,6 +244,7 @@ func (la *locatorAssertionsImpl) ToHaveAttribute(name string, value interface{},
 		},
 		value,
 		"Locator expected to have attribute",
+		la.describeAttributes,
 	)
 }
 
@@ -279,6 +295,7 @@ func (la *locatorAssertionsImpl) ToHaveCount(count int, options ...LocatorAssert
 		frameExpectOptions{ExpectedNumber: Float(float64(count)), Timeout: timeout},
 		count,
 		"Locator expected to have count",
+		la.describeMatchedElements,
 	)
 }
 
@@ -339,22 +356,29 @@ func (la *locatorAssertionsImpl) ToHaveJSProperty(name string, value interface{}
 
 func (la *locatorAssertionsImpl) ToHaveText(expected interface{}, options ...LocatorAssertionsToHaveTextOptions) error {
 	var (
-		timeout      *float64
-		useInnerText *bool
-		ignoreCase   *bool
+		timeout             *float64
+		useInnerText        *bool
+		ignoreCase          *bool
+		normalizeWhiteSpace = true
+		normalization       *UnicodeNormalization
 	)
 	if len(options) == 1 {
 		timeout = options[0].Timeout
 		useInnerText = options[0].UseInnerText
 		ignoreCase = options[0].IgnoreCase
+		if options[0].NormalizeWhiteSpace != nil {
+			normalizeWhiteSpace = *options[0].NormalizeWhiteSpace
+		}
+		normalization = options[0].Normalization
 	}
 
 	switch expected.(type) {
 	case []string, []*regexp.Regexp:
-		expectedText, err := toExpectedTextValues(convertToInterfaceList(expected), false, true, ignoreCase)
+		expectedText, err := toExpectedTextValues(convertToInterfaceList(expected), false, normalizeWhiteSpace, ignoreCase)
 		if err != nil {
 			return err
 		}
+		prepareExpectedText(expectedText, ignoreCase, normalization)
 		return la.expect(
 			"to.have.text.array",
 			frameExpectOptions{
@@ -366,10 +390,11 @@ func (la *locatorAssertionsImpl) ToHaveText(expected interface{}, options ...Loc
 			"Locator expected to have text",
 		)
 	default:
-		expectedText, err := toExpectedTextValues([]interface{}{expected}, false, true, ignoreCase)
+		expectedText, err := toExpectedTextValues([]interface{}{expected}, false, normalizeWhiteSpace, ignoreCase)
 		if err != nil {
 			return err
 		}
+		prepareExpectedText(expectedText, ignoreCase, normalization)
 		return la.expect(
 			"to.have.text",
 			frameExpectOptions{
@@ -418,5 +443,44 @@ func (la *locatorAssertionsImpl) ToHaveValues(values []interface{}, options ...L
 }
 
 func (la *locatorAssertionsImpl) Not() LocatorAssertions {
-	return newLocatorAssertions(la.actualLocator, true, la.defaultTimeout)
+	not := newLocatorAssertions(la.actualLocator, true, la.defaultTimeout, la.pollInterval, la.soft)
+	not.snapshotDir = la.snapshotDir
+	return not
+}
+
+// describeAttributes renders the attributes of the first element matched by the locator as a table.
+func (la *locatorAssertionsImpl) describeAttributes() string {
+	result, err := la.actualLocator.EvaluateAll(`elements => elements.length ? Array.from(elements[0].attributes, a => [a.name, a.value]) : null`)
+	if err != nil {
+		return fmt.Sprintf("Could not read attributes: %v", err)
+	}
+	attributes, ok := result.([]interface{})
+	if !ok {
+		return "No element matched the locator."
+	}
+	rows := make([][]string, 0, len(attributes))
+	for _, attribute := range attributes {
+		pair := attribute.([]interface{})
+		rows = append(rows, []string{pair[0].(string), fmt.Sprintf("%q", pair[1])})
+	}
+	return "Attributes of the first matched element:\n" + formatTable([]string{"name", "value"}, rows)
+}
+
+// describeMatchedElements lists the elements matched by the locator.
+func (la *locatorAssertionsImpl) describeMatchedElements() string {
+	result, err := la.actualLocator.EvaluateAll(`elements => elements.map(e => e.outerHTML.length > 100 ? e.outerHTML.slice(0, 100) + '…' : e.outerHTML)`)
+	if err != nil {
+		return fmt.Sprintf("Could not list matched elements: %v", err)
+	}
+	elements, _ := result.([]interface{})
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Matched elements (%d):", len(elements))
+	for i, element := range elements {
+		if i == maxDescribedElements {
+			fmt.Fprintf(&sb, "\n  ... and %d more", len(elements)-maxDescribedElements)
+			break
+		}
+		fmt.Fprintf(&sb, "\n  %d. %v", i+1, element)
+	}
+	return sb.String()
 }
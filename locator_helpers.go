@@ -5,8 +5,13 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"sync"
 )
 
+// testIdAttributeMu guards testIdAttributeName, which is set with [Selectors.SetTestIdAttribute] while locators may
+// be created concurrently.
+var testIdAttributeMu sync.RWMutex
+
 func convertRegexp(reg *regexp.Regexp) (pattern, flags string) {
 	matches := regexp.MustCompile(`\(\?([imsU]+)\)(.+)`).FindStringSubmatch(reg.String())
 
@@ -73,46 +78,58 @@ func getByPlaceholderSelector(text interface{}, exact bool) string {
 	return getByAttributeTextSelector("placeholder", text, exact)
 }
 
+// getByRoleSelector returns the role selector of the options. The properties are in the order of the upstream
+// clients, so that the selector, and the locator description in errors and traces, is the same for the same options.
 func getByRoleSelector(role AriaRole, options ...LocatorGetByRoleOptions) string {
-	props := make(map[string]string)
+	props := make([][2]string, 0)
 	if len(options) == 1 {
-		if options[0].Checked != nil {
-			props["checked"] = fmt.Sprintf("%t", *options[0].Checked)
+		option := options[0]
+		if option.Checked != nil {
+			props = append(props, [2]string{"checked", fmt.Sprintf("%t", *option.Checked)})
 		}
-		if options[0].Disabled != nil {
-			props["disabled"] = fmt.Sprintf("%t", *options[0].Disabled)
+		if option.Disabled != nil {
+			props = append(props, [2]string{"disabled", fmt.Sprintf("%t", *option.Disabled)})
 		}
-		if options[0].Selected != nil {
-			props["selected"] = fmt.Sprintf("%t", *options[0].Selected)
+		if option.Selected != nil {
+			props = append(props, [2]string{"selected", fmt.Sprintf("%t", *option.Selected)})
 		}
-		if options[0].Expanded != nil {
-			props["expanded"] = fmt.Sprintf("%t", *options[0].Expanded)
+		if option.Expanded != nil {
+			props = append(props, [2]string{"expanded", fmt.Sprintf("%t", *option.Expanded)})
 		}
-		if options[0].IncludeHidden != nil {
-			props["include-hidden"] = fmt.Sprintf("%t", *options[0].IncludeHidden)
+		if option.IncludeHidden != nil {
+			props = append(props, [2]string{"include-hidden", fmt.Sprintf("%t", *option.IncludeHidden)})
 		}
-		if options[0].Level != nil {
-			props["level"] = fmt.Sprintf("%d", *options[0].Level)
+		if option.Level != nil {
+			props = append(props, [2]string{"level", fmt.Sprintf("%d", *option.Level)})
 		}
-		if options[0].Name != nil {
-			exact := false
-			if options[0].Exact != nil {
-				exact = *options[0].Exact
-			}
-			props["name"] = escapeForAttributeSelector(options[0].Name, exact)
+		if option.Name != nil {
+			exact := option.Exact != nil && *option.Exact
+			props = append(props, [2]string{"name", escapeForAttributeSelector(option.Name, exact)})
 		}
-		if options[0].Pressed != nil {
-			props["pressed"] = fmt.Sprintf("%t", *options[0].Pressed)
+		if option.Pressed != nil {
+			props = append(props, [2]string{"pressed", fmt.Sprintf("%t", *option.Pressed)})
 		}
 	}
 	propsStr := ""
-	for k, v := range props {
-		propsStr += "[" + k + "=" + v + "]"
+	for _, prop := range props {
+		propsStr += "[" + prop[0] + "=" + prop[1] + "]"
 	}
 	return fmt.Sprintf("internal:role=%s%s", role, propsStr)
 }
 
-func getByTextSelector(text interface{}, exact bool) string {
+func getByTextSelector(text interface{}, options ...LocatorGetByTextOptions) string {
+	option := LocatorGetByTextOptions{}
+	if len(options) == 1 {
+		option = options[0]
+	}
+	exact := option.Exact != nil && *option.Exact
+	if s, ok := text.(string); ok && (option.IgnoreCase != nil || option.Normalization != nil) {
+		normalization := UnicodeNormalization("")
+		if option.Normalization != nil {
+			normalization = *option.Normalization
+		}
+		return getByNormalizedTextSelector(s, exact, option.IgnoreCase, normalization)
+	}
 	return fmt.Sprintf(`internal:text=%s`, escapeForTextSelector(text, exact))
 }
 
@@ -125,9 +142,13 @@ func getByTitleSelector(text interface{}, exact bool) string {
 }
 
 func getTestIdAttributeName() string {
+	testIdAttributeMu.RLock()
+	defer testIdAttributeMu.RUnlock()
 	return testIdAttributeName
 }
 
 func setTestIdAttributeName(name string) {
+	testIdAttributeMu.Lock()
+	defer testIdAttributeMu.Unlock()
 	testIdAttributeName = name
 }
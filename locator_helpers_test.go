@@ -0,0 +1,38 @@
+package playwright
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetByRoleSelector(t *testing.T) {
+	require.Equal(t, `internal:role=button`, getByRoleSelector(*AriaRoleButton))
+	require.Equal(t, `internal:role=button[name="Submit"i]`, getByRoleSelector(*AriaRoleButton, LocatorGetByRoleOptions{
+		Name: "Submit",
+	}))
+	require.Equal(t, `internal:role=button[name="Sub\"mit"s]`, getByRoleSelector(*AriaRoleButton, LocatorGetByRoleOptions{
+		Name:  `Sub"mit`,
+		Exact: Bool(true),
+	}))
+	require.Equal(t, `internal:role=heading[name=/^sub/i]`, getByRoleSelector(*AriaRoleHeading, LocatorGetByRoleOptions{
+		Name:  regexp.MustCompile(`(?i)^sub`),
+		Exact: Bool(true),
+	}))
+	// The properties are always in the same order, whatever the options.
+	for i := 0; i < 10; i++ {
+		require.Equal(t,
+			`internal:role=treeitem[checked=true][disabled=false][selected=true][expanded=false][include-hidden=true][level=2][name="a"i][pressed=true]`,
+			getByRoleSelector(*AriaRoleTreeitem, LocatorGetByRoleOptions{
+				Pressed:       Bool(true),
+				Name:          "a",
+				Level:         Int(2),
+				IncludeHidden: Bool(true),
+				Expanded:      Bool(false),
+				Selected:      Bool(true),
+				Disabled:      Bool(false),
+				Checked:       Bool(true),
+			}))
+	}
+}
@@ -0,0 +1,151 @@
+package playwright
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/textproto"
+	"sort"
+	"strings"
+)
+
+// MultipartPart is a part of a multipart/form-data request body streamed from a reader. Pass it as a value of the
+// Multipart option of [APIRequestContext] methods.
+type MultipartPart struct {
+	// Name of the file sent in the Content-Disposition header of the part. Parts without a filename are sent as
+	// regular form fields.
+	Filename string
+	// Content type of the part. Defaults to `application/octet-stream` for parts with a filename and to no content type
+	// otherwise.
+	ContentType string
+	// Content of the part.
+	Reader io.Reader
+}
+
+// PostDataPart is a part of a multipart/form-data request body, see [Request.PostDataParts].
+type PostDataPart struct {
+	// Name of the form field.
+	Name string
+	// Name of the file sent with the part, empty for regular form fields.
+	Filename string
+	// Content type of the part, empty if it has none.
+	ContentType string
+	// Content of the part, unaltered.
+	Content []byte
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// encodeMultipart serializes fields as a multipart/form-data body. Fields are written in the order of their names.
+// If contentType already holds a boundary, it is used, otherwise a random one is generated. It returns the body and
+// the content type to send it with.
+func encodeMultipart(fields map[string]interface{}, contentType string) ([]byte, string, error) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	mediaType, params := "multipart/form-data", map[string]string{}
+	if contentType != "" {
+		var err error
+		if mediaType, params, err = mime.ParseMediaType(contentType); err != nil {
+			return nil, "", fmt.Errorf("invalid content-type %q: %w", contentType, err)
+		}
+	}
+	if boundary, ok := params["boundary"]; ok {
+		if err := writer.SetBoundary(boundary); err != nil {
+			return nil, "", fmt.Errorf("invalid multipart boundary: %w", err)
+		}
+	}
+	params["boundary"] = writer.Boundary()
+
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := writeMultipartField(writer, name, fields[name]); err != nil {
+			return nil, "", fmt.Errorf("could not write multipart field %q: %w", name, err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, "", err
+	}
+	return body.Bytes(), mime.FormatMediaType(mediaType, params), nil
+}
+
+func writeMultipartField(writer *multipart.Writer, name string, value interface{}) error {
+	var part MultipartPart
+	switch v := value.(type) {
+	case InputFile:
+		part = MultipartPart{Filename: v.Name, ContentType: v.MimeType, Reader: bytes.NewReader(v.Buffer)}
+	case *InputFile:
+		part = MultipartPart{Filename: v.Name, ContentType: v.MimeType, Reader: bytes.NewReader(v.Buffer)}
+	case MultipartPart:
+		part = v
+	case *MultipartPart:
+		part = *v
+	case io.Reader:
+		part = MultipartPart{Filename: name, Reader: v}
+	default:
+		return writer.WriteField(name, fmt.Sprintf("%v", v))
+	}
+	if part.Reader == nil {
+		return errors.New("no content")
+	}
+	header := textproto.MIMEHeader{}
+	disposition := fmt.Sprintf(`form-data; name="%s"`, quoteEscaper.Replace(name))
+	if part.Filename != "" {
+		disposition += fmt.Sprintf(`; filename="%s"`, quoteEscaper.Replace(part.Filename))
+		if part.ContentType == "" {
+			part.ContentType = "application/octet-stream"
+		}
+	}
+	header.Set("Content-Disposition", disposition)
+	if part.ContentType != "" {
+		header.Set("Content-Type", part.ContentType)
+	}
+	w, err := writer.CreatePart(header)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(w, part.Reader)
+	return err
+}
+
+// decodeMultipart parses a multipart body sent with contentType into its parts, in the order they were sent.
+func decodeMultipart(body []byte, contentType string) ([]PostDataPart, error) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, fmt.Errorf("invalid content-type %q: %w", contentType, err)
+	}
+	if !strings.HasPrefix(mediaType, "multipart/") {
+		return nil, fmt.Errorf("post data is not multipart: content-type %q", contentType)
+	}
+	boundary, ok := params["boundary"]
+	if !ok {
+		return nil, fmt.Errorf("no multipart boundary in content-type %q", contentType)
+	}
+	parts := make([]PostDataPart, 0)
+	reader := multipart.NewReader(bytes.NewReader(body), boundary)
+	for {
+		part, err := reader.NextRawPart()
+		if errors.Is(err, io.EOF) {
+			return parts, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not read multipart body: %w", err)
+		}
+		content, err := io.ReadAll(part)
+		if err != nil {
+			return nil, fmt.Errorf("could not read multipart part %q: %w", part.FormName(), err)
+		}
+		parts = append(parts, PostDataPart{
+			Name:        part.FormName(),
+			Filename:    part.FileName(),
+			ContentType: part.Header.Get("Content-Type"),
+			Content:     content,
+		})
+	}
+}
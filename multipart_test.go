@@ -0,0 +1,86 @@
+package playwright
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"mime/multipart"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeMultipart(t *testing.T) {
+	body, contentType, err := encodeMultipart(map[string]interface{}{
+		"name":  "John",
+		"age":   42,
+		"file":  InputFile{Name: "f.js", MimeType: "text/javascript", Buffer: []byte("var x = 10;")},
+		"data":  MultipartPart{ContentType: "application/json", Reader: strings.NewReader(`{"a":1}`)},
+		"image": &MultipartPart{Filename: "a\"b.png", Reader: bytes.NewReader([]byte{1, 2, 3})},
+		"log":   strings.NewReader("line"),
+	}, "")
+	require.NoError(t, err)
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	require.NoError(t, err)
+	require.Equal(t, "multipart/form-data", mediaType)
+	require.NotEmpty(t, params["boundary"])
+
+	type part struct {
+		name, filename, contentType, content string
+	}
+	var parts []part
+	reader := multipart.NewReader(bytes.NewReader(body), params["boundary"])
+	for {
+		p, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		content, err := io.ReadAll(p)
+		require.NoError(t, err)
+		parts = append(parts, part{p.FormName(), p.FileName(), p.Header.Get("Content-Type"), string(content)})
+	}
+	require.Equal(t, []part{
+		{"age", "", "", "42"},
+		{"data", "", "application/json", `{"a":1}`},
+		{"file", "f.js", "text/javascript", "var x = 10;"},
+		{"image", "a\"b.png", "application/octet-stream", "\x01\x02\x03"},
+		{"log", "log", "application/octet-stream", "line"},
+		{"name", "", "", "John"},
+	}, parts)
+}
+
+func TestEncodeMultipartWithContentType(t *testing.T) {
+	body, contentType, err := encodeMultipart(map[string]interface{}{"a": "b"}, "multipart/mixed; boundary=custom")
+	require.NoError(t, err)
+	require.Equal(t, "multipart/mixed; boundary=custom", contentType)
+	require.True(t, bytes.HasPrefix(body, []byte("--custom\r\n")))
+
+	_, contentType, err = encodeMultipart(map[string]interface{}{"a": "b"}, "multipart/related")
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(contentType, "multipart/related; boundary="))
+
+	_, _, err = encodeMultipart(map[string]interface{}{"a": MultipartPart{}}, "")
+	require.ErrorContains(t, err, `could not write multipart field "a"`)
+}
+
+func TestDecodeMultipart(t *testing.T) {
+	binary := []byte{0xff, 0x00, 0xfe, '\r', '\n'}
+	body, contentType, err := encodeMultipart(map[string]interface{}{
+		"name":  "John",
+		"image": MultipartPart{Filename: "a.png", ContentType: "image/png", Reader: bytes.NewReader(binary)},
+	}, "")
+	require.NoError(t, err)
+	parts, err := decodeMultipart(body, contentType)
+	require.NoError(t, err)
+	require.Equal(t, []PostDataPart{
+		{Name: "image", Filename: "a.png", ContentType: "image/png", Content: binary},
+		{Name: "name", Content: []byte("John")},
+	}, parts)
+
+	_, err = decodeMultipart(body, "application/json")
+	require.ErrorContains(t, err, "post data is not multipart")
+	_, err = decodeMultipart(body, "multipart/form-data")
+	require.ErrorContains(t, err, "no multipart boundary")
+}
@@ -0,0 +1,128 @@
+package playwright
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// label describes a named object in logs and traces, e.g. `checkout {region=eu worker=3}`. It is empty when the object
+// has neither a name nor metadata.
+func label(name string, metadata map[string]string) string {
+	if len(metadata) == 0 {
+		return name
+	}
+	entries := make([]string, 0, len(metadata))
+	for key, value := range metadata {
+		entries = append(entries, key+"="+value)
+	}
+	sort.Strings(entries)
+	return strings.TrimSpace(fmt.Sprintf("%s {%s}", name, strings.Join(entries, " ")))
+}
+
+// named holds the name and the metadata of a [BrowserContext] or a [Page]. It has its own lock so that they can be
+// read while the object is locked, such as to log a message.
+type named struct {
+	namedMu  sync.RWMutex
+	name     string
+	metadata map[string]string
+}
+
+func (n *named) Name() string {
+	n.namedMu.RLock()
+	defer n.namedMu.RUnlock()
+	return n.name
+}
+
+func (n *named) SetName(name string) {
+	n.namedMu.Lock()
+	defer n.namedMu.Unlock()
+	n.name = name
+}
+
+func (n *named) Metadata() map[string]string {
+	n.namedMu.RLock()
+	defer n.namedMu.RUnlock()
+	out := make(map[string]string, len(n.metadata))
+	for key, value := range n.metadata {
+		out[key] = value
+	}
+	return out
+}
+
+func (n *named) SetMetadata(key string, value string) {
+	n.namedMu.Lock()
+	defer n.namedMu.Unlock()
+	if n.metadata == nil {
+		n.metadata = make(map[string]string)
+	}
+	if value == "" {
+		delete(n.metadata, key)
+	} else {
+		n.metadata[key] = value
+	}
+}
+
+func (n *named) label() string {
+	n.namedMu.RLock()
+	defer n.namedMu.RUnlock()
+	return label(n.name, n.metadata)
+}
+
+// logf logs a message of the context, prefixed with its label.
+func (b *browserContextImpl) logf(format string, v ...interface{}) {
+	logger.Printf(logPrefix(b.label())+format, v...)
+}
+
+// logf logs a message of the page, prefixed with the labels of its context and itself. It logs the message alone
+// for a nil page, such as that of a frame not attached to a page yet.
+func (p *pageImpl) logf(format string, v ...interface{}) {
+	if p == nil {
+		logger.Printf(format, v...)
+		return
+	}
+	logger.Printf(logPrefix(p.browserContext.label(), p.label())+format, v...)
+}
+
+func logPrefix(labels ...string) string {
+	prefix := ""
+	for _, l := range labels {
+		if l != "" {
+			prefix += "[" + l + "] "
+		}
+	}
+	return prefix
+}
+
+func (b *browserImpl) ContextByName(name string) BrowserContext {
+	if name == "" {
+		return nil
+	}
+	for _, context := range b.Contexts() {
+		if context.Name() == name {
+			return context
+		}
+	}
+	return nil
+}
+
+func (b *browserImpl) ContextsWithMetadata(metadata map[string]string) []BrowserContext {
+	contexts := make([]BrowserContext, 0)
+	for _, context := range b.Contexts() {
+		if hasMetadata(context.Metadata(), metadata) {
+			contexts = append(contexts, context)
+		}
+	}
+	return contexts
+}
+
+// hasMetadata returns whether metadata contains all the entries of want.
+func hasMetadata(metadata map[string]string, want map[string]string) bool {
+	for key, value := range want {
+		if v, ok := metadata[key]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
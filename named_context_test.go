@@ -0,0 +1,47 @@
+package playwright
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLabel(t *testing.T) {
+	require.Equal(t, "", label("", nil))
+	require.Equal(t, "checkout", label("checkout", map[string]string{}))
+	require.Equal(t, "checkout {region=eu worker=3}", label("checkout", map[string]string{"worker": "3", "region": "eu"}))
+	require.Equal(t, "{worker=3}", label("", map[string]string{"worker": "3"}))
+}
+
+func TestNamed(t *testing.T) {
+	n := &named{}
+	n.SetName("checkout")
+	n.SetMetadata("worker", "3")
+	n.SetMetadata("region", "eu")
+	metadata := n.Metadata()
+	metadata["worker"] = "4"
+	require.Equal(t, "checkout {region=eu worker=3}", n.label())
+
+	n.SetMetadata("region", "")
+	require.Equal(t, map[string]string{"worker": "3"}, n.Metadata())
+	require.Equal(t, "[checkout {worker=3}] [page] ", logPrefix(n.label(), "", "page"))
+}
+
+func TestNilPageLogf(t *testing.T) {
+	var buf bytes.Buffer
+	output := logger.Writer()
+	logger.SetOutput(&buf)
+	defer logger.SetOutput(output)
+	var page *pageImpl
+	page.logf("navigated to %s", "about:blank")
+	require.Contains(t, buf.String(), "navigated to about:blank")
+}
+
+func TestHasMetadata(t *testing.T) {
+	metadata := map[string]string{"worker": "3", "region": "eu"}
+	require.True(t, hasMetadata(metadata, nil))
+	require.True(t, hasMetadata(metadata, map[string]string{"worker": "3"}))
+	require.False(t, hasMetadata(metadata, map[string]string{"worker": "4"}))
+	require.False(t, hasMetadata(metadata, map[string]string{"shard": ""}))
+}
@@ -45,10 +45,7 @@ func newRawHeaders(headers interface{}) *rawHeaders {
 	r := &rawHeaders{}
 	r.headersArray = make([]NameValue, 0)
 	r.headersMap = make(map[string][]string)
-	for _, header := range headers.([]interface{}) {
-		entry := header.(map[string]interface{})
-		name := entry["name"].(string)
-		value := entry["value"].(string)
+	add := func(name, value string) {
 		r.headersArray = append(r.headersArray, NameValue{
 			Name:  name,
 			Value: value,
@@ -58,5 +55,17 @@ func newRawHeaders(headers interface{}) *rawHeaders {
 		}
 		r.headersMap[strings.ToLower(name)] = append(r.headersMap[strings.ToLower(name)], value)
 	}
+	switch v := headers.(type) {
+	case []interface{}:
+		for _, header := range v {
+			entry := header.(map[string]interface{})
+			add(entry["name"].(string), entry["value"].(string))
+		}
+	case []map[string]string:
+		// Headers serialized by serializeMapToNameAndValue, such as the ones of Route.Fallback.
+		for _, entry := range v {
+			add(entry["name"], entry["value"])
+		}
+	}
 	return r
 }
@@ -0,0 +1,203 @@
+package playwright
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// NetworkBudget limits the network usage of the pages of a context, see [BrowserNewContextOptions.NetworkBudget]. A
+// zero limit is unlimited.
+type NetworkBudget struct {
+	// Maximum number of bytes received by the pages of the context, headers included. Once it is reached, the next
+	// requests are blocked. The bytes are counted when the requests finish, so that the requests in progress can exceed
+	// the budget.
+	MaxTotalBytes int64
+	// Maximum size of the body of a response, from its `Content-Length` header, or from its body if it has none. The
+	// responses which are not fulfilled or aborted by the route handlers are fetched by the driver, which keeps their
+	// body, and blocked if they are larger. Their body is only read if they have no `Content-Length` header.
+	MaxResponseSize int64
+	// Maximum number of requests of a page from the start of its last navigation. The navigation of the main frame
+	// starts a new page load.
+	MaxRequestsPerPageLoad int
+}
+
+func getBudgetLimit(in string) *BudgetLimit {
+	v := BudgetLimit(in)
+	return &v
+}
+
+// BudgetLimit is a limit of a [NetworkBudget].
+type BudgetLimit string
+
+var (
+	// [NetworkBudget.MaxTotalBytes]
+	BudgetLimitTotalBytes *BudgetLimit = getBudgetLimit("totalBytes")
+	// [NetworkBudget.MaxResponseSize]
+	BudgetLimitResponseSize = getBudgetLimit("responseSize")
+	// [NetworkBudget.MaxRequestsPerPageLoad]
+	BudgetLimitRequestCount = getBudgetLimit("requestCount")
+)
+
+// BudgetViolation is a request which the context blocked because it exceeded its [NetworkBudget].
+type BudgetViolation struct {
+	// Time the request was blocked.
+	Time time.Time
+	// URL of the request.
+	URL string
+	// Method of the request.
+	Method string
+	// Limit exceeded by the request.
+	Limit BudgetLimit
+	// Value of the limit.
+	Max int64
+	// Value reached by the request: the bytes received by the context, the size of the response, or the number of
+	// requests of the page load.
+	Value int64
+}
+
+// networkBudget is the usage of the [NetworkBudget] of a context.
+type networkBudget struct {
+	sync.Mutex
+	NetworkBudget
+	totalBytes   int64
+	pageRequests map[*pageImpl]int
+}
+
+func newNetworkBudget(budget NetworkBudget) *networkBudget {
+	return &networkBudget{NetworkBudget: budget, pageRequests: make(map[*pageImpl]int)}
+}
+
+func (n *NetworkBudget) validate() error {
+	if n.MaxTotalBytes < 0 || n.MaxResponseSize < 0 || n.MaxRequestsPerPageLoad < 0 {
+		return errors.New("network budget limits must be non-negative")
+	}
+	return nil
+}
+
+// check counts a request of page, and returns the violation of the budget if the request must be blocked.
+func (n *networkBudget) check(page *pageImpl, isPageLoad bool) *BudgetViolation {
+	n.Lock()
+	defer n.Unlock()
+	if n.MaxTotalBytes > 0 && n.totalBytes >= n.MaxTotalBytes {
+		return &BudgetViolation{Limit: *BudgetLimitTotalBytes, Max: n.MaxTotalBytes, Value: n.totalBytes}
+	}
+	if page == nil || n.MaxRequestsPerPageLoad == 0 {
+		return nil
+	}
+	if isPageLoad {
+		n.pageRequests[page] = 0
+	}
+	n.pageRequests[page]++
+	if count := n.pageRequests[page]; count > n.MaxRequestsPerPageLoad {
+		return &BudgetViolation{Limit: *BudgetLimitRequestCount, Max: int64(n.MaxRequestsPerPageLoad), Value: int64(count)}
+	}
+	return nil
+}
+
+func (n *networkBudget) addBytes(size int64) {
+	n.Lock()
+	defer n.Unlock()
+	n.totalBytes += size
+}
+
+func (n *networkBudget) removePage(page *pageImpl) {
+	n.Lock()
+	defer n.Unlock()
+	delete(n.pageRequests, page)
+}
+
+func (b *browserContextImpl) OnBudgetExceeded(fn func(BudgetViolation)) {
+	b.On("budgetexceeded", fn)
+}
+
+// trackNetworkBudget forgets the page loads of the closed pages of the context. The bytes received are counted by
+// [browserContextImpl.trackRequestSizes].
+func (b *browserContextImpl) trackNetworkBudget() {
+	b.On("page", func(page Page) {
+		page.OnClose(func(page Page) {
+			b.networkBudget.removePage(page.(*pageImpl))
+		})
+	})
+}
+
+// reportBudgetViolation logs a request blocked by the budget of the context, and emits it.
+func (b *browserContextImpl) reportBudgetViolation(request Request, violation BudgetViolation) {
+	violation.Time = time.Now()
+	violation.URL = request.URL()
+	violation.Method = request.Method()
+	b.logf("blocked %s %s: %s budget of %d exceeded\n", violation.Method, Redact(violation.URL), violation.Limit, violation.Max)
+	b.Emit("budgetexceeded", violation)
+}
+
+// handleNetworkBudget aborts the route if its request exceeds the budget of the context, before the route handlers see
+// it. It returns whether the route was handled.
+func (b *browserContextImpl) handleNetworkBudget(route *routeImpl) bool {
+	if b.networkBudget == nil {
+		return false
+	}
+	request := route.Request().(*requestImpl)
+	page := request.safePage()
+	isPageLoad := page != nil && request.IsNavigationRequest() && request.Frame() == page.MainFrame()
+	violation := b.networkBudget.check(page, isPageLoad)
+	if violation == nil {
+		return false
+	}
+	b.reportBudgetViolation(request, *violation)
+	_, err := b.connection.WrapAPICall(func() (interface{}, error) {
+		return nil, route.Abort("blockedbyclient")
+	}, true)
+	if err != nil && !errors.Is(err, ErrTargetClosed) {
+		b.logf("could not block request over budget: %v\n", err)
+	}
+	return true
+}
+
+// continueWithinBudget continues the route which no route handler fulfilled or aborted. If the budget of the context
+// limits the size of the responses, the response is fetched and blocked if it is too large.
+func (b *browserContextImpl) continueWithinBudget(route *routeImpl) {
+	if b.networkBudget == nil || b.networkBudget.MaxResponseSize == 0 {
+		// If the page is closed or unrouteAll() was called without waiting and interception disabled,
+		// the method will throw an error - silence it.
+		_ = route.internalContinue(true)
+		return
+	}
+	_, err := b.connection.WrapAPICall(func() (interface{}, error) {
+		// The redirects are followed by the browser, so that the page sees them.
+		response, err := b.request.innerFetch("", route.Request(), APIRequestContextFetchOptions{MaxRedirects: Int(0)})
+		if err != nil {
+			return nil, route.Abort("failed")
+		}
+		defer response.Dispose()
+		size, err := responseSize(response)
+		if err != nil {
+			return nil, route.Abort("failed")
+		}
+		if size > b.networkBudget.MaxResponseSize {
+			b.reportBudgetViolation(route.Request(), BudgetViolation{
+				Limit: *BudgetLimitResponseSize,
+				Max:   b.networkBudget.MaxResponseSize,
+				Value: size,
+			})
+			return nil, route.Abort("blockedbyclient")
+		}
+		return nil, route.Fulfill(RouteFulfillOptions{Response: response})
+	}, true)
+	if err != nil && !errors.Is(err, ErrTargetClosed) {
+		b.logf("could not check response size of %s: %v\n", Redact(route.Request().URL()), err)
+	}
+}
+
+// responseSize returns the size of the body of a response from its `Content-Length` header, reading the body only if
+// the response has no valid header.
+func responseSize(response APIResponse) (int64, error) {
+	if length, err := strconv.ParseInt(response.Headers()["content-length"], 10, 64); err == nil && length >= 0 {
+		return length, nil
+	}
+	body, err := response.Body()
+	if err != nil {
+		return 0, err
+	}
+	return int64(len(body)), nil
+}
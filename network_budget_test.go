@@ -0,0 +1,44 @@
+package playwright
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetworkBudgetCheck(t *testing.T) {
+	budget := newNetworkBudget(NetworkBudget{MaxRequestsPerPageLoad: 2, MaxTotalBytes: 100})
+	page := &pageImpl{}
+
+	require.Nil(t, budget.check(page, true))
+	require.Nil(t, budget.check(page, false))
+	violation := budget.check(page, false)
+	require.NotNil(t, violation)
+	require.Equal(t, *BudgetLimitRequestCount, violation.Limit)
+	require.Equal(t, int64(2), violation.Max)
+	require.Equal(t, int64(3), violation.Value)
+	// A navigation of the main frame starts a new page load.
+	require.Nil(t, budget.check(page, true))
+	// The requests without page are not counted.
+	require.Nil(t, budget.check(nil, false))
+
+	budget.addBytes(60)
+	require.Nil(t, budget.check(nil, false))
+	budget.addBytes(40)
+	violation = budget.check(nil, false)
+	require.NotNil(t, violation)
+	require.Equal(t, *BudgetLimitTotalBytes, violation.Limit)
+	require.Equal(t, int64(100), violation.Value)
+
+	budget.removePage(page)
+	require.Empty(t, budget.pageRequests)
+	require.Error(t, (&NetworkBudget{MaxResponseSize: -1}).validate())
+	require.NoError(t, (&NetworkBudget{}).validate())
+}
+
+func TestResponseSizeShouldUseContentLength(t *testing.T) {
+	response := &apiResponseImpl{headers: newRawHeaders([]map[string]string{{"name": "Content-Length", "value": "1234"}})}
+	size, err := responseSize(response)
+	require.NoError(t, err)
+	require.Equal(t, int64(1234), size)
+}
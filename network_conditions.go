@@ -0,0 +1,80 @@
+package playwright
+
+import (
+	"errors"
+	"fmt"
+)
+
+func getConnectionType(in string) *ConnectionType {
+	v := ConnectionType(in)
+	return &v
+}
+
+// ConnectionType is the connection type reported by the Network Information API, see [Page.EmulateNetworkConditions].
+type ConnectionType string
+
+var (
+	ConnectionTypeNone       *ConnectionType = getConnectionType("none")
+	ConnectionTypeCellular2g                 = getConnectionType("cellular2g")
+	ConnectionTypeCellular3g                 = getConnectionType("cellular3g")
+	ConnectionTypeCellular4g                 = getConnectionType("cellular4g")
+	ConnectionTypeBluetooth                  = getConnectionType("bluetooth")
+	ConnectionTypeEthernet                   = getConnectionType("ethernet")
+	ConnectionTypeWifi                       = getConnectionType("wifi")
+	ConnectionTypeWimax                      = getConnectionType("wimax")
+	ConnectionTypeOther                      = getConnectionType("other")
+)
+
+// Network conditions of the throttling presets of the Chrome DevTools, for [Page.EmulateNetworkConditions].
+var (
+	NetworkConditionsSlow3G = PageEmulateNetworkConditionsOptions{
+		ConnectionType:     ConnectionTypeCellular3g,
+		DownloadThroughput: Float(500 * 1024 / 8 * 0.8),
+		Latency:            Float(400 * 5),
+		UploadThroughput:   Float(500 * 1024 / 8 * 0.8),
+	}
+	NetworkConditionsFast3G = PageEmulateNetworkConditionsOptions{
+		ConnectionType:     ConnectionTypeCellular3g,
+		DownloadThroughput: Float(1.6 * 1024 * 1024 / 8 * 0.9),
+		Latency:            Float(150 * 3.75),
+		UploadThroughput:   Float(750 * 1024 / 8 * 0.9),
+	}
+)
+
+// ErrNetworkConditionsNotSupported is returned when network conditions are emulated in a browser other than Chromium.
+var ErrNetworkConditionsNotSupported = errors.New("network conditions emulation is only supported in Chromium")
+
+func (p *pageImpl) EmulateNetworkConditions(options ...PageEmulateNetworkConditionsOptions) error {
+	session, err := p.cdpSession()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrNetworkConditionsNotSupported, err)
+	}
+	// The conditions only apply while the Network domain is enabled in the session.
+	if _, err := session.Send("Network.enable", map[string]interface{}{}); err != nil {
+		return err
+	}
+	option := PageEmulateNetworkConditionsOptions{}
+	if len(options) == 1 {
+		option = options[0]
+	}
+	params := map[string]interface{}{
+		"offline":            option.Offline != nil && *option.Offline,
+		"latency":            0.0,
+		"downloadThroughput": -1.0,
+		"uploadThroughput":   -1.0,
+	}
+	if option.Latency != nil {
+		params["latency"] = *option.Latency
+	}
+	if option.DownloadThroughput != nil {
+		params["downloadThroughput"] = *option.DownloadThroughput
+	}
+	if option.UploadThroughput != nil {
+		params["uploadThroughput"] = *option.UploadThroughput
+	}
+	if option.ConnectionType != nil {
+		params["connectionType"] = string(*option.ConnectionType)
+	}
+	_, err = session.Send("Network.emulateNetworkConditions", params)
+	return err
+}
@@ -0,0 +1,194 @@
+package playwright
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// optionsMarshaler is implemented by option values that serialize themselves into
+// protocol params, so transformOptions does not need to walk them on every call.
+type optionsMarshaler interface {
+	Marshal() map[string]interface{}
+}
+
+// typedOptions is the variadic option list of an API method, e.g. []PageReloadOptions.
+// Only the first element is used, like every API method does.
+type typedOptions[T any] []T
+
+// optionsOf wraps the variadic options of an API method so that they are serialized by
+// the encoder of T, which is built with reflection once and cached, instead of being
+// walked by transformOptions on every call. It is a performance optimization only: the
+// options are still checked at runtime, not at compile time.
+func optionsOf[T any](options []T) typedOptions[T] {
+	return typedOptions[T](options)
+}
+
+// Marshal serializes the first option into protocol params. Nil pointers, maps, slices
+// and interfaces are omitted, the same as transformOptions does.
+func (o typedOptions[T]) Marshal() map[string]interface{} {
+	if len(o) == 0 {
+		return make(map[string]interface{})
+	}
+	v := reflect.ValueOf(&o[0]).Elem()
+	if v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return make(map[string]interface{})
+		}
+		v = v.Elem()
+	}
+	out, ok := encoderOf(v.Type())(v).(map[string]interface{})
+	if !ok {
+		return make(map[string]interface{})
+	}
+	return out
+}
+
+// valueEncoder converts a value into its protocol representation.
+type valueEncoder func(v reflect.Value) interface{}
+
+var (
+	encoderCache sync.Map // map[reflect.Type]valueEncoder
+	channelType  = reflect.TypeOf(&channel{})
+)
+
+// encoderOf returns the cached encoder of typ, building it on first use.
+func encoderOf(typ reflect.Type) valueEncoder {
+	if enc, ok := encoderCache.Load(typ); ok {
+		return enc.(valueEncoder)
+	}
+	// Recursive types resolve to the final encoder through this indirection.
+	var (
+		wg  sync.WaitGroup
+		enc valueEncoder
+	)
+	wg.Add(1)
+	indirect, loaded := encoderCache.LoadOrStore(typ, valueEncoder(func(v reflect.Value) interface{} {
+		wg.Wait()
+		return enc(v)
+	}))
+	if loaded {
+		return indirect.(valueEncoder)
+	}
+	enc = newEncoder(typ)
+	wg.Done()
+	encoderCache.Store(typ, enc)
+	return enc
+}
+
+func newEncoder(typ reflect.Type) valueEncoder {
+	if typ == channelType {
+		return func(v reflect.Value) interface{} {
+			return v.Interface()
+		}
+	}
+	switch typ.Kind() {
+	case reflect.Ptr:
+		return newPtrEncoder(typ)
+	case reflect.Interface:
+		return func(v reflect.Value) interface{} {
+			return encoderOf(v.Elem().Type())(v.Elem())
+		}
+	case reflect.Struct:
+		return newStructEncoder(typ)
+	case reflect.Map:
+		return newMapEncoder(typ)
+	case reflect.Slice:
+		return newSliceEncoder(typ)
+	case reflect.String:
+		return func(v reflect.Value) interface{} {
+			if v.String() == Null().(string) {
+				return "null"
+			}
+			return v.Interface()
+		}
+	default:
+		return func(v reflect.Value) interface{} {
+			return v.Interface()
+		}
+	}
+}
+
+func newPtrEncoder(typ reflect.Type) valueEncoder {
+	switch typ.Elem().Kind() {
+	case reflect.Struct, reflect.Map, reflect.Slice:
+		elem := encoderOf(typ.Elem())
+		return func(v reflect.Value) interface{} {
+			return elem(v.Elem())
+		}
+	case reflect.String:
+		return func(v reflect.Value) interface{} {
+			if v.Elem().String() == Null().(string) {
+				return "null"
+			}
+			return v.Interface()
+		}
+	default:
+		// pointers to scalars are kept as is, they are dereferenced by encoding/json
+		return func(v reflect.Value) interface{} {
+			return v.Interface()
+		}
+	}
+}
+
+type fieldEncoder struct {
+	index  int
+	key    string
+	encode valueEncoder
+}
+
+func newStructEncoder(typ reflect.Type) valueEncoder {
+	fields := make([]fieldEncoder, 0, typ.NumField())
+	for i := 0; i < typ.NumField(); i++ {
+		fi := typ.Field(i)
+		if !fi.IsExported() {
+			continue
+		}
+		key := strings.Split(fi.Tag.Get("json"), ",")[0]
+		if key == "" {
+			key = fi.Name
+		}
+		fields = append(fields, fieldEncoder{
+			index:  i,
+			key:    key,
+			encode: encoderOf(fi.Type),
+		})
+	}
+	return func(v reflect.Value) interface{} {
+		out := make(map[string]interface{}, len(fields))
+		for _, f := range fields {
+			fv := v.Field(f.index)
+			if !skipFieldSerialization(fv) {
+				out[f.key] = f.encode(fv)
+			}
+		}
+		return out
+	}
+}
+
+func newMapEncoder(typ reflect.Type) valueEncoder {
+	elem := encoderOf(typ.Elem())
+	return func(v reflect.Value) interface{} {
+		out := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			if !skipFieldSerialization(iter.Value()) {
+				out[iter.Key().String()] = elem(iter.Value())
+			}
+		}
+		return out
+	}
+}
+
+func newSliceEncoder(typ reflect.Type) valueEncoder {
+	elem := encoderOf(typ.Elem())
+	return func(v reflect.Value) interface{} {
+		out := make([]interface{}, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			if !skipFieldSerialization(v.Index(i)) {
+				out = append(out, elem(v.Index(i)))
+			}
+		}
+		return out
+	}
+}
@@ -0,0 +1,70 @@
+package playwright
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var benchmarkContextOptions = BrowserNewContextOptions{
+	AcceptDownloads:  Bool(true),
+	BaseURL:          String("https://example.com"),
+	ColorScheme:      ColorSchemeDark,
+	ExtraHttpHeaders: map[string]string{"foo": "bar"},
+	Geolocation: &Geolocation{
+		Latitude:  59.95,
+		Longitude: 30.31667,
+	},
+	Locale:      String("en-US"),
+	Permissions: []string{"geolocation"},
+	Screen: &Size{
+		Width:  1280,
+		Height: 720,
+	},
+	StorageState: &OptionalStorageState{
+		Cookies: []OptionalCookie{
+			{Name: "foo", Value: "bar", URL: String("https://example.com")},
+		},
+	},
+	UserAgent: String(Null().(string)),
+}
+
+func TestTypedOptionsMarshal(t *testing.T) {
+	options := []BrowserNewContextOptions{benchmarkContextOptions}
+	require.Equal(t, transformStructIntoMapIfNeeded(benchmarkContextOptions), optionsOf(options).Marshal())
+	require.Equal(t, map[string]interface{}{}, optionsOf([]PageReloadOptions{}).Marshal())
+	require.Equal(t, map[string]interface{}{}, optionsOf([]*PageReloadOptions{nil}).Marshal())
+}
+
+func TestTransformOptionsWithTypedOptions(t *testing.T) {
+	options := []PageScreenshotOptions{{
+		FullPage: Bool(true),
+		Type:     ScreenshotTypeJpeg,
+	}}
+	overrides := map[string]interface{}{
+		"type": "png",
+	}
+	require.Equal(t, transformOptions(options, overrides), transformOptions(optionsOf(options), overrides))
+	require.Equal(t, transformOptions(options), transformOptions(optionsOf(options)))
+	require.Equal(t, map[string]interface{}{
+		"fullPage": Bool(true),
+		"type":     ScreenshotTypeJpeg,
+		"timeout":  1000,
+	}, transformOptions(map[string]interface{}{"timeout": 1000}, optionsOf(options)))
+}
+
+func BenchmarkTransformOptions(b *testing.B) {
+	options := []BrowserNewContextOptions{benchmarkContextOptions}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		transformOptions(options)
+	}
+}
+
+func BenchmarkTypedOptionsMarshal(b *testing.B) {
+	options := []BrowserNewContextOptions{benchmarkContextOptions}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		transformOptions(optionsOf(options))
+	}
+}
@@ -0,0 +1,177 @@
+package playwright
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ErrOriginNotAllowed is returned by the requests of [APIRequestContext] to an origin outside of the allowlist of their
+// context, see [BrowserNewContextOptions.AllowedOrigins].
+var ErrOriginNotAllowed = errors.New("origin not allowed")
+
+// OriginViolation is a request to an origin outside of the allowlist of a context, see
+// [BrowserNewContextOptions.AllowedOrigins]. The requests are blocked, except for redirects.
+type OriginViolation struct {
+	// Time the request was blocked.
+	Time time.Time
+	// URL of the request.
+	URL string
+	// Origin of the request, such as `https://example.com`.
+	Origin string
+	// Method of the request.
+	Method string
+	// Whether the request was a navigation, which fails with `net::ERR_BLOCKED_BY_CLIENT`.
+	IsNavigation bool
+	// Whether the request was sent by an [APIRequestContext] rather than a page.
+	API bool
+	// Whether the request was a redirect, which isn't routed: it is reported but not blocked.
+	Redirect bool
+}
+
+// originPattern is an allowed origin: a scheme, a host which may start with `*.` to allow its subdomains, and a port.
+type originPattern struct {
+	scheme string
+	host   string
+	port   string
+}
+
+// originAllowlist is the allowlist of the origins of a context, enabled with [BrowserNewContextOptions.AllowedOrigins].
+type originAllowlist struct {
+	patterns []originPattern
+}
+
+func newOriginAllowlist(origins []string) (*originAllowlist, error) {
+	allowlist := &originAllowlist{}
+	for _, origin := range origins {
+		u, err := url.Parse(origin)
+		if err != nil || u.Scheme == "" || u.Host == "" || (u.Path != "" && u.Path != "/") || u.RawQuery != "" {
+			return nil, fmt.Errorf("invalid allowed origin %q, expected scheme://host[:port]", origin)
+		}
+		allowlist.patterns = append(allowlist.patterns, originPattern{
+			scheme: strings.ToLower(u.Scheme),
+			host:   strings.ToLower(u.Hostname()),
+			port:   originPort(u),
+		})
+	}
+	return allowlist, nil
+}
+
+// originPort returns the port of u, or the default port of its scheme.
+func originPort(u *url.URL) string {
+	if port := u.Port(); port != "" {
+		return port
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "http", "ws":
+		return "80"
+	case "https", "wss":
+		return "443"
+	}
+	return ""
+}
+
+// check returns the origin of rawURL, and whether it is allowed. The URLs without network origin, such as `data:`
+// and `about:blank`, are allowed.
+func (a *originAllowlist) check(rawURL string) (string, bool) {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return rawURL, err == nil
+	}
+	scheme, host, port := strings.ToLower(u.Scheme), strings.ToLower(u.Hostname()), originPort(u)
+	origin := scheme + "://" + u.Host
+	for _, pattern := range a.patterns {
+		if pattern.scheme != scheme || pattern.port != port {
+			continue
+		}
+		if pattern.host == host || (strings.HasPrefix(pattern.host, "*.") && strings.HasSuffix(host, pattern.host[1:])) {
+			return origin, true
+		}
+	}
+	return origin, false
+}
+
+func (b *browserContextImpl) OnOriginViolation(fn func(OriginViolation)) {
+	b.On("originviolation", fn)
+}
+
+// reportOriginViolation logs a request outside of the allowlist of the context, and emits it.
+func (b *browserContextImpl) reportOriginViolation(violation OriginViolation) {
+	violation.Time = time.Now()
+	action := "blocked"
+	if violation.Redirect {
+		action = "could not block redirect"
+	}
+	b.logf("%s %s %s: origin %s is not allowed\n", action, violation.Method, Redact(violation.URL), violation.Origin)
+	b.Emit("originviolation", violation)
+}
+
+// trackOriginRedirects reports the redirects to origins outside of the allowlist, which the route handlers don't see.
+func (b *browserContextImpl) trackOriginRedirects() {
+	b.OnRequest(func(request Request) {
+		if request.RedirectedFrom() == nil {
+			return
+		}
+		origin, ok := b.originAllowlist.check(request.URL())
+		if ok {
+			return
+		}
+		b.reportOriginViolation(OriginViolation{
+			URL:          request.URL(),
+			Origin:       origin,
+			Method:       request.Method(),
+			IsNavigation: request.IsNavigationRequest(),
+			Redirect:     true,
+		})
+	})
+}
+
+// handleOriginAllowlist aborts the route if its request goes to an origin outside of the allowlist of the context,
+// before the route handlers see it. It returns whether the route was handled.
+func (b *browserContextImpl) handleOriginAllowlist(route *routeImpl) bool {
+	if b.originAllowlist == nil {
+		return false
+	}
+	request := route.Request()
+	origin, ok := b.originAllowlist.check(request.URL())
+	if ok {
+		return false
+	}
+	b.reportOriginViolation(OriginViolation{
+		URL:          request.URL(),
+		Origin:       origin,
+		Method:       request.Method(),
+		IsNavigation: request.IsNavigationRequest(),
+	})
+	_, err := b.connection.WrapAPICall(func() (interface{}, error) {
+		return nil, route.Abort("blockedbyclient")
+	}, true)
+	if err != nil && !errors.Is(err, ErrTargetClosed) {
+		b.logf("could not block request to %s: %v\n", origin, err)
+	}
+	return true
+}
+
+// checkFetchOrigin returns an error if a request of an [APIRequestContext] goes to an origin outside of the
+// allowlist of the context.
+func (b *browserContextImpl) checkFetchOrigin(method string, rawURL string) error {
+	if b.originAllowlist == nil {
+		return nil
+	}
+	if b.options != nil && b.options.BaseURL != nil {
+		// Relative URLs are resolved against the base URL by the driver.
+		if base, err := url.Parse(*b.options.BaseURL); err == nil {
+			if ref, err := url.Parse(rawURL); err == nil {
+				rawURL = base.ResolveReference(ref).String()
+			}
+		}
+	}
+	origin, ok := b.originAllowlist.check(rawURL)
+	if ok {
+		return nil
+	}
+	b.reportOriginViolation(OriginViolation{URL: rawURL, Origin: origin, Method: strings.ToUpper(method), API: true})
+	return fmt.Errorf("%w: %s", ErrOriginNotAllowed, origin)
+}
@@ -0,0 +1,67 @@
+package playwright
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOriginAllowlistCheck(t *testing.T) {
+	allowlist, err := newOriginAllowlist([]string{"https://example.com", "http://localhost:8080/", "https://*.cdn.example.org"})
+	require.NoError(t, err)
+
+	for _, url := range []string{
+		"https://example.com/path?query",
+		"https://EXAMPLE.com:443/",
+		"http://localhost:8080/api",
+		"https://static.cdn.example.org/app.js",
+		"data:text/html,hello",
+		"about:blank",
+	} {
+		_, ok := allowlist.check(url)
+		require.True(t, ok, url)
+	}
+	for url, origin := range map[string]string{
+		"http://example.com/":          "http://example.com",
+		"https://example.com:8443/":    "https://example.com:8443",
+		"https://api.example.com/":     "https://api.example.com",
+		"http://localhost:3000/":       "http://localhost:3000",
+		"https://cdn.example.org/":     "https://cdn.example.org",
+		"https://evil.com/example.com": "https://evil.com",
+	} {
+		got, ok := allowlist.check(url)
+		require.False(t, ok, url)
+		require.Equal(t, origin, got)
+	}
+
+	for _, origin := range []string{"example.com", "https://example.com/path", "https://example.com?q=1"} {
+		_, err := newOriginAllowlist([]string{origin})
+		require.Error(t, err, origin)
+	}
+}
+
+func TestCheckFetchOrigin(t *testing.T) {
+	allowlist, err := newOriginAllowlist([]string{"https://example.com"})
+	require.NoError(t, err)
+	context := &browserContextImpl{
+		originAllowlist: allowlist,
+		options:         &BrowserNewContextOptions{BaseURL: String("https://example.com/api/")},
+	}
+	var violations []OriginViolation
+	context.OnOriginViolation(func(violation OriginViolation) {
+		violations = append(violations, violation)
+	})
+
+	require.NoError(t, context.checkFetchOrigin("GET", "users"))
+	require.NoError(t, context.checkFetchOrigin("GET", "https://example.com/users"))
+	err = context.checkFetchOrigin("post", "https://tracker.net/collect")
+	require.ErrorIs(t, err, ErrOriginNotAllowed)
+	require.ErrorContains(t, err, "https://tracker.net")
+
+	require.Len(t, violations, 1)
+	require.Equal(t, "POST", violations[0].Method)
+	require.Equal(t, "https://tracker.net", violations[0].Origin)
+	require.True(t, violations[0].API)
+
+	require.NoError(t, (&browserContextImpl{}).checkFetchOrigin("GET", "https://tracker.net"))
+}
@@ -12,9 +12,14 @@ import (
 
 type pageImpl struct {
 	channelOwner
+	named
+	activity
 	isClosed        bool
 	closedOrCrashed chan error
 	video           *videoImpl
+	accessibility   *accessibilityImpl
+	coverage        *coverageImpl
+	speech          *speechImpl
 	mouse           *mouseImpl
 	keyboard        *keyboardImpl
 	touchscreen     *touchscreenImpl
@@ -25,15 +30,49 @@ type pageImpl struct {
 	mainFrame       Frame
 	routes          []*routeHandlerEntry
 	viewportSize    *Size
+	// emulatedMedia is the media type set with [Page.EmulateMedia], restored by [Page.PrintLayout].
+	emulatedMedia   *Media
 	ownedContext    BrowserContext
 	bindings        map[string]BindingCallFunction
+	bindingHandles  map[string]bool
 	closeReason     *string
 	closeWasCalled  bool
 	harRouters      []*harRouter
-	locatorHandlers map[float64]func()
-}
-
-func (p *pageImpl) AddLocatorHandler(locator Locator, handler func()) error {
+	locatorHandlers map[float64]*locatorHandler
+	webSocketRoutes []*webSocketRouteHandler
+	gamepadsMu      sync.Mutex
+	gamepads        []*gamepadImpl
+	sensorsMu       sync.Mutex
+	// sensors are the sensors overridden with [Page.EmulateSensor], with their availability.
+	sensors         map[SensorType]bool
+	isolatedWorldMu sync.Mutex
+	isolatedSession CDPSession
+	isolatedScripts map[string]string
+	// cdpFrameIDs are the CDP ids of the frames, see [pageImpl.cdpFrameID].
+	cdpFrameIDs  map[*frameImpl]string
+	initScripts  *initScripts
+	extraHeaders map[string]string
+	// frameHeaders are the extra HTTP headers set with [Frame.SetExtraHTTPHeaders].
+	frameHeaders   map[*frameImpl]map[string]string
+	frameHeadersMu sync.Mutex
+	bandwidth      pageBandwidth
+	prerenderMu    sync.Mutex
+	// prerenderTracked is whether the prerendering status updates are emitted, see [Page.CapturePrerenders].
+	prerenderTracked bool
+	// webSocketClose pairs the WebSockets with the ones reported by the documents, see
+	// [BrowserContext.EnableWebSocketCloseStatus].
+	webSocketClose *webSocketCloseTracker
+}
+
+// locatorHandler is a handler registered with [Page.AddLocatorHandler].
+type locatorHandler struct {
+	locator *locatorImpl
+	handler func()
+	// times is the number of calls left, nil if unlimited.
+	times *int
+}
+
+func (p *pageImpl) AddLocatorHandler(locator Locator, handler func(), options ...PageAddLocatorHandlerOptions) error {
 	if locator == nil || handler == nil {
 		return errors.New("locator or handler must not be nil")
 	}
@@ -44,21 +83,56 @@ func (p *pageImpl) AddLocatorHandler(locator Locator, handler func()) error {
 	if loc.frame != p.mainFrame {
 		return errors.New("locator must belong to the main frame of this page")
 	}
+	var times *int
+	if len(options) == 1 && options[0].Times != nil {
+		if *options[0].Times <= 0 {
+			return fmt.Errorf("times must be positive, got %d", *options[0].Times)
+		}
+		times = Int(*options[0].Times)
+	}
 	uid, err := p.channel.Send("registerLocatorHandler", map[string]any{
 		"selector": loc.selector,
 	})
 	if err != nil {
 		return err
 	}
-	p.locatorHandlers[uid.(float64)] = handler
+	p.Lock()
+	p.locatorHandlers[uid.(float64)] = &locatorHandler{locator: loc, handler: handler, times: times}
+	p.Unlock()
 	return nil
 }
 
+func (p *pageImpl) RemoveLocatorHandler(locator Locator) error {
+	if locator == nil {
+		return errors.New("locator must not be nil")
+	}
+	if locator.Err() != nil {
+		return locator.Err()
+	}
+	loc := locator.(*locatorImpl)
+	p.Lock()
+	defer p.Unlock()
+	for uid, entry := range p.locatorHandlers {
+		if entry.locator.frame == loc.frame && entry.locator.selector == loc.selector {
+			delete(p.locatorHandlers, uid)
+		}
+	}
+	return nil
+}
+
+// onLocatorHandlerTriggered runs the handler and lets the server continue with the action.
+// The server keeps triggering removed handlers, as it can't unregister them, so those are
+// resolved right away.
 func (p *pageImpl) onLocatorHandlerTriggered(uid float64) {
-	handler, ok := p.locatorHandlers[uid]
-	if !ok {
-		return
+	p.Lock()
+	entry, ok := p.locatorHandlers[uid]
+	if ok && entry.times != nil {
+		*entry.times--
+		if *entry.times <= 0 {
+			delete(p.locatorHandlers, uid)
+		}
 	}
+	p.Unlock()
 	go func() {
 		defer func() {
 			_, _ = p.connection.WrapAPICall(func() (interface{}, error) {
@@ -69,10 +143,29 @@ func (p *pageImpl) onLocatorHandlerTriggered(uid float64) {
 			}, true)
 		}()
 
-		handler()
+		if ok {
+			entry.handler()
+		}
 	}()
 }
 
+func (p *pageImpl) Accessibility() Accessibility {
+	return p.accessibility
+}
+
+func (p *pageImpl) Clock() Clock {
+	return p.browserContext.clock
+}
+
+func (p *pageImpl) Coverage() Coverage {
+	p.Lock()
+	defer p.Unlock()
+	if p.coverage == nil {
+		p.coverage = newCoverage(p)
+	}
+	return p.coverage
+}
+
 func (p *pageImpl) Context() BrowserContext {
 	return p.browserContext
 }
@@ -82,7 +175,7 @@ func (p *pageImpl) Close(options ...PageCloseOptions) error {
 		p.closeReason = options[0].Reason
 	}
 	p.closeWasCalled = true
-	_, err := p.channel.Send("close", options)
+	_, err := p.channel.Send("close", optionsOf(options))
 	if err == nil && p.ownedContext != nil {
 		err = p.ownedContext.Close()
 	}
@@ -213,13 +306,6 @@ func (p *pageImpl) AddStyleTag(options PageAddStyleTagOptions) (ElementHandle, e
 	return p.mainFrame.AddStyleTag(FrameAddStyleTagOptions(options))
 }
 
-func (p *pageImpl) SetExtraHTTPHeaders(headers map[string]string) error {
-	_, err := p.channel.Send("setExtraHTTPHeaders", map[string]interface{}{
-		"headers": serializeMapToNameAndValue(headers),
-	})
-	return err
-}
-
 func (p *pageImpl) URL() string {
 	return p.mainFrame.URL()
 }
@@ -228,32 +314,12 @@ func (p *pageImpl) Unroute(url interface{}, handlers ...routeHandler) error {
 	p.Lock()
 	defer p.Unlock()
 
-	removed, remaining, err := unroute(p.routes, url, handlers...)
+	_, remaining, err := unroute(p.routes, url, handlers...)
 	if err != nil {
 		return err
 	}
-	return p.unrouteInternal(removed, remaining, UnrouteBehaviorDefault)
-}
-
-func (p *pageImpl) unrouteInternal(removed []*routeHandlerEntry, remaining []*routeHandlerEntry, behavior *UnrouteBehavior) error {
 	p.routes = remaining
-	err := p.updateInterceptionPatterns()
-	if err != nil {
-		return err
-	}
-	if behavior == nil || behavior == UnrouteBehaviorDefault {
-		return nil
-	}
-	wg := &sync.WaitGroup{}
-	for _, entry := range removed {
-		wg.Add(1)
-		go func(entry *routeHandlerEntry) {
-			defer wg.Done()
-			entry.Stop(string(*behavior))
-		}(entry)
-	}
-	wg.Wait()
-	return nil
+	return p.updateInterceptionPatterns()
 }
 
 func (p *pageImpl) disposeHarRouters() {
@@ -269,9 +335,19 @@ func (p *pageImpl) UnrouteAll(options ...PageUnrouteAllOptions) error {
 		behavior = options[0].Behavior
 	}
 	p.Lock()
-	defer p.Unlock()
-	defer p.disposeHarRouters()
-	return p.unrouteInternal(p.routes, []*routeHandlerEntry{}, behavior)
+	removed := p.routes
+	p.routes = []*routeHandlerEntry{}
+	err := p.updateInterceptionPatterns()
+	p.Unlock()
+	stopRouteHandlers(removed, behavior)
+	p.Lock()
+	p.disposeHarRouters()
+	p.Unlock()
+	return err
+}
+
+func (p *pageImpl) ConsoleMessages() *EventSubscription[ConsoleMessage] {
+	return SubscribeEvent[ConsoleMessage](p, "console")
 }
 
 func (p *pageImpl) Content() (string, error) {
@@ -293,7 +369,7 @@ func (p *pageImpl) Goto(url string, options ...PageGotoOptions) (Response, error
 }
 
 func (p *pageImpl) Reload(options ...PageReloadOptions) (Response, error) {
-	channel, err := p.channel.Send("reload", options)
+	channel, err := p.channel.Send("reload", optionsOf(options))
 	if err != nil {
 		return nil, err
 	}
@@ -312,7 +388,7 @@ func (p *pageImpl) WaitForLoadState(options ...PageWaitForLoadStateOptions) erro
 }
 
 func (p *pageImpl) GoBack(options ...PageGoBackOptions) (Response, error) {
-	channel, err := p.channel.Send("goBack", options)
+	channel, err := p.channel.Send("goBack", optionsOf(options))
 	if err != nil {
 		return nil, err
 	}
@@ -325,7 +401,7 @@ func (p *pageImpl) GoBack(options ...PageGoBackOptions) (Response, error) {
 }
 
 func (p *pageImpl) GoForward(options ...PageGoForwardOptions) (Response, error) {
-	channel, err := p.channel.Send("goForward", options)
+	channel, err := p.channel.Send("goForward", optionsOf(options))
 	if err != nil {
 		return nil, err
 	}
@@ -338,10 +414,15 @@ func (p *pageImpl) GoForward(options ...PageGoForwardOptions) (Response, error)
 }
 
 func (p *pageImpl) EmulateMedia(options ...PageEmulateMediaOptions) error {
-	_, err := p.channel.Send("emulateMedia", options)
+	_, err := p.channel.Send("emulateMedia", optionsOf(options))
 	if err != nil {
 		return err
 	}
+	if len(options) == 1 && options[0].Media != nil {
+		p.Lock()
+		p.emulatedMedia = options[0].Media
+		p.Unlock()
+	}
 	return err
 }
 
@@ -357,6 +438,10 @@ func (p *pageImpl) SetViewportSize(width, height int) error {
 	}
 	p.viewportSize.Width = width
 	p.viewportSize.Height = height
+	p.Emit("viewportsizechange", &Size{
+		Width:  width,
+		Height: height,
+	})
 	return nil
 }
 
@@ -405,9 +490,17 @@ func (p *pageImpl) Request() APIRequestContext {
 func (p *pageImpl) Screenshot(options ...PageScreenshotOptions) ([]byte, error) {
 	var path *string
 	overrides := map[string]interface{}{}
+	if len(options) == 0 {
+		options = []PageScreenshotOptions{{}}
+	}
 	if len(options) == 1 {
 		path = options[0].Path
 		options[0].Path = nil
+		if p.browserContext != nil {
+			if err := p.browserContext.refreshPrivacyMasks(p); err != nil {
+				return nil, err
+			}
+		}
 		if options[0].Mask != nil {
 			masks := make([]map[string]interface{}, 0)
 			for _, m := range options[0].Mask {
@@ -425,7 +518,7 @@ func (p *pageImpl) Screenshot(options ...PageScreenshotOptions) ([]byte, error)
 			overrides["mask"] = masks
 		}
 	}
-	data, err := p.channel.Send("screenshot", options, overrides)
+	data, err := p.channel.Send("screenshot", optionsOf(options), overrides)
 	if err != nil {
 		return nil, err
 	}
@@ -444,9 +537,12 @@ func (p *pageImpl) Screenshot(options ...PageScreenshotOptions) ([]byte, error)
 func (p *pageImpl) PDF(options ...PagePdfOptions) ([]byte, error) {
 	var path *string
 	if len(options) == 1 {
+		if options[0].Writer != nil {
+			return nil, p.streamPDF(options[0])
+		}
 		path = options[0].Path
 	}
-	data, err := p.channel.Send("pdf", options)
+	data, err := p.channel.Send("pdf", optionsOf(options))
 	if err != nil {
 		return nil, err
 	}
@@ -617,51 +713,58 @@ func (p *pageImpl) ExpectRequest(url interface{}, cb func() error, options ...Pa
 }
 
 func (p *pageImpl) ExpectRequestFinished(cb func() error, options ...PageExpectRequestFinishedOptions) (Request, error) {
-	option := PageWaitForEventOptions{}
+	option := WaitForEventOptions[Request]{}
 	if len(options) == 1 {
 		option.Timeout = options[0].Timeout
 		option.Predicate = options[0].Predicate
 	}
-	ret, err := p.waiterForEvent("requestfinished", option).RunAndWait(cb)
-	if ret == nil {
-		return nil, err
-	}
-	return ret.(*requestImpl), err
+	return ExpectEvent(p, "requestfinished", cb, option)
 }
 
 func (p *pageImpl) ExpectWebSocket(cb func() error, options ...PageExpectWebSocketOptions) (WebSocket, error) {
-	option := PageWaitForEventOptions{}
+	option := WaitForEventOptions[WebSocket]{}
 	if len(options) == 1 {
 		option.Timeout = options[0].Timeout
 		option.Predicate = options[0].Predicate
 	}
-	ret, err := p.waiterForEvent("websocket", option).RunAndWait(cb)
-	if ret == nil {
-		return nil, err
-	}
-	return ret.(*webSocketImpl), err
+	return ExpectEvent(p, "websocket", cb, option)
 }
 
 func (p *pageImpl) ExpectWorker(cb func() error, options ...PageExpectWorkerOptions) (Worker, error) {
-	option := PageWaitForEventOptions{}
+	option := WaitForEventOptions[Worker]{}
 	if len(options) == 1 {
 		option.Timeout = options[0].Timeout
 		option.Predicate = options[0].Predicate
 	}
-	ret, err := p.waiterForEvent("worker", option).RunAndWait(cb)
-	if ret == nil {
-		return nil, err
-	}
-	return ret.(*workerImpl), err
+	return ExpectEvent(p, "worker", cb, option)
 }
 
 func (p *pageImpl) Route(url interface{}, handler routeHandler, times ...int) error {
 	p.Lock()
 	defer p.Unlock()
-	p.routes = slices.Insert(p.routes, 0, newRouteHandlerEntry(newURLMatcher(url, p.browserContext.options.BaseURL), handler, times...))
+	p.routes = insertRouteHandlerEntry(p.routes, newRouteHandlerEntry(newURLMatcher(url, p.browserContext.options.BaseURL), handler, times...))
+	return p.updateInterceptionPatterns()
+}
+
+func (p *pageImpl) RouteWithPriority(url interface{}, handler routeHandler, priority int, times ...int) error {
+	p.Lock()
+	defer p.Unlock()
+	entry := newRouteHandlerEntry(newURLMatcher(url, p.browserContext.options.BaseURL), handler, times...)
+	entry.priority = priority
+	p.routes = insertRouteHandlerEntry(p.routes, entry)
 	return p.updateInterceptionPatterns()
 }
 
+func (p *pageImpl) RouteWebSocket(url interface{}, handler func(WebSocketRoute)) error {
+	p.Lock()
+	p.webSocketRoutes = slices.Insert(p.webSocketRoutes, 0, &webSocketRouteHandler{
+		matcher: newURLMatcher(url, webSocketBaseURL(p.browserContext.options.BaseURL)),
+		handler: handler,
+	})
+	p.Unlock()
+	return p.browserContext.installWebSocketRouting()
+}
+
 func (p *pageImpl) GetAttribute(selector string, name string, options ...PageGetAttributeOptions) (string, error) {
 	if len(options) == 1 {
 		return p.mainFrame.GetAttribute(selector, name, FrameGetAttributeOptions(options[0]))
@@ -681,21 +784,26 @@ func (p *pageImpl) IsClosed() bool {
 }
 
 func (p *pageImpl) AddInitScript(script Script) error {
-	var source string
-	if script.Content != nil {
-		source = *script.Content
-	}
-	if script.Path != nil {
-		content, err := os.ReadFile(*script.Path)
-		if err != nil {
-			return err
-		}
-		source = string(content)
+	entry, err := newInitScript(script)
+	if err != nil {
+		return err
 	}
-	_, err := p.channel.Send("addInitScript", map[string]interface{}{
-		"source": source,
-	})
-	return err
+	if entry.world() != "" {
+		err = p.addIsolatedInitScript(entry, false)
+	} else {
+		_, err = p.channel.Send("addInitScript", map[string]interface{}{
+			"source": entry.guardedSource(),
+		})
+	}
+	if err != nil {
+		return err
+	}
+	p.initScripts.add(entry)
+	return nil
+}
+
+func (p *pageImpl) IsolatedWorld(name string) IsolatedWorld {
+	return p.mainFrame.IsolatedWorld(name)
 }
 
 func (p *pageImpl) Keyboard() Keyboard {
@@ -706,6 +814,10 @@ func (p *pageImpl) Mouse() Mouse {
 	return p.mouse
 }
 
+func (p *pageImpl) NewCDPSession() (CDPSession, error) {
+	return p.browserContext.NewCDPSession(p)
+}
+
 func (p *pageImpl) RouteFromHAR(har string, options ...PageRouteFromHAROptions) error {
 	opt := PageRouteFromHAROptions{}
 	if len(options) == 1 {
@@ -713,8 +825,11 @@ func (p *pageImpl) RouteFromHAR(har string, options ...PageRouteFromHAROptions)
 	}
 	if opt.Update != nil && *opt.Update {
 		return p.browserContext.recordIntoHar(har, browserContextRecordIntoHarOptions{
-			Page: p,
-			URL:  opt.URL,
+			Page:          p,
+			URL:           opt.URL,
+			UpdateContent: toHarContentPolicy(opt.UpdateContent),
+			UpdateMode:    opt.UpdateMode,
+			Merge:         opt.UpdateMerge != nil && *opt.UpdateMerge,
 		})
 	}
 	notFound := opt.NotFound
@@ -726,6 +841,29 @@ func (p *pageImpl) RouteFromHAR(har string, options ...PageRouteFromHAROptions)
 	return router.addPageRoute(p)
 }
 
+func (p *pageImpl) RecordHar(path string, options ...PageRecordHarOptions) error {
+	opt := PageRecordHarOptions{}
+	if len(options) == 1 {
+		opt = options[0]
+	}
+	content := opt.Content
+	if content == nil {
+		content = HarContentPolicyEmbed
+	}
+	mode := opt.Mode
+	if mode == nil {
+		mode = HarModeFull
+	}
+	return p.browserContext.recordIntoHar(path, browserContextRecordIntoHarOptions{
+		Page:          p,
+		URL:           opt.URLFilter,
+		MethodFilter:  opt.MethodFilter,
+		UpdateContent: content,
+		UpdateMode:    mode,
+		Merge:         opt.Merge != nil && *opt.Merge,
+	})
+}
+
 func (p *pageImpl) Touchscreen() Touchscreen {
 	return p.touchscreen
 }
@@ -740,11 +878,18 @@ func newPage(parent *channelOwner, objectType string, guid string, initializer m
 		workers:         make([]Worker, 0),
 		routes:          make([]*routeHandlerEntry, 0),
 		bindings:        make(map[string]BindingCallFunction),
+		bindingHandles:  make(map[string]bool),
 		viewportSize:    viewportSize,
 		harRouters:      make([]*harRouter, 0),
-		locatorHandlers: make(map[float64]func(), 0),
+		locatorHandlers: make(map[float64]*locatorHandler, 0),
+		isolatedScripts: make(map[string]string),
+		cdpFrameIDs:     make(map[*frameImpl]string),
+		initScripts:     &initScripts{},
+		webSocketClose:  newWebSocketCloseTracker(),
+		frameHeaders:    make(map[*frameImpl]map[string]string),
 	}
 	bt.createChannelOwner(bt, parent, objectType, guid, initializer)
+	bt.touch()
 	bt.browserContext = fromChannel(parent.channel).(*browserContextImpl)
 	bt.timeoutSettings = newTimeoutSettings(bt.browserContext.timeoutSettings)
 	mainframe := fromChannel(initializer["mainFrame"]).(*frameImpl)
@@ -752,7 +897,8 @@ func newPage(parent *channelOwner, objectType string, guid string, initializer m
 	bt.mainFrame = mainframe
 	bt.frames = []Frame{mainframe}
 	bt.mouse = newMouse(bt.channel)
-	bt.keyboard = newKeyboard(bt.channel)
+	bt.accessibility = newAccessibility(bt.channel)
+	bt.keyboard = newKeyboard(bt.channel, bt)
 	bt.touchscreen = newTouchscreen(bt.channel)
 	bt.channel.On("bindingCall", func(params map[string]interface{}) {
 		bt.onBinding(fromChannel(params["binding"]).(*bindingCallImpl))
@@ -798,7 +944,9 @@ func newPage(parent *channelOwner, objectType string, guid string, initializer m
 		bt.Video().(*videoImpl).artifactReady(artifact)
 	})
 	bt.channel.On("webSocket", func(ev map[string]interface{}) {
-		bt.Emit("websocket", fromChannel(ev["webSocket"]).(*webSocketImpl))
+		ws := fromChannel(ev["webSocket"]).(*webSocketImpl)
+		bt.trackWebSocket(ws)
+		bt.Emit("websocket", ws)
 	})
 
 	bt.channel.On("worker", func(ev map[string]interface{}) {
@@ -838,8 +986,11 @@ func (p *pageImpl) closeErrorWithReason() error {
 }
 
 func (p *pageImpl) onBinding(binding *bindingCallImpl) {
-	function := p.bindings[binding.initializer["name"].(string)]
+	name := binding.initializer["name"].(string)
+	function := p.bindings[name]
 	if function == nil {
+		// The function was unexposed, the call fails instead of waiting for a result.
+		go binding.reject(fmt.Errorf("Function '%s' is not exposed in the page", name))
 		return
 	}
 	go binding.Call(function)
@@ -853,6 +1004,9 @@ func (p *pageImpl) onFrameAttached(frame *frameImpl) {
 
 func (p *pageImpl) onFrameDetached(frame *frameImpl) {
 	frame.detached = true
+	p.removeFrameHeaders(frame)
+	p.webSocketClose.dropFrame(frame)
+	p.forgetCDPFrameID(frame)
 	frames := make([]Frame, 0)
 	for i := 0; i < len(p.frames); i++ {
 		if p.frames[i] != frame {
@@ -867,58 +1021,25 @@ func (p *pageImpl) onFrameDetached(frame *frameImpl) {
 
 func (p *pageImpl) onRoute(route *routeImpl) {
 	go func() {
+		p.applyFrameHeaders(route)
 		p.Lock()
 		route.context = p.browserContext
 		routes := make([]*routeHandlerEntry, len(p.routes))
 		copy(routes, p.routes)
 		p.Unlock()
-
-		checkInterceptionIfNeeded := func() {
-			p.Lock()
-			defer p.Unlock()
-			if len(p.routes) == 0 {
-				_, err := p.connection.WrapAPICall(func() (interface{}, error) {
-					err := p.updateInterceptionPatterns()
-					return nil, err
-				}, true)
-				if err != nil {
-					logger.Printf("could not update interception patterns: %v\n", err)
-				}
-			}
+		if p.browserContext.handleOriginAllowlist(route) || p.browserContext.handleDryRun(route) ||
+			p.browserContext.handleNetworkBudget(route) {
+			return
 		}
-
-		url := route.Request().URL()
-		for _, handlerEntry := range routes {
-			// If the page was closed we stall all requests right away.
-			if p.closeWasCalled || p.browserContext.closeWasCalled {
-				return
-			}
-			if !handlerEntry.Matches(url) {
-				continue
-			}
-			if !slices.ContainsFunc(p.routes, func(entry *routeHandlerEntry) bool {
-				return entry == handlerEntry
-			}) {
-				continue
-			}
-			if handlerEntry.WillExceed() {
-				p.routes = slices.DeleteFunc(p.routes, func(rhe *routeHandlerEntry) bool {
-					return rhe == handlerEntry
-				})
-			}
-			handled := handlerEntry.Handle(route)
-			checkInterceptionIfNeeded()
-
-			if <-handled {
-				return
-			}
-		}
-		p.browserContext.onRoute(route)
+		p.browserContext.dispatchRoute(route, p, routes)
 	}()
 }
 
 func (p *pageImpl) updateInterceptionPatterns() error {
 	patterns := prepareInterceptionPatterns(p.routes)
+	if p.hasFrameHeaders() {
+		patterns = []map[string]interface{}{{"glob": "**/*"}}
+	}
 	_, err := p.channel.Send("setNetworkInterceptionPatterns", map[string]interface{}{
 		"patterns": patterns,
 	})
@@ -1042,7 +1163,15 @@ func (p *pageImpl) ExposeBinding(name string, binding BindingCallFunction, handl
 	if _, ok := p.browserContext.bindings[name]; ok {
 		return fmt.Errorf("Function '%s' has been already registered in the browser context", name)
 	}
+	if registeredHandle, ok := p.bindingHandles[name]; ok {
+		if registeredHandle != needsHandle {
+			return fmt.Errorf("Function '%s' was exposed with a different handle option before", name)
+		}
+		p.bindings[name] = binding
+		return p.restoreBinding(name)
+	}
 	p.bindings[name] = binding
+	p.bindingHandles[name] = needsHandle
 	_, err := p.channel.Send("exposeBinding", map[string]interface{}{
 		"name":        name,
 		"needsHandle": needsHandle,
@@ -1194,13 +1323,10 @@ func (p *pageImpl) GetByTestId(testId interface{}) Locator {
 }
 
 func (p *pageImpl) GetByText(text interface{}, options ...PageGetByTextOptions) Locator {
-	exact := false
 	if len(options) == 1 {
-		if *options[0].Exact {
-			exact = true
-		}
+		return p.Locator(getByTextSelector(text, LocatorGetByTextOptions(options[0])))
 	}
-	return p.Locator(getByTextSelector(text, exact))
+	return p.Locator(getByTextSelector(text))
 }
 
 func (p *pageImpl) GetByTitle(text interface{}, options ...PageGetByTitleOptions) Locator {
@@ -1257,6 +1383,10 @@ func (p *pageImpl) OnFrameNavigated(fn func(Frame)) {
 	p.On("framenavigated", fn)
 }
 
+func (p *pageImpl) OnIdle(fn func(Page)) {
+	p.On("idle", fn)
+}
+
 func (p *pageImpl) OnLoad(fn func(Page)) {
 	p.On("load", fn)
 }
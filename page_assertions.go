@@ -10,12 +10,15 @@ type pageAssertionsImpl struct {
 	actualPage Page
 }
 
-func newPageAssertions(page Page, isNot bool, defaultTimeout *float64) *pageAssertionsImpl {
+func newPageAssertions(page Page, isNot bool, defaultTimeout, pollInterval *float64, soft *softErrors) *pageAssertionsImpl {
 	return &pageAssertionsImpl{
 		assertionsBase: assertionsBase{
 			actualLocator:  page.Locator(":root"),
 			isNot:          isNot,
 			defaultTimeout: defaultTimeout,
+			pollInterval:   pollInterval,
+			soft:           soft,
+			isPage:         true,
 		},
 		actualPage: page,
 	}
@@ -64,5 +67,7 @@ func (pa *pageAssertionsImpl) ToHaveURL(urlOrRegExp interface{}, options ...Page
 }
 
 func (pa *pageAssertionsImpl) Not() PageAssertions {
-	return newPageAssertions(pa.actualPage, true, pa.defaultTimeout)
+	not := newPageAssertions(pa.actualPage, true, pa.defaultTimeout, pa.pollInterval, pa.soft)
+	not.snapshotDir = pa.snapshotDir
+	return not
 }
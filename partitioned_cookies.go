@@ -0,0 +1,266 @@
+package playwright
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrPartitionedCookiesNotSupported is returned when partitioned cookies are added in a browser other than Chromium.
+var ErrPartitionedCookiesNotSupported = errors.New("partitioned cookies are only supported in Chromium")
+
+// cdpCookie is a cookie of the DevTools protocol. The partition key is the top-level site of the partition, or an
+// object with the site in newer Chromium versions.
+type cdpCookie struct {
+	Name         string          `json:"name"`
+	Value        string          `json:"value"`
+	Domain       string          `json:"domain"`
+	Path         string          `json:"path"`
+	PartitionKey json.RawMessage `json:"partitionKey"`
+}
+
+// topLevelSite returns the top-level site of the partition key of the cookie, or "" if it is not partitioned.
+func (c *cdpCookie) topLevelSite() string {
+	if len(c.PartitionKey) == 0 {
+		return ""
+	}
+	var site string
+	if err := json.Unmarshal(c.PartitionKey, &site); err == nil {
+		return site
+	}
+	var key struct {
+		TopLevelSite string `json:"topLevelSite"`
+	}
+	if err := json.Unmarshal(c.PartitionKey, &key); err == nil {
+		return key.TopLevelSite
+	}
+	return ""
+}
+
+func (b *browserContextImpl) isChromium() bool {
+	if isChromium, ok := b.initializer["isChromium"].(bool); ok {
+		return isChromium
+	}
+	return b.browser != nil && b.browser.browserType != nil && b.browser.browserType.Name() == "chromium"
+}
+
+// browserSession returns the DevTools session of the browser shared by its contexts, created on first use. It must be
+// called with browserSessionMu locked.
+func (b *browserImpl) browserSession() (CDPSession, error) {
+	if b.sharedSession == nil || b.sharedSession.IsDetached() {
+		session, err := b.NewBrowserCDPSession()
+		if err != nil {
+			return nil, err
+		}
+		b.sharedSession = session
+	}
+	return b.sharedSession, nil
+}
+
+// cdpBrowserContextID returns the id of the context in the DevTools protocol. It is read from the target of a page of
+// the context, or else it is the only browser context that isn't one of the other contexts of the browser. It must be
+// called with the browserSessionMu of the browser locked.
+func (b *browserContextImpl) cdpBrowserContextID(session CDPSession) (string, error) {
+	if b.cdpContextID != "" {
+		return b.cdpContextID, nil
+	}
+	if pages := b.Pages(); len(pages) > 0 {
+		pageSession, err := b.NewCDPSession(pages[0])
+		if err != nil {
+			return "", err
+		}
+		result, err := SendCDP[struct {
+			TargetInfo struct {
+				BrowserContextID string `json:"browserContextId"`
+			} `json:"targetInfo"`
+		}](pageSession, "Target.getTargetInfo", nil)
+		_ = pageSession.Detach()
+		if err != nil {
+			return "", err
+		}
+		b.cdpContextID = result.TargetInfo.BrowserContextID
+		return b.cdpContextID, nil
+	}
+	result, err := SendCDP[struct {
+		BrowserContextIDs []string `json:"browserContextIds"`
+	}](session, "Target.getBrowserContexts", nil)
+	if err != nil {
+		return "", err
+	}
+	known := make(map[string]bool)
+	for _, context := range b.browser.Contexts() {
+		if other, ok := context.(*browserContextImpl); ok && other != b {
+			known[other.cdpContextID] = true
+		}
+	}
+	var candidates []string
+	for _, id := range result.BrowserContextIDs {
+		if !known[id] {
+			candidates = append(candidates, id)
+		}
+	}
+	if len(candidates) != 1 {
+		return "", errors.New("could not find the context in the DevTools protocol, open a page first")
+	}
+	b.cdpContextID = candidates[0]
+	return b.cdpContextID, nil
+}
+
+// withCookieSession calls fn with a DevTools session and the id of the context the cookies are read and written for,
+// the browser session shared by the contexts. Persistent contexts are the default context of their browser, fn is
+// called with a session of their first page and no id.
+func (b *browserContextImpl) withCookieSession(fn func(session CDPSession, contextID string) error) error {
+	if !b.isChromium() {
+		return ErrPartitionedCookiesNotSupported
+	}
+	if b.browser == nil {
+		pages := b.Pages()
+		if len(pages) == 0 {
+			return errors.New("partitioned cookies of a persistent context require a page")
+		}
+		session, err := b.NewCDPSession(pages[0])
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrPartitionedCookiesNotSupported, err)
+		}
+		defer func() {
+			_ = session.Detach()
+		}()
+		return fn(session, "")
+	}
+	b.browser.browserSessionMu.Lock()
+	session, err := b.browser.browserSession()
+	if err != nil {
+		b.browser.browserSessionMu.Unlock()
+		return fmt.Errorf("%w: %v", ErrPartitionedCookiesNotSupported, err)
+	}
+	contextID, err := b.cdpBrowserContextID(session)
+	b.browser.browserSessionMu.Unlock()
+	if err != nil {
+		return err
+	}
+	return fn(session, contextID)
+}
+
+// storageParams returns the params of a method of the Storage domain for the context.
+func storageParams(contextID string, params map[string]interface{}) map[string]interface{} {
+	if contextID != "" {
+		params["browserContextId"] = contextID
+	}
+	return params
+}
+
+// addPartitionKeys sets the partition keys of the partitioned cookies returned by the driver, which doesn't report
+// them. The cookies are matched by name, domain, path and value with those of the DevTools protocol. The keys are
+// best-effort: the cookies are left without keys if they can't be looked up.
+func (b *browserContextImpl) addPartitionKeys(cookies []interface{}) {
+	if len(cookies) == 0 || !b.isChromium() {
+		return
+	}
+	err := b.withCookieSession(func(session CDPSession, contextID string) error {
+		result, err := SendCDP[struct {
+			Cookies []cdpCookie `json:"cookies"`
+		}](session, "Storage.getCookies", storageParams(contextID, map[string]interface{}{}))
+		if err != nil {
+			return err
+		}
+		partitioned := make([]cdpCookie, 0)
+		for _, cookie := range result.Cookies {
+			if cookie.topLevelSite() != "" {
+				partitioned = append(partitioned, cookie)
+			}
+		}
+		for _, item := range cookies {
+			cookie, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for i, c := range partitioned {
+				if c.Name == cookie["name"] && c.Value == cookie["value"] && c.Domain == cookie["domain"] && c.Path == cookie["path"] {
+					cookie["partitionKey"] = c.topLevelSite()
+					partitioned = append(partitioned[:i], partitioned[i+1:]...)
+					break
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		b.logf("could not look up the partition keys of the cookies: %v\n", err)
+	}
+}
+
+// splitPartitionedCookies returns the cookies without and with a partition key.
+func splitPartitionedCookies(cookies []OptionalCookie) ([]OptionalCookie, []OptionalCookie) {
+	var unpartitioned, partitioned []OptionalCookie
+	for _, cookie := range cookies {
+		if cookie.PartitionKey != nil {
+			partitioned = append(partitioned, cookie)
+		} else {
+			unpartitioned = append(unpartitioned, cookie)
+		}
+	}
+	return unpartitioned, partitioned
+}
+
+// cdpCookieParam returns the `Network.CookieParam` of the DevTools protocol setting cookie, with the partition key as
+// an object, or as the top-level site for older Chromium versions.
+func cdpCookieParam(cookie OptionalCookie, keyObject bool) map[string]interface{} {
+	param := map[string]interface{}{
+		"name":  cookie.Name,
+		"value": cookie.Value,
+	}
+	if cookie.URL != nil {
+		param["url"] = *cookie.URL
+	}
+	if cookie.Domain != nil {
+		param["domain"] = *cookie.Domain
+	}
+	if cookie.Path != nil {
+		param["path"] = *cookie.Path
+	}
+	if cookie.Expires != nil && *cookie.Expires > 0 {
+		param["expires"] = *cookie.Expires
+	}
+	if cookie.HttpOnly != nil {
+		param["httpOnly"] = *cookie.HttpOnly
+	}
+	if cookie.Secure != nil {
+		param["secure"] = *cookie.Secure
+	}
+	if cookie.SameSite != nil {
+		param["sameSite"] = string(*cookie.SameSite)
+	}
+	if keyObject {
+		param["partitionKey"] = map[string]interface{}{"topLevelSite": *cookie.PartitionKey, "hasCrossSiteAncestor": false}
+	} else {
+		param["partitionKey"] = *cookie.PartitionKey
+	}
+	return param
+}
+
+// addPartitionedCookies adds the cookies with a partition key with the DevTools protocol, as the driver drops the
+// partition keys.
+func (b *browserContextImpl) addPartitionedCookies(cookies []OptionalCookie) error {
+	for _, cookie := range cookies {
+		if cookie.Secure == nil || !*cookie.Secure {
+			return fmt.Errorf("partitioned cookie %s must be secure", cookie.Name)
+		}
+	}
+	return b.withCookieSession(func(session CDPSession, contextID string) error {
+		send := func(keyObject bool) error {
+			params := make([]interface{}, 0, len(cookies))
+			for _, cookie := range cookies {
+				params = append(params, cdpCookieParam(cookie, keyObject))
+			}
+			_, err := session.Send("Storage.setCookies", storageParams(contextID, map[string]interface{}{"cookies": params}))
+			return err
+		}
+		if err := send(true); err != nil {
+			// Chromium versions before 125 take the top-level site as the partition key.
+			if err := send(false); err != nil {
+				return fmt.Errorf("could not add partitioned cookies: %w", err)
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,56 @@
+package playwright
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCDPCookieTopLevelSite(t *testing.T) {
+	for raw, site := range map[string]string{
+		``:                "",
+		`"https://a.com"`: "https://a.com",
+		`{"topLevelSite": "https://b.com", "hasCrossSiteAncestor": false}`: "https://b.com",
+	} {
+		cookie := cdpCookie{PartitionKey: json.RawMessage(raw)}
+		require.Equal(t, site, cookie.topLevelSite(), raw)
+	}
+}
+
+func TestSplitPartitionedCookies(t *testing.T) {
+	cookies := []OptionalCookie{
+		{Name: "a"},
+		{Name: "b", PartitionKey: String("https://top-level.com")},
+		{Name: "c"},
+	}
+	unpartitioned, partitioned := splitPartitionedCookies(cookies)
+	require.Equal(t, []OptionalCookie{cookies[0], cookies[2]}, unpartitioned)
+	require.Equal(t, []OptionalCookie{cookies[1]}, partitioned)
+}
+
+func TestCDPCookieParam(t *testing.T) {
+	cookie := OptionalCookie{
+		Name:         "a",
+		Value:        "1",
+		URL:          String("https://example.com/"),
+		Expires:      Float(-1),
+		Secure:       Bool(true),
+		SameSite:     SameSiteAttributeNone,
+		PartitionKey: String("https://top-level.com"),
+	}
+	require.Equal(t, map[string]interface{}{
+		"name":         "a",
+		"value":        "1",
+		"url":          "https://example.com/",
+		"secure":       true,
+		"sameSite":     "None",
+		"partitionKey": map[string]interface{}{"topLevelSite": "https://top-level.com", "hasCrossSiteAncestor": false},
+	}, cdpCookieParam(cookie, true))
+	require.Equal(t, "https://top-level.com", cdpCookieParam(cookie, false)["partitionKey"])
+}
+
+func TestCookieToOptionalCookieKeepsPartitionKey(t *testing.T) {
+	cookie := Cookie{Name: "a", PartitionKey: String("https://top-level.com")}
+	require.Equal(t, cookie.PartitionKey, cookie.ToOptionalCookie().PartitionKey)
+}
@@ -0,0 +1,188 @@
+package playwright
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// ErrPDFStreamingNotSupported is returned when a PDF is streamed to a writer in a browser other than Chromium.
+var ErrPDFStreamingNotSupported = errors.New("streaming PDFs is only supported in Chromium")
+
+// pdfPaperFormats are the sizes of the paper formats in inches, by lower case name.
+var pdfPaperFormats = map[string][2]float64{
+	"letter":  {8.5, 11},
+	"legal":   {8.5, 14},
+	"tabloid": {11, 17},
+	"ledger":  {17, 11},
+	"a0":      {33.1, 46.8},
+	"a1":      {23.4, 33.1},
+	"a2":      {16.54, 23.4},
+	"a3":      {11.7, 16.54},
+	"a4":      {8.27, 11.7},
+	"a5":      {5.83, 8.27},
+	"a6":      {4.13, 5.83},
+}
+
+var pdfUnitToPixels = map[string]float64{
+	"px": 1,
+	"in": 96,
+	"cm": 37.8,
+	"mm": 3.78,
+}
+
+// pdfLengthToInches converts a length of the PDF options, such as `10cm`, to inches. Lengths without a unit are in
+// pixels. It returns fallback if length is nil.
+func pdfLengthToInches(length *string, fallback float64) (float64, error) {
+	if length == nil {
+		return fallback, nil
+	}
+	text := *length
+	unit := "px"
+	if len(text) > 2 {
+		if _, ok := pdfUnitToPixels[strings.ToLower(text[len(text)-2:])]; ok {
+			unit = strings.ToLower(text[len(text)-2:])
+			text = text[:len(text)-2]
+		}
+	}
+	value, err := strconv.ParseFloat(strings.TrimSpace(text), 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse parameter value: %s", *length)
+	}
+	return value * pdfUnitToPixels[unit] / 96, nil
+}
+
+// pdfPaperSize returns the width and height of the paper in inches, from its format or its lengths. It defaults to
+// the letter format.
+func pdfPaperSize(format, width, height *string) (float64, float64, error) {
+	if format != nil {
+		size, ok := pdfPaperFormats[strings.ToLower(*format)]
+		if !ok {
+			return 0, 0, fmt.Errorf("unknown paper format: %s", *format)
+		}
+		return size[0], size[1], nil
+	}
+	w, err := pdfLengthToInches(width, 8.5)
+	if err != nil {
+		return 0, 0, err
+	}
+	h, err := pdfLengthToInches(height, 11)
+	if err != nil {
+		return 0, 0, err
+	}
+	return w, h, nil
+}
+
+// pdfParams returns the parameters of Page.printToPDF for the options, with the same defaults as [Page.PDF].
+func pdfParams(option PagePdfOptions) (map[string]interface{}, error) {
+	params := map[string]interface{}{
+		"transferMode":            "ReturnAsStream",
+		"scale":                   1.0,
+		"displayHeaderFooter":     option.DisplayHeaderFooter != nil && *option.DisplayHeaderFooter,
+		"headerTemplate":          "",
+		"footerTemplate":          "",
+		"printBackground":         option.PrintBackground != nil && *option.PrintBackground,
+		"landscape":               option.Landscape != nil && *option.Landscape,
+		"pageRanges":              "",
+		"preferCSSPageSize":       option.PreferCSSPageSize != nil && *option.PreferCSSPageSize,
+		"generateTaggedPDF":       option.Tagged != nil && *option.Tagged,
+		"generateDocumentOutline": option.Outline != nil && *option.Outline,
+	}
+	if option.Scale != nil {
+		params["scale"] = *option.Scale
+	}
+	if option.HeaderTemplate != nil {
+		params["headerTemplate"] = *option.HeaderTemplate
+	}
+	if option.FooterTemplate != nil {
+		params["footerTemplate"] = *option.FooterTemplate
+	}
+	if option.PageRanges != nil {
+		params["pageRanges"] = *option.PageRanges
+	}
+	width, height, err := pdfPaperSize(option.Format, option.Width, option.Height)
+	if err != nil {
+		return nil, err
+	}
+	params["paperWidth"] = width
+	params["paperHeight"] = height
+	margin := Margin{}
+	if option.Margin != nil {
+		margin = *option.Margin
+	}
+	for name, length := range map[string]*string{
+		"marginTop":    margin.Top,
+		"marginRight":  margin.Right,
+		"marginBottom": margin.Bottom,
+		"marginLeft":   margin.Left,
+	} {
+		inches, err := pdfLengthToInches(length, 0)
+		if err != nil {
+			return nil, err
+		}
+		params[name] = inches
+	}
+	return params, nil
+}
+
+// streamPDF prints the page to the writer of option, reading the PDF from the browser in chunks.
+func (p *pageImpl) streamPDF(option PagePdfOptions) error {
+	params, err := pdfParams(option)
+	if err != nil {
+		return err
+	}
+	session, err := p.cdpSession()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrPDFStreamingNotSupported, err)
+	}
+	result, err := SendCDP[struct {
+		Stream string `json:"stream"`
+	}](session, "Page.printToPDF", params)
+	if err != nil {
+		return err
+	}
+	w := option.Writer
+	if option.Path != nil {
+		file, err := os.Create(*option.Path)
+		if err != nil {
+			_, _ = session.Send("IO.close", map[string]interface{}{"handle": result.Stream})
+			return err
+		}
+		defer file.Close()
+		w = io.MultiWriter(w, file)
+	}
+	return readCDPStream(session, result.Stream, w)
+}
+
+// readCDPStream copies the protocol stream of handle to w and closes it.
+func readCDPStream(session CDPSession, handle string, w io.Writer) error {
+	defer func() {
+		_, _ = session.Send("IO.close", map[string]interface{}{"handle": handle})
+	}()
+	for {
+		chunk, err := SendCDP[struct {
+			Data          string `json:"data"`
+			Base64Encoded bool   `json:"base64Encoded"`
+			EOF           bool   `json:"eof"`
+		}](session, "IO.read", map[string]interface{}{"handle": handle})
+		if err != nil {
+			return err
+		}
+		data := []byte(chunk.Data)
+		if chunk.Base64Encoded {
+			if data, err = base64.StdEncoding.DecodeString(chunk.Data); err != nil {
+				return fmt.Errorf("could not decode base64: %w", err)
+			}
+		}
+		if _, err := w.Write(data); err != nil {
+			return err
+		}
+		if chunk.EOF {
+			return nil
+		}
+	}
+}
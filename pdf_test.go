@@ -0,0 +1,66 @@
+package playwright
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPDFLengthToInches(t *testing.T) {
+	for length, inches := range map[string]float64{
+		"96":     1,
+		"48px":   0.5,
+		"2in":    2,
+		"2.54cm": 37.8 * 2.54 / 96,
+		"10MM":   3.78 * 10 / 96,
+	} {
+		value, err := pdfLengthToInches(String(length), 0)
+		require.NoError(t, err)
+		require.InDelta(t, inches, value, 1e-9, length)
+	}
+	value, err := pdfLengthToInches(nil, 8.5)
+	require.NoError(t, err)
+	require.Equal(t, 8.5, value)
+	_, err = pdfLengthToInches(String("1pt"), 0)
+	require.EqualError(t, err, "failed to parse parameter value: 1pt")
+}
+
+func TestPDFParams(t *testing.T) {
+	params, err := pdfParams(PagePdfOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 8.5, params["paperWidth"])
+	require.Equal(t, 11.0, params["paperHeight"])
+	require.Equal(t, 0.0, params["marginTop"])
+	require.Equal(t, 1.0, params["scale"])
+	require.Equal(t, false, params["generateTaggedPDF"])
+
+	params, err = pdfParams(PagePdfOptions{
+		Format:         String("A4"),
+		Width:          String("1in"),
+		Margin:         &Margin{Top: String("1in"), Left: String("96px")},
+		Scale:          Float(0.5),
+		PageRanges:     String("1-2"),
+		HeaderTemplate: String("<span class=title></span>"),
+		Tagged:         Bool(true),
+		Outline:        Bool(true),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 8.27, params["paperWidth"])
+	require.Equal(t, 11.7, params["paperHeight"])
+	require.Equal(t, 1.0, params["marginTop"])
+	require.Equal(t, 1.0, params["marginLeft"])
+	require.Equal(t, 0.0, params["marginBottom"])
+	require.Equal(t, 0.5, params["scale"])
+	require.Equal(t, "1-2", params["pageRanges"])
+	require.Equal(t, "<span class=title></span>", params["headerTemplate"])
+	require.Equal(t, true, params["generateTaggedPDF"])
+	require.Equal(t, true, params["generateDocumentOutline"])
+
+	params, err = pdfParams(PagePdfOptions{Width: String("10cm"), Height: String("200mm")})
+	require.NoError(t, err)
+	require.InDelta(t, 378.0/96, params["paperWidth"], 1e-9)
+	require.InDelta(t, 756.0/96, params["paperHeight"], 1e-9)
+
+	_, err = pdfParams(PagePdfOptions{Format: String("B5")})
+	require.EqualError(t, err, "unknown paper format: B5")
+}
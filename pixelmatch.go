@@ -0,0 +1,190 @@
+package playwright
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+)
+
+// pixelmatchOptions configures [pixelmatch].
+type pixelmatchOptions struct {
+	// threshold is the matching threshold between 0 and 1, smaller values make the
+	// comparison more sensitive.
+	threshold float64
+	// includeAA counts anti-aliased pixels as different.
+	includeAA bool
+}
+
+var (
+	diffColor = color.NRGBA{R: 255, A: 255}
+	aaColor   = color.NRGBA{R: 255, G: 255, A: 255}
+)
+
+// toNRGBA converts img to an [image.NRGBA] starting at the origin.
+func toNRGBA(img image.Image) *image.NRGBA {
+	if nrgba, ok := img.(*image.NRGBA); ok && nrgba.Rect.Min == (image.Point{}) && nrgba.Stride == 4*nrgba.Rect.Dx() {
+		return nrgba
+	}
+	bounds := img.Bounds()
+	nrgba := image.NewNRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(nrgba, nrgba.Rect, img, bounds.Min, draw.Src)
+	return nrgba
+}
+
+// pixelmatch compares two images of the same size in the YIQ color space, ignoring
+// anti-aliasing unless includeAA is set, and returns the number of different pixels. If
+// output is not nil, it is filled with a diff image: different pixels are red, anti-aliased
+// ones yellow and the others a faded grayscale version of img1.
+func pixelmatch(img1, img2, output *image.NRGBA, options pixelmatchOptions) int {
+	width, height := img1.Rect.Dx(), img1.Rect.Dy()
+	maxDelta := 35215 * options.threshold * options.threshold
+	diff := 0
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			pos := (y*width + x) * 4
+			delta := colorDelta(img1.Pix, img2.Pix, pos, pos, false)
+			if math.Abs(delta) > maxDelta {
+				if !options.includeAA && (antialiased(img1, x, y, img2) || antialiased(img2, x, y, img1)) {
+					if output != nil {
+						output.SetNRGBA(x, y, aaColor)
+					}
+					continue
+				}
+				if output != nil {
+					output.SetNRGBA(x, y, diffColor)
+				}
+				diff++
+			} else if output != nil {
+				pix := img1.Pix[pos : pos+4]
+				gray := blend(rgb2y(float64(pix[0]), float64(pix[1]), float64(pix[2])), 0.1*float64(pix[3])/255)
+				value := uint8(math.Round(gray))
+				output.SetNRGBA(x, y, color.NRGBA{R: value, G: value, B: value, A: 255})
+			}
+		}
+	}
+	return diff
+}
+
+// antialiased reports whether the pixel at x1, y1 is likely part of an anti-aliased edge,
+// that is, it is between a darker and a brighter neighbour which both sit in an area of
+// the same color in both images.
+func antialiased(img *image.NRGBA, x1, y1 int, img2 *image.NRGBA) bool {
+	width, height := img.Rect.Dx(), img.Rect.Dy()
+	x0, y0, x2, y2 := neighbourhood(x1, y1, width, height)
+	pos := (y1*width + x1) * 4
+	zeroes := 0
+	if x1 == x0 || x1 == x2 || y1 == y0 || y1 == y2 {
+		zeroes = 1
+	}
+	var minDelta, maxDelta float64
+	var minX, minY, maxX, maxY int
+	for x := x0; x <= x2; x++ {
+		for y := y0; y <= y2; y++ {
+			if x == x1 && y == y1 {
+				continue
+			}
+			delta := colorDelta(img.Pix, img.Pix, pos, (y*width+x)*4, true)
+			if delta == 0 {
+				zeroes++
+				if zeroes > 2 {
+					return false
+				}
+			} else if delta < minDelta {
+				minDelta, minX, minY = delta, x, y
+			} else if delta > maxDelta {
+				maxDelta, maxX, maxY = delta, x, y
+			}
+		}
+	}
+	if minDelta == 0 || maxDelta == 0 {
+		return false
+	}
+	return (hasManySiblings(img, minX, minY) && hasManySiblings(img2, minX, minY)) ||
+		(hasManySiblings(img, maxX, maxY) && hasManySiblings(img2, maxX, maxY))
+}
+
+// hasManySiblings reports whether at least three neighbours of the pixel have its color.
+func hasManySiblings(img *image.NRGBA, x1, y1 int) bool {
+	width, height := img.Rect.Dx(), img.Rect.Dy()
+	x0, y0, x2, y2 := neighbourhood(x1, y1, width, height)
+	pos := (y1*width + x1) * 4
+	zeroes := 0
+	if x1 == x0 || x1 == x2 || y1 == y0 || y1 == y2 {
+		zeroes = 1
+	}
+	for x := x0; x <= x2; x++ {
+		for y := y0; y <= y2; y++ {
+			if x == x1 && y == y1 {
+				continue
+			}
+			pos2 := (y*width + x) * 4
+			if img.Pix[pos] == img.Pix[pos2] && img.Pix[pos+1] == img.Pix[pos2+1] &&
+				img.Pix[pos+2] == img.Pix[pos2+2] && img.Pix[pos+3] == img.Pix[pos2+3] {
+				zeroes++
+			}
+			if zeroes > 2 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// colorDelta returns the squared YIQ distance between two pixels, negative if the first
+// one is brighter. Translucent pixels are blended with white first. If yOnly is set, only
+// the brightness difference is returned.
+func colorDelta(pix1, pix2 []uint8, k, m int, yOnly bool) float64 {
+	r1, g1, b1, a1 := float64(pix1[k]), float64(pix1[k+1]), float64(pix1[k+2]), float64(pix1[k+3])
+	r2, g2, b2, a2 := float64(pix2[m]), float64(pix2[m+1]), float64(pix2[m+2]), float64(pix2[m+3])
+	if a1 == a2 && r1 == r2 && g1 == g2 && b1 == b2 {
+		return 0
+	}
+	if a1 < 255 {
+		a1 /= 255
+		r1, g1, b1 = blend(r1, a1), blend(g1, a1), blend(b1, a1)
+	}
+	if a2 < 255 {
+		a2 /= 255
+		r2, g2, b2 = blend(r2, a2), blend(g2, a2), blend(b2, a2)
+	}
+	y1, y2 := rgb2y(r1, g1, b1), rgb2y(r2, g2, b2)
+	y := y1 - y2
+	if yOnly {
+		return y
+	}
+	i := rgb2i(r1, g1, b1) - rgb2i(r2, g2, b2)
+	q := rgb2q(r1, g1, b1) - rgb2q(r2, g2, b2)
+	delta := 0.5053*y*y + 0.299*i*i + 0.1957*q*q
+	if y1 > y2 {
+		return -delta
+	}
+	return delta
+}
+
+// neighbourhood returns the bounds of the 3x3 area around x, y clipped to the image.
+func neighbourhood(x, y, width, height int) (x0, y0, x2, y2 int) {
+	x0, y0, x2, y2 = x-1, y-1, x+1, y+1
+	if x0 < 0 {
+		x0 = 0
+	}
+	if y0 < 0 {
+		y0 = 0
+	}
+	if x2 > width-1 {
+		x2 = width - 1
+	}
+	if y2 > height-1 {
+		y2 = height - 1
+	}
+	return x0, y0, x2, y2
+}
+
+func rgb2y(r, g, b float64) float64 { return r*0.29889531 + g*0.58662247 + b*0.11448223 }
+func rgb2i(r, g, b float64) float64 { return r*0.59597799 - g*0.27417610 - b*0.32180189 }
+func rgb2q(r, g, b float64) float64 { return r*0.21147017 - g*0.52261711 + b*0.31114694 }
+
+// blend blends the color c with white using alpha a.
+func blend(c, a float64) float64 {
+	return 255 + (c-255)*a
+}
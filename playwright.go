@@ -23,6 +23,8 @@ type Playwright struct {
 	WebKit    BrowserType
 	Request   APIRequest
 	Devices   map[string]*DeviceDescriptor
+	// driver runs the Playwright instance, nil for instances connected to with [BrowserType.Connect].
+	driver *PlaywrightDriver
 }
 
 // Stop stops the Playwright instance
@@ -0,0 +1,122 @@
+package playwright
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrPrerenderNotSupported is returned when prerendering is inspected in a browser other than Chromium.
+var ErrPrerenderNotSupported = errors.New("prerendering inspection is only supported in Chromium")
+
+func getPrerenderStatus(in string) *PrerenderStatus {
+	v := PrerenderStatus(in)
+	return &v
+}
+
+// PrerenderStatus is the status of a [PrerenderAttempt].
+type PrerenderStatus string
+
+var (
+	// The prerendering is triggered but not started yet.
+	PrerenderStatusPending *PrerenderStatus = getPrerenderStatus("Pending")
+	// The page is being prerendered.
+	PrerenderStatusRunning = getPrerenderStatus("Running")
+	// The page is prerendered and ready to be activated.
+	PrerenderStatusReady = getPrerenderStatus("Ready")
+	// The prerendered page was activated by a navigation.
+	PrerenderStatusSuccess = getPrerenderStatus("Success")
+	// The prerendering failed or was discarded, see [PrerenderAttempt.FinalStatus].
+	PrerenderStatusFailure = getPrerenderStatus("Failure")
+	// The browser does not support prerendering the page.
+	PrerenderStatusNotSupported = getPrerenderStatus("NotSupported")
+)
+
+// PrerenderAttempt is the status of a page prerendered by a page, see [Page.OnPrerender].
+type PrerenderAttempt struct {
+	// URL of the prerendered page.
+	URL string
+	// Status of the prerendering.
+	Status PrerenderStatus
+	// Reason the prerendering ended, such as `Activated`, `TriggerDestroyed` or `MainFrameNavigation`, if it ended.
+	FinalStatus string
+	// Mojo interface of the prerendered page which the browser disallows, if that discarded it.
+	DisallowedMojoInterface string
+}
+
+// prerenderStatusUpdated is a `Preload.prerenderStatusUpdated` event of the DevTools protocol.
+type prerenderStatusUpdated struct {
+	Key struct {
+		URL string `json:"url"`
+	} `json:"key"`
+	Status                  PrerenderStatus `json:"status"`
+	PrerenderStatus         string          `json:"prerenderStatus"`
+	DisallowedMojoInterface string          `json:"disallowedMojoInterface"`
+}
+
+func (p *pageImpl) OnPrerender(fn func(PrerenderAttempt)) {
+	p.On("prerender", fn)
+}
+
+// CapturePrerenders emits the prerendering status updates of the page, reported by the Preload domain of the
+// DevTools protocol once it is enabled.
+func (p *pageImpl) CapturePrerenders() error {
+	p.prerenderMu.Lock()
+	defer p.prerenderMu.Unlock()
+	if p.prerenderTracked {
+		return nil
+	}
+	session, err := p.cdpSession()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrPrerenderNotSupported, err)
+	}
+	remove := OnCDPEvent(session, "Preload.prerenderStatusUpdated", func(event prerenderStatusUpdated) {
+		p.Emit("prerender", PrerenderAttempt{
+			URL:                     event.Key.URL,
+			Status:                  event.Status,
+			FinalStatus:             event.PrerenderStatus,
+			DisallowedMojoInterface: event.DisallowedMojoInterface,
+		})
+	})
+	if _, err := session.Send("Preload.enable", map[string]interface{}{}); err != nil {
+		remove()
+		return fmt.Errorf("%w: %v", ErrPrerenderNotSupported, err)
+	}
+	p.prerenderTracked = true
+	return nil
+}
+
+func (p *pageImpl) ExpectPrerenderActivation(cb func() error, options ...PageExpectPrerenderActivationOptions) (*PrerenderAttempt, error) {
+	option := PageExpectPrerenderActivationOptions{}
+	if len(options) == 1 {
+		option = options[0]
+	}
+	if err := p.CapturePrerenders(); err != nil {
+		return nil, err
+	}
+	var matcher *urlMatcher
+	if option.URL != nil {
+		matcher = newURLMatcher(option.URL, p.browserContext.options.BaseURL)
+	}
+	ret, err := p.waiterForEvent("prerender", PageWaitForEventOptions{
+		Timeout: option.Timeout,
+		Predicate: func(attempt PrerenderAttempt) bool {
+			return attempt.Status == *PrerenderStatusSuccess && (matcher == nil || matcher.Matches(attempt.URL))
+		},
+	}).RunAndWait(cb)
+	if err != nil {
+		return nil, err
+	}
+	attempt := ret.(PrerenderAttempt)
+	return &attempt, nil
+}
+
+func (p *pageImpl) WasPrerendered() (bool, error) {
+	ret, err := p.mainFrame.Evaluate(`() => {
+		const [navigation] = performance.getEntriesByType('navigation');
+		return !!navigation && navigation.activationStart > 0;
+	}`)
+	if err != nil {
+		return false, err
+	}
+	return ret.(bool), nil
+}
@@ -0,0 +1,310 @@
+package playwright
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/playwright-community/playwright-go/internal/multierror"
+)
+
+// printLayoutScript is a heuristic that paginates the document in pages of pageHeight pixels, applying the forced
+// breaks and the breaks avoided inside elements of the print style sheets, and returns the paginated positions of the
+// elements matching the selectors. Content is moved down to the next page as by the browser, except that lines of
+// text and replaced elements may be split across pages. Forced breaks before the first content, or after the last
+// one, are ignored.
+const printLayoutScript = `({ selectors, pageHeight }) => {
+  const forced = value => ['page', 'always', 'left', 'right', 'recto', 'verso'].includes(value);
+  const avoided = value => ['avoid', 'avoid-page'].includes(value);
+  const tracked = new Map();
+  selectors.forEach((selector, selectorIndex) => {
+    document.querySelectorAll(selector).forEach((element, index) => {
+      if (!tracked.has(element))
+        tracked.set(element, []);
+      tracked.get(element).push({ selectorIndex, index });
+    });
+  });
+  const elements = [];
+  let shift = 0;
+  const breakAt = y => {
+    const offset = (y + shift) % pageHeight;
+    if (offset > 0.5 && pageHeight - offset > 0.5)
+      shift += pageHeight - offset;
+  };
+  const open = [];
+  const pendingBreaks = [];
+  const close = element => {
+    const { node, top, bottom } = element;
+    for (const { selectorIndex, index } of tracked.get(node))
+      elements.push({ selectorIndex, index, top, bottom: bottom + shift });
+  };
+  let started = false;
+  const visited = [];
+  const contentBefore = node => {
+    if (!started)
+      started = visited.some(element => !element.contains(node));
+    return started;
+  };
+  const visit = node => {
+    while (open.length && !open[open.length - 1].node.contains(node))
+      close(open.pop());
+    while (pendingBreaks.length && !pendingBreaks[pendingBreaks.length - 1].node.contains(node))
+      breakAt(pendingBreaks.pop().bottom);
+  };
+  for (const node of document.body ? document.body.querySelectorAll('*') : []) {
+    const style = getComputedStyle(node);
+    if (style.display === 'none' || style.display === 'contents' || style.position === 'fixed')
+      continue;
+    visit(node);
+    const rect = node.getBoundingClientRect();
+    const top = rect.top + window.scrollY;
+    const bottom = rect.bottom + window.scrollY;
+    if ((forced(style.breakBefore) || forced(style.pageBreakBefore)) && contentBefore(node))
+      breakAt(top);
+    if ((avoided(style.breakInside) || avoided(style.pageBreakInside)) && bottom - top <= pageHeight) {
+      const start = Math.floor((top + shift) / pageHeight);
+      const end = Math.floor((bottom + shift - 0.5) / pageHeight);
+      if (end > start)
+        breakAt(top);
+    }
+    if (tracked.has(node))
+      open.push({ node, top: top + shift, bottom });
+    if (forced(style.breakAfter) || forced(style.pageBreakAfter))
+      pendingBreaks.push({ node, bottom });
+    if (!started && bottom > top)
+      visited.push(node);
+  }
+  while (open.length)
+    close(open.pop());
+  const height = Math.max(document.documentElement.scrollHeight, document.body ? document.body.scrollHeight : 0);
+  return {
+    pageCount: Math.max(1, Math.floor((height + shift - 0.5) / pageHeight) + 1),
+    elements,
+  };
+}`
+
+// PrintLayout is the layout of a page printed on paper, see [Page.PrintLayout].
+type PrintLayout struct {
+	// Number of pages of the printed document.
+	PageCount int
+	// Size of the content area of the pages, the paper without the margins, in CSS pixels.
+	PageSize Size
+	// Elements matching the selectors of [PagePrintLayoutOptions], by selector then in document order.
+	Elements []PrintLayoutElement
+}
+
+// PrintLayoutElement is an element of a [PrintLayout].
+type PrintLayoutElement struct {
+	// Selector matching the element.
+	Selector string
+	// Index of the element among the elements matching the selector.
+	Index int
+	// Pages where the element starts and ends, starting at 1.
+	StartPage int
+	EndPage   int
+	// Offset of the top of the element from the top of its first page, in CSS pixels.
+	Offset float64
+	// Height of the element once paginated, in CSS pixels, including the space left by breaks inside it.
+	Height float64
+}
+
+// IsSplit returns whether the element is split across pages.
+func (e PrintLayoutElement) IsSplit() bool {
+	return e.StartPage != e.EndPage
+}
+
+// StartsPage returns whether the element starts at the top of a page.
+func (e PrintLayoutElement) StartsPage() bool {
+	return e.Offset < 1
+}
+
+// ElementsOf returns the elements matching selector, which must be one of the selectors of [PagePrintLayoutOptions].
+func (l *PrintLayout) ElementsOf(selector string) []PrintLayoutElement {
+	elements := make([]PrintLayoutElement, 0)
+	for _, element := range l.Elements {
+		if element.Selector == selector {
+			elements = append(elements, element)
+		}
+	}
+	return elements
+}
+
+// ExpectStartsPage returns an error unless every element matching selector starts a page, as with
+// `break-before: page`.
+func (l *PrintLayout) ExpectStartsPage(selector string) error {
+	elements, err := l.expectElements(selector)
+	if err != nil {
+		return err
+	}
+	for _, element := range elements {
+		if !element.StartsPage() {
+			return fmt.Errorf("expected element %d of %q to start a page, but it starts %vpx down page %d",
+				element.Index, selector, math.Round(element.Offset), element.StartPage)
+		}
+	}
+	return nil
+}
+
+// ExpectNotSplit returns an error if an element matching selector is split across pages, as prevented by
+// `break-inside: avoid`.
+func (l *PrintLayout) ExpectNotSplit(selector string) error {
+	elements, err := l.expectElements(selector)
+	if err != nil {
+		return err
+	}
+	for _, element := range elements {
+		if element.IsSplit() {
+			return fmt.Errorf("expected element %d of %q not to be split across pages, but it spans pages %d to %d",
+				element.Index, selector, element.StartPage, element.EndPage)
+		}
+	}
+	return nil
+}
+
+// ExpectOnPage returns an error unless every element matching selector is on the page, starting at 1.
+func (l *PrintLayout) ExpectOnPage(selector string, page int) error {
+	elements, err := l.expectElements(selector)
+	if err != nil {
+		return err
+	}
+	for _, element := range elements {
+		if element.StartPage != page || element.EndPage != page {
+			if element.IsSplit() {
+				return fmt.Errorf("expected element %d of %q to be on page %d, but it spans pages %d to %d",
+					element.Index, selector, page, element.StartPage, element.EndPage)
+			}
+			return fmt.Errorf("expected element %d of %q to be on page %d, but it is on page %d",
+				element.Index, selector, page, element.StartPage)
+		}
+	}
+	return nil
+}
+
+func (l *PrintLayout) expectElements(selector string) ([]PrintLayoutElement, error) {
+	elements := l.ElementsOf(selector)
+	if len(elements) == 0 {
+		return nil, fmt.Errorf("no element matching %q in the print layout", selector)
+	}
+	return elements, nil
+}
+
+func (p *pageImpl) PrintLayout(options ...PagePrintLayoutOptions) (layout *PrintLayout, err error) {
+	option := PagePrintLayoutOptions{}
+	if len(options) == 1 {
+		option = options[0]
+	}
+	width, height, err := pdfPaperSize(option.Format, option.Width, option.Height)
+	if err != nil {
+		return nil, err
+	}
+	if option.Landscape != nil && *option.Landscape {
+		width, height = height, width
+	}
+	margin := Margin{}
+	if option.Margin != nil {
+		margin = *option.Margin
+	}
+	for _, m := range []struct {
+		length *string
+		size   *float64
+	}{{margin.Top, &height}, {margin.Bottom, &height}, {margin.Left, &width}, {margin.Right, &width}} {
+		inches, err := pdfLengthToInches(m.length, 0)
+		if err != nil {
+			return nil, err
+		}
+		*m.size -= inches
+	}
+	scale := 1.0
+	if option.Scale != nil {
+		scale = *option.Scale
+	}
+	pageSize := Size{Width: int(math.Round(width * 96 / scale)), Height: int(math.Round(height * 96 / scale))}
+	if pageSize.Width <= 0 || pageSize.Height <= 0 {
+		return nil, fmt.Errorf("invalid page size %dx%d: the margins are larger than the paper", pageSize.Width, pageSize.Height)
+	}
+
+	// The media type and the viewport are restored even when the layout fails, and an error restoring them is
+	// returned, as the page is then left laid out for printing.
+	p.Lock()
+	media := p.emulatedMedia
+	p.Unlock()
+	if media == nil {
+		media = MediaNoOverride
+	}
+	viewport := p.ViewportSize()
+	defer func() {
+		restoreErrs := []error{err}
+		if viewport != nil {
+			restoreErrs = append(restoreErrs, p.SetViewportSize(viewport.Width, viewport.Height))
+		}
+		restoreErrs = append(restoreErrs, p.EmulateMedia(PageEmulateMediaOptions{Media: media}))
+		if err = multierror.Join(restoreErrs...); err != nil {
+			layout = nil
+		}
+	}()
+	if err := p.EmulateMedia(PageEmulateMediaOptions{Media: MediaPrint}); err != nil {
+		return nil, err
+	}
+	// The content is laid out in the width of the pages, as when printed.
+	if viewport != nil {
+		if err := p.SetViewportSize(pageSize.Width, viewport.Height); err != nil {
+			return nil, err
+		}
+	}
+
+	selectors := option.Selectors
+	if selectors == nil {
+		selectors = []string{}
+	}
+	result, err := p.mainFrame.Evaluate(printLayoutScript, map[string]interface{}{
+		"selectors":  selectors,
+		"pageHeight": pageSize.Height,
+	})
+	if err != nil {
+		return nil, err
+	}
+	paginated, err := decodeCDPValue[struct {
+		PageCount int `json:"pageCount"`
+		Elements  []struct {
+			SelectorIndex int     `json:"selectorIndex"`
+			Index         int     `json:"index"`
+			Top           float64 `json:"top"`
+			Bottom        float64 `json:"bottom"`
+		} `json:"elements"`
+	}](result)
+	if err != nil {
+		return nil, err
+	}
+	printLayout := &PrintLayout{
+		PageCount: paginated.PageCount,
+		PageSize:  pageSize,
+		Elements:  make([]PrintLayoutElement, 0, len(paginated.Elements)),
+	}
+	// The elements are closed in the order of their ends, and sorted back by selector and in document order.
+	sort.SliceStable(paginated.Elements, func(i, j int) bool {
+		a, b := paginated.Elements[i], paginated.Elements[j]
+		if a.SelectorIndex != b.SelectorIndex {
+			return a.SelectorIndex < b.SelectorIndex
+		}
+		return a.Index < b.Index
+	})
+	for _, element := range paginated.Elements {
+		printLayout.Elements = append(printLayout.Elements, newPrintLayoutElement(
+			selectors[element.SelectorIndex], element.Index, element.Top, element.Bottom, float64(pageSize.Height)))
+	}
+	return printLayout, nil
+}
+
+// newPrintLayoutElement returns the element between top and bottom, in paginated CSS pixels.
+func newPrintLayoutElement(selector string, index int, top, bottom, pageHeight float64) PrintLayoutElement {
+	end := math.Max(top, bottom-0.5)
+	startPage := int(math.Floor(top/pageHeight)) + 1
+	return PrintLayoutElement{
+		Selector:  selector,
+		Index:     index,
+		StartPage: startPage,
+		EndPage:   int(math.Floor(end/pageHeight)) + 1,
+		Offset:    top - float64(startPage-1)*pageHeight,
+		Height:    math.Max(0, bottom-top),
+	}
+}
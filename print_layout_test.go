@@ -0,0 +1,40 @@
+package playwright
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrintLayoutElements(t *testing.T) {
+	layout := &PrintLayout{
+		PageCount: 3,
+		PageSize:  Size{Width: 816, Height: 1056},
+		Elements: []PrintLayoutElement{
+			newPrintLayoutElement("h2", 0, 8, 40, 1056),
+			newPrintLayoutElement("h2", 1, 1056, 1090, 1056),
+			newPrintLayoutElement("table", 0, 1000, 1200, 1056),
+			newPrintLayoutElement("footer", 0, 2100, 2112, 1056),
+		},
+	}
+	require.Len(t, layout.ElementsOf("h2"), 2)
+	require.Empty(t, layout.ElementsOf("p"))
+
+	second := layout.ElementsOf("h2")[1]
+	require.Equal(t, 2, second.StartPage)
+	require.Equal(t, 2, second.EndPage)
+	require.True(t, second.StartsPage())
+	require.False(t, second.IsSplit())
+
+	table := layout.ElementsOf("table")[0]
+	require.True(t, table.IsSplit())
+	require.Equal(t, 1000.0, table.Offset)
+	require.Equal(t, 200.0, table.Height)
+
+	require.EqualError(t, layout.ExpectStartsPage("h2"), `expected element 0 of "h2" to start a page, but it starts 8px down page 1`)
+	require.NoError(t, layout.ExpectNotSplit("h2"))
+	require.EqualError(t, layout.ExpectNotSplit("table"), `expected element 0 of "table" not to be split across pages, but it spans pages 1 to 2`)
+	require.NoError(t, layout.ExpectOnPage("footer", 2))
+	require.EqualError(t, layout.ExpectOnPage("footer", 3), `expected element 0 of "footer" to be on page 3, but it is on page 2`)
+	require.EqualError(t, layout.ExpectOnPage("p", 1), `no element matching "p" in the print layout`)
+}
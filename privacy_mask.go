@@ -0,0 +1,321 @@
+package playwright
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/playwright-community/playwright-go/internal/multierror"
+)
+
+func getPrivacyMaskStyle(in string) *PrivacyMaskStyle {
+	v := PrivacyMaskStyle(in)
+	return &v
+}
+
+// PrivacyMaskStyle is how the masks of [BrowserContext.AddPrivacyMask] hide the elements and regions.
+type PrivacyMaskStyle string
+
+var (
+	// Fills the masks with their color.
+	PrivacyMaskStyleFill *PrivacyMaskStyle = getPrivacyMaskStyle("fill")
+	// Blurs what is under the masks.
+	PrivacyMaskStyleBlur = getPrivacyMaskStyle("blur")
+)
+
+const defaultPrivacyMaskColor = "#000000"
+
+// privacyMaskState is the state of the masks of a document, shared by the scripts adding and clearing them.
+const privacyMaskState = `(globalThis.__pwPrivacyMasks = globalThis.__pwPrivacyMasks || { masks: [] })`
+
+// privacyMaskBinding is the binding the overlay of the main frame asks the elements matching the locators of the
+// masks with, when its DOM changes.
+const privacyMaskBinding = "__pwPrivacyMaskRefresh"
+
+// privacyMaskInstallSource draws the masks over the elements matching their selectors and locators and over their
+// regions, so that they are in the screenshots and the video frames. The overlays are drawn again when the DOM
+// changes, the document scrolls or is resized, and when animations and transitions end. The masks are fixed elements
+// in a closed shadow root, which don't receive pointer events.
+const privacyMaskInstallSource = `(() => {
+  const state = ` + privacyMaskState + `;
+  if (state.render)
+    return;
+  state.elements = new Map();
+  const overlays = [];
+  let host = null;
+  let root = null;
+  const addBox = (boxes, element, mask) => {
+    if (!element.isConnected)
+      return;
+    const rect = element.getBoundingClientRect();
+    if (rect.width > 0 && rect.height > 0)
+      boxes.push({ x: rect.left, y: rect.top, width: rect.width, height: rect.height, mask });
+  };
+  state.render = () => {
+    const boxes = [];
+    for (const mask of state.masks) {
+      for (const selector of mask.selectors) {
+        let elements = [];
+        try {
+          elements = document.querySelectorAll(selector);
+        } catch (e) {
+        }
+        for (const element of elements)
+          addBox(boxes, element, mask);
+      }
+      if (window === window.top) {
+        for (const token of mask.locators) {
+          for (const element of state.elements.get(token) || [])
+            addBox(boxes, element, mask);
+        }
+        for (const region of mask.regions)
+          boxes.push({ x: region.x - window.scrollX, y: region.y - window.scrollY, width: region.width, height: region.height, mask });
+      }
+    }
+    if (!boxes.length && !overlays.length)
+      return;
+    if (!host || !host.isConnected) {
+      if (!document.documentElement)
+        return;
+      if (!host) {
+        host = document.createElement('x-pw-privacy-mask');
+        host.style.cssText = 'position: fixed; top: 0; left: 0; width: 0; height: 0; overflow: visible; display: block; pointer-events: none; z-index: 2147483647;';
+        root = host.attachShadow({ mode: 'closed' });
+      }
+      document.documentElement.appendChild(host);
+    }
+    while (overlays.length < boxes.length) {
+      const overlay = document.createElement('div');
+      root.appendChild(overlay);
+      overlays.push(overlay);
+    }
+    overlays.forEach((overlay, index) => {
+      const box = boxes[index];
+      if (!box) {
+        overlay.style.cssText = 'display: none;';
+        return;
+      }
+      const fill = box.mask.style === 'blur' ?
+        'backdrop-filter: blur(20px); -webkit-backdrop-filter: blur(20px); background: rgba(128, 128, 128, 0.2);' :
+        'background: ' + box.mask.color + ';';
+      overlay.style.cssText = 'position: fixed; pointer-events: none; left: ' + box.x + 'px; top: ' + box.y + 'px; width: ' +
+        box.width + 'px; height: ' + box.height + 'px; ' + fill;
+    });
+  };
+  let scheduled = false;
+  state.schedule = () => {
+    if (scheduled)
+      return;
+    scheduled = true;
+    requestAnimationFrame(() => {
+      scheduled = false;
+      state.render();
+    });
+  };
+  state.setElements = (token, elements) => {
+    state.elements.set(token, elements);
+    state.render();
+  };
+  let refreshing = false;
+  let dirty = false;
+  state.refresh = () => {
+    const refresh = window.` + privacyMaskBinding + `;
+    if (window !== window.top || typeof refresh !== 'function' || !state.masks.some(mask => mask.locators.length))
+      return;
+    if (refreshing) {
+      dirty = true;
+      return;
+    }
+    refreshing = true;
+    refresh().catch(() => {}).finally(() => {
+      refreshing = false;
+      if (dirty) {
+        dirty = false;
+        state.refresh();
+      }
+    });
+  };
+  new MutationObserver(() => {
+    state.schedule();
+    state.refresh();
+  }).observe(document, { attributes: true, childList: true, subtree: true, characterData: true });
+  window.addEventListener('scroll', state.schedule, { capture: true, passive: true });
+  window.addEventListener('resize', state.schedule);
+  document.addEventListener('transitionend', state.schedule, true);
+  document.addEventListener('animationend', state.schedule, true);
+})();`
+
+// privacyMaskSetElements passes the elements matching a locator of the masks to the overlay of the main frame.
+const privacyMaskSetElements = `(elements, token) => {
+  const state = globalThis.__pwPrivacyMasks;
+  if (state && state.setElements)
+    state.setElements(token, elements);
+}`
+
+// privacyMask is a mask added with [BrowserContext.AddPrivacyMask].
+type privacyMask struct {
+	Selectors []string `json:"selectors"`
+	Regions   []Rect   `json:"regions"`
+	Style     string   `json:"style"`
+	Color     string   `json:"color"`
+	// Locators are the tokens the overlay of the main frame is passed the elements matching the locators with.
+	Locators []string `json:"locators"`
+	// selectors are the selectors of the locators, in the main frame.
+	selectors []string
+}
+
+// privacyMasks are the masks of a browser context.
+type privacyMasks struct {
+	sync.Mutex
+	masks          []*privacyMask
+	installed      bool
+	bindingExposed bool
+}
+
+func (b *browserContextImpl) AddPrivacyMask(options BrowserContextAddPrivacyMaskOptions) error {
+	mask := &privacyMask{
+		Selectors: options.Selectors,
+		Regions:   options.Regions,
+		Style:     string(*PrivacyMaskStyleFill),
+		Color:     defaultPrivacyMaskColor,
+	}
+	if mask.Selectors == nil {
+		mask.Selectors = []string{}
+	}
+	if mask.Regions == nil {
+		mask.Regions = []Rect{}
+	}
+	if options.Style != nil {
+		if *options.Style != *PrivacyMaskStyleFill && *options.Style != *PrivacyMaskStyleBlur {
+			return fmt.Errorf("unknown privacy mask style: %s", *options.Style)
+		}
+		mask.Style = string(*options.Style)
+	}
+	if options.Color != nil {
+		mask.Color = *options.Color
+	}
+	mask.Locators = []string{}
+	for _, locator := range options.Locators {
+		l, ok := locator.(*locatorImpl)
+		if !ok {
+			return errors.New("privacy masks only support locators created by this package")
+		}
+		if l.err != nil {
+			return l.err
+		}
+		token, err := newIsolatedWorldToken()
+		if err != nil {
+			return err
+		}
+		mask.Locators = append(mask.Locators, token)
+		mask.selectors = append(mask.selectors, l.selector)
+	}
+	if len(mask.Selectors)+len(mask.Regions)+len(mask.Locators) == 0 {
+		return errors.New("a privacy mask needs selectors, locators or regions")
+	}
+
+	b.privacyMasks.Lock()
+	if len(mask.Locators) > 0 && !b.privacyMasks.bindingExposed {
+		if err := b.ExposeBinding(privacyMaskBinding, b.onPrivacyMaskRefresh); err != nil {
+			b.privacyMasks.Unlock()
+			return err
+		}
+		b.privacyMasks.bindingExposed = true
+	}
+	data, err := json.Marshal(mask)
+	if err != nil {
+		b.privacyMasks.Unlock()
+		return err
+	}
+	source := fmt.Sprintf("%s.masks.push(%s);\n%s.schedule();", privacyMaskState, data, privacyMaskState)
+	if !b.privacyMasks.installed {
+		source = privacyMaskInstallSource + "\n" + source
+	}
+	if err := b.addUntrackedInitScript(source); err != nil {
+		b.privacyMasks.Unlock()
+		return err
+	}
+	b.privacyMasks.installed = true
+	b.privacyMasks.masks = append(b.privacyMasks.masks, mask)
+	b.privacyMasks.Unlock()
+	if err := b.evaluateInAllFrames(privacyMaskInstallSource + "\n" + source); err != nil {
+		return err
+	}
+	var errs []error
+	for _, page := range b.Pages() {
+		errs = append(errs, b.refreshPrivacyMasks(page.(*pageImpl)))
+	}
+	return multierror.Join(errs...)
+}
+
+func (b *browserContextImpl) ClearPrivacyMasks() error {
+	b.privacyMasks.Lock()
+	defer b.privacyMasks.Unlock()
+	b.privacyMasks.masks = nil
+	if !b.privacyMasks.installed {
+		return nil
+	}
+	source := privacyMaskState + ".masks.length = 0;\n" + privacyMaskState + ".elements && " + privacyMaskState + ".elements.clear();\n" +
+		privacyMaskState + ".render && " + privacyMaskState + ".render();"
+	if err := b.addUntrackedInitScript(source); err != nil {
+		return err
+	}
+	return b.evaluateInAllFrames(source)
+}
+
+// evaluateInAllFrames evaluates source in the frames of the pages of the context, which already ran the init scripts.
+func (b *browserContextImpl) evaluateInAllFrames(source string) error {
+	var errs []error
+	for _, page := range b.Pages() {
+		for _, frame := range page.Frames() {
+			if _, err := frame.Evaluate("() => {\n" + source + "\n}"); err != nil && !errors.Is(err, ErrTargetClosed) && !frame.IsDetached() {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return multierror.Join(errs...)
+}
+
+// privacyMaskLocator is a locator of a privacy mask, with the token its elements are passed to the overlay with.
+type privacyMaskLocator struct {
+	token    string
+	selector string
+}
+
+func (b *browserContextImpl) privacyMaskLocators() []privacyMaskLocator {
+	b.privacyMasks.Lock()
+	defer b.privacyMasks.Unlock()
+	var locators []privacyMaskLocator
+	for _, mask := range b.privacyMasks.masks {
+		for i, token := range mask.Locators {
+			locators = append(locators, privacyMaskLocator{token: token, selector: mask.selectors[i]})
+		}
+	}
+	return locators
+}
+
+// refreshPrivacyMasks passes the elements matching the locators of the privacy masks in the main frame of page to its
+// overlay, which draws their masks right away.
+func (b *browserContextImpl) refreshPrivacyMasks(page *pageImpl) error {
+	if page == nil {
+		return nil
+	}
+	var errs []error
+	for _, locator := range b.privacyMaskLocators() {
+		_, err := newLocator(page.mainFrame.(*frameImpl), locator.selector).EvaluateAll(privacyMaskSetElements, locator.token)
+		if err != nil && !errors.Is(err, ErrTargetClosed) {
+			errs = append(errs, err)
+		}
+	}
+	return multierror.Join(errs...)
+}
+
+func (b *browserContextImpl) onPrivacyMaskRefresh(source *BindingSource, args ...interface{}) interface{} {
+	if page, ok := source.Page.(*pageImpl); ok {
+		if err := b.refreshPrivacyMasks(page); err != nil {
+			b.logf("could not refresh the privacy masks: %v\n", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package playwright
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddPrivacyMaskValidatesOptions(t *testing.T) {
+	context := &browserContextImpl{}
+	require.Error(t, context.AddPrivacyMask(BrowserContextAddPrivacyMaskOptions{}))
+	require.Error(t, context.AddPrivacyMask(BrowserContextAddPrivacyMaskOptions{
+		Selectors: []string{".secret"},
+		Style:     getPrivacyMaskStyle("pixelate"),
+	}))
+	require.Error(t, context.AddPrivacyMask(BrowserContextAddPrivacyMaskOptions{
+		Locators: []Locator{&locatorImpl{frame: &frameImpl{}, selector: ".secret", err: ErrLocatorNotSameFrame}},
+	}))
+	require.Empty(t, context.privacyMasks.masks)
+}
+
+func TestPrivacyMaskLocatorsPairTokensWithSelectors(t *testing.T) {
+	context := &browserContextImpl{}
+	require.Empty(t, context.privacyMaskLocators())
+	context.privacyMasks.masks = []*privacyMask{
+		{Locators: []string{"pw-1", "pw-2"}, selectors: []string{".email", ".phone"}},
+		{Selectors: []string{".card"}, Locators: []string{}},
+		{Locators: []string{"pw-3"}, selectors: []string{".name"}},
+	}
+	require.Equal(t, []privacyMaskLocator{
+		{token: "pw-1", selector: ".email"},
+		{token: "pw-2", selector: ".phone"},
+		{token: "pw-3", selector: ".name"},
+	}, context.privacyMaskLocators())
+}
@@ -0,0 +1,293 @@
+package playwright
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/playwright-community/playwright-go/internal/multierror"
+)
+
+// ErrNoHealthyProxy is returned by [ProxyPool.NextProxy] when every proxy of the pool is unhealthy.
+var ErrNoHealthyProxy = errors.New("no healthy proxy available")
+
+// ProxyProvider supplies the proxies of browser contexts, see [BrowserNewContextOptions.ProxyProvider], and of the
+// requests forwarded by a [ProxyRouter]. It is told about the outcome of the requests made through its proxies, so
+// that it can stop handing out the failing ones. Implementations must be safe for concurrent use.
+type ProxyProvider interface {
+	// NextProxy returns the proxy to use for the next context or request.
+	NextProxy() (*Proxy, error)
+	// ReportSuccess reports that a request through proxy succeeded.
+	ReportSuccess(proxy *Proxy)
+	// ReportFailure reports that a request through proxy failed because of the proxy, e.g. it could not be
+	// connected to.
+	ReportFailure(proxy *Proxy, err error)
+}
+
+// ProxyPoolOptions are the options of [NewProxyPool].
+type ProxyPoolOptions struct {
+	// Number of consecutive failures after which a proxy is unhealthy. Defaults to `3`.
+	MaxFailures *int
+	// Time an unhealthy proxy is skipped for, after which it is tried again. Defaults to 1 minute.
+	Cooldown *time.Duration
+}
+
+// ProxyHealth is the health of a proxy of a [ProxyPool].
+type ProxyHealth struct {
+	// Server of the proxy.
+	Server string
+	// Whether the proxy is handed out by the pool.
+	Healthy bool
+	// Number of failures since the last success.
+	ConsecutiveFailures int
+	// Number of successes and failures reported for the proxy.
+	Successes, Failures int
+}
+
+// ProxyPool is a [ProxyProvider] handing out the proxies of a pool in turn, skipping the unhealthy ones.
+type ProxyPool struct {
+	mu          sync.Mutex
+	entries     []*proxyPoolEntry
+	next        int
+	maxFailures int
+	cooldown    time.Duration
+	now         func() time.Time
+}
+
+type proxyPoolEntry struct {
+	proxy               Proxy
+	consecutiveFailures int
+	unhealthyUntil      time.Time
+	successes, failures int
+}
+
+// NewProxyPool creates a pool of proxies, handed out in the order they are given.
+func NewProxyPool(proxies []Proxy, options ...ProxyPoolOptions) (*ProxyPool, error) {
+	if len(proxies) == 0 {
+		return nil, errors.New("proxy pool must have at least one proxy")
+	}
+	pool := &ProxyPool{maxFailures: 3, cooldown: time.Minute, now: time.Now}
+	if len(options) == 1 {
+		if options[0].MaxFailures != nil {
+			if *options[0].MaxFailures < 1 {
+				return nil, fmt.Errorf("proxy pool: maxFailures must be at least 1, got %d", *options[0].MaxFailures)
+			}
+			pool.maxFailures = *options[0].MaxFailures
+		}
+		if options[0].Cooldown != nil {
+			pool.cooldown = *options[0].Cooldown
+		}
+	}
+	for _, proxy := range proxies {
+		pool.entries = append(pool.entries, &proxyPoolEntry{proxy: proxy})
+	}
+	return pool, nil
+}
+
+func (p *ProxyPool) NextProxy() (*Proxy, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := p.now()
+	for range p.entries {
+		entry := p.entries[p.next]
+		p.next = (p.next + 1) % len(p.entries)
+		if !now.Before(entry.unhealthyUntil) {
+			proxy := entry.proxy
+			return &proxy, nil
+		}
+	}
+	return nil, ErrNoHealthyProxy
+}
+
+func (p *ProxyPool) ReportSuccess(proxy *Proxy) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if entry := p.entry(proxy); entry != nil {
+		entry.successes++
+		entry.consecutiveFailures = 0
+	}
+}
+
+func (p *ProxyPool) ReportFailure(proxy *Proxy, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	entry := p.entry(proxy)
+	if entry == nil {
+		return
+	}
+	entry.failures++
+	entry.consecutiveFailures++
+	if entry.consecutiveFailures >= p.maxFailures {
+		entry.unhealthyUntil = p.now().Add(p.cooldown)
+		entry.consecutiveFailures = 0
+	}
+}
+
+// Health returns the health of the proxies of the pool, in order.
+func (p *ProxyPool) Health() []ProxyHealth {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := p.now()
+	health := make([]ProxyHealth, 0, len(p.entries))
+	for _, entry := range p.entries {
+		health = append(health, ProxyHealth{
+			Server:              entry.proxy.Server,
+			Healthy:             !now.Before(entry.unhealthyUntil),
+			ConsecutiveFailures: entry.consecutiveFailures,
+			Successes:           entry.successes,
+			Failures:            entry.failures,
+		})
+	}
+	return health
+}
+
+func (p *ProxyPool) entry(proxy *Proxy) *proxyPoolEntry {
+	if proxy == nil {
+		return nil
+	}
+	for _, entry := range p.entries {
+		if entry.proxy.Server == proxy.Server {
+			return entry
+		}
+	}
+	return nil
+}
+
+// proxyErrorMarkers are parts of the error messages of the browsers and of the API requests for failures caused by
+// the proxy.
+var proxyErrorMarkers = []string{"proxy", "tunnel_connection_failed", "tunnel connection failed", "socks"}
+
+func isProxyError(err error) bool {
+	if err == nil {
+		return false
+	}
+	message := strings.ToLower(err.Error())
+	for _, marker := range proxyErrorMarkers {
+		if strings.Contains(message, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// trackProxyHealth reports the requests of context made through proxy to provider.
+func trackProxyHealth(context BrowserContext, provider ProxyProvider, proxy *Proxy) {
+	context.OnRequestFinished(func(Request) {
+		provider.ReportSuccess(proxy)
+	})
+	context.OnRequestFailed(func(request Request) {
+		if err := request.Failure(); isProxyError(err) {
+			provider.ReportFailure(proxy, err)
+		}
+	})
+}
+
+// ProxyRouterOptions are the options of [NewProxyRouter].
+type ProxyRouterOptions struct {
+	// Number of requests forwarded through a proxy before switching to the next one. Defaults to `1`.
+	RequestsPerProxy *int
+	// Maximum number of proxies a request is tried with, switching to the next proxy after a failure. Defaults to
+	// `2`.
+	MaxAttempts *int
+}
+
+// ProxyRouter is a route handler forwarding the requests of a page or a context through the proxies of a
+// [ProxyProvider], switching to the next proxy every few requests. Requests are made by [APIRequestContext]s
+// created for each proxy, so they don't share the cookies of the browser context:
+//
+//	router := playwright.NewProxyRouter(pw.Request, pool, playwright.ProxyRouterOptions{RequestsPerProxy: playwright.Int(10)})
+//	defer router.Close()
+//	err := page.Route("**/*", router.Handle)
+type ProxyRouter struct {
+	request          APIRequest
+	provider         ProxyProvider
+	requestsPerProxy int
+	maxAttempts      int
+
+	mu       sync.Mutex
+	current  *Proxy
+	served   int
+	contexts map[string]APIRequestContext
+}
+
+// NewProxyRouter creates a router forwarding requests with request through the proxies of provider.
+func NewProxyRouter(request APIRequest, provider ProxyProvider, options ...ProxyRouterOptions) *ProxyRouter {
+	router := &ProxyRouter{
+		request:          request,
+		provider:         provider,
+		requestsPerProxy: 1,
+		maxAttempts:      2,
+		contexts:         make(map[string]APIRequestContext),
+	}
+	if len(options) == 1 {
+		if options[0].RequestsPerProxy != nil && *options[0].RequestsPerProxy > 0 {
+			router.requestsPerProxy = *options[0].RequestsPerProxy
+		}
+		if options[0].MaxAttempts != nil && *options[0].MaxAttempts > 0 {
+			router.maxAttempts = *options[0].MaxAttempts
+		}
+	}
+	return router
+}
+
+// Handle forwards the request of route through a proxy and fulfills it with the response, or aborts it if it fails
+// with every proxy tried.
+func (r *ProxyRouter) Handle(route Route) {
+	for attempt := 0; attempt < r.maxAttempts; attempt++ {
+		proxy, requestContext, err := r.next(attempt > 0)
+		if err != nil {
+			break
+		}
+		response, err := requestContext.Fetch(route.Request(), APIRequestContextFetchOptions{MaxRedirects: Int(0)})
+		if err != nil {
+			// The proxy is the first hop of the request, so failing to get a response is reported as its failure.
+			r.provider.ReportFailure(proxy, err)
+			continue
+		}
+		r.provider.ReportSuccess(proxy)
+		_ = route.Fulfill(RouteFulfillOptions{Response: response})
+		return
+	}
+	_ = route.Abort("failed")
+}
+
+// next returns the proxy to forward the next request through and its request context. With rotate, it switches to
+// the next proxy whatever the number of requests served by the current one.
+func (r *ProxyRouter) next(rotate bool) (*Proxy, APIRequestContext, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.current == nil || rotate || r.served >= r.requestsPerProxy {
+		proxy, err := r.provider.NextProxy()
+		if err != nil {
+			return nil, nil, err
+		}
+		r.current, r.served = proxy, 0
+	}
+	r.served++
+	requestContext, ok := r.contexts[r.current.Server]
+	if !ok {
+		var err error
+		requestContext, err = r.request.NewContext(APIRequestNewContextOptions{Proxy: r.current})
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not create request context for proxy %s: %w", r.current.Server, err)
+		}
+		r.contexts[r.current.Server] = requestContext
+	}
+	return r.current, requestContext, nil
+}
+
+// Close disposes the request contexts of the router.
+func (r *ProxyRouter) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var errs []error
+	for server, requestContext := range r.contexts {
+		if err := requestContext.Dispose(); err != nil {
+			errs = append(errs, err)
+		}
+		delete(r.contexts, server)
+	}
+	return multierror.Join(errs...)
+}
@@ -0,0 +1,61 @@
+package playwright
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProxyPool(t *testing.T) {
+	_, err := NewProxyPool(nil)
+	require.Error(t, err)
+
+	now := time.Unix(0, 0)
+	pool, err := NewProxyPool([]Proxy{{Server: "http://a:3128"}, {Server: "http://b:3128"}}, ProxyPoolOptions{
+		MaxFailures: Int(2),
+		Cooldown:    Duration(time.Minute),
+	})
+	require.NoError(t, err)
+	pool.now = func() time.Time { return now }
+
+	var servers []string
+	for i := 0; i < 3; i++ {
+		proxy, err := pool.NextProxy()
+		require.NoError(t, err)
+		servers = append(servers, proxy.Server)
+	}
+	require.Equal(t, []string{"http://a:3128", "http://b:3128", "http://a:3128"}, servers)
+
+	b := &Proxy{Server: "http://b:3128"}
+	pool.ReportFailure(b, errors.New("net::ERR_PROXY_CONNECTION_FAILED"))
+	pool.ReportSuccess(b)
+	pool.ReportFailure(b, errors.New("net::ERR_PROXY_CONNECTION_FAILED"))
+	require.True(t, pool.Health()[1].Healthy)
+	pool.ReportFailure(b, errors.New("net::ERR_PROXY_CONNECTION_FAILED"))
+	require.Equal(t, ProxyHealth{Server: "http://b:3128", Healthy: false, Successes: 1, Failures: 3}, pool.Health()[1])
+
+	for i := 0; i < 2; i++ {
+		proxy, err := pool.NextProxy()
+		require.NoError(t, err)
+		require.Equal(t, "http://a:3128", proxy.Server)
+	}
+	pool.ReportFailure(&Proxy{Server: "http://a:3128"}, errors.New("tunnel connection failed"))
+	pool.ReportFailure(&Proxy{Server: "http://a:3128"}, errors.New("tunnel connection failed"))
+	_, err = pool.NextProxy()
+	require.ErrorIs(t, err, ErrNoHealthyProxy)
+
+	now = now.Add(time.Minute)
+	proxy, err := pool.NextProxy()
+	require.NoError(t, err)
+	require.Equal(t, "http://b:3128", proxy.Server)
+}
+
+func TestIsProxyError(t *testing.T) {
+	require.True(t, isProxyError(errors.New("net::ERR_PROXY_CONNECTION_FAILED")))
+	require.True(t, isProxyError(errors.New("net::ERR_TUNNEL_CONNECTION_FAILED")))
+	require.True(t, isProxyError(errors.New("NS_ERROR_PROXY_CONNECTION_REFUSED")))
+	require.False(t, isProxyError(errors.New("net::ERR_NAME_NOT_RESOLVED")))
+	require.False(t, isProxyError(nil))
+}
@@ -0,0 +1,212 @@
+package playwright
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// RedactionPlaceholder replaces the secrets registered with [RedactSecrets] and [RedactPatterns].
+const RedactionPlaceholder = "[REDACTED]"
+
+// redactions are the secrets masked in the protocol logs, the errors, the HAR files and the traces.
+var redactions = struct {
+	sync.RWMutex
+	// secrets are sorted by decreasing length, so that a secret containing another one is masked as a whole.
+	secrets  []string
+	patterns []*regexp.Regexp
+}{}
+
+// RedactSecrets registers secret values, such as tokens and passwords, to mask with [RedactionPlaceholder] in:
+//   - the protocol logs printed with the DEBUGP environment variable,
+//   - the messages and stacks of the errors returned by the Playwright driver, including their call logs,
+//   - the HAR files recorded with [BrowserNewContextOptions.RecordHarPath], [Page.RecordHar] or
+//     [BrowserContext.RouteFromHAR], once exported,
+//   - the traces saved by [Tracing.Stop] and [Tracing.StopChunk].
+//
+// The secrets are masked as they are and JSON escaped, but not in other encodings such as base64, which HAR files use
+// for binary bodies. The secrets apply to every Playwright instance of the process, and to the files written after
+// they are registered. Empty values are ignored.
+func RedactSecrets(secrets ...string) {
+	redactions.Lock()
+	defer redactions.Unlock()
+	for _, secret := range secrets {
+		if secret == "" {
+			continue
+		}
+		redactions.secrets = append(redactions.secrets, secret)
+		if escaped := jsonEscape(secret); escaped != secret {
+			redactions.secrets = append(redactions.secrets, escaped)
+		}
+	}
+	sort.SliceStable(redactions.secrets, func(i, j int) bool {
+		return len(redactions.secrets[i]) > len(redactions.secrets[j])
+	})
+}
+
+// RedactPatterns registers patterns of secrets, such as `Bearer \S+`, to mask like [RedactSecrets]. The whole match of
+// a pattern is masked.
+func RedactPatterns(patterns ...*regexp.Regexp) {
+	redactions.Lock()
+	defer redactions.Unlock()
+	for _, pattern := range patterns {
+		if pattern != nil {
+			redactions.patterns = append(redactions.patterns, pattern)
+		}
+	}
+}
+
+// ResetRedactions removes the secrets and patterns registered with [RedactSecrets] and [RedactPatterns].
+func ResetRedactions() {
+	redactions.Lock()
+	defer redactions.Unlock()
+	redactions.secrets = nil
+	redactions.patterns = nil
+}
+
+// Redact returns s with the registered secrets masked, for logging values outside of Playwright.
+func Redact(s string) string {
+	redactions.RLock()
+	defer redactions.RUnlock()
+	for _, secret := range redactions.secrets {
+		s = strings.ReplaceAll(s, secret, RedactionPlaceholder)
+	}
+	for _, pattern := range redactions.patterns {
+		s = pattern.ReplaceAllLiteralString(s, RedactionPlaceholder)
+	}
+	return s
+}
+
+func hasRedactions() bool {
+	redactions.RLock()
+	defer redactions.RUnlock()
+	return len(redactions.secrets) > 0 || len(redactions.patterns) > 0
+}
+
+func jsonEscape(s string) string {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	_ = encoder.Encode(s)
+	escaped := strings.TrimSpace(buf.String())
+	return escaped[1 : len(escaped)-1]
+}
+
+// redactError masks the secrets in the message and the stack of a protocol error.
+func redactError(err Error) Error {
+	if !hasRedactions() {
+		return err
+	}
+	err.Message = Redact(err.Message)
+	err.Stack = Redact(err.Stack)
+	return err
+}
+
+// redactJSON masks the secrets in the strings of a JSON document, keeping its structure valid. Documents that are
+// not JSON are masked as text.
+func redactJSON(data []byte) []byte {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil || decoder.More() {
+		return []byte(Redact(string(data)))
+	}
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(redactValue(value)); err != nil {
+		return []byte(Redact(string(data)))
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n"))
+}
+
+// redactJSONLines masks the secrets in a document with a JSON value per line, as the events of traces.
+func redactJSONLines(data []byte) []byte {
+	lines := bytes.Split(data, []byte("\n"))
+	for i, line := range lines {
+		if len(bytes.TrimSpace(line)) > 0 {
+			lines[i] = redactJSON(line)
+		}
+	}
+	return bytes.Join(lines, []byte("\n"))
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case string:
+		return Redact(v)
+	case []interface{}:
+		for i := range v {
+			v[i] = redactValue(v[i])
+		}
+	case map[string]interface{}:
+		for key := range v {
+			v[key] = redactValue(v[key])
+		}
+	}
+	return value
+}
+
+// redactFile masks the secrets in the HAR file or the trace at path, which is a JSON document or a zip archive.
+func redactFile(path string) error {
+	if !hasRedactions() {
+		return nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if bytes.HasPrefix(data, []byte("PK\x03\x04")) {
+		data, err = redactArchive(data)
+		if err != nil {
+			return fmt.Errorf("could not redact %s: %w", path, err)
+		}
+	} else {
+		data = redactJSON(data)
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// redactArchive masks the secrets in the entries of a zip archive: the JSON documents, the files of events of traces,
+// and the resources that are text.
+func redactArchive(data []byte) ([]byte, error) {
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	var out bytes.Buffer
+	writer := zip.NewWriter(&out)
+	for _, file := range reader.File {
+		content, err := readZipFile(file)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case strings.HasSuffix(file.Name, ".har") || strings.HasSuffix(file.Name, ".json"):
+			content = redactJSON(content)
+		case strings.HasSuffix(file.Name, ".trace") || strings.HasSuffix(file.Name, ".network") ||
+			strings.HasSuffix(file.Name, ".stacks"):
+			content = redactJSONLines(content)
+		case utf8.Valid(content):
+			content = []byte(Redact(string(content)))
+		}
+		w, err := writer.CreateHeader(&zip.FileHeader{Name: file.Name, Method: file.Method, Modified: file.Modified})
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write(content); err != nil {
+			return nil, err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
@@ -0,0 +1,99 @@
+package playwright
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedact(t *testing.T) {
+	defer ResetRedactions()
+	require.Equal(t, "token s3cr3t", Redact("token s3cr3t"))
+
+	RedactSecrets("s3cr3t", "", `pa"ss`, "s3cr3t-long")
+	RedactPatterns(regexp.MustCompile(`Bearer \S+`), nil)
+	require.Equal(t, "token [REDACTED] and [REDACTED]", Redact("token s3cr3t and s3cr3t-long"))
+	require.Equal(t, `{"password":"[REDACTED]"}`, Redact(`{"password":"pa\"ss"}`))
+	require.Equal(t, "Authorization: [REDACTED]", Redact("Authorization: Bearer abc.def"))
+
+	ResetRedactions()
+	require.Equal(t, "token s3cr3t", Redact("token s3cr3t"))
+}
+
+func TestRedactError(t *testing.T) {
+	defer ResetRedactions()
+	RedactSecrets("hunter2")
+
+	err := parseCallError(Error{Name: "TimeoutError", Message: "waiting for fill(\"hunter2\")", Stack: "at hunter2"}, "fill", "Locator.Fill")
+	require.True(t, errors.Is(err, ErrTimeout))
+	require.NotContains(t, err.Error(), "hunter2")
+	var timeoutErr *TimeoutError
+	require.ErrorAs(t, err, &timeoutErr)
+	require.Equal(t, "at [REDACTED]", timeoutErr.Err.Stack)
+	require.NotContains(t, parseError(Error{Name: "Error", Message: "hunter2"}).Error(), "hunter2")
+}
+
+func TestRedactJSON(t *testing.T) {
+	defer ResetRedactions()
+	RedactSecrets("tok\"en")
+
+	require.JSONEq(t,
+		`{"headers":[{"name":"Authorization","value":"[REDACTED]"}],"size":12345678901234567890,"time":1.5}`,
+		string(redactJSON([]byte(`{"headers":[{"name":"Authorization","value":"tok\"en"}],"size":12345678901234567890,"time":1.5}`))))
+	require.Equal(t, "not json [REDACTED]", string(redactJSON([]byte(`not json tok"en`))))
+	require.Equal(t, "{\"a\":\"[REDACTED]\"}\n\n{\"b\":1}\n",
+		string(redactJSONLines([]byte("{\"a\":\"tok\\\"en\"}\n\n{\"b\":1}\n"))))
+}
+
+func TestRedactFile(t *testing.T) {
+	defer ResetRedactions()
+	dir := t.TempDir()
+	harPath := filepath.Join(dir, "log.har")
+	require.NoError(t, os.WriteFile(harPath, []byte(`{"log":{"entries":[{"request":{"url":"https://example.com/?key=abc123"}}]}}`), 0o644))
+	// Nothing is rewritten without redactions.
+	require.NoError(t, redactFile(filepath.Join(dir, "missing.har")))
+
+	RedactSecrets("abc123")
+	require.NoError(t, redactFile(harPath))
+	data, err := os.ReadFile(harPath)
+	require.NoError(t, err)
+	require.Equal(t, `{"log":{"entries":[{"request":{"url":"https://example.com/?key=[REDACTED]"}}]}}`, string(data))
+
+	var archive bytes.Buffer
+	writer := zip.NewWriter(&archive)
+	for name, content := range map[string]string{
+		"trace.network":    "{\"url\":\"abc123\"}\n",
+		"resources/a.html": "<p>abc123</p>",
+		"resources/b.png":  "\x89PNG\xff\xfeabc123",
+	} {
+		w, err := writer.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+	tracePath := filepath.Join(dir, "trace.zip")
+	require.NoError(t, os.WriteFile(tracePath, archive.Bytes(), 0o644))
+	require.NoError(t, redactFile(tracePath))
+	data, err = os.ReadFile(tracePath)
+	require.NoError(t, err)
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	contents := make(map[string]string)
+	for _, file := range reader.File {
+		content, err := readZipFile(file)
+		require.NoError(t, err)
+		contents[file.Name] = string(content)
+	}
+	require.Equal(t, map[string]string{
+		"trace.network":    "{\"url\":\"[REDACTED]\"}\n",
+		"resources/a.html": "<p>[REDACTED]</p>",
+		"resources/b.png":  "\x89PNG\xff\xfeabc123",
+	}, contents)
+}
@@ -0,0 +1,165 @@
+package playwright
+
+import (
+	"sync"
+	"time"
+)
+
+// remoteConnection connects to a remote browser for [BrowserType.Connect], checking that the server still responds
+// with heartbeats and reconnecting when the connection is lost.
+type remoteConnection struct {
+	browserType       *browserTypeImpl
+	wsEndpoint        string
+	options           BrowserTypeConnectOptions
+	heartbeatInterval time.Duration
+	heartbeatTimeout  time.Duration
+	reconnectAttempts int
+	reconnectBackoff  time.Duration
+	onReconnect       func(Browser)
+}
+
+func newRemoteConnection(browserType *browserTypeImpl, wsEndpoint string, option BrowserTypeConnectOptions) *remoteConnection {
+	r := &remoteConnection{
+		browserType:      browserType,
+		wsEndpoint:       wsEndpoint,
+		heartbeatTimeout: 30 * time.Second,
+		reconnectBackoff: time.Second,
+		onReconnect:      option.OnReconnect,
+	}
+	if option.HeartbeatInterval != nil {
+		r.heartbeatInterval = time.Duration(*option.HeartbeatInterval * float64(time.Millisecond))
+	}
+	if option.HeartbeatTimeout != nil {
+		r.heartbeatTimeout = time.Duration(*option.HeartbeatTimeout * float64(time.Millisecond))
+	}
+	if option.ReconnectAttempts != nil {
+		r.reconnectAttempts = *option.ReconnectAttempts
+	}
+	if option.ReconnectBackoff != nil {
+		r.reconnectBackoff = time.Duration(*option.ReconnectBackoff * float64(time.Millisecond))
+	}
+	// These options are handled by the client, the driver does not know them.
+	option.Adapter = nil
+	option.HeartbeatInterval = nil
+	option.HeartbeatTimeout = nil
+	option.OnReconnect = nil
+	option.ReconnectAttempts = nil
+	option.ReconnectBackoff = nil
+	r.options = option
+	return r
+}
+
+// connect connects to the server, retrying if the connection can not be established.
+func (r *remoteConnection) connect() (*browserImpl, error) {
+	browser, err := r.connectOnce()
+	if err != nil && r.reconnectAttempts > 0 {
+		logger.Printf("could not connect to %s, retrying: %v\n", r.wsEndpoint, err)
+		return r.retry()
+	}
+	return browser, err
+}
+
+// retry connects up to reconnectAttempts times, waiting before every attempt twice as long as before the previous one.
+func (r *remoteConnection) retry() (browser *browserImpl, err error) {
+	backoff := r.reconnectBackoff
+	for attempt := 0; attempt < r.reconnectAttempts; attempt++ {
+		time.Sleep(backoff)
+		backoff *= 2
+		if browser, err = r.connectOnce(); err == nil {
+			return browser, nil
+		}
+		logger.Printf("could not connect to %s (attempt %d of %d): %v\n", r.wsEndpoint, attempt+1, r.reconnectAttempts, err)
+	}
+	return nil, err
+}
+
+func (r *remoteConnection) reconnect() {
+	browser, err := r.retry()
+	if err != nil {
+		return
+	}
+	if r.onReconnect != nil {
+		r.onReconnect(browser)
+	}
+}
+
+func (r *remoteConnection) connectOnce() (*browserImpl, error) {
+	overrides := map[string]interface{}{
+		"wsEndpoint": r.wsEndpoint,
+	}
+	localUtils := r.browserType.connection.LocalUtils()
+	pipe, err := localUtils.channel.SendReturnAsDict("connect", optionsOf([]BrowserTypeConnectOptions{r.options}), overrides)
+	if err != nil {
+		return nil, err
+	}
+	jsonPipe := fromChannel(pipe.(map[string]interface{})["pipe"]).(*jsonPipe)
+	connection := newConnection(jsonPipe, localUtils)
+
+	playwright, err := connection.Start()
+	if err != nil {
+		return nil, err
+	}
+	playwright.setSelectors(r.browserType.playwright.Selectors)
+	browser := fromChannel(playwright.initializer["preLaunchedBrowser"]).(*browserImpl)
+	browser.shouldCloseConnectionOnClose = true
+	stop := make(chan struct{})
+	var stopOnce sync.Once
+	pipeClosed := func() {
+		stopOnce.Do(func() { close(stop) })
+		for _, context := range browser.Contexts() {
+			pages := context.Pages()
+			for _, page := range pages {
+				page.(*pageImpl).onClose()
+			}
+			context.(*browserContextImpl).onClose()
+		}
+		browser.onClose()
+		connection.cleanup()
+
+		browser.RLock()
+		closed := browser.closeWasCalled
+		browser.RUnlock()
+		if !closed && r.reconnectAttempts > 0 && r.browserType.connection.closedError.Get() == nil {
+			go r.reconnect()
+		}
+	}
+	jsonPipe.On("closed", pipeClosed)
+	if r.heartbeatInterval > 0 {
+		go r.heartbeat(playwright, jsonPipe, stop)
+	}
+
+	r.browserType.didLaunchBrowser(browser)
+	return browser, nil
+}
+
+// heartbeat closes the connection when the server does not respond within heartbeatTimeout, so that the calls waiting
+// for it fail and it can be re-established, instead of hanging until the operating system notices the lost connection.
+func (r *remoteConnection) heartbeat(playwright *Playwright, pipe *jsonPipe, stop <-chan struct{}) {
+	ticker := time.NewTicker(r.heartbeatInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+		pong := make(chan struct{})
+		go func() {
+			// A request context is created and disposed of without affecting the browser, and any reply, even an
+			// error, shows that the server responds.
+			if request, err := playwright.Request.NewContext(); err == nil {
+				_ = request.Dispose()
+			}
+			close(pong)
+		}()
+		select {
+		case <-stop:
+			return
+		case <-pong:
+		case <-time.After(r.heartbeatTimeout):
+			logger.Printf("closing connection to %s: no response to heartbeat in %s\n", r.wsEndpoint, r.heartbeatTimeout)
+			_ = pipe.Close()
+			return
+		}
+	}
+}
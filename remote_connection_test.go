@@ -0,0 +1,36 @@
+package playwright
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoteConnectionOptions(t *testing.T) {
+	r := newRemoteConnection(nil, "ws://localhost:1234", BrowserTypeConnectOptions{})
+	require.Equal(t, time.Duration(0), r.heartbeatInterval)
+	require.Equal(t, 30*time.Second, r.heartbeatTimeout)
+	require.Equal(t, 0, r.reconnectAttempts)
+	require.Equal(t, time.Second, r.reconnectBackoff)
+
+	reconnected := false
+	r = newRemoteConnection(nil, "ws://localhost:1234", BrowserTypeConnectOptions{
+		ExposeNetwork:     String("<loopback>"),
+		HeartbeatInterval: Float(500),
+		HeartbeatTimeout:  Float(2000),
+		OnReconnect:       func(Browser) { reconnected = true },
+		ReconnectAttempts: Int(3),
+		ReconnectBackoff:  Float(250),
+	})
+	require.Equal(t, 500*time.Millisecond, r.heartbeatInterval)
+	require.Equal(t, 2*time.Second, r.heartbeatTimeout)
+	require.Equal(t, 3, r.reconnectAttempts)
+	require.Equal(t, 250*time.Millisecond, r.reconnectBackoff)
+	r.onReconnect(nil)
+	require.True(t, reconnected)
+	// Only the options known by the driver are sent.
+	require.Equal(t, map[string]interface{}{
+		"exposeNetwork": String("<loopback>"),
+	}, optionsOf([]BrowserTypeConnectOptions{r.options}).Marshal())
+}
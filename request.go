@@ -1,9 +1,12 @@
 package playwright
 
 import (
+	"bytes"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 )
 
 type serializedFallbackOverrides struct {
@@ -68,6 +71,22 @@ func (r *requestImpl) PostDataBuffer() ([]byte, error) {
 	return base64.StdEncoding.DecodeString(r.initializer["postData"].(string))
 }
 
+func (r *requestImpl) PostDataReader() (io.Reader, error) {
+	body, err := r.PostDataBuffer()
+	if err != nil || body == nil {
+		return nil, err
+	}
+	return bytes.NewReader(body), nil
+}
+
+func (r *requestImpl) PostDataParts() ([]PostDataPart, error) {
+	body, err := r.PostDataBuffer()
+	if err != nil || body == nil {
+		return nil, err
+	}
+	return decodeMultipart(body, r.Headers()["content-type"])
+}
+
 func (r *requestImpl) Headers() map[string]string {
 	if r.fallbackOverrides.Headers != nil {
 		return newRawHeaders(serializeMapToNameAndValue(r.fallbackOverrides.Headers)).Headers()
@@ -89,12 +108,12 @@ func (r *requestImpl) Response() (Response, error) {
 }
 
 func (r *requestImpl) Frame() Frame {
-	channel, ok := r.initializer["frame"]
-	if !ok {
+	channel := fromNullableChannel(r.initializer["frame"])
+	if channel == nil {
 		// Service Worker requests do not have an associated frame.
 		return nil
 	}
-	frame := fromChannel(channel).(*frameImpl)
+	frame := channel.(*frameImpl)
 	if frame.page == nil {
 		// Frame for this navigation request is not available, because the request
 		// was issued before the frame is created. You can check whether the request
@@ -181,11 +200,11 @@ func (r *requestImpl) ActualHeaders() (*rawHeaders, error) {
 }
 
 func (r *requestImpl) ServiceWorker() Worker {
-	channel, ok := r.initializer["serviceWorker"]
-	if !ok {
+	worker := fromNullableChannel(r.initializer["serviceWorker"])
+	if worker == nil {
 		return nil
 	}
-	return fromChannel(channel).(*workerImpl)
+	return worker.(*workerImpl)
 }
 
 func (r *requestImpl) Sizes() (*RequestSizesResult, error) {
@@ -193,6 +212,9 @@ func (r *requestImpl) Sizes() (*RequestSizesResult, error) {
 	if err != nil {
 		return nil, err
 	}
+	if response == nil {
+		return nil, errors.New("unable to fetch sizes for failed request")
+	}
 	sizes, err := response.(*responseImpl).channel.Send("sizes")
 	if err != nil {
 		return nil, err
@@ -202,22 +224,36 @@ func (r *requestImpl) Sizes() (*RequestSizesResult, error) {
 	return result, nil
 }
 
-func (r *requestImpl) applyFallbackOverrides(options RouteFallbackOptions) {
+// applyFallbackOverrides merges the overrides of a route handler into the ones of the previous handlers, so that the
+// next handlers and the request sent to the network see the request modified by every handler.
+func (r *requestImpl) applyFallbackOverrides(options RouteFallbackOptions) error {
+	var postData []byte
+	switch v := options.PostData.(type) {
+	case nil:
+	case string:
+		postData = []byte(v)
+	case []byte:
+		postData = v
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Errorf("could not marshal post data: %w", err)
+		}
+		postData = data
+	}
 	if options.URL != nil {
 		r.fallbackOverrides.URL = options.URL
 	}
 	if options.Method != nil {
 		r.fallbackOverrides.Method = options.Method
 	}
-	r.fallbackOverrides.Headers = options.Headers
-	if options.PostData != nil {
-		switch v := options.PostData.(type) {
-		case string:
-			r.fallbackOverrides.PostDataBuffer = []byte(v)
-		case []byte:
-			r.fallbackOverrides.PostDataBuffer = v
-		}
+	if options.Headers != nil {
+		r.fallbackOverrides.Headers = options.Headers
+	}
+	if postData != nil {
+		r.fallbackOverrides.PostDataBuffer = postData
 	}
+	return nil
 }
 
 func (r *requestImpl) targetClosed() <-chan error {
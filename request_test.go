@@ -0,0 +1,33 @@
+package playwright
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyFallbackOverridesShouldMergeOverrides(t *testing.T) {
+	request := &requestImpl{fallbackOverrides: &serializedFallbackOverrides{}}
+	request.initializer = map[string]interface{}{"url": "https://example.com/api", "method": "GET"}
+
+	require.NoError(t, request.applyFallbackOverrides(RouteFallbackOptions{
+		Headers: map[string]string{"authorization": "Bearer token"},
+	}))
+	require.NoError(t, request.applyFallbackOverrides(RouteFallbackOptions{
+		Method:   String("POST"),
+		PostData: map[string]interface{}{"id": 1},
+	}))
+	require.NoError(t, request.applyFallbackOverrides(RouteFallbackOptions{
+		URL: String("https://example.com/api/v2"),
+	}))
+
+	require.Equal(t, "https://example.com/api/v2", request.URL())
+	require.Equal(t, "POST", request.Method())
+	require.Equal(t, "Bearer token", request.Headers()["authorization"])
+	postData, err := request.PostData()
+	require.NoError(t, err)
+	require.JSONEq(t, `{"id":1}`, postData)
+
+	require.Error(t, request.applyFallbackOverrides(RouteFallbackOptions{PostData: func() {}}))
+	require.Equal(t, "POST", request.Method())
+}
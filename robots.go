@@ -0,0 +1,210 @@
+package playwright
+
+import (
+	"bufio"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RobotsTxt is a parsed robots.txt file, see [ParseRobotsTxt].
+type RobotsTxt struct {
+	groups   []robotsGroup
+	sitemaps []string
+}
+
+type robotsGroup struct {
+	userAgents []string
+	rules      []robotsRule
+	crawlDelay *time.Duration
+}
+
+type robotsRule struct {
+	allow   bool
+	pattern string
+}
+
+// ParseRobotsTxt parses the content of a robots.txt file following RFC 9309.
+// Unknown directives and malformed lines are ignored.
+func ParseRobotsTxt(content string) *RobotsTxt {
+	robots := &RobotsTxt{}
+	var current *robotsGroup
+	// consecutive user-agent lines share the same group
+	lastWasAgent := false
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		key, value, found := strings.Cut(line, ":")
+		if !found {
+			continue
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+		switch key {
+		case "user-agent":
+			if !lastWasAgent || current == nil {
+				robots.groups = append(robots.groups, robotsGroup{})
+				current = &robots.groups[len(robots.groups)-1]
+			}
+			current.userAgents = append(current.userAgents, strings.ToLower(value))
+			lastWasAgent = true
+			continue
+		case "allow", "disallow":
+			if current != nil && value != "" {
+				current.rules = append(current.rules, robotsRule{allow: key == "allow", pattern: value})
+			}
+		case "crawl-delay":
+			if seconds, err := strconv.ParseFloat(value, 64); current != nil && err == nil && seconds >= 0 {
+				delay := time.Duration(seconds * float64(time.Second))
+				current.crawlDelay = &delay
+			}
+		case "sitemap":
+			robots.sitemaps = append(robots.sitemaps, value)
+		}
+		lastWasAgent = false
+	}
+	return robots
+}
+
+// FetchRobotsTxt downloads and parses the robots.txt of the origin of rawURL.
+// A missing robots.txt (4xx) allows everything.
+func FetchRobotsTxt(request APIRequestContext, rawURL string) (*RobotsTxt, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	robotsURL := url.URL{Scheme: u.Scheme, Host: u.Host, Path: "/robots.txt"}
+	response, err := request.Get(robotsURL.String())
+	if err != nil {
+		return nil, err
+	}
+	defer response.Dispose()
+	if response.Status() >= 400 && response.Status() < 500 {
+		return &RobotsTxt{}, nil
+	}
+	if !response.Ok() {
+		return nil, fmt.Errorf("could not fetch %s: %d %s", robotsURL.String(), response.Status(), response.StatusText())
+	}
+	body, err := response.Text()
+	if err != nil {
+		return nil, err
+	}
+	return ParseRobotsTxt(body), nil
+}
+
+// Allowed reports whether userAgent may fetch rawURL, which can either be a full URL or a path.
+func (r *RobotsTxt) Allowed(userAgent, rawURL string) bool {
+	group := r.groupFor(userAgent)
+	if group == nil {
+		return true
+	}
+	path := rawURL
+	if u, err := url.Parse(rawURL); err == nil {
+		path = u.EscapedPath()
+		if u.RawQuery != "" {
+			path += "?" + u.RawQuery
+		}
+	}
+	if path == "" {
+		path = "/"
+	}
+	if path == "/robots.txt" {
+		return true
+	}
+	matched := -1
+	allowed := true
+	for _, rule := range group.rules {
+		if length, ok := matchRobotsPattern(rule.pattern, path); ok {
+			// the most specific rule wins, allow wins ties
+			if length > matched || (length == matched && rule.allow) {
+				matched = length
+				allowed = rule.allow
+			}
+		}
+	}
+	return allowed
+}
+
+// CrawlDelay returns the "Crawl-delay" that applies to userAgent, if any.
+func (r *RobotsTxt) CrawlDelay(userAgent string) (time.Duration, bool) {
+	group := r.groupFor(userAgent)
+	if group == nil || group.crawlDelay == nil {
+		return 0, false
+	}
+	return *group.crawlDelay, true
+}
+
+// Sitemaps returns the sitemap URLs listed in the robots.txt.
+func (r *RobotsTxt) Sitemaps() []string {
+	return append([]string{}, r.sitemaps...)
+}
+
+// ThrottleOptions returns the options that make a [HostThrottle] honor the crawl delay for userAgent.
+func (r *RobotsTxt) ThrottleOptions(userAgent string) ThrottleOptions {
+	options := ThrottleOptions{}
+	if delay, ok := r.CrawlDelay(userAgent); ok {
+		options.Delay = &delay
+	}
+	return options
+}
+
+// groupFor returns the group with the longest user-agent matching userAgent, or the "*" group.
+func (r *RobotsTxt) groupFor(userAgent string) *robotsGroup {
+	userAgent = strings.ToLower(userAgent)
+	var (
+		best     *robotsGroup
+		wildcard *robotsGroup
+		bestLen  int
+	)
+	for i := range r.groups {
+		group := &r.groups[i]
+		for _, agent := range group.userAgents {
+			if agent == "*" {
+				if wildcard == nil {
+					wildcard = group
+				}
+			} else if agent != "" && strings.Contains(userAgent, agent) && len(agent) > bestLen {
+				best = group
+				bestLen = len(agent)
+			}
+		}
+	}
+	if best != nil {
+		return best
+	}
+	return wildcard
+}
+
+// matchRobotsPattern matches path against a robots.txt path pattern supporting the "*"
+// wildcard and the "$" end anchor. It returns the pattern length used for precedence.
+func matchRobotsPattern(pattern, path string) (int, bool) {
+	anchored := strings.HasSuffix(pattern, "$")
+	expr := strings.TrimSuffix(pattern, "$")
+	parts := strings.Split(expr, "*")
+	if !strings.HasPrefix(path, parts[0]) {
+		return 0, false
+	}
+	rest := path[len(parts[0]):]
+	for i, part := range parts[1:] {
+		if anchored && i == len(parts)-2 {
+			if !strings.HasSuffix(rest, part) {
+				return 0, false
+			}
+			return len(pattern), true
+		}
+		idx := strings.Index(rest, part)
+		if idx < 0 {
+			return 0, false
+		}
+		rest = rest[idx+len(part):]
+	}
+	if anchored && len(parts) == 1 && rest != "" {
+		return 0, false
+	}
+	return len(pattern), true
+}
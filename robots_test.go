@@ -0,0 +1,105 @@
+package playwright
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testRobotsTxt = `
+# comment
+User-agent: *
+Disallow: /private/
+Allow: /private/public.html
+Disallow: /*.pdf$
+Crawl-delay: 2
+
+User-agent: FooBot
+User-agent: BarBot
+Disallow: /
+
+User-agent: FooBot-News
+Allow: /news
+Disallow: /
+Crawl-delay: 0.5
+
+Sitemap: https://example.com/sitemap.xml
+`
+
+func TestRobotsTxtAllowed(t *testing.T) {
+	robots := ParseRobotsTxt(testRobotsTxt)
+	testCases := []struct {
+		userAgent string
+		url       string
+		allowed   bool
+	}{
+		{"Mozilla/5.0", "/", true},
+		{"Mozilla/5.0", "https://example.com/private/secret.html", false},
+		{"Mozilla/5.0", "https://example.com/private/public.html", true},
+		{"Mozilla/5.0", "/docs/manual.pdf", false},
+		{"Mozilla/5.0", "/docs/manual.pdf?download=1", true},
+		{"FooBot/1.0", "/", false},
+		{"barbot", "/index.html", false},
+		{"BarBot", "/robots.txt", true},
+		{"FooBot-News/2.1", "/news/today", true},
+		{"FooBot-News/2.1", "/sports", false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.userAgent+" "+tc.url, func(t *testing.T) {
+			require.Equal(t, tc.allowed, robots.Allowed(tc.userAgent, tc.url))
+		})
+	}
+	require.True(t, ParseRobotsTxt("").Allowed("FooBot", "/private"))
+}
+
+func TestRobotsTxtCrawlDelay(t *testing.T) {
+	robots := ParseRobotsTxt(testRobotsTxt)
+	delay, ok := robots.CrawlDelay("Mozilla/5.0")
+	require.True(t, ok)
+	require.Equal(t, 2*time.Second, delay)
+	delay, ok = robots.CrawlDelay("FooBot-News")
+	require.True(t, ok)
+	require.Equal(t, 500*time.Millisecond, delay)
+	_, ok = robots.CrawlDelay("FooBot")
+	require.False(t, ok)
+	require.Equal(t, 500*time.Millisecond, *robots.ThrottleOptions("FooBot-News").Delay)
+	require.Equal(t, []string{"https://example.com/sitemap.xml"}, robots.Sitemaps())
+}
+
+func TestHostThrottleState(t *testing.T) {
+	throttle := NewHostThrottle(ThrottleOptions{
+		MaxConcurrency:    Int(1),
+		RequestsPerSecond: Float(20),
+	})
+	throttle.SetHostOptions("slow.example.com", ThrottleOptions{
+		Delay: Duration(100 * time.Millisecond),
+	})
+	require.Nil(t, throttle.stateOf("data:text/html,foo"))
+	state := throttle.stateOf("https://example.com/a")
+	require.Same(t, state, throttle.stateOf("https://EXAMPLE.com/b"))
+	require.Equal(t, 50*time.Millisecond, state.interval)
+	require.Equal(t, 1, cap(state.slots))
+
+	slow := throttle.stateOf("https://slow.example.com/")
+	require.Nil(t, slow.slots)
+	start := time.Now()
+	slow.acquire()
+	slow.acquire()
+	slow.acquire()
+	require.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+
+	state.acquire()
+	acquired := make(chan bool)
+	go func() {
+		state.acquire()
+		acquired <- true
+	}()
+	select {
+	case <-acquired:
+		t.Fatal("second request should wait for a free slot")
+	case <-time.After(100 * time.Millisecond):
+	}
+	<-state.slots
+	<-acquired
+}
@@ -3,8 +3,12 @@ package playwright
 import (
 	"encoding/base64"
 	"errors"
+	"fmt"
+	"io"
+	"mime"
 	"net/http"
 	"os"
+	"path/filepath"
 	"reflect"
 	"strconv"
 	"strings"
@@ -144,15 +148,34 @@ func (r *routeImpl) innerFulfill(options ...RouteFulfillOptions) error {
 		option.Body = base64.StdEncoding.EncodeToString(body)
 		length = len(body)
 		isBase64 = true
+	} else if reader, ok := option.Body.(io.Reader); ok {
+		if closer, ok := reader.(io.Closer); ok {
+			defer closer.Close()
+		}
+		body, n, _, err := encodeFulfillBody(reader)
+		if err != nil {
+			return fmt.Errorf("could not read body: %w", err)
+		}
+		option.Body = body
+		length = n
+		isBase64 = true
 	} else if option.Path != nil {
-		content, err := os.ReadFile(*option.Path)
+		file, err := os.Open(*option.Path)
 		if err != nil {
 			return err
 		}
-		fileContentType = http.DetectContentType(content)
-		option.Body = base64.StdEncoding.EncodeToString(content)
+		defer file.Close()
+		body, n, head, err := encodeFulfillBody(file)
+		if err != nil {
+			return fmt.Errorf("could not read %s: %w", *option.Path, err)
+		}
+		fileContentType = mime.TypeByExtension(filepath.Ext(*option.Path))
+		if fileContentType == "" {
+			fileContentType = http.DetectContentType(head)
+		}
+		option.Body = body
 		isBase64 = true
-		length = len(content)
+		length = n
 	}
 
 	if option.Headers != nil {
@@ -183,6 +206,29 @@ func (r *routeImpl) innerFulfill(options ...RouteFulfillOptions) error {
 	return err
 }
 
+// encodeFulfillBody reads a response body to its end and returns it encoded in base64, as the protocol needs, with its
+// length and its first bytes to sniff its content type. The body is encoded while it is read, so that it is not held
+// twice in memory.
+func encodeFulfillBody(reader io.Reader) (string, int, []byte, error) {
+	var encoded strings.Builder
+	encoder := base64.NewEncoder(base64.StdEncoding, &encoded)
+	head := make([]byte, 512)
+	n, err := io.ReadFull(reader, head)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return "", 0, nil, err
+	}
+	head = head[:n]
+	_, _ = encoder.Write(head)
+	rest, err := io.Copy(encoder, reader)
+	if err != nil {
+		return "", 0, nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return "", 0, nil, err
+	}
+	return encoded.String(), n + int(rest), head, nil
+}
+
 func (r *routeImpl) Fallback(options ...RouteFallbackOptions) error {
 	err := r.checkNotHandled()
 	if err != nil {
@@ -192,7 +238,9 @@ func (r *routeImpl) Fallback(options ...RouteFallbackOptions) error {
 	if len(options) == 1 {
 		opt = options[0]
 	}
-	r.Request().(*requestImpl).applyFallbackOverrides(opt)
+	if err := r.Request().(*requestImpl).applyFallbackOverrides(opt); err != nil {
+		return err
+	}
 	r.reportHandled(false)
 	return nil
 }
@@ -229,7 +277,9 @@ func (r *routeImpl) Continue(options ...RouteContinueOptions) error {
 	}
 
 	return r.handleRoute(func() error {
-		r.Request().(*requestImpl).applyFallbackOverrides(*option)
+		if err := r.Request().(*requestImpl).applyFallbackOverrides(*option); err != nil {
+			return err
+		}
 		return r.internalContinue(false)
 	})
 }
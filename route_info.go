@@ -0,0 +1,126 @@
+package playwright
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"runtime"
+	"sync/atomic"
+)
+
+// RouteInfo describes a route registered with [Page.Route] or [BrowserContext.Route].
+type RouteInfo struct {
+	// URL is the pattern the route was registered with: a glob string, a [*regexp.Regexp]
+	// or a func(string) bool.
+	URL interface{}
+	// Pattern is a readable form of URL. Predicates are shown as the name of the function.
+	Pattern string
+	// Handler is the name of the handler function. Closures are named after the function
+	// they are declared in, e.g. "main.main.func1".
+	Handler string
+	// Times is the number of requests the route handles before it is removed, 0 if unlimited.
+	Times int
+	// Remaining is the number of requests the route still handles, nil if unlimited.
+	Remaining *int
+	// HitCount is the number of requests the route has handled so far, including the ones it
+	// passed on with [Route.Fallback].
+	HitCount int
+	// Priority is the priority of the route, see [Page.RouteWithPriority].
+	Priority int
+	// Context is whether the route was registered on the browser context rather than on a page.
+	Context bool
+}
+
+func (r *routeHandlerEntry) info() RouteInfo {
+	hits := int(atomic.LoadInt32(&r.count))
+	info := RouteInfo{
+		URL:      r.matcher.raw,
+		Pattern:  describeURLPattern(r.matcher.raw),
+		Handler:  functionName(r.handler),
+		Times:    r.times,
+		HitCount: hits,
+		Priority: r.priority,
+	}
+	if r.times > 0 {
+		remaining := r.times - hits
+		if remaining < 0 {
+			remaining = 0
+		}
+		info.Remaining = Int(remaining)
+	}
+	return info
+}
+
+// routeInfos describes the routes of a page or a context in the order they are matched against requests.
+func routeInfos(routes []*routeHandlerEntry, context bool) []RouteInfo {
+	infos := make([]RouteInfo, 0, len(routes))
+	for _, entry := range routes {
+		info := entry.info()
+		info.Context = context
+		infos = append(infos, info)
+	}
+	return infos
+}
+
+func describeURLPattern(raw interface{}) string {
+	switch v := raw.(type) {
+	case string:
+		return v
+	case *regexp.Regexp:
+		return "/" + v.String() + "/"
+	}
+	return fmt.Sprintf("predicate %s", functionName(raw))
+}
+
+// functionName returns the name of the function fn as reported by the runtime.
+func functionName(fn interface{}) string {
+	value := reflect.ValueOf(fn)
+	if value.Kind() != reflect.Func || value.IsNil() {
+		return ""
+	}
+	if f := runtime.FuncForPC(value.Pointer()); f != nil {
+		return f.Name()
+	}
+	return ""
+}
+
+func (p *pageImpl) Routes() []RouteInfo {
+	p.Lock()
+	defer p.Unlock()
+	return routeInfos(p.routes, false)
+}
+
+func (b *browserContextImpl) Routes() []RouteInfo {
+	b.Lock()
+	defer b.Unlock()
+	return routeInfos(b.routes, true)
+}
+
+// matchingRouteInfos describes the routes of chain matching url, in the order they are matched against it.
+func matchingRouteInfos(chain []routeChainEntry, url string) []RouteInfo {
+	infos := make([]RouteInfo, 0)
+	for _, entry := range chain {
+		if entry.Matches(url) {
+			info := entry.info()
+			info.Context = entry.page == nil
+			infos = append(infos, info)
+		}
+	}
+	return infos
+}
+
+func (p *pageImpl) ListRoutes(url string) []RouteInfo {
+	p.Lock()
+	pageRoutes := append([]*routeHandlerEntry(nil), p.routes...)
+	p.Unlock()
+	p.browserContext.Lock()
+	contextRoutes := append([]*routeHandlerEntry(nil), p.browserContext.routes...)
+	p.browserContext.Unlock()
+	return matchingRouteInfos(mergeRouteChains(p, pageRoutes, contextRoutes), url)
+}
+
+func (b *browserContextImpl) ListRoutes(url string) []RouteInfo {
+	b.Lock()
+	defer b.Unlock()
+	return matchingRouteInfos(mergeRouteChains(nil, nil, b.routes), url)
+}
@@ -0,0 +1,72 @@
+package playwright
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func handleRouteForTest(route Route) {}
+
+func TestRouteHandlerEntryInfo(t *testing.T) {
+	entry := newRouteHandlerEntry(newURLMatcher("**/api/*", nil), handleRouteForTest, 3)
+	entry.count = 1
+	info := entry.info()
+	require.Equal(t, "**/api/*", info.URL)
+	require.Equal(t, "**/api/*", info.Pattern)
+	require.True(t, strings.HasSuffix(info.Handler, ".handleRouteForTest"), info.Handler)
+	require.Equal(t, 3, info.Times)
+	require.Equal(t, 2, *info.Remaining)
+	require.Equal(t, 1, info.HitCount)
+
+	pattern := regexp.MustCompile(`\.png$`)
+	info = newRouteHandlerEntry(newURLMatcher(pattern, nil), handleRouteForTest).info()
+	require.Equal(t, `/\.png$/`, info.Pattern)
+	require.Nil(t, info.Remaining)
+
+	info = newRouteHandlerEntry(newURLMatcher(func(url string) bool { return true }, nil), handleRouteForTest).info()
+	require.True(t, strings.HasPrefix(info.Pattern, "predicate "), info.Pattern)
+}
+
+func TestInsertRouteHandlerEntryShouldOrderByPriority(t *testing.T) {
+	newEntry := func(priority int) *routeHandlerEntry {
+		entry := newRouteHandlerEntry(newURLMatcher("**/*", nil), handleRouteForTest)
+		entry.priority = priority
+		return entry
+	}
+	first, second, high, low := newEntry(0), newEntry(0), newEntry(5), newEntry(-1)
+	var routes []*routeHandlerEntry
+	for _, entry := range []*routeHandlerEntry{first, low, high, second} {
+		routes = insertRouteHandlerEntry(routes, entry)
+	}
+	require.Equal(t, []*routeHandlerEntry{high, second, first, low}, routes)
+}
+
+func TestMergeRouteChains(t *testing.T) {
+	newEntry := func(url string, priority int) *routeHandlerEntry {
+		entry := newRouteHandlerEntry(newURLMatcher(url, nil), handleRouteForTest)
+		entry.priority = priority
+		return entry
+	}
+	page := &pageImpl{}
+	pageRoutes := []*routeHandlerEntry{newEntry("**/page-high", 2), newEntry("**/page", 0), newEntry("**/page-low", -1)}
+	contextRoutes := []*routeHandlerEntry{newEntry("**/context-high", 3), newEntry("**/context", 0)}
+
+	chain := mergeRouteChains(page, pageRoutes, contextRoutes)
+	patterns := make([]string, 0, len(chain))
+	for _, entry := range chain {
+		patterns = append(patterns, entry.matcher.raw.(string))
+	}
+	// The routes of the page come first for the same priority.
+	require.Equal(t, []string{"**/context-high", "**/page-high", "**/page", "**/context", "**/page-low"}, patterns)
+	require.Nil(t, chain[0].page)
+	require.Equal(t, page, chain[1].page)
+
+	infos := matchingRouteInfos(chain, "https://example.com/context")
+	require.Len(t, infos, 1)
+	require.Equal(t, "**/context", infos[0].Pattern)
+	require.True(t, infos[0].Context)
+	require.Zero(t, infos[0].Priority)
+}
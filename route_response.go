@@ -0,0 +1,38 @@
+package playwright
+
+import (
+	"errors"
+)
+
+func (r *routeImpl) ContinueWithResponse(handler func(response APIResponse) (*RouteFulfillOptions, error), options ...RouteFetchOptions) error {
+	if err := r.checkNotHandled(); err != nil {
+		return err
+	}
+	response, err := r.Fetch(options...)
+	if err != nil {
+		return err
+	}
+	fulfill, err := handler(response)
+	if err != nil {
+		if disposeErr := response.Dispose(); disposeErr != nil {
+			logger.Printf("could not dispose response: %v\n", disposeErr)
+		}
+		abortErr := r.Abort()
+		if errors.Is(err, ErrAbortResponse) {
+			return abortErr
+		}
+		// The request was already performed, so it is aborted rather than left pending or sent again.
+		if abortErr != nil {
+			logger.Printf("could not abort request: %v\n", abortErr)
+		}
+		return err
+	}
+	option := RouteFulfillOptions{}
+	if fulfill != nil {
+		option = *fulfill
+	}
+	if option.Response == nil {
+		option.Response = response
+	}
+	return r.Fulfill(option)
+}
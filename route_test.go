@@ -0,0 +1,31 @@
+package playwright
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeFulfillBody(t *testing.T) {
+	for _, content := range [][]byte{{}, []byte("hello"), bytes.Repeat([]byte("0123456789"), 1000)} {
+		body, length, head, err := encodeFulfillBody(bytes.NewReader(content))
+		require.NoError(t, err)
+		require.Equal(t, base64.StdEncoding.EncodeToString(content), body)
+		require.Equal(t, len(content), length)
+		if len(content) > 512 {
+			require.Equal(t, content[:512], head)
+		} else {
+			require.Equal(t, content, head)
+		}
+	}
+
+	errRead := errors.New("connection reset")
+	_, _, _, err := encodeFulfillBody(io.MultiReader(strings.NewReader("partial"), iotest.ErrReader(errRead)))
+	require.ErrorIs(t, err, errRead)
+}
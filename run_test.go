@@ -1,10 +1,15 @@
 package playwright
 
 import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -65,6 +70,107 @@ func TestDriverDownloadHostEnv(t *testing.T) {
 	}
 }
 
+func testDriverArchive(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, content := range files {
+		f, err := w.Create(name)
+		require.NoError(t, err)
+		_, err = f.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestDriverDownloadMirrorsAndChecksum(t *testing.T) {
+	archive := testDriverArchive(t, map[string]string{"package/cli.js": "// cli"})
+	sum := sha256.Sum256(archive)
+	var requested []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = append(requested, r.URL.Path)
+		if strings.HasPrefix(r.URL.Path, "/corrupted/") {
+			_, _ = w.Write([]byte("not the driver"))
+			return
+		}
+		_, _ = w.Write(archive)
+	}))
+	defer ts.Close()
+
+	driver, err := NewDriver(&RunOptions{
+		DriverDirectory: t.TempDir(),
+		DownloadMirrors: []string{ts.URL + "/corrupted/", ts.URL + "/mirror"},
+		DriverChecksum:  hex.EncodeToString(sum[:]),
+	})
+	require.NoError(t, err)
+	body, err := driver.driverArchive()
+	require.NoError(t, err)
+	require.Equal(t, archive, body)
+	require.Len(t, requested, 2)
+	require.True(t, strings.HasPrefix(requested[1], "/mirror/builds/driver/"))
+
+	driver.options.DownloadMirrors = driver.options.DownloadMirrors[:1]
+	_, err = driver.driverArchive()
+	require.ErrorContains(t, err, "checksum mismatch")
+	require.Contains(t, driver.downloadEnv(), "PLAYWRIGHT_DOWNLOAD_HOST="+ts.URL+"/corrupted")
+}
+
+func TestDriverDownloadProxy(t *testing.T) {
+	proxied := ""
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer proxy.Close()
+
+	client, err := newDownloadClient(proxy.URL)
+	require.NoError(t, err)
+	_, err = downloadDriver(client, []string{"http://driver.example.com/builds/driver/playwright.zip"}, "")
+	require.ErrorContains(t, err, "404 Not Found")
+	require.Equal(t, "http://driver.example.com/builds/driver/playwright.zip", proxied)
+}
+
+func TestDriverInstallFromArchive(t *testing.T) {
+	archivePath := filepath.Join(t.TempDir(), "driver.zip")
+	require.NoError(t, os.WriteFile(archivePath, testDriverArchive(t, map[string]string{"package/cli.js": "// cli"}), 0o644))
+	driverDirectory := t.TempDir()
+	driver, err := NewDriver(&RunOptions{
+		DriverDirectory: driverDirectory,
+		DriverArchive:   archivePath,
+		DownloadMirrors: []string{"http://127.0.0.1:0"},
+	})
+	require.NoError(t, err)
+	body, err := driver.driverArchive()
+	require.NoError(t, err)
+	require.NoError(t, extractDriver(body, driver.driverDirectory))
+	content, err := os.ReadFile(getDriverCliJs(driver.driverDirectory))
+	require.NoError(t, err)
+	require.Equal(t, "// cli", string(content))
+
+	driver.options.DriverChecksum = strings.Repeat("0", 64)
+	_, err = driver.driverArchive()
+	require.ErrorContains(t, err, "checksum mismatch")
+
+	err = extractDriver(testDriverArchive(t, map[string]string{"../escape.js": ""}), driverDirectory)
+	require.ErrorContains(t, err, "invalid file path in driver archive")
+}
+
+func TestDriverCommandServiceWorkerNetworkEvents(t *testing.T) {
+	driver, err := NewDriver(&RunOptions{DriverDirectory: t.TempDir()})
+	require.NoError(t, err)
+	require.Nil(t, driver.Command("run-driver").Env)
+
+	driver.options.ServiceWorkerNetworkEvents = true
+	require.Contains(t, driver.Command("run-driver").Env, serviceWorkerNetworkEventsEnv+"=1")
+
+	driver.options.DownloadProxy = "http://proxy.example.com:3128"
+	cmd := driver.Command("install")
+	driver.addDownloadEnv(cmd)
+	require.Contains(t, cmd.Env, serviceWorkerNetworkEventsEnv+"=1")
+	require.Contains(t, cmd.Env, "HTTPS_PROXY=http://proxy.example.com:3128")
+}
+
 func TestShouldNotHangWhenPlaywrightUnexpectedExit(t *testing.T) {
 	if getBrowserName() != "chromium" {
 		t.Skip("chromium only")
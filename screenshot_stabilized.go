@@ -0,0 +1,139 @@
+package playwright
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+func (l *locatorImpl) ScreenshotStabilized(options ...LocatorScreenshotStabilizedOptions) ([]byte, error) {
+	if l.err != nil {
+		return nil, l.err
+	}
+	option := LocatorScreenshotStabilizedOptions{}
+	if len(options) == 1 {
+		option = options[0]
+	}
+	stableFrames := 2
+	if option.StableFrames != nil {
+		if *option.StableFrames < 1 {
+			return nil, fmt.Errorf("stableFrames must be at least 1, got %d", *option.StableFrames)
+		}
+		stableFrames = *option.StableFrames
+	}
+	interval := 100 * time.Millisecond
+	if option.Interval != nil {
+		interval = time.Duration(*option.Interval * float64(time.Millisecond))
+	}
+	timeout := l.frame.page.timeoutSettings.Timeout()
+	if option.Timeout != nil {
+		timeout = *option.Timeout
+	}
+	var deadline time.Time
+	if timeout > 0 {
+		deadline = time.Now().Add(time.Duration(timeout * float64(time.Millisecond)))
+	}
+	remaining := func() *float64 {
+		if deadline.IsZero() {
+			return Float(0)
+		}
+		left := float64(time.Until(deadline).Milliseconds())
+		if left < 1 {
+			left = 1
+		}
+		return Float(left)
+	}
+	// The frames are compared as PNG images, whatever the type of the returned screenshot.
+	frameOption := LocatorScreenshotOptions{
+		Animations:     option.Animations,
+		Caret:          option.Caret,
+		Mask:           option.Mask,
+		MaskColor:      option.MaskColor,
+		OmitBackground: option.OmitBackground,
+		Scale:          option.Scale,
+		Style:          option.Style,
+		Type:           ScreenshotTypePng,
+	}
+	comparison := snapshotComparison{
+		maxDiffPixels: option.MaxDiffPixels,
+		threshold:     option.Threshold,
+	}
+
+	var (
+		previous    []byte
+		previousBox *Rect
+		matching    = 0
+	)
+	for {
+		box, err := l.BoundingBox(LocatorBoundingBoxOptions{Timeout: remaining()})
+		if err != nil {
+			return nil, err
+		}
+		frameOption.Timeout = remaining()
+		frame, err := l.Screenshot(frameOption)
+		if err != nil {
+			return nil, err
+		}
+		same := previous != nil && sameRect(previousBox, box)
+		if same {
+			message, _, err := compareSnapshots(previous, frame, comparison)
+			if err != nil {
+				return nil, err
+			}
+			same = message == ""
+		}
+		if same {
+			matching++
+		} else {
+			matching = 1
+		}
+		previous, previousBox = frame, box
+		if matching >= stableFrames {
+			return l.finishStabilizedScreenshot(frame, option, remaining())
+		}
+		if !deadline.IsZero() && time.Now().Add(interval).After(deadline) {
+			return nil, newTimeoutError(fmt.Sprintf("Timeout %vms exceeded while waiting for the element to stop changing", timeout))
+		}
+		time.Sleep(interval)
+	}
+}
+
+// finishStabilizedScreenshot saves the stable frame to the path of the options, or takes the screenshot again if it
+// must be a JPEG image.
+func (l *locatorImpl) finishStabilizedScreenshot(frame []byte, option LocatorScreenshotStabilizedOptions, timeout *float64) ([]byte, error) {
+	jpeg := option.Type != nil && *option.Type == *ScreenshotTypeJpeg
+	if option.Path != nil {
+		ext := strings.ToLower(filepath.Ext(*option.Path))
+		jpeg = jpeg || ext == ".jpg" || ext == ".jpeg"
+	}
+	if jpeg {
+		return l.Screenshot(LocatorScreenshotOptions{
+			Animations:     option.Animations,
+			Caret:          option.Caret,
+			Mask:           option.Mask,
+			MaskColor:      option.MaskColor,
+			OmitBackground: option.OmitBackground,
+			Path:           option.Path,
+			Quality:        option.Quality,
+			Scale:          option.Scale,
+			Style:          option.Style,
+			Timeout:        timeout,
+			Type:           ScreenshotTypeJpeg,
+		})
+	}
+	if option.Path != nil {
+		if err := os.WriteFile(*option.Path, frame, 0o644); err != nil {
+			return nil, err
+		}
+	}
+	return frame, nil
+}
+
+func sameRect(a, b *Rect) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
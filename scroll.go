@@ -0,0 +1,181 @@
+package playwright
+
+import (
+	"errors"
+	"fmt"
+)
+
+func getScrollBehavior(in string) *ScrollBehavior {
+	v := ScrollBehavior(in)
+	return &v
+}
+
+// ScrollBehavior is how scrolling is animated, see [Page.ScrollTo].
+type ScrollBehavior string
+
+var (
+	ScrollBehaviorAuto    *ScrollBehavior = getScrollBehavior("auto")
+	ScrollBehaviorSmooth                  = getScrollBehavior("smooth")
+	ScrollBehaviorInstant                 = getScrollBehavior("instant")
+)
+
+// scrollScript scrolls the element, or the page if it is null, and waits for the scrolling to end. Scrolling ends on
+// the scrollend event, or once the position stayed the same for the settle time in browsers without it.
+const scrollScript = `async (element, { action, x, y, behavior, settle, timeout }) => {
+  const target = element || window;
+  const position = () => element ? { x: element.scrollLeft, y: element.scrollTop } : { x: window.scrollX, y: window.scrollY };
+  if (action === 'position')
+    return { ended: true, ...position() };
+  const scrollEnd = new Promise(resolve => {
+    const start = performance.now();
+    let last = position();
+    let lastChange = start;
+    let timer;
+    const finish = ended => {
+      clearTimeout(timer);
+      target.removeEventListener('scrollend', onScrollEnd);
+      resolve(ended);
+    };
+    const onScrollEnd = () => finish(true);
+    target.addEventListener('scrollend', onScrollEnd);
+    const check = () => {
+      const now = performance.now();
+      const current = position();
+      if (current.x !== last.x || current.y !== last.y) {
+        last = current;
+        lastChange = now;
+      } else if (now - lastChange >= settle) {
+        return finish(true);
+      }
+      if (timeout && now - start >= timeout)
+        return finish(false);
+      timer = setTimeout(check, 16);
+    };
+    timer = setTimeout(check, 16);
+  });
+  const options = { left: x, top: y, behavior };
+  if (action === 'scrollTo')
+    target.scrollTo(options);
+  else if (action === 'scrollBy')
+    target.scrollBy(options);
+  const ended = await scrollEnd;
+  return { ended, ...position() };
+}`
+
+// newScrollParams returns the parameters of scrollScript, the timeout is set by the caller.
+func newScrollParams(action string, x, y float64, behavior *ScrollBehavior, settle *float64) map[string]interface{} {
+	params := map[string]interface{}{
+		"action": action,
+		"x":      x,
+		"y":      y,
+		"settle": 100.0,
+	}
+	if behavior != nil {
+		params["behavior"] = string(*behavior)
+	}
+	if settle != nil {
+		params["settle"] = *settle
+	}
+	return params
+}
+
+// scrollResult converts the result of scrollScript, failing if the scrolling did not end within timeout.
+func scrollResult(result interface{}, timeout float64) (*Position, error) {
+	value, ok := result.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("could not read scroll position")
+	}
+	if ended, _ := value["ended"].(bool); !ended {
+		return nil, newTimeoutError(fmt.Sprintf("Timeout %vms exceeded while waiting for scrolling to end", timeout))
+	}
+	return &Position{X: evaluatedNumber(value["x"]), Y: evaluatedNumber(value["y"])}, nil
+}
+
+func (p *pageImpl) scroll(params map[string]interface{}, timeout *float64) (*Position, error) {
+	t := p.timeoutSettings.Timeout()
+	if timeout != nil {
+		t = *timeout
+	}
+	params["timeout"] = t
+	result, err := p.Evaluate(fmt.Sprintf("arg => (%s)(null, arg)", scrollScript), params)
+	if err != nil {
+		return nil, err
+	}
+	return scrollResult(result, t)
+}
+
+func (p *pageImpl) ScrollPosition() (*Position, error) {
+	return p.scroll(newScrollParams("position", 0, 0, nil, nil), nil)
+}
+
+func (p *pageImpl) ScrollTo(x, y float64, options ...PageScrollToOptions) (*Position, error) {
+	option := PageScrollToOptions{}
+	if len(options) == 1 {
+		option = options[0]
+	}
+	return p.scroll(newScrollParams("scrollTo", x, y, option.Behavior, nil), option.Timeout)
+}
+
+func (p *pageImpl) ScrollBy(deltaX, deltaY float64, options ...PageScrollByOptions) (*Position, error) {
+	option := PageScrollByOptions{}
+	if len(options) == 1 {
+		option = options[0]
+	}
+	return p.scroll(newScrollParams("scrollBy", deltaX, deltaY, option.Behavior, nil), option.Timeout)
+}
+
+func (p *pageImpl) WaitForScrollEnd(options ...PageWaitForScrollEndOptions) (*Position, error) {
+	option := PageWaitForScrollEndOptions{}
+	if len(options) == 1 {
+		option = options[0]
+	}
+	return p.scroll(newScrollParams("wait", 0, 0, nil, option.Settle), option.Timeout)
+}
+
+func (l *locatorImpl) scroll(params map[string]interface{}, timeout *float64) (*Position, error) {
+	if l.err != nil {
+		return nil, l.err
+	}
+	t := l.frame.page.timeoutSettings.Timeout()
+	if timeout != nil {
+		t = *timeout
+	}
+	params["timeout"] = t
+	result, err := l.Evaluate(scrollScript, params, LocatorEvaluateOptions{Timeout: Float(t)})
+	if err != nil {
+		return nil, err
+	}
+	return scrollResult(result, t)
+}
+
+func (l *locatorImpl) ScrollPosition(options ...LocatorScrollPositionOptions) (*Position, error) {
+	option := LocatorScrollPositionOptions{}
+	if len(options) == 1 {
+		option = options[0]
+	}
+	return l.scroll(newScrollParams("position", 0, 0, nil, nil), option.Timeout)
+}
+
+func (l *locatorImpl) ScrollTo(x, y float64, options ...LocatorScrollToOptions) (*Position, error) {
+	option := LocatorScrollToOptions{}
+	if len(options) == 1 {
+		option = options[0]
+	}
+	return l.scroll(newScrollParams("scrollTo", x, y, option.Behavior, nil), option.Timeout)
+}
+
+func (l *locatorImpl) ScrollBy(deltaX, deltaY float64, options ...LocatorScrollByOptions) (*Position, error) {
+	option := LocatorScrollByOptions{}
+	if len(options) == 1 {
+		option = options[0]
+	}
+	return l.scroll(newScrollParams("scrollBy", deltaX, deltaY, option.Behavior, nil), option.Timeout)
+}
+
+func (l *locatorImpl) WaitForScrollEnd(options ...LocatorWaitForScrollEndOptions) (*Position, error) {
+	option := LocatorWaitForScrollEndOptions{}
+	if len(options) == 1 {
+		option = options[0]
+	}
+	return l.scroll(newScrollParams("wait", 0, 0, nil, option.Settle), option.Timeout)
+}
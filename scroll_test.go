@@ -0,0 +1,26 @@
+package playwright
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScrollResult(t *testing.T) {
+	position, err := scrollResult(map[string]interface{}{"ended": true, "x": 0, "y": 120.5}, 1000)
+	require.NoError(t, err)
+	require.Equal(t, &Position{X: 0, Y: 120.5}, position)
+
+	_, err = scrollResult(map[string]interface{}{"ended": false, "x": 0, "y": 10}, 1000)
+	require.ErrorIs(t, err, ErrTimeout)
+	var timeoutErr *TimeoutError
+	require.ErrorAs(t, err, &timeoutErr)
+	require.EqualError(t, err, "Timeout 1000ms exceeded while waiting for scrolling to end")
+}
+
+func TestNewScrollParams(t *testing.T) {
+	require.Equal(t, map[string]interface{}{
+		"action": "scrollBy", "x": 0.0, "y": 50.0, "settle": 100.0, "behavior": "smooth",
+	}, newScrollParams("scrollBy", 0, 50, ScrollBehaviorSmooth, nil))
+	require.Equal(t, 250.0, newScrollParams("wait", 0, 0, nil, Float(250))["settle"])
+}
@@ -68,8 +68,12 @@ func (s *selectorsImpl) addChannel(channel *selectorsOwnerImpl) {
 	s.channels.Store(channel.guid, channel)
 	for _, params := range s.registrations {
 		channel.channel.SendNoReply("register", params)
+	}
+	// The attribute is also used by the browsers launched or connected after it was set, e.g. in the strict mode
+	// errors and the locators generated by codegen.
+	if name := getTestIdAttributeName(); name != defaultTestIdAttributeName {
 		channel.channel.SendNoReply("setTestIdAttributeName", map[string]interface{}{
-			"testIdAttributeName": getTestIdAttributeName(),
+			"testIdAttributeName": name,
 		})
 	}
 }
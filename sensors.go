@@ -0,0 +1,228 @@
+package playwright
+
+import (
+	"errors"
+	"fmt"
+)
+
+func getSensorType(in string) *SensorType {
+	v := SensorType(in)
+	return &v
+}
+
+// SensorType is a sensor of the [Generic Sensor API] emulated with [Page.EmulateSensor].
+//
+// [Generic Sensor API]: https://developer.mozilla.org/en-US/docs/Web/API/Sensor_APIs
+type SensorType string
+
+var (
+	SensorTypeAbsoluteOrientation *SensorType = getSensorType("absolute-orientation")
+	SensorTypeAccelerometer                   = getSensorType("accelerometer")
+	SensorTypeAmbientLight                    = getSensorType("ambient-light")
+	SensorTypeGravity                         = getSensorType("gravity")
+	SensorTypeGyroscope                       = getSensorType("gyroscope")
+	SensorTypeLinearAcceleration              = getSensorType("linear-acceleration")
+	SensorTypeMagnetometer                    = getSensorType("magnetometer")
+	SensorTypeRelativeOrientation             = getSensorType("relative-orientation")
+)
+
+// ErrSensorsNotSupported is returned when the device orientation or a sensor is emulated in a browser other than
+// Chromium.
+var ErrSensorsNotSupported = errors.New("sensor emulation is only supported in Chromium")
+
+// sensorReadingSizes are the number of values of the readings of the sensors: a single value, x, y and z, or a
+// quaternion.
+var sensorReadingSizes = map[SensorType]int{
+	*SensorTypeAbsoluteOrientation: 4,
+	*SensorTypeAccelerometer:       3,
+	*SensorTypeAmbientLight:        1,
+	*SensorTypeGravity:             3,
+	*SensorTypeGyroscope:           3,
+	*SensorTypeLinearAcceleration:  3,
+	*SensorTypeMagnetometer:        3,
+	*SensorTypeRelativeOrientation: 4,
+}
+
+// DeviceOrientation is the orientation of the device in degrees, as in a [DeviceOrientationEvent].
+//
+// [DeviceOrientationEvent]: https://developer.mozilla.org/en-US/docs/Web/API/DeviceOrientationEvent
+type DeviceOrientation struct {
+	// Rotation around the z axis, between 0 and 360.
+	Alpha float64 `json:"alpha"`
+	// Rotation around the x axis, between -180 and 180.
+	Beta float64 `json:"beta"`
+	// Rotation around the y axis, between -90 and 90.
+	Gamma float64 `json:"gamma"`
+	// Whether the orientation is relative to the Earth, rather than to an arbitrary frame.
+	Absolute bool `json:"absolute"`
+}
+
+// DeviceMotionVector is an acceleration of a [DeviceMotion], in meters per second squared.
+type DeviceMotionVector struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+	Z float64 `json:"z"`
+}
+
+// DeviceRotationRate is the rotation rate of a [DeviceMotion], in degrees per second.
+type DeviceRotationRate struct {
+	Alpha float64 `json:"alpha"`
+	Beta  float64 `json:"beta"`
+	Gamma float64 `json:"gamma"`
+}
+
+// DeviceMotion is the motion of the device, as in a [DeviceMotionEvent]. Unset values are null in the event.
+//
+// [DeviceMotionEvent]: https://developer.mozilla.org/en-US/docs/Web/API/DeviceMotionEvent
+type DeviceMotion struct {
+	// Acceleration of the device, without the effect of gravity.
+	Acceleration *DeviceMotionVector `json:"acceleration"`
+	// Acceleration of the device, with the effect of gravity.
+	AccelerationIncludingGravity *DeviceMotionVector `json:"accelerationIncludingGravity"`
+	RotationRate                 *DeviceRotationRate `json:"rotationRate"`
+	// Interval at which the data is obtained from the device, in milliseconds. Defaults to `16`.
+	Interval *float64 `json:"interval"`
+}
+
+// dispatchDeviceOrientationScript dispatches a deviceorientation event on the window of a frame.
+const dispatchDeviceOrientationScript = `orientation => {
+  window.dispatchEvent(new DeviceOrientationEvent('deviceorientation', orientation));
+}`
+
+// dispatchDeviceMotionScript dispatches a devicemotion event on the window of a frame.
+const dispatchDeviceMotionScript = `motion => {
+  window.dispatchEvent(new DeviceMotionEvent('devicemotion', {
+    acceleration: motion.acceleration || undefined,
+    accelerationIncludingGravity: motion.accelerationIncludingGravity || undefined,
+    rotationRate: motion.rotationRate || undefined,
+    interval: motion.interval === undefined ? 16 : motion.interval,
+  }));
+}`
+
+func (p *pageImpl) EmulateDeviceOrientation(options ...PageEmulateDeviceOrientationOptions) error {
+	session, err := p.cdpSession()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrSensorsNotSupported, err)
+	}
+	if len(options) == 0 || options[0].Orientation == nil {
+		_, err := session.Send("DeviceOrientation.clearDeviceOrientationOverride", map[string]interface{}{})
+		return err
+	}
+	orientation := options[0].Orientation
+	_, err = session.Send("DeviceOrientation.setDeviceOrientationOverride", map[string]interface{}{
+		"alpha": orientation.Alpha,
+		"beta":  orientation.Beta,
+		"gamma": orientation.Gamma,
+	})
+	return err
+}
+
+// sensorReading returns the reading of Emulation.setSensorOverrideReadings for the values of a sensor.
+func sensorReading(sensor SensorType, values []float64) (map[string]interface{}, error) {
+	size, ok := sensorReadingSizes[sensor]
+	if !ok {
+		return nil, fmt.Errorf("unknown sensor type: %s", sensor)
+	}
+	if len(values) != size {
+		return nil, fmt.Errorf("invalid reading of %s sensor: expected %d values, got %d", sensor, size, len(values))
+	}
+	switch size {
+	case 1:
+		return map[string]interface{}{"single": map[string]interface{}{"value": values[0]}}, nil
+	case 3:
+		return map[string]interface{}{"xyz": map[string]interface{}{"x": values[0], "y": values[1], "z": values[2]}}, nil
+	default:
+		return map[string]interface{}{"quaternion": map[string]interface{}{
+			"x": values[0], "y": values[1], "z": values[2], "w": values[3],
+		}}, nil
+	}
+}
+
+func (p *pageImpl) EmulateSensor(sensor *SensorType, options ...PageEmulateSensorOptions) error {
+	if sensor == nil {
+		return errors.New("sensor type is required")
+	}
+	if _, ok := sensorReadingSizes[*sensor]; !ok {
+		return fmt.Errorf("unknown sensor type: %s", *sensor)
+	}
+	var reading map[string]interface{}
+	available := true
+	if len(options) == 1 {
+		if options[0].Available != nil {
+			available = *options[0].Available
+		}
+		if options[0].Reading != nil {
+			var err error
+			if reading, err = sensorReading(*sensor, options[0].Reading); err != nil {
+				return err
+			}
+		}
+	}
+	session, err := p.cdpSession()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrSensorsNotSupported, err)
+	}
+	p.sensorsMu.Lock()
+	defer p.sensorsMu.Unlock()
+	wasAvailable, overridden := p.sensors[*sensor]
+	if len(options) == 0 {
+		if !overridden {
+			return nil
+		}
+		delete(p.sensors, *sensor)
+		_, err := session.Send("Emulation.setSensorOverrideEnabled", map[string]interface{}{
+			"enabled": false,
+			"type":    *sensor,
+		})
+		return err
+	}
+	// The metadata of a sensor is set when the override is enabled, so it is enabled again when it changes.
+	if !overridden || wasAvailable != available {
+		if overridden {
+			if _, err := session.Send("Emulation.setSensorOverrideEnabled", map[string]interface{}{
+				"enabled": false,
+				"type":    *sensor,
+			}); err != nil {
+				return err
+			}
+			delete(p.sensors, *sensor)
+		}
+		if _, err := session.Send("Emulation.setSensorOverrideEnabled", map[string]interface{}{
+			"enabled":  true,
+			"type":     *sensor,
+			"metadata": map[string]interface{}{"available": available},
+		}); err != nil {
+			return err
+		}
+		if p.sensors == nil {
+			p.sensors = make(map[SensorType]bool)
+		}
+		p.sensors[*sensor] = available
+	}
+	if reading == nil || !available {
+		return nil
+	}
+	_, err = session.Send("Emulation.setSensorOverrideReadings", map[string]interface{}{
+		"type":    *sensor,
+		"reading": reading,
+	})
+	return err
+}
+
+func (p *pageImpl) DispatchDeviceOrientation(orientation DeviceOrientation) error {
+	return p.dispatchInFrames(dispatchDeviceOrientationScript, transformStructIntoMapIfNeeded(orientation))
+}
+
+func (p *pageImpl) DispatchDeviceMotion(motion DeviceMotion) error {
+	return p.dispatchInFrames(dispatchDeviceMotionScript, transformStructIntoMapIfNeeded(motion))
+}
+
+// dispatchInFrames evaluates script with arg in the frames of the page, ignoring the frames detached meanwhile.
+func (p *pageImpl) dispatchInFrames(script string, arg interface{}) error {
+	for _, frame := range p.Frames() {
+		if _, err := frame.Evaluate(script, arg); err != nil && !errors.Is(err, ErrTargetClosed) {
+			return err
+		}
+	}
+	return nil
+}
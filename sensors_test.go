@@ -0,0 +1,26 @@
+package playwright
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSensorReading(t *testing.T) {
+	reading, err := sensorReading(*SensorTypeAmbientLight, []float64{42})
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"single": map[string]interface{}{"value": 42.0}}, reading)
+
+	reading, err = sensorReading(*SensorTypeAccelerometer, []float64{1, 2, 9.8})
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"xyz": map[string]interface{}{"x": 1.0, "y": 2.0, "z": 9.8}}, reading)
+
+	reading, err = sensorReading(*SensorTypeRelativeOrientation, []float64{0, 0, 0, 1})
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"quaternion": map[string]interface{}{"x": 0.0, "y": 0.0, "z": 0.0, "w": 1.0}}, reading)
+
+	_, err = sensorReading(*SensorTypeGyroscope, []float64{1})
+	require.EqualError(t, err, "invalid reading of gyroscope sensor: expected 3 values, got 1")
+	_, err = sensorReading("proximity", []float64{1})
+	require.EqualError(t, err, "unknown sensor type: proximity")
+}
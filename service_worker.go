@@ -0,0 +1,66 @@
+package playwright
+
+import (
+	"errors"
+)
+
+// errServiceWorkerFound stops waiting for a service worker which is already running.
+var errServiceWorkerFound = errors.New("service worker found")
+
+func (b *browserContextImpl) OnServiceWorkerClose(fn func(Worker)) {
+	b.On("serviceworkerclose", fn)
+}
+
+func (b *browserContextImpl) WaitForServiceWorker(options ...BrowserContextWaitForServiceWorkerOptions) (Worker, error) {
+	option := BrowserContextWaitForServiceWorkerOptions{}
+	if len(options) == 1 {
+		option = options[0]
+	}
+	var matcher *urlMatcher
+	if option.URL != nil {
+		matcher = newURLMatcher(option.URL, b.options.BaseURL)
+	}
+	matches := func(worker Worker) bool {
+		return (matcher == nil || matcher.Matches(worker.URL())) && (option.Predicate == nil || option.Predicate(worker))
+	}
+	w := b.waiterForEvent("serviceworker", BrowserContextWaitForEventOptions{Predicate: matches, Timeout: option.Timeout})
+	var found Worker
+	// The running workers are checked once the waiter listens, so that a worker starting meanwhile is not missed.
+	ret, err := w.RunAndWait(func() error {
+		for _, worker := range b.ServiceWorkers() {
+			if matches(worker) {
+				found = worker
+				return errServiceWorkerFound
+			}
+		}
+		return nil
+	})
+	if found != nil {
+		return found, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ret.(Worker), nil
+}
+
+func (p *pageImpl) UpdateServiceWorkers() error {
+	return p.callServiceWorkerRegistrations("update")
+}
+
+func (p *pageImpl) UnregisterServiceWorkers() error {
+	return p.callServiceWorkerRegistrations("unregister")
+}
+
+// callServiceWorkerRegistrations calls method on each service worker registration of the origin of the page. It does
+// nothing where service workers are not available, such as in the pages served over HTTP by other hosts than
+// localhost.
+func (p *pageImpl) callServiceWorkerRegistrations(method string) error {
+	_, err := p.mainFrame.Evaluate(`async method => {
+		if (!navigator.serviceWorker)
+			return;
+		const registrations = await navigator.serviceWorker.getRegistrations();
+		await Promise.all(registrations.map(registration => registration[method]()));
+	}`, method)
+	return err
+}
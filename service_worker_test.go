@@ -0,0 +1,40 @@
+package playwright
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newServiceWorkerForTest(url string) *workerImpl {
+	worker := &workerImpl{}
+	worker.initializer = map[string]interface{}{"url": url}
+	return worker
+}
+
+func TestWaitForServiceWorker(t *testing.T) {
+	context := &browserContextImpl{options: &BrowserNewContextOptions{}, timeoutSettings: newTimeoutSettings(nil)}
+	running := newServiceWorkerForTest("https://example.com/sw.js")
+	context.serviceWorkers = []Worker{running}
+
+	// A running worker is returned right away.
+	worker, err := context.WaitForServiceWorker(BrowserContextWaitForServiceWorkerOptions{URL: "**/sw.js"})
+	require.NoError(t, err)
+	require.Equal(t, running, worker)
+
+	started := newServiceWorkerForTest("https://example.com/other-sw.js")
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		context.Emit("serviceworker", newServiceWorkerForTest("https://example.com/ignored.js"))
+		context.Emit("serviceworker", started)
+	}()
+	worker, err = context.WaitForServiceWorker(BrowserContextWaitForServiceWorkerOptions{
+		Predicate: func(w Worker) bool { return w.URL() == "https://example.com/other-sw.js" },
+	})
+	require.NoError(t, err)
+	require.Equal(t, started, worker)
+
+	_, err = context.WaitForServiceWorker(BrowserContextWaitForServiceWorkerOptions{URL: "**/missing.js", Timeout: Float(50)})
+	require.ErrorIs(t, err, ErrTimeout)
+}
@@ -0,0 +1,113 @@
+package playwright
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// SessionState is a checkpoint of a [BrowserContext]: its storage state and the URL and
+// scroll position of every open page. It can be written to disk and restored into a fresh
+// context, so long-running automation survives process restarts.
+type SessionState struct {
+	StorageState *StorageState `json:"storageState"`
+	Pages        []SessionPage `json:"pages"`
+}
+
+// SessionPage is the state of a single page in a [SessionState].
+type SessionPage struct {
+	URL     string  `json:"url"`
+	ScrollX float64 `json:"scrollX"`
+	ScrollY float64 `json:"scrollY"`
+}
+
+// SaveSession captures the session state of context. If path is given, the state is also
+// written to that file as JSON.
+func SaveSession(context BrowserContext, path ...string) (*SessionState, error) {
+	storageState, err := context.StorageState()
+	if err != nil {
+		return nil, fmt.Errorf("could not get storage state: %w", err)
+	}
+	session := &SessionState{
+		StorageState: storageState,
+		Pages:        make([]SessionPage, 0),
+	}
+	for _, page := range context.Pages() {
+		state := SessionPage{URL: page.URL()}
+		scroll, err := page.Evaluate(`() => JSON.stringify({ scrollX: window.scrollX, scrollY: window.scrollY })`)
+		if err != nil {
+			return nil, fmt.Errorf("could not get scroll position of %s: %w", state.URL, err)
+		}
+		if err := json.Unmarshal([]byte(scroll.(string)), &state); err != nil {
+			return nil, fmt.Errorf("could not get scroll position of %s: %w", state.URL, err)
+		}
+		session.Pages = append(session.Pages, state)
+	}
+	if len(path) == 1 {
+		data, err := json.Marshal(session)
+		if err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(path[0], data, 0o600); err != nil {
+			return nil, err
+		}
+	}
+	return session, nil
+}
+
+// LoadSession reads a session state written by [SaveSession].
+func LoadSession(path string) (*SessionState, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var session SessionState
+	if err := json.Unmarshal(data, &session); err != nil {
+		return nil, fmt.Errorf("could not parse session state: %w", err)
+	}
+	return &session, nil
+}
+
+// Restore creates a new context in browser with the saved storage state, then reopens
+// every saved page and scrolls it back to its position. The storage state overrides
+// options.StorageState and options.StorageStatePath.
+func (s *SessionState) Restore(browser Browser, options ...BrowserNewContextOptions) (BrowserContext, error) {
+	contextOptions := BrowserNewContextOptions{}
+	if len(options) == 1 {
+		contextOptions = options[0]
+	}
+	if s.StorageState != nil {
+		contextOptions.StorageState = s.StorageState.ToOptionalStorageState()
+		contextOptions.StorageStatePath = nil
+	}
+	context, err := browser.NewContext(contextOptions)
+	if err != nil {
+		return nil, err
+	}
+	for _, state := range s.Pages {
+		if err := restoreSessionPage(context, state); err != nil {
+			_ = context.Close()
+			return nil, err
+		}
+	}
+	return context, nil
+}
+
+func restoreSessionPage(context BrowserContext, state SessionPage) error {
+	page, err := context.NewPage()
+	if err != nil {
+		return err
+	}
+	if state.URL == "" || state.URL == "about:blank" {
+		return nil
+	}
+	if _, err := page.Goto(state.URL); err != nil {
+		return fmt.Errorf("could not restore %s: %w", state.URL, err)
+	}
+	if state.ScrollX != 0 || state.ScrollY != 0 {
+		if _, err := page.Evaluate(`([x, y]) => window.scrollTo(x, y)`, []float64{state.ScrollX, state.ScrollY}); err != nil {
+			return fmt.Errorf("could not restore scroll position of %s: %w", state.URL, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package playwright
+
+import (
+	"errors"
+	"sync"
+)
+
+// sharedPlaywright is a reference counted Playwright instance, see [Default].
+type sharedPlaywright struct {
+	sync.Mutex
+	pw    *Playwright
+	refs  int
+	start func(options ...*RunOptions) (*Playwright, error)
+	stop  func(pw *Playwright) error
+}
+
+var defaultPlaywright = &sharedPlaywright{
+	start: Run,
+	stop:  (*Playwright).Stop,
+}
+
+// Default returns the process-wide shared Playwright instance. The instance is started
+// with options on the first call, later calls reuse it and ignore options. Every
+// successful call must be paired with a call to [ReleaseDefault], the instance is
+// stopped when the last reference is released.
+//
+// Libraries embedding playwright-go should prefer Default over [Run], so they don't
+// start several drivers or stop an instance that is still used by someone else.
+func Default(options ...*RunOptions) (*Playwright, error) {
+	return defaultPlaywright.acquire(options...)
+}
+
+// ReleaseDefault releases a reference acquired with [Default] and stops the shared
+// instance once it is no longer referenced.
+func ReleaseDefault() error {
+	return defaultPlaywright.release()
+}
+
+func (s *sharedPlaywright) acquire(options ...*RunOptions) (*Playwright, error) {
+	s.Lock()
+	defer s.Unlock()
+	if s.pw == nil {
+		pw, err := s.start(options...)
+		if err != nil {
+			return nil, err
+		}
+		s.pw = pw
+	}
+	s.refs++
+	return s.pw, nil
+}
+
+func (s *sharedPlaywright) release() error {
+	s.Lock()
+	defer s.Unlock()
+	if s.refs == 0 {
+		return errors.New("playwright: default instance is not acquired")
+	}
+	s.refs--
+	if s.refs > 0 {
+		return nil
+	}
+	pw := s.pw
+	s.pw = nil
+	return s.stop(pw)
+}
@@ -0,0 +1,66 @@
+package playwright
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSharedPlaywrightReferenceCounting(t *testing.T) {
+	var started, stopped int
+	shared := &sharedPlaywright{
+		start: func(options ...*RunOptions) (*Playwright, error) {
+			started++
+			return &Playwright{}, nil
+		},
+		stop: func(pw *Playwright) error {
+			stopped++
+			return nil
+		},
+	}
+
+	wg := &sync.WaitGroup{}
+	instances := make(chan *Playwright, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			pw, err := shared.acquire()
+			require.NoError(t, err)
+			instances <- pw
+		}()
+	}
+	wg.Wait()
+	close(instances)
+	first := <-instances
+	for pw := range instances {
+		require.Same(t, first, pw)
+	}
+	require.Equal(t, 1, started)
+
+	for i := 0; i < 9; i++ {
+		require.NoError(t, shared.release())
+	}
+	require.Equal(t, 0, stopped)
+	require.NoError(t, shared.release())
+	require.Equal(t, 1, stopped)
+	require.Error(t, shared.release())
+
+	pw, err := shared.acquire()
+	require.NoError(t, err)
+	require.NotSame(t, first, pw)
+	require.Equal(t, 2, started)
+}
+
+func TestSharedPlaywrightStartError(t *testing.T) {
+	shared := &sharedPlaywright{
+		start: func(options ...*RunOptions) (*Playwright, error) {
+			return nil, errors.New("driver not installed")
+		},
+	}
+	_, err := shared.acquire()
+	require.EqualError(t, err, "driver not installed")
+	require.Error(t, shared.release())
+}
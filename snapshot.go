@@ -0,0 +1,367 @@
+package playwright
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// SnapshotUpdateEnv is the environment variable controlling how snapshot assertions
+// update the stored snapshots:
+//   - "missing" (default): snapshots that don't exist yet are written, and the assertion fails.
+//   - "all": snapshots that don't match are overwritten, and the assertion passes.
+//   - "none": snapshots are never written.
+const SnapshotUpdateEnv = "PLAYWRIGHT_UPDATE_SNAPSHOTS"
+
+// DefaultSnapshotDir is the directory snapshots are stored in unless [ExpectOptions.SnapshotDir]
+// is set. Snapshots are kept in a subdirectory per platform, because rendering differs
+// between operating systems.
+const DefaultSnapshotDir = "testdata/snapshots"
+
+type snapshotUpdateMode int
+
+const (
+	snapshotUpdateMissing snapshotUpdateMode = iota
+	snapshotUpdateAll
+	snapshotUpdateNone
+)
+
+func snapshotUpdateModeFromEnv() snapshotUpdateMode {
+	switch strings.ToLower(os.Getenv(SnapshotUpdateEnv)) {
+	case "all", "1", "true":
+		return snapshotUpdateAll
+	case "none", "0", "false":
+		return snapshotUpdateNone
+	}
+	return snapshotUpdateMissing
+}
+
+// snapshotComparison holds the thresholds two images are compared with.
+type snapshotComparison struct {
+	maxDiffPixels     *int
+	maxDiffPixelRatio *float64
+	threshold         *float64
+}
+
+// snapshotResult is the outcome of comparing a value with the stored snapshot.
+type snapshotResult struct {
+	missing bool
+	// message describes the difference, it is empty if the value matches.
+	message string
+	diff    []byte
+}
+
+func (r *snapshotResult) matches() bool {
+	return !r.missing && r.message == ""
+}
+
+// snapshotFile is a snapshot stored in dir/<platform>/name.
+type snapshotFile struct {
+	path string
+}
+
+func newSnapshotFile(dir string, name string) (*snapshotFile, error) {
+	if name == "" {
+		return nil, errors.New("snapshot name must not be empty")
+	}
+	name = filepath.Clean(filepath.FromSlash(name))
+	if filepath.IsAbs(name) || name == ".." || strings.HasPrefix(name, ".."+string(filepath.Separator)) {
+		return nil, fmt.Errorf("snapshot name %q must be a relative path inside the snapshot directory", name)
+	}
+	if dir == "" {
+		dir = DefaultSnapshotDir
+	}
+	return &snapshotFile{path: filepath.Join(dir, runtime.GOOS, name)}, nil
+}
+
+// outputPath returns the path of a file written next to the snapshot when it doesn't match,
+// e.g. name-actual.png.
+func (s *snapshotFile) outputPath(suffix string, ext string) string {
+	base := strings.TrimSuffix(s.path, filepath.Ext(s.path))
+	return base + "-" + suffix + ext
+}
+
+func (s *snapshotFile) compare(actual []byte, options snapshotComparison) (*snapshotResult, error) {
+	expected, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return &snapshotResult{missing: true}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	message, diff, err := compareSnapshots(expected, actual, options)
+	if err != nil {
+		return nil, err
+	}
+	return &snapshotResult{message: message, diff: diff}, nil
+}
+
+// finish turns the result of the last comparison into the result of the assertion, writing
+// the snapshot or the actual value and the diff as needed.
+func (s *snapshotFile) finish(actual []byte, result *snapshotResult, isNot bool) error {
+	if isNot {
+		if result.matches() {
+			return fmt.Errorf("Snapshot %s expected not to match", s.path)
+		}
+		return nil
+	}
+	if result.matches() {
+		s.removeOutputs()
+		return nil
+	}
+	mode := snapshotUpdateModeFromEnv()
+	if mode == snapshotUpdateAll {
+		s.removeOutputs()
+		return s.write(s.path, actual)
+	}
+	if result.missing {
+		if mode == snapshotUpdateNone {
+			return fmt.Errorf("A snapshot doesn't exist at %s", s.path)
+		}
+		if err := s.write(s.path, actual); err != nil {
+			return err
+		}
+		return fmt.Errorf("A snapshot doesn't exist at %s, writing actual", s.path)
+	}
+	actualPath := s.outputPath("actual", filepath.Ext(s.path))
+	if err := s.write(actualPath, actual); err != nil {
+		return err
+	}
+	message := fmt.Sprintf("Snapshot %s doesn't match: %s\nExpected: %s\nReceived: %s", s.path, result.message, s.path, actualPath)
+	if result.diff != nil {
+		diffPath := s.outputPath("diff", ".png")
+		if err := s.write(diffPath, result.diff); err != nil {
+			return err
+		}
+		message += "\nDiff: " + diffPath
+	}
+	return errors.New(message)
+}
+
+func (s *snapshotFile) write(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("could not create snapshot directory: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("could not write snapshot: %w", err)
+	}
+	return nil
+}
+
+// removeOutputs removes the files written by a previous failed comparison.
+func (s *snapshotFile) removeOutputs() {
+	_ = os.Remove(s.outputPath("actual", filepath.Ext(s.path)))
+	_ = os.Remove(s.outputPath("diff", ".png"))
+}
+
+var pngSignature = []byte("\x89PNG\r\n\x1a\n")
+
+// compareSnapshots compares two values and describes how they differ, or returns an empty
+// message if they match. PNG images are compared pixel by pixel within the thresholds and
+// a diff image is returned, other values must be equal.
+func compareSnapshots(expected, actual []byte, options snapshotComparison) (string, []byte, error) {
+	if bytes.Equal(expected, actual) {
+		return "", nil, nil
+	}
+	if !bytes.HasPrefix(expected, pngSignature) || !bytes.HasPrefix(actual, pngSignature) {
+		return "the contents are different", nil, nil
+	}
+	expectedImage, err := png.Decode(bytes.NewReader(expected))
+	if err != nil {
+		return "", nil, fmt.Errorf("could not decode expected image: %w", err)
+	}
+	actualImage, err := png.Decode(bytes.NewReader(actual))
+	if err != nil {
+		return "", nil, fmt.Errorf("could not decode actual image: %w", err)
+	}
+	expectedSize, actualSize := expectedImage.Bounds().Size(), actualImage.Bounds().Size()
+	if expectedSize != actualSize {
+		return fmt.Sprintf("expected an image %dx%d, received %dx%d", expectedSize.X, expectedSize.Y, actualSize.X, actualSize.Y), nil, nil
+	}
+	threshold := 0.2
+	if options.threshold != nil {
+		threshold = *options.threshold
+	}
+	output := image.NewNRGBA(image.Rect(0, 0, actualSize.X, actualSize.Y))
+	count := pixelmatch(toNRGBA(expectedImage), toNRGBA(actualImage), output, pixelmatchOptions{threshold: threshold})
+	total := actualSize.X * actualSize.Y
+	allowed := 0
+	if options.maxDiffPixels != nil {
+		allowed = *options.maxDiffPixels
+	}
+	if options.maxDiffPixelRatio != nil {
+		if byRatio := int(*options.maxDiffPixelRatio * float64(total)); byRatio > allowed {
+			allowed = byRatio
+		}
+	}
+	if count <= allowed {
+		return "", nil, nil
+	}
+	var diff bytes.Buffer
+	if err := png.Encode(&diff, output); err != nil {
+		return "", nil, fmt.Errorf("could not encode diff image: %w", err)
+	}
+	ratio := float64(count) / float64(total)
+	return fmt.Sprintf("%d pixels (ratio %.2f of all image pixels) are different", count, ratio), diff.Bytes(), nil
+}
+
+// toHaveScreenshot takes screenshots until one matches the snapshot or the timeout is
+// reached. New snapshots are only written once two consecutive screenshots are the same,
+// so that they don't capture an animation halfway.
+func (b *assertionsBase) toHaveScreenshot(name string, timeout *float64, comparison snapshotComparison, screenshot func(timeout *float64) ([]byte, error)) error {
+	if filepath.Ext(name) == "" {
+		name += ".png"
+	}
+	file, err := newSnapshotFile(b.snapshotDir, name)
+	if err != nil {
+		return err
+	}
+	if timeout == nil {
+		timeout = b.defaultTimeout
+	}
+	interval := 100 * time.Millisecond
+	if b.pollInterval != nil {
+		interval = time.Duration(*b.pollInterval * float64(time.Millisecond))
+	}
+	deadline := time.Now().Add(time.Duration(*timeout * float64(time.Millisecond)))
+	update := snapshotUpdateModeFromEnv() == snapshotUpdateAll
+	var previous []byte
+	for {
+		remaining := float64(time.Until(deadline).Milliseconds())
+		if remaining < 1 {
+			remaining = 1
+		}
+		actual, err := screenshot(Float(remaining))
+		if err != nil {
+			return err
+		}
+		result, err := file.compare(actual, comparison)
+		if err != nil {
+			return err
+		}
+		stable := bytes.Equal(previous, actual)
+		previous = actual
+		done := time.Now().Add(interval).After(deadline)
+		switch {
+		case result.matches() != b.isNot:
+			return file.finish(actual, result, b.isNot)
+		case (result.missing || update) && !b.isNot:
+			if stable {
+				return file.finish(actual, result, false)
+			}
+			if done {
+				return newTimeoutError(fmt.Sprintf("Timeout %vms exceeded while generating screenshot %s because the page kept changing", *timeout, file.path))
+			}
+		case done:
+			return file.finish(actual, result, b.isNot)
+		}
+		time.Sleep(interval)
+	}
+}
+
+type snapshotAssertionsImpl struct {
+	actual      []byte
+	snapshotDir string
+	soft        *softErrors
+}
+
+func (sa *snapshotAssertionsImpl) ToMatchSnapshot(name string, options ...SnapshotAssertionsToMatchSnapshotOptions) error {
+	return sa.soft.record(sa.toMatchSnapshot(name, options...))
+}
+
+func (sa *snapshotAssertionsImpl) toMatchSnapshot(name string, options ...SnapshotAssertionsToMatchSnapshotOptions) error {
+	file, err := newSnapshotFile(sa.snapshotDir, name)
+	if err != nil {
+		return err
+	}
+	var comparison snapshotComparison
+	if len(options) == 1 {
+		comparison = snapshotComparison{
+			maxDiffPixels:     options[0].MaxDiffPixels,
+			maxDiffPixelRatio: options[0].MaxDiffPixelRatio,
+			threshold:         options[0].Threshold,
+		}
+	}
+	result, err := file.compare(sa.actual, comparison)
+	if err != nil {
+		return err
+	}
+	return file.finish(sa.actual, result, false)
+}
+
+func (pa *pageAssertionsImpl) ToHaveScreenshot(name string, options ...PageAssertionsToHaveScreenshotOptions) error {
+	var option PageAssertionsToHaveScreenshotOptions
+	if len(options) == 1 {
+		option = options[0]
+	}
+	screenshotOptions := PageScreenshotOptions{
+		Animations:     ScreenshotAnimationsDisabled,
+		Caret:          ScreenshotCaretHide,
+		Clip:           option.Clip,
+		FullPage:       option.FullPage,
+		Mask:           option.Mask,
+		MaskColor:      option.MaskColor,
+		OmitBackground: option.OmitBackground,
+		Scale:          ScreenshotScaleCss,
+		Style:          option.Style,
+	}
+	if option.Animations != nil {
+		screenshotOptions.Animations = option.Animations
+	}
+	if option.Caret != nil {
+		screenshotOptions.Caret = option.Caret
+	}
+	if option.Scale != nil {
+		screenshotOptions.Scale = option.Scale
+	}
+	comparison := snapshotComparison{
+		maxDiffPixels:     option.MaxDiffPixels,
+		maxDiffPixelRatio: option.MaxDiffPixelRatio,
+		threshold:         option.Threshold,
+	}
+	return pa.soft.record(pa.toHaveScreenshot(name, option.Timeout, comparison, func(timeout *float64) ([]byte, error) {
+		screenshotOptions.Timeout = timeout
+		return pa.actualPage.Screenshot(screenshotOptions)
+	}))
+}
+
+func (la *locatorAssertionsImpl) ToHaveScreenshot(name string, options ...LocatorAssertionsToHaveScreenshotOptions) error {
+	var option LocatorAssertionsToHaveScreenshotOptions
+	if len(options) == 1 {
+		option = options[0]
+	}
+	screenshotOptions := LocatorScreenshotOptions{
+		Animations:     ScreenshotAnimationsDisabled,
+		Caret:          ScreenshotCaretHide,
+		Mask:           option.Mask,
+		MaskColor:      option.MaskColor,
+		OmitBackground: option.OmitBackground,
+		Scale:          ScreenshotScaleCss,
+		Style:          option.Style,
+	}
+	if option.Animations != nil {
+		screenshotOptions.Animations = option.Animations
+	}
+	if option.Caret != nil {
+		screenshotOptions.Caret = option.Caret
+	}
+	if option.Scale != nil {
+		screenshotOptions.Scale = option.Scale
+	}
+	comparison := snapshotComparison{
+		maxDiffPixels:     option.MaxDiffPixels,
+		maxDiffPixelRatio: option.MaxDiffPixelRatio,
+		threshold:         option.Threshold,
+	}
+	return la.soft.record(la.toHaveScreenshot(name, option.Timeout, comparison, func(timeout *float64) ([]byte, error) {
+		screenshotOptions.Timeout = timeout
+		return la.actualLocator.Screenshot(screenshotOptions)
+	}))
+}
@@ -0,0 +1,129 @@
+package playwright
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func encodeTestImage(t *testing.T, width, height int, changed ...image.Point) []byte {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.SetNRGBA(x, y, color.NRGBA{R: 255, G: 255, B: 255, A: 255})
+		}
+	}
+	for _, point := range changed {
+		img.SetNRGBA(point.X, point.Y, color.NRGBA{A: 255})
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func TestPixelmatchCountsDifferentPixels(t *testing.T) {
+	img1 := toNRGBA(image.NewNRGBA(image.Rect(0, 0, 8, 8)))
+	img2 := image.NewNRGBA(image.Rect(0, 0, 8, 8))
+	copy(img2.Pix, img1.Pix)
+	require.Equal(t, 0, pixelmatch(img1, img2, nil, pixelmatchOptions{threshold: 0.1}))
+	for _, point := range []image.Point{{1, 1}, {5, 5}} {
+		img2.SetNRGBA(point.X, point.Y, color.NRGBA{R: 255, A: 255})
+	}
+	output := image.NewNRGBA(img1.Rect)
+	require.Equal(t, 2, pixelmatch(img1, img2, output, pixelmatchOptions{threshold: 0.1}))
+	require.Equal(t, diffColor, output.NRGBAAt(1, 1))
+	require.NotEqual(t, diffColor, output.NRGBAAt(0, 0))
+}
+
+func TestPixelmatchThreshold(t *testing.T) {
+	img1 := image.NewNRGBA(image.Rect(0, 0, 4, 4))
+	img2 := image.NewNRGBA(image.Rect(0, 0, 4, 4))
+	for i := range img1.Pix {
+		img1.Pix[i] = 200
+		img2.Pix[i] = 205
+	}
+	require.Equal(t, 16, pixelmatch(img1, img2, nil, pixelmatchOptions{threshold: 0}))
+	require.Equal(t, 0, pixelmatch(img1, img2, nil, pixelmatchOptions{threshold: 0.1}))
+}
+
+func TestCompareSnapshots(t *testing.T) {
+	expected := encodeTestImage(t, 10, 10)
+	actual := encodeTestImage(t, 10, 10, image.Point{2, 2}, image.Point{7, 7})
+
+	message, diff, err := compareSnapshots(expected, expected, snapshotComparison{})
+	require.NoError(t, err)
+	require.Empty(t, message)
+	require.Nil(t, diff)
+
+	message, diff, err = compareSnapshots(expected, actual, snapshotComparison{})
+	require.NoError(t, err)
+	require.Equal(t, "2 pixels (ratio 0.02 of all image pixels) are different", message)
+	require.NotNil(t, diff)
+
+	message, _, err = compareSnapshots(expected, actual, snapshotComparison{maxDiffPixels: Int(2)})
+	require.NoError(t, err)
+	require.Empty(t, message)
+
+	message, _, err = compareSnapshots(expected, actual, snapshotComparison{maxDiffPixelRatio: Float(0.05)})
+	require.NoError(t, err)
+	require.Empty(t, message)
+
+	message, _, err = compareSnapshots(expected, encodeTestImage(t, 10, 5), snapshotComparison{})
+	require.NoError(t, err)
+	require.Equal(t, "expected an image 10x10, received 10x5", message)
+
+	message, _, err = compareSnapshots([]byte("a"), []byte("b"), snapshotComparison{})
+	require.NoError(t, err)
+	require.Equal(t, "the contents are different", message)
+}
+
+func TestNewSnapshotFile(t *testing.T) {
+	file, err := newSnapshotFile("snapshots", "home/header.png")
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join("snapshots", runtime.GOOS, "home", "header.png"), file.path)
+	require.Equal(t, filepath.Join("snapshots", runtime.GOOS, "home", "header-diff.png"), file.outputPath("diff", ".png"))
+
+	file, err = newSnapshotFile("", "a.png")
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(DefaultSnapshotDir, runtime.GOOS, "a.png"), file.path)
+
+	for _, name := range []string{"", "../a.png", "/tmp/a.png"} {
+		_, err := newSnapshotFile("snapshots", name)
+		require.Error(t, err, name)
+	}
+}
+
+func TestSnapshotAssertionsUpdateModes(t *testing.T) {
+	dir := t.TempDir()
+	expect := Expect(ExpectOptions{SnapshotDir: String(dir)})
+	path := filepath.Join(dir, runtime.GOOS, "value.txt")
+
+	t.Setenv(SnapshotUpdateEnv, "none")
+	require.ErrorContains(t, expect.Snapshot([]byte("one")).ToMatchSnapshot("value.txt"), "doesn't exist")
+	require.NoFileExists(t, path)
+
+	t.Setenv(SnapshotUpdateEnv, "")
+	require.ErrorContains(t, expect.Snapshot([]byte("one")).ToMatchSnapshot("value.txt"), "writing actual")
+	require.FileExists(t, path)
+	require.NoError(t, expect.Snapshot([]byte("one")).ToMatchSnapshot("value.txt"))
+
+	err := expect.Snapshot([]byte("two")).ToMatchSnapshot("value.txt")
+	require.ErrorContains(t, err, "doesn't match")
+	actual, err := os.ReadFile(filepath.Join(dir, runtime.GOOS, "value-actual.txt"))
+	require.NoError(t, err)
+	require.Equal(t, "two", string(actual))
+
+	t.Setenv(SnapshotUpdateEnv, "all")
+	require.NoError(t, expect.Snapshot([]byte("two")).ToMatchSnapshot("value.txt"))
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "two", string(content))
+	require.NoFileExists(t, filepath.Join(dir, runtime.GOOS, "value-actual.txt"))
+}
@@ -0,0 +1,362 @@
+package playwright
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// speechBinding is the binding the stubs installed by [speechScript] use to report utterances and recognitions to the
+// client.
+const speechBinding = "__pwSpeech"
+
+// speechScript replaces speechSynthesis and SpeechRecognition with stubs. Utterances are spoken instantly and reported
+// to the client, recognitions wait for the results the client delivers with `__pwSpeechStubs.recognize`.
+const speechScript = `(voices => {
+  if (window.__pwSpeechStubs)
+    return;
+  const report = event => window.` + speechBinding + `(event).catch(() => {});
+  const fire = (target, type, init = {}) => {
+    const event = new Event(type);
+    for (const [key, value] of Object.entries(init))
+      Object.defineProperty(event, key, { value });
+    target.dispatchEvent(event);
+    const handler = target['on' + type];
+    if (typeof handler === 'function')
+      handler.call(target, event);
+  };
+
+  class SpeechSynthesisUtterance extends EventTarget {
+    constructor(text = '') {
+      super();
+      this.text = String(text);
+      this.lang = '';
+      this.voice = null;
+      this.rate = 1;
+      this.pitch = 1;
+      this.volume = 1;
+    }
+  }
+  const synthesisVoices = voices.map(voice => Object.freeze({
+    name: voice.name,
+    lang: voice.lang,
+    voiceURI: voice.name,
+    localService: true,
+    default: voice.default,
+  }));
+  class SpeechSynthesis extends EventTarget {
+    constructor() {
+      super();
+      this._queue = [];
+      this.paused = false;
+      this.speaking = false;
+    }
+    get pending() {
+      return this._queue.length > 0;
+    }
+    getVoices() {
+      return [...synthesisVoices];
+    }
+    speak(utterance) {
+      this._queue.push(utterance);
+      if (!this.speaking && !this.paused)
+        setTimeout(() => this._next(), 0);
+    }
+    cancel() {
+      const queue = this._queue;
+      this._queue = [];
+      for (const utterance of queue)
+        fire(utterance, 'error', { utterance, error: 'canceled', charIndex: 0, elapsedTime: 0 });
+    }
+    pause() {
+      this.paused = true;
+    }
+    resume() {
+      this.paused = false;
+      if (!this.speaking)
+        setTimeout(() => this._next(), 0);
+    }
+    _next() {
+      if (this.speaking || this.paused || !this._queue.length)
+        return;
+      const utterance = this._queue.shift();
+      this.speaking = true;
+      report({
+        type: 'speak',
+        text: utterance.text,
+        lang: utterance.lang || (utterance.voice && utterance.voice.lang) || document.documentElement.lang || '',
+        voice: utterance.voice ? utterance.voice.name : '',
+        rate: utterance.rate,
+        pitch: utterance.pitch,
+        volume: utterance.volume,
+      });
+      fire(utterance, 'start', { utterance, charIndex: 0, elapsedTime: 0 });
+      setTimeout(() => {
+        this.speaking = false;
+        fire(utterance, 'end', { utterance, charIndex: utterance.text.length, elapsedTime: 0 });
+        this._next();
+      }, 0);
+    }
+  }
+
+  const recognitions = new Set();
+  class SpeechRecognition extends EventTarget {
+    constructor() {
+      super();
+      this.lang = '';
+      this.continuous = false;
+      this.interimResults = false;
+      this.maxAlternatives = 1;
+      this.grammars = [];
+      this._results = [];
+    }
+    start() {
+      if (recognitions.has(this))
+        throw new DOMException('recognition has already started', 'InvalidStateError');
+      recognitions.add(this);
+      this._results = [];
+      report({
+        type: 'recognitionStart',
+        lang: this.lang || document.documentElement.lang || '',
+        continuous: this.continuous,
+        interimResults: this.interimResults,
+        maxAlternatives: this.maxAlternatives,
+      });
+      fire(this, 'start');
+      fire(this, 'audiostart');
+    }
+    stop() {
+      this._end();
+    }
+    abort() {
+      this._end();
+    }
+    _end() {
+      if (!recognitions.delete(this))
+        return;
+      fire(this, 'audioend');
+      fire(this, 'end');
+    }
+    _deliver({ transcripts, confidence, isFinal }) {
+      if (!isFinal && !this.interimResults)
+        return;
+      const alternatives = transcripts.slice(0, Math.max(1, this.maxAlternatives)).map(transcript => Object.freeze({ transcript, confidence }));
+      const result = Object.assign([...alternatives], { isFinal, item: i => alternatives[i] || null });
+      const resultIndex = this._results.length;
+      const results = [...this._results, result];
+      if (isFinal)
+        this._results.push(result);
+      fire(this, 'result', { resultIndex, results: Object.assign(results, { item: i => results[i] || null }) });
+      if (isFinal && !this.continuous)
+        this._end();
+    }
+  }
+
+  Object.defineProperty(window, 'speechSynthesis', { configurable: true, value: new SpeechSynthesis() });
+  for (const [name, value] of Object.entries({
+    SpeechSynthesisUtterance,
+    SpeechRecognition,
+    webkitSpeechRecognition: SpeechRecognition,
+  }))
+    Object.defineProperty(window, name, { configurable: true, writable: true, value });
+  window.__pwSpeechStubs = {
+    recognize(result) {
+      const active = [...recognitions];
+      for (const recognition of active)
+        recognition._deliver(result);
+      return active.length;
+    },
+    fail(error) {
+      const active = [...recognitions];
+      for (const recognition of active) {
+        fire(recognition, 'error', { error, message: '' });
+        recognition._end();
+      }
+      return active.length;
+    },
+  };
+})`
+
+// SpeechVoice is a voice of the speech synthesis stub, see [SpeechInstallOptions].
+type SpeechVoice struct {
+	// Name of the voice.
+	Name string `json:"name"`
+	// Language of the voice, such as `en-US`.
+	Lang string `json:"lang"`
+	// Whether the voice is the default voice.
+	Default bool `json:"default"`
+}
+
+// SpeechUtterance is a text spoken by a page with `speechSynthesis.speak`, see [Speech.OnSpeak].
+type SpeechUtterance struct {
+	// Text of the utterance.
+	Text string `json:"text"`
+	// Language of the utterance, from the utterance, its voice or the document.
+	Lang string `json:"lang"`
+	// Name of the voice of the utterance, empty for the default voice.
+	Voice  string  `json:"voice"`
+	Rate   float64 `json:"rate"`
+	Pitch  float64 `json:"pitch"`
+	Volume float64 `json:"volume"`
+	// Frame that spoke the utterance.
+	Frame Frame `json:"-"`
+}
+
+// SpeechRecognitionStart is a speech recognition started by a page, see [Speech.OnRecognitionStart].
+type SpeechRecognitionStart struct {
+	// Language of the recognition, from the recognition or the document.
+	Lang            string `json:"lang"`
+	Continuous      bool   `json:"continuous"`
+	InterimResults  bool   `json:"interimResults"`
+	MaxAlternatives int    `json:"maxAlternatives"`
+	// Frame that started the recognition.
+	Frame Frame `json:"-"`
+}
+
+var defaultSpeechVoices = []SpeechVoice{{Name: "Playwright English", Lang: "en-US", Default: true}}
+
+type speechImpl struct {
+	eventEmitter
+	page       *pageImpl
+	mu         sync.Mutex
+	installed  bool
+	utterances []SpeechUtterance
+}
+
+func newSpeech(page *pageImpl) *speechImpl {
+	return &speechImpl{page: page}
+}
+
+func (s *speechImpl) Install(options ...SpeechInstallOptions) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.installed {
+		return errors.New("speech stubs are already installed")
+	}
+	voices := defaultSpeechVoices
+	if len(options) == 1 && options[0].Voices != nil {
+		voices = options[0].Voices
+	}
+	encoded, err := json.Marshal(voices)
+	if err != nil {
+		return err
+	}
+	if err := s.page.ExposeBinding(speechBinding, s.onBinding); err != nil {
+		return err
+	}
+	script := fmt.Sprintf("(%s)(%s)", speechScript, encoded)
+	if _, err := s.page.channel.Send("addInitScript", map[string]interface{}{
+		"source": script,
+	}); err != nil {
+		return err
+	}
+	if err := evaluateInFrames([]Page{s.page}, script); err != nil {
+		return err
+	}
+	s.installed = true
+	return nil
+}
+
+func (s *speechImpl) onBinding(source *BindingSource, args ...interface{}) interface{} {
+	if len(args) != 1 {
+		return nil
+	}
+	event, ok := args[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	switch event["type"] {
+	case "speak":
+		utterance, err := decodeCDPValue[SpeechUtterance](event)
+		if err != nil {
+			return nil
+		}
+		utterance.Frame = source.Frame
+		s.mu.Lock()
+		s.utterances = append(s.utterances, utterance)
+		s.mu.Unlock()
+		s.Emit("speak", utterance)
+	case "recognitionStart":
+		start, err := decodeCDPValue[SpeechRecognitionStart](event)
+		if err != nil {
+			return nil
+		}
+		start.Frame = source.Frame
+		s.Emit("recognitionstart", start)
+	}
+	return nil
+}
+
+func (s *speechImpl) OnSpeak(fn func(SpeechUtterance)) {
+	s.On("speak", fn)
+}
+
+func (s *speechImpl) OnRecognitionStart(fn func(SpeechRecognitionStart)) {
+	s.On("recognitionstart", fn)
+}
+
+func (s *speechImpl) Utterances() []SpeechUtterance {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]SpeechUtterance{}, s.utterances...)
+}
+
+func (s *speechImpl) Recognize(transcript string, options ...SpeechRecognizeOptions) error {
+	result := map[string]interface{}{
+		"transcripts": []string{transcript},
+		"confidence":  1.0,
+		"isFinal":     true,
+	}
+	if len(options) == 1 {
+		option := options[0]
+		result["transcripts"] = append([]string{transcript}, option.Alternatives...)
+		if option.Confidence != nil {
+			result["confidence"] = *option.Confidence
+		}
+		if option.IsFinal != nil {
+			result["isFinal"] = *option.IsFinal
+		}
+	}
+	return s.dispatch("result => window.__pwSpeechStubs ? window.__pwSpeechStubs.recognize(result) : 0", result)
+}
+
+func (s *speechImpl) FailRecognition(errorCode string) error {
+	return s.dispatch("error => window.__pwSpeechStubs ? window.__pwSpeechStubs.fail(error) : 0", errorCode)
+}
+
+// dispatch evaluates script in the frames of the page, and fails if no recognition is listening in any of them.
+func (s *speechImpl) dispatch(script string, arg interface{}) error {
+	s.mu.Lock()
+	installed := s.installed
+	s.mu.Unlock()
+	if !installed {
+		return errors.New("speech stubs are not installed")
+	}
+	listening := 0
+	for _, frame := range s.page.Frames() {
+		count, err := frame.Evaluate(script, arg)
+		if err != nil {
+			if errors.Is(err, ErrTargetClosed) {
+				continue
+			}
+			return err
+		}
+		if n, ok := count.(int); ok {
+			listening += n
+		}
+	}
+	if listening == 0 {
+		return errors.New("no speech recognition is listening")
+	}
+	return nil
+}
+
+func (p *pageImpl) Speech() Speech {
+	p.Lock()
+	defer p.Unlock()
+	if p.speech == nil {
+		p.speech = newSpeech(p)
+	}
+	return p.speech
+}
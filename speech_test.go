@@ -0,0 +1,47 @@
+package playwright
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpeechShouldReportUtterances(t *testing.T) {
+	s := newSpeech(&pageImpl{})
+	spoken := make([]SpeechUtterance, 0)
+	s.OnSpeak(func(utterance SpeechUtterance) {
+		spoken = append(spoken, utterance)
+	})
+	started := make([]SpeechRecognitionStart, 0)
+	s.OnRecognitionStart(func(start SpeechRecognitionStart) {
+		started = append(started, start)
+	})
+	source := &BindingSource{}
+	s.onBinding(source, map[string]interface{}{
+		"type":   "speak",
+		"text":   "Hallo",
+		"lang":   "de-DE",
+		"voice":  "",
+		"rate":   1.5,
+		"pitch":  1,
+		"volume": 0.5,
+	})
+	s.onBinding(source, map[string]interface{}{
+		"type":            "recognitionStart",
+		"lang":            "en-US",
+		"continuous":      true,
+		"maxAlternatives": 2,
+	})
+	s.onBinding(source, "unexpected")
+
+	utterance := SpeechUtterance{Text: "Hallo", Lang: "de-DE", Rate: 1.5, Pitch: 1, Volume: 0.5}
+	require.Equal(t, []SpeechUtterance{utterance}, spoken)
+	require.Equal(t, []SpeechUtterance{utterance}, s.Utterances())
+	require.Equal(t, []SpeechRecognitionStart{{Lang: "en-US", Continuous: true, MaxAlternatives: 2}}, started)
+}
+
+func TestSpeechShouldRequireInstall(t *testing.T) {
+	s := newSpeech(&pageImpl{})
+	require.EqualError(t, s.Recognize("hello"), "speech stubs are not installed")
+	require.EqualError(t, s.FailRecognition("no-speech"), "speech stubs are not installed")
+}
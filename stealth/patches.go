@@ -0,0 +1,91 @@
+package stealth
+
+// utilsSource defines helpers of the patches. Patched functions keep the name, length and toString() of the
+// functions they replace, so that they still look native.
+const utilsSource = `const nativeToString = Function.prototype.toString;
+const patched = new WeakMap();
+const toString = function toString() {
+  return patched.has(this) ? nativeToString.call(patched.get(this)) : nativeToString.call(this);
+};
+patched.set(toString, nativeToString);
+Object.defineProperty(Function.prototype, 'toString', { value: toString, writable: true, configurable: true });
+const replaceMethod = (object, name, replacement) => {
+  const original = object[name];
+  if (typeof original !== 'function')
+    return;
+  const proxy = new Proxy(original, { apply: (target, thisArg, args) => replacement.call(thisArg, target, args) });
+  patched.set(proxy, original);
+  Object.defineProperty(object, name, { value: proxy, writable: true, configurable: true });
+};
+const replaceGetter = (object, name, getter) => {
+  const descriptor = Object.getOwnPropertyDescriptor(object, name);
+  if (!descriptor || !descriptor.get)
+    return;
+  const proxy = new Proxy(descriptor.get, { apply: (target, thisArg) => getter.call(thisArg, target) });
+  patched.set(proxy, descriptor.get);
+  Object.defineProperty(object, name, { ...descriptor, get: proxy });
+};`
+
+const webdriverPatch = `if (navigator.webdriver !== undefined) {
+  delete Object.getPrototypeOf(navigator).webdriver;
+}`
+
+const permissionsPatch = `if (window.navigator.permissions && window.Notification) {
+  replaceMethod(Permissions.prototype, 'query', function (query, args) {
+    const descriptor = args[0];
+    if (descriptor && descriptor.name === 'notifications') {
+      const state = Notification.permission === 'default' ? 'prompt' : Notification.permission;
+      return Promise.resolve(Object.setPrototypeOf({ state, name: 'notifications', onchange: null }, PermissionStatus.prototype));
+    }
+    return query.apply(this, args);
+  });
+}`
+
+const pluginsPatch = `if (navigator.plugins && navigator.plugins.length === 0) {
+  const names = ['PDF Viewer', 'Chrome PDF Viewer', 'Chromium PDF Viewer', 'Microsoft Edge PDF Viewer', 'WebKit built-in PDF'];
+  const mimeTypeData = [
+    { type: 'application/pdf', suffixes: 'pdf', description: 'Portable Document Format' },
+    { type: 'text/pdf', suffixes: 'pdf', description: 'Portable Document Format' },
+  ];
+  const makeArray = (items, proto, key) => {
+    const array = Object.create(proto);
+    items.forEach((item, i) => {
+      Object.defineProperty(array, i, { value: item, enumerable: true });
+      Object.defineProperty(array, item[key], { value: item });
+    });
+    Object.defineProperty(array, 'length', { value: items.length });
+    replaceMethod(array, 'item', function (item, args) { return this[args[0]] || null; });
+    replaceMethod(array, 'namedItem', function (namedItem, args) { return (Object.getOwnPropertyDescriptor(this, args[0]) || {}).value || null; });
+    array[Symbol.iterator] = function* () { for (let i = 0; i < items.length; i++) yield items[i]; };
+    return array;
+  };
+  const mimeTypes = mimeTypeData.map(data => Object.setPrototypeOf({ ...data }, MimeType.prototype));
+  const plugins = names.map(name => {
+    const plugin = makeArray(mimeTypes, Plugin.prototype, 'type');
+    Object.defineProperties(plugin, {
+      name: { value: name },
+      filename: { value: 'internal-pdf-viewer' },
+      description: { value: 'Portable Document Format' },
+    });
+    return plugin;
+  });
+  mimeTypes.forEach(mimeType => Object.defineProperty(mimeType, 'enabledPlugin', { value: plugins[0] }));
+  const pluginArray = makeArray(plugins, PluginArray.prototype, 'name');
+  replaceMethod(pluginArray, 'refresh', function () {});
+  const mimeTypeArray = makeArray(mimeTypes, MimeTypeArray.prototype, 'type');
+  replaceGetter(Navigator.prototype, 'plugins', () => pluginArray);
+  replaceGetter(Navigator.prototype, 'mimeTypes', () => mimeTypeArray);
+}`
+
+const webGLPatch = `for (const context of [window.WebGLRenderingContext, window.WebGL2RenderingContext]) {
+  if (!context)
+    continue;
+  replaceMethod(context.prototype, 'getParameter', function (getParameter, args) {
+    // UNMASKED_VENDOR_WEBGL and UNMASKED_RENDERER_WEBGL of WEBGL_debug_renderer_info.
+    if (args[0] === 0x9245)
+      return config.webGLVendor;
+    if (args[0] === 0x9246)
+      return config.webGLRenderer;
+    return getParameter.apply(this, args);
+  });
+}`
@@ -0,0 +1,106 @@
+// Package stealth applies patches to hide common signs of browser automation from the pages, such as
+// navigator.webdriver being set or an empty navigator.plugins.
+//
+// The patches are best-effort: they make the common automation checks pass, but they don't make automated
+// browsers indistinguishable from regular ones, and detection scripts can still notice the patches themselves.
+// Only use them against sites whose terms allow automated access.
+//
+//	context, err := browser.NewContext()
+//	err = stealth.Apply(context)
+//
+// Patches are applied with init scripts, so they take effect on the documents loaded after [Apply] is called.
+package stealth
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/playwright-community/playwright-go"
+)
+
+// Target is a [playwright.Page] or a [playwright.BrowserContext] to apply the patches to.
+type Target interface {
+	AddInitScript(script playwright.Script) error
+}
+
+// Options toggle the patches applied by [Apply]. Every patch is applied unless it is disabled.
+type Options struct {
+	// Removes navigator.webdriver, which is set in automated browsers. Defaults to `true`.
+	Webdriver *bool
+	// Makes navigator.permissions.query() report the state of the notifications permission consistently with
+	// Notification.permission, as automated Chromium reports `denied` for the former and `default` for the latter.
+	// Defaults to `true`.
+	Permissions *bool
+	// Fills navigator.plugins and navigator.mimeTypes with the PDF viewer entries of regular browsers, as they are
+	// empty in headless Chromium. Defaults to `true`.
+	Plugins *bool
+	// Reports WebGLVendor and WebGLRenderer as the unmasked vendor and renderer of WebGL contexts, instead of the
+	// software renderer of headless browsers. Defaults to `true`.
+	WebGL *bool
+	// Unmasked WebGL vendor reported by the WebGL patch. Defaults to `Intel Inc.`.
+	WebGLVendor *string
+	// Unmasked WebGL renderer reported by the WebGL patch. Defaults to `Intel Iris OpenGL Engine`.
+	WebGLRenderer *string
+}
+
+type patch struct {
+	enabled func(Options) *bool
+	source  string
+}
+
+var patches = []patch{
+	{func(o Options) *bool { return o.Webdriver }, webdriverPatch},
+	{func(o Options) *bool { return o.Permissions }, permissionsPatch},
+	{func(o Options) *bool { return o.Plugins }, pluginsPatch},
+	{func(o Options) *bool { return o.WebGL }, webGLPatch},
+}
+
+// Apply adds the enabled patches to target, as a single init script.
+func Apply(target Target, options ...Options) error {
+	script, err := Script(options...)
+	if err != nil {
+		return err
+	}
+	if script == "" {
+		return nil
+	}
+	if err := target.AddInitScript(playwright.Script{Content: playwright.String(script)}); err != nil {
+		return fmt.Errorf("could not apply stealth patches: %w", err)
+	}
+	return nil
+}
+
+// Script returns the init script applying the enabled patches, or an empty string if they are all disabled. It is
+// useful to apply the patches by other means than [Apply], e.g. with [playwright.Page.AddInitScript] in an isolated
+// world.
+func Script(options ...Options) (string, error) {
+	option := Options{}
+	if len(options) == 1 {
+		option = options[0]
+	}
+	config, err := json.Marshal(map[string]string{
+		"webGLVendor":   valueOr(option.WebGLVendor, "Intel Inc."),
+		"webGLRenderer": valueOr(option.WebGLRenderer, "Intel Iris OpenGL Engine"),
+	})
+	if err != nil {
+		return "", err
+	}
+	var sources []string
+	for _, p := range patches {
+		if enabled := p.enabled(option); enabled == nil || *enabled {
+			sources = append(sources, p.source)
+		}
+	}
+	if len(sources) == 0 {
+		return "", nil
+	}
+	return "(() => {\nconst config = " + string(config) + ";\n" + utilsSource + "\n" + strings.Join(sources, "\n") + "\n})();", nil
+}
+
+func valueOr(value *string, fallback string) string {
+	if value == nil {
+		return fallback
+	}
+	return *value
+}
@@ -0,0 +1,58 @@
+package stealth
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+type scriptRecorder struct {
+	scripts []string
+	err     error
+}
+
+func (r *scriptRecorder) AddInitScript(script playwright.Script) error {
+	r.scripts = append(r.scripts, *script.Content)
+	return r.err
+}
+
+func TestScript(t *testing.T) {
+	script, err := Script()
+	require.NoError(t, err)
+	for _, source := range []string{webdriverPatch, permissionsPatch, pluginsPatch, webGLPatch} {
+		require.Contains(t, script, source)
+	}
+	require.Contains(t, script, `"webGLVendor":"Intel Inc."`)
+
+	script, err = Script(Options{
+		Plugins:     playwright.Bool(false),
+		WebGL:       playwright.Bool(true),
+		WebGLVendor: playwright.String(`Google Inc. "ANGLE"`),
+	})
+	require.NoError(t, err)
+	require.NotContains(t, script, pluginsPatch)
+	require.Contains(t, script, `"webGLVendor":"Google Inc. \"ANGLE\""`)
+
+	script, err = Script(Options{
+		Webdriver:   playwright.Bool(false),
+		Permissions: playwright.Bool(false),
+		Plugins:     playwright.Bool(false),
+		WebGL:       playwright.Bool(false),
+	})
+	require.NoError(t, err)
+	require.Empty(t, script)
+}
+
+func TestApply(t *testing.T) {
+	recorder := &scriptRecorder{}
+	require.NoError(t, Apply(recorder, Options{WebGL: playwright.Bool(false)}))
+	require.Len(t, recorder.scripts, 1)
+	require.True(t, strings.HasPrefix(recorder.scripts[0], "(() => {"))
+	require.NotContains(t, recorder.scripts[0], webGLPatch)
+
+	recorder.err = errors.New("page closed")
+	require.ErrorContains(t, Apply(recorder), "could not apply stealth patches: page closed")
+}
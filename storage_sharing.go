@@ -0,0 +1,98 @@
+package playwright
+
+import (
+	"fmt"
+	"net/url"
+	"sync"
+
+	"github.com/playwright-community/playwright-go/internal/multierror"
+)
+
+// setLocalStorageScript sets the local storage of the origin of the document.
+const setLocalStorageScript = `entries => {
+  for (const { name, value } of entries)
+    localStorage.setItem(name, value);
+}`
+
+// ApplyStorageState sets the cookies and the local storage of state in a live context, as the StorageState option of
+// [Browser.NewContext] does in a new one. The local storage of an origin is set by navigating a temporary page of the
+// context to it, with the requests of the page fulfilled by an empty document so that the server of the origin is not
+// contacted. The existing cookies and local storage of the context are kept, unless state overrides them.
+func ApplyStorageState(context BrowserContext, state *OptionalStorageState) error {
+	if state == nil {
+		return nil
+	}
+	if len(state.Cookies) > 0 {
+		if err := context.AddCookies(state.Cookies); err != nil {
+			return fmt.Errorf("could not add cookies: %w", err)
+		}
+	}
+	origins := make([]Origin, 0, len(state.Origins))
+	for _, origin := range state.Origins {
+		if len(origin.LocalStorage) == 0 {
+			continue
+		}
+		u, err := url.Parse(origin.Origin)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("could not set local storage of origin %q: only http and https origins are supported", origin.Origin)
+		}
+		origins = append(origins, Origin{Origin: u.Scheme + "://" + u.Host, LocalStorage: origin.LocalStorage})
+	}
+	if len(origins) == 0 {
+		return nil
+	}
+	page, err := context.NewPage()
+	if err != nil {
+		return fmt.Errorf("could not create page to set local storage: %w", err)
+	}
+	defer page.Close()
+	if err := page.Route("**/*", func(route Route) {
+		_ = route.Fulfill(RouteFulfillOptions{
+			ContentType: String("text/html"),
+			Body:        "<html></html>",
+		})
+	}); err != nil {
+		return err
+	}
+	for _, origin := range origins {
+		if _, err := page.Goto(origin.Origin + "/"); err != nil {
+			return fmt.Errorf("could not navigate to %s: %w", origin.Origin, err)
+		}
+		if _, err := page.Evaluate(setLocalStorageScript, origin.LocalStorage); err != nil {
+			return fmt.Errorf("could not set local storage of %s: %w", origin.Origin, err)
+		}
+	}
+	return nil
+}
+
+// CopyStorageState copies the cookies and the local storage of a live context to another one, without going through a
+// file, see [ApplyStorageState].
+func CopyStorageState(from BrowserContext, to BrowserContext) error {
+	state, err := from.StorageState()
+	if err != nil {
+		return fmt.Errorf("could not get storage state: %w", err)
+	}
+	return ApplyStorageState(to, state.ToOptionalStorageState())
+}
+
+// BroadcastStorageState copies the cookies and the local storage of a context to other contexts concurrently, such as
+// a login performed once to the contexts of parallel workers, see [ApplyStorageState]. The storage state is read once,
+// the errors of all the contexts are returned joined.
+func BroadcastStorageState(from BrowserContext, to ...BrowserContext) error {
+	state, err := from.StorageState()
+	if err != nil {
+		return fmt.Errorf("could not get storage state: %w", err)
+	}
+	optional := state.ToOptionalStorageState()
+	errs := make([]error, len(to))
+	var wg sync.WaitGroup
+	for i, context := range to {
+		wg.Add(1)
+		go func(i int, context BrowserContext) {
+			defer wg.Done()
+			errs[i] = ApplyStorageState(context, optional)
+		}(i, context)
+	}
+	wg.Wait()
+	return multierror.Join(errs...)
+}
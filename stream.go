@@ -3,6 +3,7 @@ package playwright
 import (
 	"bufio"
 	"encoding/base64"
+	"io"
 	"os"
 	"path/filepath"
 )
@@ -23,11 +24,7 @@ func (s *streamImpl) SaveAs(path string) error {
 	defer file.Close()
 	writer := bufio.NewWriter(file)
 	for {
-		binary, err := s.channel.Send("read", map[string]interface{}{"size": 1024 * 1024})
-		if err != nil {
-			return err
-		}
-		bytes, err := base64.StdEncoding.DecodeString(binary.(string))
+		bytes, err := s.read()
 		if err != nil {
 			return err
 		}
@@ -39,17 +36,13 @@ func (s *streamImpl) SaveAs(path string) error {
 			return err
 		}
 	}
-	return nil
+	return writer.Flush()
 }
 
 func (s *streamImpl) ReadAll() ([]byte, error) {
 	var data []byte
 	for {
-		binary, err := s.channel.Send("read", map[string]interface{}{"size": 1024 * 1024})
-		if err != nil {
-			return nil, err
-		}
-		bytes, err := base64.StdEncoding.DecodeString(binary.(string))
+		bytes, err := s.read()
 		if err != nil {
 			return nil, err
 		}
@@ -61,6 +54,60 @@ func (s *streamImpl) ReadAll() ([]byte, error) {
 	return data, nil
 }
 
+// streamReader reads a stream chunk by chunk as the caller consumes it.
+type streamReader struct {
+	stream *streamImpl
+	buf    []byte
+	eof    bool
+	closed bool
+}
+
+func (r *streamReader) Read(p []byte) (int, error) {
+	if r.closed {
+		return 0, os.ErrClosed
+	}
+	for len(r.buf) == 0 {
+		if r.eof {
+			return 0, io.EOF
+		}
+		chunk, err := r.stream.read()
+		if err != nil {
+			return 0, err
+		}
+		if len(chunk) == 0 {
+			r.eof = true
+		}
+		r.buf = chunk
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// Close releases the stream on the server. It can be called before the stream is fully read.
+func (r *streamReader) Close() error {
+	if r.closed {
+		return nil
+	}
+	r.closed = true
+	r.buf = nil
+	_, err := r.stream.channel.Send("close")
+	return err
+}
+
+func (s *streamImpl) read() ([]byte, error) {
+	binary, err := s.channel.Send("read", map[string]interface{}{"size": 1024 * 1024})
+	if err != nil {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(binary.(string))
+}
+
+// Reader returns an [io.ReadCloser] streaming the content of the stream.
+func (s *streamImpl) Reader() io.ReadCloser {
+	return &streamReader{stream: s}
+}
+
 func newStream(parent *channelOwner, objectType string, guid string, initializer map[string]interface{}) *streamImpl {
 	stream := &streamImpl{}
 	stream.createChannelOwner(stream, parent, objectType, guid, initializer)
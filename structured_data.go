@@ -0,0 +1,352 @@
+package playwright
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/playwright-community/playwright-go/internal/multierror"
+)
+
+// structuredDataScript returns the JSON-LD scripts of the document, and its top level microdata items converted to
+// JSON-LD like objects: the types of an item are in `@type`, its id in `@id`, and a property with several values is
+// an array. The URL properties are resolved by the browser.
+const structuredDataScript = `() => {
+  const urlAttributes = { A: 'href', AREA: 'href', LINK: 'href', AUDIO: 'src', EMBED: 'src', IFRAME: 'src', IMG: 'src',
+    SOURCE: 'src', TRACK: 'src', VIDEO: 'src', OBJECT: 'data' };
+  const byDocumentOrder = (a, b) => a.compareDocumentPosition(b) & Node.DOCUMENT_POSITION_FOLLOWING ? -1 : 1;
+  const properties = root => {
+    const pending = [...root.children];
+    for (const id of (root.getAttribute('itemref') || '').split(/\s+/).filter(Boolean)) {
+      const element = document.getElementById(id);
+      if (element)
+        pending.push(element);
+    }
+    const seen = new Set();
+    const result = [];
+    while (pending.length) {
+      const element = pending.shift();
+      if (seen.has(element))
+        continue;
+      seen.add(element);
+      if (element.hasAttribute('itemprop'))
+        result.push(element);
+      if (!element.hasAttribute('itemscope'))
+        pending.push(...element.children);
+    }
+    return result.sort(byDocumentOrder);
+  };
+  const value = (element, ancestors) => {
+    if (element.hasAttribute('itemscope'))
+      return ancestors.has(element) ? null : item(element, ancestors);
+    if (element.tagName === 'META')
+      return element.getAttribute('content') || '';
+    if (urlAttributes[element.tagName])
+      return element[urlAttributes[element.tagName]] || '';
+    if (element.tagName === 'DATA' || element.tagName === 'METER')
+      return element.getAttribute('value') || '';
+    if (element.tagName === 'TIME' && element.hasAttribute('datetime'))
+      return element.getAttribute('datetime');
+    return (element.textContent || '').trim();
+  };
+  const item = (element, ancestors = new Set()) => {
+    ancestors = new Set([...ancestors, element]);
+    const result = {};
+    const types = (element.getAttribute('itemtype') || '').split(/\s+/).filter(Boolean);
+    if (types.length)
+      result['@type'] = types.length === 1 ? types[0] : types;
+    if (element.hasAttribute('itemid'))
+      result['@id'] = element.getAttribute('itemid');
+    for (const property of properties(element)) {
+      const propertyValue = value(property, ancestors);
+      for (const name of property.getAttribute('itemprop').split(/\s+/).filter(Boolean)) {
+        if (!(name in result))
+          result[name] = propertyValue;
+        else if (Array.isArray(result[name]))
+          result[name].push(propertyValue);
+        else
+          result[name] = [result[name], propertyValue];
+      }
+    }
+    return result;
+  };
+  return {
+    jsonld: [...document.querySelectorAll('script[type="application/ld+json" i]')].map(script => script.textContent),
+    microdata: [...document.querySelectorAll('[itemscope]:not([itemprop])')].map(element => item(element)),
+  };
+}`
+
+// StructuredDataItem is a schema.org item of a page, see [Page.StructuredData].
+type StructuredDataItem struct {
+	// Format of the item, `json-ld` or `microdata`.
+	Format string
+	// Types of the item without the schema.org prefix, such as `Product`.
+	Types []string
+	// Id of the item, from `@id` or `itemid`.
+	ID string
+	// Properties of the item as JSON-LD, including `@type` and `@id`. Nested items are maps, and properties with
+	// several values are slices.
+	Properties map[string]interface{}
+}
+
+// Is returns whether the item has the type, without the schema.org prefix.
+func (i StructuredDataItem) Is(typ string) bool {
+	for _, t := range i.Types {
+		if t == typ {
+			return true
+		}
+	}
+	return false
+}
+
+// Decode decodes the properties of the item into v, as with [json.Unmarshal].
+func (i StructuredDataItem) Decode(v interface{}) error {
+	data, err := json.Marshal(i.Properties)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// StructuredDataError is a problem found in the structured data of a page.
+type StructuredDataError struct {
+	// Format of the data, `json-ld` or `microdata`.
+	Format string
+	// Index of the JSON-LD script, or of the top level microdata item, in the document.
+	Index int
+	// Types of the item, if any.
+	Types   []string
+	Message string
+}
+
+func (e StructuredDataError) Error() string {
+	if len(e.Types) > 0 {
+		return fmt.Sprintf("%s %d (%s): %s", e.Format, e.Index, strings.Join(e.Types, ", "), e.Message)
+	}
+	return fmt.Sprintf("%s %d: %s", e.Format, e.Index, e.Message)
+}
+
+// StructuredData is the schema.org structured data of a page, see [Page.StructuredData].
+type StructuredData struct {
+	// Items in the JSON-LD scripts, then the top level microdata items, in document order. The items of a JSON-LD
+	// `@graph` are separate items.
+	Items []StructuredDataItem
+	// Problems found in the data: invalid JSON, items without type or outside of schema.org, and items missing
+	// properties required for rich results.
+	Errors []StructuredDataError
+}
+
+// ItemsOf returns the items of the type, without the schema.org prefix.
+func (d *StructuredData) ItemsOf(typ string) []StructuredDataItem {
+	items := make([]StructuredDataItem, 0)
+	for _, item := range d.Items {
+		if item.Is(typ) {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// Err returns the errors of the data joined, or nil if it has none.
+func (d *StructuredData) Err() error {
+	errs := make([]error, 0, len(d.Errors))
+	for _, err := range d.Errors {
+		errs = append(errs, err)
+	}
+	return multierror.Join(errs...)
+}
+
+// structuredDataRequiredProperties are the properties search engines require on the top level items of common types
+// to show them as rich results.
+var structuredDataRequiredProperties = map[string][]string{
+	"Article":        {"headline"},
+	"BreadcrumbList": {"itemListElement"},
+	"Event":          {"name", "startDate", "location"},
+	"FAQPage":        {"mainEntity"},
+	"JobPosting":     {"title", "description", "datePosted", "hiringOrganization"},
+	"Organization":   {"name"},
+	"Person":         {"name"},
+	"Product":        {"name"},
+	"Recipe":         {"name", "image"},
+	"Review":         {"author", "reviewRating"},
+}
+
+func (p *pageImpl) StructuredData() (*StructuredData, error) {
+	result, err := p.mainFrame.Evaluate(structuredDataScript)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := decodeCDPValue[struct {
+		JSONLD    []string                 `json:"jsonld"`
+		Microdata []map[string]interface{} `json:"microdata"`
+	}](result)
+	if err != nil {
+		return nil, err
+	}
+	return parseStructuredData(raw.JSONLD, raw.Microdata), nil
+}
+
+func parseStructuredData(scripts []string, microdata []map[string]interface{}) *StructuredData {
+	data := &StructuredData{
+		Items:  make([]StructuredDataItem, 0),
+		Errors: make([]StructuredDataError, 0),
+	}
+	for index, script := range scripts {
+		var value interface{}
+		if err := json.Unmarshal([]byte(script), &value); err != nil {
+			data.Errors = append(data.Errors, StructuredDataError{
+				Format:  "json-ld",
+				Index:   index,
+				Message: fmt.Sprintf("invalid JSON: %v", err),
+			})
+			continue
+		}
+		data.addJSONLD(index, value, nil)
+	}
+	for index, properties := range microdata {
+		item := StructuredDataItem{Format: "microdata", Properties: properties}
+		fail := func(message string) {
+			data.Errors = append(data.Errors, StructuredDataError{
+				Format: "microdata", Index: index, Types: item.Types, Message: message,
+			})
+		}
+		types := structuredDataStrings(properties["@type"])
+		if len(types) == 0 {
+			fail("item has no itemtype")
+			continue
+		}
+		item.Types, item.ID = normalizeSchemaTypes(types), structuredDataString(properties["@id"])
+		if !isSchemaOrgType(types[0]) {
+			fail(fmt.Sprintf("itemtype is not schema.org: %s", types[0]))
+			continue
+		}
+		data.Items = append(data.Items, item)
+		for _, message := range missingStructuredDataProperties(item) {
+			fail(message)
+		}
+	}
+	return data
+}
+
+// addJSONLD adds the items of a JSON-LD value of the script at index, with the context inherited from its parent.
+func (d *StructuredData) addJSONLD(index int, value, context interface{}) {
+	fail := func(types []string, message string) {
+		d.Errors = append(d.Errors, StructuredDataError{Format: "json-ld", Index: index, Types: types, Message: message})
+	}
+	switch v := value.(type) {
+	case []interface{}:
+		for _, node := range v {
+			d.addJSONLD(index, node, context)
+		}
+	case map[string]interface{}:
+		if c, ok := v["@context"]; ok {
+			context = c
+		}
+		if graph, ok := v["@graph"]; ok {
+			d.addJSONLD(index, graph, context)
+			return
+		}
+		types := normalizeSchemaTypes(structuredDataStrings(v["@type"]))
+		if context == nil {
+			fail(types, "missing @context")
+			return
+		}
+		if !isSchemaOrgContext(context) {
+			fail(types, fmt.Sprintf("@context is not schema.org: %v", context))
+			return
+		}
+		if len(types) == 0 {
+			fail(nil, "missing @type")
+			return
+		}
+		item := StructuredDataItem{
+			Format:     "json-ld",
+			Types:      types,
+			ID:         structuredDataString(v["@id"]),
+			Properties: v,
+		}
+		d.Items = append(d.Items, item)
+		for _, message := range missingStructuredDataProperties(item) {
+			fail(types, message)
+		}
+	default:
+		fail(nil, fmt.Sprintf("expected an object or an array, got %v", value))
+	}
+}
+
+// missingStructuredDataProperties returns the errors of the required properties missing from item.
+func missingStructuredDataProperties(item StructuredDataItem) []string {
+	var messages []string
+	for _, typ := range item.Types {
+		for _, property := range structuredDataRequiredProperties[typ] {
+			if value, ok := item.Properties[property]; !ok || value == nil || value == "" {
+				messages = append(messages, fmt.Sprintf("%s is missing required property %q", typ, property))
+			}
+		}
+	}
+	sort.Strings(messages)
+	return messages
+}
+
+func isSchemaOrgType(typ string) bool {
+	return normalizeSchemaType(typ) != typ || !strings.Contains(typ, ":")
+}
+
+func isSchemaOrgContext(context interface{}) bool {
+	switch c := context.(type) {
+	case string:
+		return strings.Contains(strings.ToLower(c), "schema.org")
+	case []interface{}:
+		for _, entry := range c {
+			if isSchemaOrgContext(entry) {
+				return true
+			}
+		}
+	case map[string]interface{}:
+		return isSchemaOrgContext(c["@vocab"])
+	}
+	return false
+}
+
+// normalizeSchemaType removes the schema.org prefix of typ, such as `https://schema.org/`.
+func normalizeSchemaType(typ string) string {
+	for _, prefix := range []string{"https://schema.org/", "http://schema.org/", "https://www.schema.org/", "http://www.schema.org/", "schema:"} {
+		if strings.HasPrefix(typ, prefix) {
+			return strings.TrimPrefix(typ, prefix)
+		}
+	}
+	return typ
+}
+
+func normalizeSchemaTypes(types []string) []string {
+	normalized := make([]string, 0, len(types))
+	for _, typ := range types {
+		normalized = append(normalized, normalizeSchemaType(typ))
+	}
+	return normalized
+}
+
+// structuredDataStrings returns the strings of a value that is a string or an array of strings.
+func structuredDataStrings(value interface{}) []string {
+	switch v := value.(type) {
+	case string:
+		if v != "" {
+			return []string{v}
+		}
+	case []interface{}:
+		strs := make([]string, 0, len(v))
+		for _, entry := range v {
+			if s, ok := entry.(string); ok && s != "" {
+				strs = append(strs, s)
+			}
+		}
+		return strs
+	}
+	return nil
+}
+
+func structuredDataString(value interface{}) string {
+	s, _ := value.(string)
+	return s
+}
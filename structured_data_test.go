@@ -0,0 +1,68 @@
+package playwright
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStructuredDataJSONLD(t *testing.T) {
+	data := parseStructuredData([]string{
+		`{"@context": "https://schema.org", "@type": "Product", "name": "Shoe", "offers": {"@type": "Offer", "price": "9.99"}}`,
+		`{"@context": {"@vocab": "http://schema.org/"}, "@graph": [
+			{"@type": "Organization", "@id": "#org", "name": "ACME"},
+			{"@type": ["schema:Article", "NewsArticle"]}
+		]}`,
+		`{"@type": "Person", "name": "No context"}`,
+		`{"@context": "https://example.com/vocab", "@type": "Thing"}`,
+		`{"@context": "https://schema.org", "name": "No type"}`,
+		`{not json`,
+	}, nil)
+	require.Len(t, data.Items, 3)
+	require.Equal(t, []string{"Product"}, data.Items[0].Types)
+	require.Equal(t, "#org", data.Items[1].ID)
+	require.True(t, data.Items[2].Is("Article"))
+	require.True(t, data.Items[2].Is("NewsArticle"))
+	require.Len(t, data.ItemsOf("Organization"), 1)
+
+	var product struct {
+		Name   string `json:"name"`
+		Offers struct {
+			Price string `json:"price"`
+		} `json:"offers"`
+	}
+	require.NoError(t, data.Items[0].Decode(&product))
+	require.Equal(t, "Shoe", product.Name)
+	require.Equal(t, "9.99", product.Offers.Price)
+
+	messages := make([]string, 0)
+	for _, err := range data.Errors {
+		messages = append(messages, err.Error())
+	}
+	require.Equal(t, []string{
+		`json-ld 1 (Article, NewsArticle): Article is missing required property "headline"`,
+		"json-ld 2 (Person): missing @context",
+		"json-ld 3 (Thing): @context is not schema.org: https://example.com/vocab",
+		"json-ld 4: missing @type",
+		"json-ld 5: invalid JSON: invalid character 'n' looking for beginning of object key string",
+	}, messages)
+	require.Error(t, data.Err())
+}
+
+func TestParseStructuredDataMicrodata(t *testing.T) {
+	data := parseStructuredData(nil, []map[string]interface{}{
+		{"@type": "https://schema.org/Event", "name": "Concert", "startDate": "2024-05-01"},
+		{"name": "No type"},
+		{"@type": "https://example.com/Thing"},
+	})
+	require.Len(t, data.Items, 1)
+	require.Equal(t, "microdata", data.Items[0].Format)
+	require.Equal(t, []string{"Event"}, data.Items[0].Types)
+	require.Equal(t, []StructuredDataError{
+		{Format: "microdata", Index: 0, Types: []string{"Event"}, Message: `Event is missing required property "location"`},
+		{Format: "microdata", Index: 1, Message: "item has no itemtype"},
+		{Format: "microdata", Index: 2, Types: []string{"https://example.com/Thing"}, Message: "itemtype is not schema.org: https://example.com/Thing"},
+	}, data.Errors)
+
+	require.NoError(t, parseStructuredData(nil, nil).Err())
+}
@@ -0,0 +1,249 @@
+package playwright
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// workerTargetTypes are the CDP target types of the workers, by whether the worker is a service worker.
+var workerTargetTypes = map[bool][]string{
+	false: {"worker", "shared_worker"},
+	true:  {"service_worker"},
+}
+
+// targetCDPSession is a CDPSession of a target Playwright does not create sessions for, such as a worker. Its messages
+// are exchanged through a browser session attached to the target without flattening, which routes them by session
+// id in the Target domain: the CDP sessions of the driver send their messages in their own session, so they can't
+// address the session of a target attached with flattening.
+type targetCDPSession struct {
+	eventEmitter
+	browserSession CDPSession
+	sessionID      string
+	lastID         atomic.Int64
+	// mu guards callbacks and detached, so that no reply is delivered to a callback closed on detach.
+	mu        sync.Mutex
+	callbacks map[int64]chan targetCDPMessage
+	detached  bool
+}
+
+type targetCDPMessage struct {
+	ID     int64                  `json:"id"`
+	Method string                 `json:"method"`
+	Params map[string]interface{} `json:"params"`
+	Result interface{}            `json:"result"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func (b *browserContextImpl) newWorkerCDPSession(worker *workerImpl) (CDPSession, error) {
+	if b.browser == nil {
+		return nil, errors.New("CDP sessions of workers are only supported in contexts of a browser")
+	}
+	browserSession, err := b.browser.NewBrowserCDPSession()
+	if err != nil {
+		return nil, err
+	}
+	session, err := attachWorkerTarget(browserSession, worker)
+	if err != nil {
+		_ = browserSession.Detach()
+		return nil, err
+	}
+	return session, nil
+}
+
+// attachWorkerTarget attaches to the target of worker. The targets of the type of the worker and with its URL may be
+// those of other workers with the same script, so the worker is given a token and the target having it is kept.
+func attachWorkerTarget(browserSession CDPSession, worker *workerImpl) (*targetCDPSession, error) {
+	token, err := newIsolatedWorldToken()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := worker.Evaluate(`token => { globalThis[token] = true; }`, token); err != nil {
+		return nil, err
+	}
+	result, err := browserSession.Send("Target.getTargets", map[string]interface{}{})
+	if err != nil {
+		return nil, err
+	}
+	infos, _ := result.(map[string]interface{})["targetInfos"].([]interface{})
+	for _, info := range infos {
+		target, _ := info.(map[string]interface{})
+		if target["url"] != worker.URL() || !isWorkerTargetType(target["type"], worker) {
+			continue
+		}
+		session, err := attachTargetCDPSession(browserSession, target["targetId"].(string))
+		if err != nil {
+			continue
+		}
+		value, err := session.Send("Runtime.evaluate", map[string]interface{}{
+			"expression":    fmt.Sprintf("%[1]q in globalThis && delete globalThis[%[1]q]", token),
+			"returnByValue": true,
+		})
+		if err == nil && hasWorkerToken(value) {
+			return session, nil
+		}
+		session.detachTarget()
+	}
+	return nil, fmt.Errorf("could not find the target of worker %s", worker.URL())
+}
+
+func isWorkerTargetType(targetType interface{}, worker *workerImpl) bool {
+	for _, t := range workerTargetTypes[worker.context != nil] {
+		if targetType == t {
+			return true
+		}
+	}
+	return false
+}
+
+// hasWorkerToken returns whether the result of Runtime.evaluate shows that the target had the token of the worker.
+func hasWorkerToken(result interface{}) bool {
+	value, err := decodeCDPValue[struct {
+		Result struct {
+			Value interface{} `json:"value"`
+		} `json:"result"`
+	}](result)
+	return err == nil && value.Result.Value == true
+}
+
+func attachTargetCDPSession(browserSession CDPSession, targetID string) (*targetCDPSession, error) {
+	result, err := browserSession.Send("Target.attachToTarget", map[string]interface{}{
+		"targetId": targetID,
+		"flatten":  false,
+	})
+	if err != nil {
+		return nil, err
+	}
+	s := &targetCDPSession{
+		browserSession: browserSession,
+		sessionID:      result.(map[string]interface{})["sessionId"].(string),
+		callbacks:      make(map[int64]chan targetCDPMessage),
+	}
+	browserSession.On("Target.receivedMessageFromTarget", s.onMessage)
+	browserSession.On("Target.detachedFromTarget", func(params map[string]interface{}) {
+		if params["sessionId"] == s.sessionID {
+			s.onDetached()
+		}
+	})
+	return s, nil
+}
+
+func (s *targetCDPSession) onMessage(params map[string]interface{}) {
+	if params["sessionId"] != s.sessionID {
+		return
+	}
+	var msg targetCDPMessage
+	raw, _ := params["message"].(string)
+	if err := json.Unmarshal([]byte(raw), &msg); err != nil {
+		logger.Printf("could not decode message of target session %s: %v\n", s.sessionID, err)
+		return
+	}
+	if msg.ID != 0 {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if callback, ok := s.callbacks[msg.ID]; ok {
+			delete(s.callbacks, msg.ID)
+			callback <- msg
+		}
+		return
+	}
+	s.Emit(msg.Method, msg.Params)
+}
+
+func (s *targetCDPSession) onDetached() {
+	if !s.markDetached() {
+		return
+	}
+	_ = s.browserSession.Detach()
+	s.Emit("detached", s)
+}
+
+// markDetached marks the session detached and fails the pending calls, and returns false if it already was.
+func (s *targetCDPSession) markDetached() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.detached {
+		return false
+	}
+	s.detached = true
+	for id, callback := range s.callbacks {
+		delete(s.callbacks, id)
+		close(callback)
+	}
+	return true
+}
+
+// detachTarget detaches from the target, keeping the browser session.
+func (s *targetCDPSession) detachTarget() {
+	if s.markDetached() {
+		_, _ = s.browserSession.Send("Target.detachFromTarget", map[string]interface{}{
+			"sessionId": s.sessionID,
+		})
+	}
+}
+
+func (s *targetCDPSession) OnDetached(fn func(CDPSession)) {
+	s.On("detached", fn)
+}
+
+func (s *targetCDPSession) IsDetached() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.detached
+}
+
+func (s *targetCDPSession) Send(method string, params map[string]interface{}) (interface{}, error) {
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+	id := s.lastID.Add(1)
+	message, err := json.Marshal(map[string]interface{}{
+		"id":     id,
+		"method": method,
+		"params": params,
+	})
+	if err != nil {
+		return nil, err
+	}
+	callback := make(chan targetCDPMessage, 1)
+	s.mu.Lock()
+	if s.detached {
+		s.mu.Unlock()
+		return nil, fmt.Errorf("%w: CDP session is detached", ErrTargetClosed)
+	}
+	s.callbacks[id] = callback
+	s.mu.Unlock()
+	if _, err := s.browserSession.Send("Target.sendMessageToTarget", map[string]interface{}{
+		"sessionId": s.sessionID,
+		"message":   string(message),
+	}); err != nil {
+		s.mu.Lock()
+		delete(s.callbacks, id)
+		s.mu.Unlock()
+		return nil, err
+	}
+	msg, ok := <-callback
+	if !ok {
+		return nil, fmt.Errorf("%w: CDP session was detached while waiting for %s", ErrTargetClosed, method)
+	}
+	if msg.Error != nil {
+		return nil, fmt.Errorf("%s: %s", method, msg.Error.Message)
+	}
+	return msg.Result, nil
+}
+
+func (s *targetCDPSession) Detach() error {
+	if s.IsDetached() {
+		return nil
+	}
+	_, err := s.browserSession.Send("Target.detachFromTarget", map[string]interface{}{
+		"sessionId": s.sessionID,
+	})
+	s.onDetached()
+	return err
+}
@@ -0,0 +1,118 @@
+package playwright
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeBrowserCDPSession answers the messages sent to a target as a worker would.
+type fakeBrowserCDPSession struct {
+	eventEmitter
+	sent     []string
+	detached bool
+}
+
+func (f *fakeBrowserCDPSession) Send(method string, params map[string]interface{}) (interface{}, error) {
+	f.sent = append(f.sent, method)
+	switch method {
+	case "Target.attachToTarget":
+		return map[string]interface{}{"sessionId": "session-1"}, nil
+	case "Target.sendMessageToTarget":
+		var msg map[string]interface{}
+		if err := json.Unmarshal([]byte(params["message"].(string)), &msg); err != nil {
+			return nil, err
+		}
+		var reply string
+		if msg["method"] == "Runtime.evaluate" {
+			reply = `{"id":` + jsonNumber(msg["id"]) + `,"result":{"result":{"value":2}}}`
+		} else {
+			reply = `{"id":` + jsonNumber(msg["id"]) + `,"error":{"code":-32601,"message":"'Page.enable' wasn't found"}}`
+		}
+		f.Emit("Target.receivedMessageFromTarget", map[string]interface{}{
+			"sessionId": "session-1",
+			"message":   `{"method":"Runtime.consoleAPICalled","params":{"type":"log"}}`,
+		})
+		f.Emit("Target.receivedMessageFromTarget", map[string]interface{}{"sessionId": "other", "message": `{"id":1}`})
+		f.Emit("Target.receivedMessageFromTarget", map[string]interface{}{"sessionId": "session-1", "message": reply})
+		return map[string]interface{}{}, nil
+	case "Target.detachFromTarget":
+		f.Emit("Target.detachedFromTarget", map[string]interface{}{"sessionId": "session-1"})
+		return map[string]interface{}{}, nil
+	}
+	return nil, nil
+}
+
+func (f *fakeBrowserCDPSession) Detach() error {
+	f.detached = true
+	return nil
+}
+
+func (f *fakeBrowserCDPSession) IsDetached() bool {
+	return f.detached
+}
+
+func (f *fakeBrowserCDPSession) OnDetached(fn func(CDPSession)) {
+	f.On("detached", fn)
+}
+
+func jsonNumber(v interface{}) string {
+	data, _ := json.Marshal(v)
+	return string(data)
+}
+
+func TestTargetCDPSession(t *testing.T) {
+	browserSession := &fakeBrowserCDPSession{}
+	session, err := attachTargetCDPSession(browserSession, "target-1")
+	require.NoError(t, err)
+
+	events := make([]interface{}, 0)
+	session.On("Runtime.consoleAPICalled", func(params map[string]interface{}) {
+		events = append(events, params["type"])
+	})
+	result, err := session.Send("Runtime.evaluate", map[string]interface{}{"expression": "1 + 1"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"result": map[string]interface{}{"value": float64(2)}}, result)
+	require.Equal(t, []interface{}{"log"}, events)
+
+	_, err = session.Send("Page.enable", nil)
+	require.ErrorContains(t, err, "Page.enable: 'Page.enable' wasn't found")
+
+	require.NoError(t, session.Detach())
+	require.True(t, browserSession.detached)
+	_, err = session.Send("Runtime.evaluate", nil)
+	require.ErrorIs(t, err, ErrTargetClosed)
+}
+
+// silentBrowserCDPSession never answers the messages sent to a target.
+type silentBrowserCDPSession struct {
+	fakeBrowserCDPSession
+	sent chan struct{}
+}
+
+func (f *silentBrowserCDPSession) Send(method string, params map[string]interface{}) (interface{}, error) {
+	if method == "Target.sendMessageToTarget" {
+		f.sent <- struct{}{}
+		return map[string]interface{}{}, nil
+	}
+	return f.fakeBrowserCDPSession.Send(method, params)
+}
+
+func TestTargetCDPSessionDetachedWhileWaiting(t *testing.T) {
+	browserSession := &silentBrowserCDPSession{sent: make(chan struct{}, 1)}
+	session, err := attachTargetCDPSession(browserSession, "target-1")
+	require.NoError(t, err)
+
+	errs := make(chan error, 1)
+	go func() {
+		_, err := session.Send("Runtime.evaluate", nil)
+		errs <- err
+	}()
+	<-browserSession.sent
+	session.onDetached()
+	require.ErrorIs(t, <-errs, ErrTargetClosed)
+	require.True(t, browserSession.detached)
+	// A reply arriving after the session was detached is dropped.
+	session.onMessage(map[string]interface{}{"sessionId": "session-1", "message": `{"id":1,"result":{}}`})
+}
@@ -0,0 +1,96 @@
+package playwright_test
+
+import (
+	"testing"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessibilitySnapshot(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.SetContent(`
+		<head><title>Accessibility Test</title></head>
+		<body>
+			<h1>Inputs</h1>
+			<input placeholder="Empty input" autofocus />
+			<input aria-label="Filled" value="value" />
+			<input type="checkbox" aria-label="Agree" checked />
+		</body>`))
+	snapshot, err := page.Accessibility().Snapshot()
+	require.NoError(t, err)
+	require.NotNil(t, snapshot)
+	require.Equal(t, "Accessibility Test", snapshot.Name)
+
+	heading := snapshot.Find(func(n *playwright.AccessibilityNode) bool {
+		return n.Role == "heading"
+	})
+	require.NotNil(t, heading)
+	require.Equal(t, "Inputs", heading.Name)
+
+	filled := snapshot.Find(func(n *playwright.AccessibilityNode) bool {
+		return n.Name == "Filled"
+	})
+	require.NotNil(t, filled)
+	require.Equal(t, "value", filled.Value())
+
+	checkbox := snapshot.Find(func(n *playwright.AccessibilityNode) bool {
+		return n.Role == "checkbox"
+	})
+	require.NotNil(t, checkbox)
+	require.Equal(t, "checked", *checkbox.Checked)
+}
+
+func TestAccessibilitySnapshotShouldSupportRoot(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.SetContent(`<div><button>My Button</button></div><p>Other</p>`))
+	button, err := page.QuerySelector("button")
+	require.NoError(t, err)
+	snapshot, err := page.Accessibility().Snapshot(playwright.AccessibilitySnapshotOptions{
+		Root: button,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, snapshot)
+	require.Equal(t, "button", snapshot.Role)
+	require.Equal(t, "My Button", snapshot.Name)
+	require.Empty(t, snapshot.Children)
+}
+
+func TestAccessibilitySnapshotShouldReturnAllNodesWhenNotInterestingOnly(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.SetContent(`<div><div><span>Text</span></div></div>`))
+	interesting, err := page.Accessibility().Snapshot()
+	require.NoError(t, err)
+	all, err := page.Accessibility().Snapshot(playwright.AccessibilitySnapshotOptions{
+		InterestingOnly: playwright.Bool(false),
+	})
+	require.NoError(t, err)
+	require.Greater(t, countAccessibilityNodes(all), countAccessibilityNodes(interesting))
+}
+
+func TestAccessibilitySnapshotShouldReturnNilForHiddenRoot(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.SetContent(`<button style="display: none">Hidden</button>`))
+	button, err := page.QuerySelector("button")
+	require.NoError(t, err)
+	snapshot, err := page.Accessibility().Snapshot(playwright.AccessibilitySnapshotOptions{
+		Root: button,
+	})
+	require.NoError(t, err)
+	require.Nil(t, snapshot)
+}
+
+func countAccessibilityNodes(node *playwright.AccessibilityNode) int {
+	if node == nil {
+		return 0
+	}
+	count := 1
+	for _, child := range node.Children {
+		count += countAccessibilityNodes(child)
+	}
+	return count
+}
@@ -0,0 +1,88 @@
+package playwright_test
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBrowserContextAuditLogShouldRecordActions(t *testing.T) {
+	BeforeEach(t, playwright.BrowserNewContextOptions{
+		AuditLog: playwright.Bool(true),
+	})
+
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	require.NoError(t, page.SetContent(`<button onclick="window.clicked = true">Submit</button>`))
+	require.NoError(t, page.Locator("button").Click())
+	require.Error(t, page.Locator("#missing").Click(playwright.LocatorClickOptions{Timeout: playwright.Float(100)}))
+
+	entries := context.AuditLog()
+	actions := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		actions = append(actions, entry.Action)
+		require.False(t, entry.Pending, entry.Action)
+		require.Positive(t, entry.Goroutine)
+		require.False(t, entry.Time.IsZero())
+	}
+	require.Equal(t, []string{"Page.Goto", "Page.SetContent", "Locator.Click", "Locator.Click"}, actions)
+	require.Equal(t, server.EMPTY_PAGE, entries[0].URL)
+	require.Equal(t, "button", entries[2].Selector)
+	require.Equal(t, server.EMPTY_PAGE, entries[2].URL)
+	require.Empty(t, entries[2].Error)
+	require.NotEmpty(t, entries[2].Stack)
+	require.True(t, strings.Contains(entries[2].Stack[0], "audit_log_test.go"), entries[2].Stack[0])
+	require.Contains(t, entries[3].Error, "Timeout")
+
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	require.NoError(t, context.ExportAuditLog(path))
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	lines := 0
+	for scanner.Scan() {
+		var entry playwright.AuditLogEntry
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		require.Equal(t, entries[lines].Action, entry.Action)
+		lines++
+	}
+	require.Equal(t, len(entries), lines)
+}
+
+func TestBrowserContextAuditLogShouldBeAttachedToTraces(t *testing.T) {
+	BeforeEach(t, playwright.BrowserNewContextOptions{
+		AuditLog: playwright.Bool(true),
+	})
+
+	require.NoError(t, context.Tracing().Start())
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	tracePath := filepath.Join(t.TempDir(), "trace.zip")
+	require.NoError(t, context.Tracing().Stop(tracePath))
+
+	reader, err := zip.OpenReader(tracePath)
+	require.NoError(t, err)
+	defer reader.Close()
+	found := false
+	for _, file := range reader.File {
+		found = found || file.Name == "audit-log.jsonl"
+	}
+	require.True(t, found)
+}
+
+func TestBrowserContextAuditLogShouldBeDisabledByDefault(t *testing.T) {
+	BeforeEach(t)
+
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	require.Empty(t, context.AuditLog())
+	require.Error(t, context.ExportAuditLog(filepath.Join(t.TempDir(), "audit.jsonl")))
+}
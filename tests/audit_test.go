@@ -0,0 +1,92 @@
+package playwright_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageAudit(t *testing.T) {
+	BeforeEach(t)
+
+	server.SetRoute("/audit.html", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = w.Write([]byte(`<!DOCTYPE html>
+<html lang="en">
+<head>
+  <title>Audited</title>
+  <meta name="viewport" content="width=device-width, initial-scale=1">
+  <meta name="description" content="A page to audit">
+</head>
+<body>
+  <h1>Audited</h1>
+  <img src="data:image/gif;base64,R0lGODlhAQABAAAAACw=" width="10" height="10">
+  <a href="/other.html">click here</a>
+  <button></button>
+</body>
+</html>`))
+	})
+	_, err := page.Goto(server.PREFIX + "/audit.html")
+	require.NoError(t, err)
+	report, err := page.Audit()
+	if !isChromium {
+		require.ErrorIs(t, err, playwright.ErrAuditNotSupported)
+		return
+	}
+	require.NoError(t, err)
+	require.Equal(t, server.PREFIX+"/audit.html", report.URL)
+	require.Len(t, report.Categories, 4)
+	for _, category := range report.Categories {
+		require.NotNil(t, category.Score, category.ID)
+	}
+
+	require.Len(t, report.Audit("image-alt").Items, 1)
+	require.Equal(t, []string{"<button>"}, report.Audit("button-name").Items)
+	require.False(t, report.Audit("link-text").Passed())
+	require.True(t, report.Audit("document-title").Passed())
+	require.True(t, report.Audit("html-has-lang").Passed())
+	require.True(t, report.Audit("meta-description").Passed())
+	require.True(t, report.Audit("viewport").Passed())
+	require.True(t, report.Audit("doctype").Passed())
+	require.True(t, report.Audit("is-on-https").Passed())
+	require.Greater(t, *report.Audit("dom-size").NumericValue, 5.0)
+	require.NotNil(t, report.Audit("first-contentful-paint").NumericValue)
+	require.Nil(t, report.Audit("mainthread-work-breakdown").NumericValue)
+	require.Less(t, *report.Category(*playwright.AuditCategoryAccessibility).Score, 1.0)
+
+	report, err = page.Audit(playwright.PageAuditOptions{
+		Categories: []playwright.AuditCategory{*playwright.AuditCategoryPerformance},
+		Reload:     playwright.Bool(true),
+	})
+	require.NoError(t, err)
+	mainThreadTime := report.Audit("mainthread-work-breakdown").NumericValue
+	require.NotNil(t, mainThreadTime)
+	require.Greater(t, *mainThreadTime, 0.0)
+	require.Less(t, *mainThreadTime, 10000.0)
+}
+
+func TestPageAuditCategories(t *testing.T) {
+	BeforeEach(t)
+
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	report, err := page.Audit(playwright.PageAuditOptions{
+		Categories: []playwright.AuditCategory{*playwright.AuditCategorySeo},
+		Reload:     playwright.Bool(true),
+	})
+	if !isChromium {
+		require.ErrorIs(t, err, playwright.ErrAuditNotSupported)
+		return
+	}
+	require.NoError(t, err)
+	require.Len(t, report.Categories, 1)
+	require.Equal(t, *playwright.AuditCategorySeo, report.Categories[0].ID)
+	require.Nil(t, report.Audit("largest-contentful-paint"))
+	require.False(t, report.Audit("document-title").Passed())
+	require.Equal(t, 1.0, *report.Audit("http-status-code").Score)
+
+	_, err = page.Audit(playwright.PageAuditOptions{Categories: []playwright.AuditCategory{"pwa"}})
+	require.EqualError(t, err, "unknown audit category: pwa")
+}
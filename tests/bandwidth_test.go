@@ -0,0 +1,72 @@
+package playwright_test
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageBandwidthShouldCountBytesByResourceType(t *testing.T) {
+	BeforeEach(t)
+
+	server.SetRoute("/data.txt", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, strings.Repeat("a", 4096))
+	})
+	recording, err := browser.NewContext(playwright.BrowserNewContextOptions{
+		RecordBandwidth: playwright.Bool(true),
+	})
+	require.NoError(t, err)
+	defer recording.Close()
+	recordingPage, err := recording.NewPage()
+	require.NoError(t, err)
+
+	_, err = recordingPage.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	_, err = recordingPage.Evaluate(`url => fetch(url, { method: 'POST', body: 'x'.repeat(1000) }).then(r => r.text())`, server.PREFIX+"/data.txt")
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		bandwidth := recordingPage.Bandwidth()
+		return bandwidth.ByResourceType["document"].Requests == 1 && bandwidth.ByResourceType["fetch"].Requests == 1
+	}, 5*time.Second, 50*time.Millisecond)
+	bandwidth := recordingPage.Bandwidth()
+	fetch := bandwidth.ByResourceType["fetch"]
+	require.GreaterOrEqual(t, fetch.BytesSent, int64(1000))
+	require.GreaterOrEqual(t, fetch.BytesReceived, int64(4096))
+	require.Equal(t, bandwidth.ByResourceType["document"].BytesReceived+fetch.BytesReceived, bandwidth.Total.BytesReceived)
+
+	// The pages of other contexts record nothing.
+	_, err = page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	require.Zero(t, page.Bandwidth().Total.Requests)
+}
+
+func TestPageBandwidthShouldCountFailedRequests(t *testing.T) {
+	BeforeEach(t)
+
+	recording, err := browser.NewContext(playwright.BrowserNewContextOptions{
+		RecordBandwidth: playwright.Bool(true),
+	})
+	require.NoError(t, err)
+	defer recording.Close()
+	recordingPage, err := recording.NewPage()
+	require.NoError(t, err)
+	require.NoError(t, recordingPage.Route("**/upload", func(route playwright.Route) {
+		require.NoError(t, route.Abort())
+	}))
+
+	_, err = recordingPage.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	_, err = recordingPage.Evaluate(`url => fetch(url, { method: 'POST', body: 'x'.repeat(1000) }).catch(() => {})`, server.PREFIX+"/upload")
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return recordingPage.Bandwidth().ByResourceType["fetch"].Requests == 1
+	}, 5*time.Second, 50*time.Millisecond)
+	require.Equal(t, int64(1000), recordingPage.Bandwidth().ByResourceType["fetch"].BytesSent)
+}
@@ -0,0 +1,59 @@
+package playwright_test
+
+import (
+	"testing"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageExpectBFCacheNavigationShouldReportNotRestoredReasons(t *testing.T) {
+	BeforeEach(t)
+
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	_, err = page.Goto(server.PREFIX + "/grid.html")
+	require.NoError(t, err)
+	report, err := page.ExpectBFCacheNavigation(func() error {
+		_, err := page.GoBack()
+		return err
+	})
+	if !isChromium {
+		require.ErrorIs(t, err, playwright.ErrBFCacheNotSupported)
+		return
+	}
+	require.NoError(t, err)
+	// The back/forward cache is disabled by default.
+	require.False(t, report.Restored)
+	require.Equal(t, server.EMPTY_PAGE, report.URL)
+	require.NotEmpty(t, report.NotRestoredReasons)
+	require.ErrorIs(t, report.Err(), playwright.ErrNotRestoredFromBFCache)
+}
+
+func TestPageExpectBFCacheNavigationShouldReportRestoredPages(t *testing.T) {
+	if !isChromium {
+		t.Skip("back/forward cache inspection is only supported in Chromium")
+	}
+	BeforeEach(t)
+
+	bfcacheBrowser, err := browserType.Launch(playwright.BrowserTypeLaunchOptions{
+		IgnoreDefaultArgs: []string{"--disable-back-forward-cache"},
+	})
+	require.NoError(t, err)
+	defer bfcacheBrowser.Close()
+	bfcachePage, err := bfcacheBrowser.NewPage()
+	require.NoError(t, err)
+
+	_, err = bfcachePage.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	_, err = bfcachePage.Goto(server.CROSS_PROCESS_PREFIX + "/grid.html")
+	require.NoError(t, err)
+	report, err := bfcachePage.ExpectBFCacheNavigation(func() error {
+		_, err := bfcachePage.GoBack()
+		return err
+	})
+	require.NoError(t, err)
+	require.NoError(t, report.Err())
+	require.True(t, report.Restored)
+	require.Equal(t, server.EMPTY_PAGE, report.URL)
+}
@@ -162,6 +162,176 @@ func TestBrowserTypeConnectShouldEmitDisconnectedEvent(t *testing.T) {
 	require.Len(t, disconnected2.Get(), 1)
 }
 
+func TestBrowserTypeConnectShouldReconnectWhenConnectionIsLost(t *testing.T) {
+	BeforeEach(t)
+
+	remoteServer, err := newRemoteServer()
+	require.NoError(t, err)
+	defer remoteServer.Close()
+	proxy, wsEndpoint, err := newFlakyProxy(remoteServer.url)
+	require.NoError(t, err)
+	defer proxy.Close()
+
+	reconnected := make(chan playwright.Browser, 1)
+	browser1, err := browserType.Connect(wsEndpoint, playwright.BrowserTypeConnectOptions{
+		ReconnectAttempts: playwright.Int(3),
+		ReconnectBackoff:  playwright.Float(100),
+		OnReconnect: func(browser playwright.Browser) {
+			reconnected <- browser
+		},
+	})
+	require.NoError(t, err)
+	disconnected := make(chan bool, 1)
+	browser1.OnDisconnected(func(playwright.Browser) {
+		disconnected <- true
+	})
+	proxy.Drop()
+	<-disconnected
+	require.False(t, browser1.IsConnected())
+
+	select {
+	case browser2 := <-reconnected:
+		defer browser2.Close()
+		require.True(t, browser2.IsConnected())
+		page, err := browser2.NewPage()
+		require.NoError(t, err)
+		result, err := page.Evaluate("11 * 11")
+		require.NoError(t, err)
+		require.Equal(t, 121, result)
+	case <-time.After(10 * time.Second):
+		t.Fatal("browser did not reconnect")
+	}
+}
+
+func TestBrowserTypeConnectShouldNotReconnectWhenClosed(t *testing.T) {
+	BeforeEach(t)
+
+	remoteServer, err := newRemoteServer()
+	require.NoError(t, err)
+	defer remoteServer.Close()
+
+	reconnected := make(chan playwright.Browser, 1)
+	browser1, err := browserType.Connect(remoteServer.url, playwright.BrowserTypeConnectOptions{
+		ReconnectAttempts: playwright.Int(3),
+		ReconnectBackoff:  playwright.Float(100),
+		OnReconnect: func(browser playwright.Browser) {
+			reconnected <- browser
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, browser1.Close())
+	select {
+	case browser2 := <-reconnected:
+		browser2.Close()
+		t.Fatal("closed browser reconnected")
+	case <-time.After(time.Second):
+	}
+}
+
+func TestBrowserTypeConnectHeartbeatShouldCloseStalledConnection(t *testing.T) {
+	BeforeEach(t)
+
+	remoteServer, err := newRemoteServer()
+	require.NoError(t, err)
+	defer remoteServer.Close()
+	proxy, wsEndpoint, err := newFlakyProxy(remoteServer.url)
+	require.NoError(t, err)
+	defer proxy.Close()
+
+	browser1, err := browserType.Connect(wsEndpoint, playwright.BrowserTypeConnectOptions{
+		HeartbeatInterval: playwright.Float(100),
+		HeartbeatTimeout:  playwright.Float(500),
+	})
+	require.NoError(t, err)
+	disconnected := make(chan bool, 1)
+	browser1.OnDisconnected(func(playwright.Browser) {
+		disconnected <- true
+	})
+	page, err := browser1.NewPage()
+	require.NoError(t, err)
+	proxy.Stall()
+	select {
+	case <-disconnected:
+	case <-time.After(10 * time.Second):
+		t.Fatal("stalled connection was not closed")
+	}
+	_, err = page.Title()
+	require.ErrorIs(t, err, playwright.ErrTargetClosed)
+}
+
+func TestBrowserTypeConnectShouldRetryToConnect(t *testing.T) {
+	BeforeEach(t)
+
+	remoteServer, err := newRemoteServer()
+	require.NoError(t, err)
+	defer remoteServer.Close()
+
+	_, err = browserType.Connect("ws://127.0.0.1:1/", playwright.BrowserTypeConnectOptions{
+		ReconnectAttempts: playwright.Int(2),
+		ReconnectBackoff:  playwright.Float(50),
+	})
+	require.Error(t, err)
+
+	browser1, err := browserType.Connect(remoteServer.url, playwright.BrowserTypeConnectOptions{
+		ExposeNetwork:     playwright.String("<loopback>"),
+		ReconnectAttempts: playwright.Int(2),
+	})
+	require.NoError(t, err)
+	defer browser1.Close()
+	page, err := browser1.NewPage()
+	require.NoError(t, err)
+	_, err = page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+}
+
+type testGridAdapter struct {
+	wsEndpoint string
+	released   chan bool
+}
+
+func (a *testGridAdapter) Resolve(address string, browserName string) (*playwright.GridEndpoint, error) {
+	if address != "grid://test/"+browserName {
+		return nil, fmt.Errorf("unexpected address %s", address)
+	}
+	return &playwright.GridEndpoint{
+		WSEndpoint: a.wsEndpoint,
+		Release: func() error {
+			a.released <- true
+			return nil
+		},
+	}, nil
+}
+
+func TestBrowserTypeConnectWithAdapter(t *testing.T) {
+	BeforeEach(t)
+
+	remoteServer, err := newRemoteServer()
+	require.NoError(t, err)
+	defer remoteServer.Close()
+
+	adapter := &testGridAdapter{wsEndpoint: remoteServer.url, released: make(chan bool, 1)}
+	browser1, err := browserType.Connect("grid://test/"+browserName, playwright.BrowserTypeConnectOptions{
+		Adapter: adapter,
+	})
+	require.NoError(t, err)
+	page, err := browser1.NewPage()
+	require.NoError(t, err)
+	result, err := page.Evaluate("11 * 11")
+	require.NoError(t, err)
+	require.Equal(t, 121, result)
+	require.NoError(t, browser1.Close())
+	select {
+	case <-adapter.released:
+	case <-time.After(5 * time.Second):
+		t.Fatal("endpoint was not released")
+	}
+
+	_, err = browserType.Connect("grid://other", playwright.BrowserTypeConnectOptions{
+		Adapter: adapter,
+	})
+	require.ErrorContains(t, err, "could not resolve endpoint of grid://other")
+}
+
 func TestBrowserTypeConnectSlowMo(t *testing.T) {
 	BeforeEach(t)
 
@@ -275,6 +445,78 @@ func TestBrowserTypeConnectOverCDPTwice(t *testing.T) {
 	require.Len(t, browser2.Contexts()[0].Pages(), 2)
 }
 
+func TestBrowserTypeConnectOverCDPShouldAdoptExistingTargets(t *testing.T) {
+	if !isChromium {
+		t.Skip("CDP is only supported on Chromium")
+	}
+	BeforeEach(t)
+
+	port, err := getFreePort()
+	require.NoError(t, err)
+	browserServer, err := browserType.Launch(playwright.BrowserTypeLaunchOptions{
+		Args: []string{fmt.Sprintf("--remote-debugging-port=%d", port)},
+	})
+	require.NoError(t, err)
+	defer browserServer.Close()
+	browser1, err := browserType.ConnectOverCDP(fmt.Sprintf("http://localhost:%d", port))
+	require.NoError(t, err)
+	defer browser1.Close()
+	context1 := browser1.Contexts()[0]
+	serviceWorker, err := context1.ExpectEvent("serviceworker", func() error {
+		page, err := context1.NewPage()
+		if err != nil {
+			return err
+		}
+		_, err = page.Goto(server.PREFIX + "/serviceworkers/empty/sw.html")
+		return err
+	})
+	require.NoError(t, err)
+	require.Equal(t, server.PREFIX+"/serviceworkers/empty/sw.js", serviceWorker.(playwright.Worker).URL())
+	workerPage, err := context1.NewPage()
+	require.NoError(t, err)
+	_, err = workerPage.ExpectWorker(func() error {
+		_, err := workerPage.Goto(server.PREFIX + "/worker/worker.html")
+		return err
+	})
+	require.NoError(t, err)
+
+	browser2, err := browserType.ConnectOverCDP(fmt.Sprintf("http://localhost:%d", port))
+	require.NoError(t, err)
+	defer browser2.Close()
+	context2 := browser2.Contexts()[0]
+	require.Len(t, context2.Pages(), 2)
+	require.Len(t, context2.ServiceWorkers(), 1)
+	require.Equal(t, server.PREFIX+"/serviceworkers/empty/sw.js", context2.ServiceWorkers()[0].URL())
+
+	session, err := context2.NewCDPSession(context2.ServiceWorkers()[0])
+	require.NoError(t, err)
+	defer session.Detach()
+	result, err := session.Send("Runtime.evaluate", map[string]interface{}{
+		"expression":    "self.constructor.name",
+		"returnByValue": true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "ServiceWorkerGlobalScope", result.(map[string]interface{})["result"].(map[string]interface{})["value"])
+
+	var adopted playwright.Page
+	for _, page := range context2.Pages() {
+		if page.URL() == server.PREFIX+"/worker/worker.html" {
+			adopted = page
+		}
+	}
+	require.NotNil(t, adopted)
+	require.Eventually(t, func() bool { return len(adopted.Workers()) == 1 }, 5*time.Second, 50*time.Millisecond)
+	session, err = context2.NewCDPSession(adopted.Workers()[0])
+	require.NoError(t, err)
+	defer session.Detach()
+	result, err = session.Send("Runtime.evaluate", map[string]interface{}{
+		"expression":    "self.constructor.name",
+		"returnByValue": true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "DedicatedWorkerGlobalScope", result.(map[string]interface{})["result"].(map[string]interface{})["value"])
+}
+
 func TestSetInputFilesShouldPreserveLastModifiedTimestamp(t *testing.T) {
 	BeforeEach(t)
 
@@ -322,3 +564,47 @@ func TestSetInputFilesShouldPreserveLastModifiedTimestamp(t *testing.T) {
 		require.Less(t, math.Abs(float64(expected-int64(timestamp.(int)))), 1000.0)
 	}
 }
+
+func TestBrowserTypeLaunchServer(t *testing.T) {
+	BeforeEach(t)
+
+	browserServer, err := browserType.LaunchServer(playwright.BrowserTypeLaunchServerOptions{
+		WsPath: playwright.String("/launch-server-test"),
+	})
+	require.NoError(t, err)
+	defer browserServer.Kill()
+	require.Regexp(t, `^ws://.+/launch-server-test$`, browserServer.WSEndpoint())
+
+	remote, err := browserType.Connect(browserServer.WSEndpoint())
+	require.NoError(t, err)
+	page, err := remote.NewPage()
+	require.NoError(t, err)
+	result, err := page.Evaluate("11 * 11")
+	require.NoError(t, err)
+	require.Equal(t, 121, result)
+
+	disconnected := make(chan bool, 1)
+	remote.OnDisconnected(func(playwright.Browser) {
+		disconnected <- true
+	})
+	require.NoError(t, browserServer.Close())
+	select {
+	case <-disconnected:
+	case <-time.After(10 * time.Second):
+		t.Fatal("browser was not disconnected after the server closed")
+	}
+	require.NoError(t, browserServer.Close())
+}
+
+func TestBrowserTypeLaunchServerKill(t *testing.T) {
+	BeforeEach(t)
+
+	browserServer, err := browserType.LaunchServer()
+	require.NoError(t, err)
+	require.NotNil(t, browserServer.Process())
+	require.NoError(t, browserServer.Kill())
+	_, err = browserType.Connect(browserServer.WSEndpoint(), playwright.BrowserTypeConnectOptions{
+		Timeout: playwright.Float(1000),
+	})
+	require.Error(t, err)
+}
@@ -3,6 +3,7 @@ package playwright_test
 import (
 	"testing"
 
+	"github.com/playwright-community/playwright-go"
 	"github.com/stretchr/testify/require"
 )
 
@@ -51,3 +52,77 @@ func TestCDPSessionDetach(t *testing.T) {
 		require.Error(t, err)
 	}
 }
+
+func TestPageNewCDPSessionSendCDP(t *testing.T) {
+	BeforeEach(t)
+	if !isChromium {
+		t.Skip("CDP sessions are only supported on Chromium")
+	}
+
+	session, err := page.NewCDPSession()
+	require.NoError(t, err)
+	_, err = session.Send("Performance.enable", nil)
+	require.NoError(t, err)
+	type metrics struct {
+		Metrics []struct {
+			Name  string  `json:"name"`
+			Value float64 `json:"value"`
+		} `json:"metrics"`
+	}
+	result, err := playwright.SendCDP[metrics](session, "Performance.getMetrics", nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, result.Metrics)
+}
+
+func TestFrameNewCDPSession(t *testing.T) {
+	BeforeEach(t)
+	if !isChromium {
+		t.Skip("CDP sessions are only supported on Chromium")
+	}
+
+	session, err := page.MainFrame().NewCDPSession()
+	require.NoError(t, err)
+	result, err := playwright.SendCDP[map[string]interface{}](session, "Runtime.evaluate", map[string]interface{}{
+		"expression":    "21 * 2",
+		"returnByValue": true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, float64(42), result["result"].(map[string]interface{})["value"])
+}
+
+func TestCDPSessionShouldBufferEventsAndDetachWhenPageCloses(t *testing.T) {
+	BeforeEach(t)
+	if !isChromium {
+		t.Skip("CDP sessions are only supported on Chromium")
+	}
+
+	newPage, err := context.NewPage()
+	require.NoError(t, err)
+	session, err := newPage.NewCDPSession()
+	require.NoError(t, err)
+	detached := make(chan bool, 1)
+	session.OnDetached(func(playwright.CDPSession) {
+		detached <- true
+	})
+	type requestWillBeSent struct {
+		Request struct {
+			URL string `json:"url"`
+		} `json:"request"`
+	}
+	requests := playwright.SubscribeCDPEvent[requestWillBeSent](session, "Network.requestWillBeSent")
+	_, err = session.Send("Network.enable", nil)
+	require.NoError(t, err)
+	_, err = newPage.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	require.NoError(t, newPage.Close())
+	<-detached
+	require.True(t, session.IsDetached())
+	_, err = session.Send("Network.disable", nil)
+	require.ErrorIs(t, err, playwright.ErrTargetClosed)
+
+	urls := make([]string, 0)
+	for request := range requests.Events() {
+		urls = append(urls, request.Request.URL)
+	}
+	require.Contains(t, urls, server.EMPTY_PAGE)
+}
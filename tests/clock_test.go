@@ -0,0 +1,85 @@
+package playwright_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClockRunForShouldFireTimers(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.Clock().Install(playwright.ClockInstallOptions{Time: 0}))
+	require.NoError(t, page.Clock().PauseAt(1000))
+	_, err := page.Evaluate(`() => {
+		window.calls = [];
+		setTimeout(() => window.calls.push('timeout'), 500);
+		setInterval(() => window.calls.push('interval'), 400);
+	}`)
+	require.NoError(t, err)
+	require.NoError(t, page.Clock().RunFor(1000))
+	calls, err := page.Evaluate(`window.calls`)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{"interval", "timeout", "interval"}, calls)
+	now, err := page.Evaluate(`Date.now()`)
+	require.NoError(t, err)
+	require.Equal(t, 2000, now)
+}
+
+func TestClockFastForwardShouldFireTimersOnce(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.Clock().Install(playwright.ClockInstallOptions{Time: 0}))
+	require.NoError(t, page.Clock().PauseAt(1000))
+	_, err := page.Evaluate(`() => {
+		window.calls = 0;
+		setInterval(() => window.calls++, 100);
+	}`)
+	require.NoError(t, err)
+	require.NoError(t, page.Clock().FastForward("01:00"))
+	calls, err := page.Evaluate(`window.calls`)
+	require.NoError(t, err)
+	require.Equal(t, 1, calls)
+}
+
+func TestClockSetFixedTimeShouldApplyToNewPages(t *testing.T) {
+	BeforeEach(t)
+
+	fixed := time.Date(2024, 2, 2, 8, 0, 0, 0, time.UTC)
+	require.NoError(t, context.Clock().SetFixedTime(fixed))
+	newPage, err := context.NewPage()
+	require.NoError(t, err)
+	_, err = newPage.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	for _, p := range []playwright.Page{page, newPage} {
+		now, err := p.Evaluate(`Date.now()`)
+		require.NoError(t, err)
+		require.Equal(t, int(fixed.UnixMilli()), now)
+	}
+}
+
+func TestClockPauseAtShouldNotGoBackwards(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.Clock().Install(playwright.ClockInstallOptions{Time: 10000}))
+	require.Error(t, page.Clock().PauseAt(0))
+}
+
+func TestClockShouldRestoreTheStateInNewPages(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.Clock().Install(playwright.ClockInstallOptions{Time: 0}))
+	require.NoError(t, page.Clock().PauseAt(1000))
+	require.NoError(t, page.Clock().FastForward(2000))
+	newPage, err := context.NewPage()
+	require.NoError(t, err)
+	_, err = newPage.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	for _, p := range []playwright.Page{page, newPage} {
+		now, err := p.Evaluate(`Date.now()`)
+		require.NoError(t, err)
+		require.Equal(t, 3000, now)
+	}
+}
@@ -160,3 +160,28 @@ func TestConsoleShouldHaveLocationForConsoleAPICalls(t *testing.T) {
 	require.Equal(t, server.PREFIX+"/consolelog.html", message.Location().URL)
 	require.Equal(t, 7, message.Location().LineNumber)
 }
+
+func TestConsoleMessagesShouldDeliverMessagesOnChannel(t *testing.T) {
+	BeforeEach(t)
+
+	messages := page.ConsoleMessages()
+	defer messages.Unsubscribe()
+	_, err := page.Evaluate(`() => { console.log("hello"); console.log("world"); }`)
+	require.NoError(t, err)
+	require.Equal(t, "hello", (<-messages.Events()).Text())
+	require.Equal(t, "world", (<-messages.Events()).Text())
+}
+
+func TestConsoleMessagesShouldCloseChannelWhenPageCloses(t *testing.T) {
+	BeforeEach(t)
+
+	newPage, err := context.NewPage()
+	require.NoError(t, err)
+	messages := newPage.ConsoleMessages()
+	_, err = newPage.Evaluate(`() => console.log("bye")`)
+	require.NoError(t, err)
+	require.NoError(t, newPage.Close())
+	require.Equal(t, "bye", (<-messages.Events()).Text())
+	_, ok := <-messages.Events()
+	require.False(t, ok)
+}
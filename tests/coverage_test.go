@@ -0,0 +1,96 @@
+package playwright_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+func serveCoveragePage() {
+	server.SetRoute("/coverage/app.js", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/javascript")
+		_, _ = w.Write([]byte("function used() {\n  return 1;\n}\nfunction unused() {\n  return 2;\n}\nused();\n"))
+	})
+	server.SetRoute("/coverage/style.css", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/css")
+		_, _ = w.Write([]byte("div { color: red; }\n.missing { color: blue; }\n"))
+	})
+	server.SetRoute("/coverage/index.html", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = w.Write([]byte(`<link rel="stylesheet" href="style.css"><div>hello</div><script src="app.js"></script>`))
+	})
+}
+
+func TestCoverageJS(t *testing.T) {
+	BeforeEach(t)
+
+	serveCoveragePage()
+	err := page.Coverage().StartJSCoverage()
+	if !isChromium {
+		require.ErrorIs(t, err, playwright.ErrCoverageNotSupported)
+		return
+	}
+	require.NoError(t, err)
+	require.Error(t, page.Coverage().StartJSCoverage())
+	_, err = page.Goto(server.PREFIX + "/coverage/index.html")
+	require.NoError(t, err)
+	entries, err := page.Coverage().StopJSCoverage()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, server.PREFIX+"/coverage/app.js", entries[0].URL)
+	require.Contains(t, entries[0].Source, "function unused()")
+
+	lcov := playwright.JSCoverageToLcov(entries)
+	require.Contains(t, lcov, "SF:"+server.PREFIX+"/coverage/app.js\n")
+	require.Contains(t, lcov, "FNDA:1,used\n")
+	require.Contains(t, lcov, "FNDA:0,unused\n")
+	require.Contains(t, lcov, "DA:5,0\n")
+	istanbul := playwright.JSCoverageToIstanbul(entries)
+	require.Contains(t, istanbul, server.PREFIX+"/coverage/app.js")
+}
+
+func TestCoverageJSShouldReportAnonymousScripts(t *testing.T) {
+	BeforeEach(t)
+	if !isChromium {
+		t.Skip("coverage is only supported in Chromium")
+	}
+
+	server.SetRoute("/coverage/eval.html", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = w.Write([]byte(`<script>eval("1 + 1")</script>`))
+	})
+	require.NoError(t, page.Coverage().StartJSCoverage(playwright.CoverageStartJSCoverageOptions{
+		ReportAnonymousScripts: playwright.Bool(true),
+	}))
+	_, err := page.Goto(server.PREFIX + "/coverage/eval.html")
+	require.NoError(t, err)
+	entries, err := page.Coverage().StopJSCoverage()
+	require.NoError(t, err)
+	anonymous := 0
+	for _, entry := range entries {
+		if entry.URL == "" {
+			anonymous++
+		}
+	}
+	require.Greater(t, anonymous, 0)
+}
+
+func TestCoverageCSS(t *testing.T) {
+	BeforeEach(t)
+	if !isChromium {
+		t.Skip("coverage is only supported in Chromium")
+	}
+
+	serveCoveragePage()
+	require.NoError(t, page.Coverage().StartCSSCoverage())
+	_, err := page.Goto(server.PREFIX + "/coverage/index.html")
+	require.NoError(t, err)
+	entries, err := page.Coverage().StopCSSCoverage()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, server.PREFIX+"/coverage/style.css", entries[0].URL)
+	require.Equal(t, []playwright.TextRange{{Start: 0, End: 19}}, entries[0].Ranges)
+	require.Contains(t, playwright.CSSCoverageToLcov(entries), "DA:1,1\nDA:2,0\n")
+}
@@ -0,0 +1,48 @@
+package playwright_test
+
+import (
+	"testing"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageEmulateCPUThrottling(t *testing.T) {
+	BeforeEach(t)
+
+	const busyLoop = `() => {
+		const start = performance.now();
+		let x = 0;
+		for (let i = 0; i < 2e7; i++)
+			x += i;
+		return performance.now() - start;
+	}`
+	err := page.EmulateCPUThrottling(4)
+	if !isChromium {
+		require.ErrorIs(t, err, playwright.ErrCPUThrottlingNotSupported)
+		return
+	}
+	require.NoError(t, err)
+	throttled, err := page.Evaluate(busyLoop)
+	require.NoError(t, err)
+	require.NoError(t, page.EmulateCPUThrottling(1))
+	normal, err := page.Evaluate(busyLoop)
+	require.NoError(t, err)
+	require.Greater(t, toFloat(throttled), 2*toFloat(normal))
+}
+
+func TestPageEmulateCPUThrottlingShouldRejectInvalidRate(t *testing.T) {
+	BeforeEach(t)
+
+	require.ErrorContains(t, page.EmulateCPUThrottling(0.5), "invalid CPU throttling rate 0.5")
+}
+
+func toFloat(v interface{}) float64 {
+	switch n := v.(type) {
+	case int:
+		return float64(n)
+	case float64:
+		return n
+	}
+	return 0
+}
@@ -0,0 +1,78 @@
+package playwright_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageOnCSPViolationShouldReportEventsAndReports(t *testing.T) {
+	BeforeEach(t)
+
+	server.SetRoute("/csp.html", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Header().Set("Content-Security-Policy", "script-src 'self'; report-uri /csp-report")
+		fmt.Fprint(w, `<script>window.inline = true;</script>`)
+	})
+	server.SetRoute("/csp-report", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	violations := make(chan playwright.CSPViolation, 10)
+	page.OnCSPViolation(func(violation playwright.CSPViolation) {
+		violations <- violation
+	})
+	require.NoError(t, page.Context().CaptureCSPViolations())
+	_, err := page.Goto(server.PREFIX + "/csp.html")
+	require.NoError(t, err)
+	sources := map[playwright.CSPViolationSource]playwright.CSPViolation{}
+	timeout := time.After(10 * time.Second)
+	for len(sources) < 2 {
+		select {
+		case violation := <-violations:
+			sources[violation.Source] = violation
+		case <-timeout:
+			t.Fatalf("CSP violations not reported, got %v", sources)
+		}
+	}
+	for _, violation := range sources {
+		require.Equal(t, server.PREFIX+"/csp.html", violation.DocumentURL)
+		require.Equal(t, "inline", violation.BlockedURL)
+		require.Equal(t, "enforce", violation.Disposition)
+	}
+}
+
+func TestPageOnCSPViolationShouldReportLoadedDocuments(t *testing.T) {
+	BeforeEach(t)
+
+	server.SetRoute("/csp.html", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Header().Set("Content-Security-Policy", "script-src 'self' 'unsafe-inline'")
+		fmt.Fprint(w, `<body></body>`)
+	})
+	_, err := page.Goto(server.PREFIX + "/csp.html")
+	require.NoError(t, err)
+	violations := make(chan playwright.CSPViolation, 10)
+	page.OnCSPViolation(func(violation playwright.CSPViolation) {
+		violations <- violation
+	})
+	require.NoError(t, page.Context().CaptureCSPViolations())
+	_, err = page.Evaluate(`url => {
+		const script = document.createElement('script');
+		script.src = url;
+		document.body.append(script);
+	}`, server.CROSS_PROCESS_PREFIX+"/injectedfile.js")
+	require.NoError(t, err)
+	select {
+	case violation := <-violations:
+		require.Equal(t, *playwright.CSPViolationSourceEvent, violation.Source)
+		require.Equal(t, server.CROSS_PROCESS_PREFIX+"/injectedfile.js", violation.BlockedURL)
+	case <-time.After(10 * time.Second):
+		t.Fatal("CSP violation not reported")
+	}
+}
@@ -0,0 +1,61 @@
+package playwright_test
+
+import (
+	"testing"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageDocumentMetadata(t *testing.T) {
+	BeforeEach(t)
+
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	require.NoError(t, page.SetContent(`
+		<html lang="en">
+		<head>
+			<title>Preview</title>
+			<meta name="description" content="Page description">
+			<meta property="og:title" content="OG title">
+			<meta property="og:image" content="/og.png">
+			<meta property="og:image:width" content="1200">
+			<meta name="twitter:card" content="summary">
+			<link rel="canonical" href="/canonical">
+			<link rel="icon" href="/icon.svg" type="image/svg+xml" sizes="any">
+		</head>
+		<body></body>
+		</html>`))
+	_, err = page.Evaluate(`() => {
+		const meta = document.createElement('meta');
+		meta.name = 'twitter:site';
+		meta.content = '@playwright';
+		document.head.appendChild(meta);
+	}`)
+	require.NoError(t, err)
+
+	metadata, err := page.DocumentMetadata()
+	require.NoError(t, err)
+	require.Equal(t, "Preview", metadata.Title)
+	require.Equal(t, "Page description", metadata.Description)
+	require.Equal(t, "en", metadata.Lang)
+	require.Equal(t, server.PREFIX+"/canonical", metadata.CanonicalURL)
+	require.Equal(t, []playwright.Favicon{
+		{URL: server.PREFIX + "/icon.svg", Rel: "icon", Type: "image/svg+xml", Sizes: "any"},
+	}, metadata.Favicons)
+	require.Equal(t, "OG title", metadata.OpenGraph.Title)
+	require.Equal(t, []playwright.OpenGraphMedia{{URL: server.PREFIX + "/og.png", Width: 1200}}, metadata.OpenGraph.Images)
+	require.Equal(t, "summary", metadata.TwitterCard.Card)
+	require.Equal(t, "@playwright", metadata.TwitterCard.Site)
+}
+
+func TestPageDocumentMetadataShouldDefaultToFaviconIco(t *testing.T) {
+	BeforeEach(t)
+
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	metadata, err := page.DocumentMetadata()
+	require.NoError(t, err)
+	require.Equal(t, []playwright.Favicon{{URL: server.PREFIX + "/favicon.ico", Rel: "icon"}}, metadata.Favicons)
+	require.Empty(t, metadata.OpenGraph.Images)
+}
@@ -1,7 +1,9 @@
 package playwright_test
 
 import (
+	"crypto/sha256"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
 	"path/filepath"
@@ -48,6 +50,47 @@ func TestDownloadBasic(t *testing.T) {
 	require.NoFileExists(t, file)
 }
 
+func TestDownloadCreateReadStream(t *testing.T) {
+	BeforeEach(t)
+
+	content := strings.Repeat("foobar", 512*1024)
+	server.SetRoute("/download", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/octet-stream")
+		w.Header().Add("Content-Disposition", "attachment")
+		if _, err := w.Write([]byte(content)); err != nil {
+			log.Printf("could not write: %v", err)
+		}
+	})
+	require.NoError(t, page.SetContent(
+		fmt.Sprintf(`<a href="%s/download">download</a>`, server.PREFIX),
+	))
+
+	download, err := page.ExpectDownload(func() error {
+		return page.Locator("a").Click()
+	})
+	require.NoError(t, err)
+	stream, err := download.CreateReadStream()
+	require.NoError(t, err)
+	hash := sha256.New()
+	n, err := io.Copy(hash, stream)
+	require.NoError(t, err)
+	require.Equal(t, int64(len(content)), n)
+	expected := sha256.Sum256([]byte(content))
+	require.Equal(t, expected[:], hash.Sum(nil))
+	require.NoError(t, stream.Close())
+	require.NoError(t, stream.Close())
+	_, err = stream.Read(make([]byte, 1))
+	require.Error(t, err)
+
+	stream, err = download.CreateReadStream()
+	require.NoError(t, err)
+	head := make([]byte, 6)
+	_, err = io.ReadFull(stream, head)
+	require.NoError(t, err)
+	require.Equal(t, "foobar", string(head))
+	require.NoError(t, stream.Close())
+}
+
 func TestDownloadCancel(t *testing.T) {
 	BeforeEach(t)
 
@@ -0,0 +1,109 @@
+package playwright_test
+
+import (
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBrowserContextDryRunShouldBlockStateChangingRequests(t *testing.T) {
+	BeforeEach(t, playwright.BrowserNewContextOptions{
+		DryRun: playwright.DryRunModeBlock,
+	})
+
+	var posts atomic.Int32
+	server.SetRoute("/orders", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			posts.Add(1)
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	var actions []playwright.DryRunAction
+	context.OnDryRun(func(action playwright.DryRunAction) {
+		actions = append(actions, action)
+	})
+
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	result, err := page.Evaluate(`url => fetch(url, { method: 'POST', body: 'order' }).then(() => 'sent', () => 'blocked')`, server.PREFIX+"/orders")
+	require.NoError(t, err)
+	require.Equal(t, "blocked", result)
+	result, err = page.Evaluate(`url => fetch(url).then(r => r.status)`, server.PREFIX+"/orders")
+	require.NoError(t, err)
+	require.Equal(t, 200, result)
+
+	_, err = context.Request().Post(server.PREFIX + "/orders")
+	require.ErrorIs(t, err, playwright.ErrDryRun)
+	response, err := context.Request().Get(server.PREFIX + "/orders")
+	require.NoError(t, err)
+	require.Equal(t, 200, response.Status())
+
+	require.Equal(t, int32(0), posts.Load())
+	require.Len(t, actions, 2)
+	require.Equal(t, "POST", actions[0].Method)
+	require.False(t, actions[0].API)
+	require.True(t, actions[1].API)
+	require.Equal(t, actions, context.DryRunActions())
+}
+
+func TestBrowserContextDryRunShouldReplaceRequestsWithNoOps(t *testing.T) {
+	BeforeEach(t, playwright.BrowserNewContextOptions{
+		DryRun: playwright.DryRunModeLog,
+	})
+
+	var posts atomic.Int32
+	server.SetRoute("/submit", func(w http.ResponseWriter, r *http.Request) {
+		posts.Add(1)
+		w.WriteHeader(http.StatusOK)
+	})
+	routed := false
+	require.NoError(t, page.Route("**/submit", func(route playwright.Route) {
+		routed = true
+		require.NoError(t, route.Continue())
+	}))
+
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	require.NoError(t, page.SetContent(`<form method="post" action="/submit"><button>Submit</button></form>`))
+	require.NoError(t, page.Locator("button").Click())
+	result, err := page.Evaluate(`url => fetch(url, { method: 'DELETE' }).then(r => r.status)`, server.PREFIX+"/submit")
+	require.NoError(t, err)
+	require.Equal(t, 204, result)
+
+	response, err := context.Request().Put(server.PREFIX + "/submit")
+	require.NoError(t, err)
+	require.Equal(t, 204, response.Status())
+
+	require.Equal(t, int32(0), posts.Load())
+	require.False(t, routed)
+	require.Len(t, context.DryRunActions(), 3)
+}
+
+func TestBrowserContextDryRunShouldStopFormSubmissions(t *testing.T) {
+	BeforeEach(t, playwright.BrowserNewContextOptions{
+		DryRun: playwright.DryRunModeBlock,
+	})
+
+	var searches atomic.Int32
+	server.SetRoute("/search", func(w http.ResponseWriter, r *http.Request) {
+		searches.Add(1)
+		w.WriteHeader(http.StatusOK)
+	})
+	actions := make(chan playwright.DryRunAction, 1)
+	context.OnDryRun(func(action playwright.DryRunAction) {
+		actions <- action
+	})
+
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	require.NoError(t, page.SetContent(`<form action="/search"><input name="q" value="shoes"><button>Search</button></form>`))
+	require.NoError(t, page.Locator("button").Click())
+	action := <-actions
+	require.Equal(t, "GET", action.Method)
+	require.Equal(t, server.PREFIX+"/search", action.URL)
+	require.Equal(t, server.EMPTY_PAGE, page.URL())
+	require.Equal(t, int32(0), searches.Load())
+}
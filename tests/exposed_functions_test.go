@@ -0,0 +1,95 @@
+package playwright_test
+
+import (
+	"testing"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageUnexposeFunction(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.ExposeFunction("compute", func(args ...interface{}) interface{} {
+		return args[0].(int) * args[1].(int)
+	}))
+	require.NoError(t, page.ExposeFunction("other", func(args ...interface{}) interface{} {
+		return nil
+	}))
+	require.Equal(t, []string{"compute", "other"}, page.ListExposedFunctions())
+	result, err := page.Evaluate(`() => compute(9, 4)`)
+	require.NoError(t, err)
+	require.Equal(t, 36, result)
+
+	require.NoError(t, page.UnexposeFunction("compute"))
+	require.Equal(t, []string{"other"}, page.ListExposedFunctions())
+	result, err = page.Evaluate(`() => typeof window.compute`)
+	require.NoError(t, err)
+	require.Equal(t, "undefined", result)
+	_, err = page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	result, err = page.Evaluate(`() => [typeof window.compute, typeof window.other]`)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{"undefined", "function"}, result)
+
+	require.Error(t, page.UnexposeFunction("compute"))
+}
+
+func TestPageUnexposeFunctionShouldRejectKeptReferences(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.ExposeFunction("compute", func(args ...interface{}) interface{} {
+		return 1
+	}))
+	_, err := page.Evaluate(`() => { window.kept = compute; }`)
+	require.NoError(t, err)
+	require.NoError(t, page.UnexposeFunction("compute"))
+	_, err = page.Evaluate(`() => kept()`)
+	require.ErrorContains(t, err, "Function 'compute' is not exposed in the page")
+}
+
+func TestPageExposeFunctionAgainAfterUnexpose(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.ExposeFunction("compute", func(args ...interface{}) interface{} {
+		return 1
+	}))
+	require.NoError(t, page.UnexposeFunction("compute"))
+	require.NoError(t, page.ExposeFunction("compute", func(args ...interface{}) interface{} {
+		return 2
+	}))
+	result, err := page.Evaluate(`() => compute()`)
+	require.NoError(t, err)
+	require.Equal(t, 2, result)
+	_, err = page.Reload()
+	require.NoError(t, err)
+	result, err = page.Evaluate(`() => compute()`)
+	require.NoError(t, err)
+	require.Equal(t, 2, result)
+}
+
+func TestBrowserContextUnexposeFunction(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, context.ExposeFunction("compute", func(args ...interface{}) interface{} {
+		return 42
+	}))
+	require.Equal(t, []string{"compute"}, context.ListExposedFunctions())
+	require.NoError(t, context.UnexposeFunction("compute"))
+	require.Empty(t, context.ListExposedFunctions())
+
+	newPage, err := context.NewPage()
+	require.NoError(t, err)
+	_, err = newPage.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	result, err := newPage.Evaluate(`() => typeof window.compute`)
+	require.NoError(t, err)
+	require.Equal(t, "undefined", result)
+}
+
+func TestBrowserContextListExposedFunctionsShouldHideInternalBindings(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, context.RouteWebSocket("**/ws", func(ws playwright.WebSocketRoute) {}))
+	require.Empty(t, context.ListExposedFunctions())
+}
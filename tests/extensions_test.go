@@ -0,0 +1,50 @@
+package playwright_test
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLaunchPersistentContextShouldLoadExtensions(t *testing.T) {
+	BeforeEach(t)
+
+	if !isChromium {
+		_, err := browserType.LaunchPersistentContext(t.TempDir(), playwright.BrowserTypeLaunchPersistentContextOptions{
+			Extensions: []string{Asset("simple-extension")},
+		})
+		require.ErrorIs(t, err, playwright.ErrExtensionsNotSupported)
+		return
+	}
+	if runtime.GOOS == "windows" {
+		t.Skip("flaky on windows")
+	}
+	context, err := browserType.LaunchPersistentContext(t.TempDir(), playwright.BrowserTypeLaunchPersistentContextOptions{
+		Headless:   playwright.Bool(false),
+		Extensions: []string{Asset("simple-extension")},
+	})
+	require.NoError(t, err)
+	defer context.Close()
+	var background playwright.Page
+	if pages := context.BackgroundPages(); len(pages) == 1 {
+		background = pages[0]
+	} else {
+		ret, err := context.WaitForEvent("backgroundPage")
+		require.NoError(t, err)
+		background = ret.(playwright.Page)
+	}
+	magic, err := background.Evaluate("() => window.MAGIC")
+	require.NoError(t, err)
+	require.Equal(t, 42, magic)
+}
+
+func TestLaunchPersistentContextShouldRejectInvalidExtensions(t *testing.T) {
+	BeforeEach(t)
+
+	_, err := browserType.LaunchPersistentContext(t.TempDir(), playwright.BrowserTypeLaunchPersistentContextOptions{
+		Extensions: []string{t.TempDir()},
+	})
+	require.Error(t, err)
+}
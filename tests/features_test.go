@@ -0,0 +1,67 @@
+package playwright_test
+
+import (
+	"testing"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLaunchShouldToggleBlinkFeatures(t *testing.T) {
+	BeforeEach(t)
+
+	browser, err := browserType.Launch(playwright.BrowserTypeLaunchOptions{
+		DisableBlinkFeatures: []string{"AutomationControlled"},
+	})
+	if !isChromium {
+		require.ErrorIs(t, err, playwright.ErrBlinkFeaturesNotSupported)
+		return
+	}
+	require.NoError(t, err)
+	defer browser.Close()
+	page, err := browser.NewPage()
+	require.NoError(t, err)
+	webdriver, err := page.Evaluate(`() => navigator.webdriver`)
+	require.NoError(t, err)
+	require.Equal(t, false, webdriver)
+}
+
+func TestBrowserNewContextShouldAddOriginTrialTokens(t *testing.T) {
+	BeforeEach(t)
+
+	token := "A2JhY2tncm91bmQ="
+	context, err := browser.NewContext(playwright.BrowserNewContextOptions{
+		OriginTrialTokens: []string{token},
+	})
+	require.NoError(t, err)
+	defer context.Close()
+	page, err := context.NewPage()
+	require.NoError(t, err)
+	_, err = page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	content, err := page.Locator(`meta[http-equiv="origin-trial"]`).GetAttribute("content")
+	require.NoError(t, err)
+	require.Equal(t, token, content)
+
+	trials, err := page.OriginTrials()
+	if !isChromium {
+		require.ErrorIs(t, err, playwright.ErrOriginTrialsNotSupported)
+		return
+	}
+	require.NoError(t, err)
+	for _, trial := range trials {
+		for _, token := range trial.Tokens {
+			// The token is not signed by Chrome.
+			require.NotEqual(t, "Success", token.Status)
+		}
+	}
+}
+
+func TestBrowserNewContextShouldRejectInvalidOriginTrialTokens(t *testing.T) {
+	BeforeEach(t)
+
+	_, err := browser.NewContext(playwright.BrowserNewContextOptions{
+		OriginTrialTokens: []string{"not a token"},
+	})
+	require.ErrorContains(t, err, "invalid origin trial token")
+}
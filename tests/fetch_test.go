@@ -1,6 +1,7 @@
 package playwright_test
 
 import (
+	"bytes"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -448,3 +449,94 @@ func TestShouldSupportMultipartFormData(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestShouldRetryRequestsWithRetryPolicy(t *testing.T) {
+	BeforeEach(t)
+
+	var mu sync.Mutex
+	attempts := 0
+	server.SetRoute("/flaky", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		attempts++
+		current := attempts
+		mu.Unlock()
+		if current < 3 {
+			w.WriteHeader(503)
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	})
+
+	request, err := pw.Request.NewContext(playwright.APIRequestNewContextOptions{
+		RetryPolicy: &playwright.APIRequestRetryPolicy{
+			InitialBackoff: playwright.Duration(10 * time.Millisecond),
+		},
+	})
+	require.NoError(t, err)
+	defer request.Dispose()
+	response, err := request.Get(server.PREFIX + "/flaky")
+	require.NoError(t, err)
+	require.Equal(t, 200, response.Status())
+	require.Equal(t, 3, attempts)
+
+	attempts = 0
+	response, err = request.Get(server.PREFIX+"/flaky", playwright.APIRequestContextGetOptions{
+		RetryPolicy: &playwright.APIRequestRetryPolicy{
+			MaxAttempts: playwright.Int(2),
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 503, response.Status())
+	require.Equal(t, 2, attempts)
+}
+
+func TestShouldSupportStreamedMultipartParts(t *testing.T) {
+	BeforeEach(t)
+
+	type receivedPart struct {
+		filename    string
+		contentType string
+		content     string
+	}
+	received := make(chan map[string]receivedPart, 1)
+	server.SetRoute("/empty.html", func(w http.ResponseWriter, r *http.Request) {
+		reader, err := r.MultipartReader()
+		if err != nil {
+			w.WriteHeader(400)
+			return
+		}
+		parts := map[string]receivedPart{}
+		for {
+			part, err := reader.NextPart()
+			if err != nil {
+				break
+			}
+			content, _ := io.ReadAll(part)
+			parts[part.FormName()] = receivedPart{part.FileName(), part.Header.Get("Content-Type"), string(content)}
+		}
+		received <- parts
+		w.WriteHeader(200)
+	})
+
+	response, err := context.Request().Post(server.EMPTY_PAGE, playwright.APIRequestContextPostOptions{
+		Multipart: map[string]interface{}{
+			"name": "John",
+			"metadata": playwright.MultipartPart{
+				ContentType: "application/json",
+				Reader:      strings.NewReader(`{"size":3}`),
+			},
+			"upload": playwright.MultipartPart{
+				Filename:    "data.bin",
+				ContentType: "application/x-custom",
+				Reader:      bytes.NewReader([]byte("abc")),
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 200, response.Status())
+	require.Equal(t, map[string]receivedPart{
+		"name":     {"", "", "John"},
+		"metadata": {"", "application/json", `{"size":3}`},
+		"upload":   {"data.bin", "application/x-custom", "abc"},
+	}, <-received)
+}
@@ -250,3 +250,25 @@ func TestFrameLocatorOwnerShouldWork(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "frame1", name)
 }
+
+func TestFrameLocatorShouldComposeNestedFrames(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.SetContent(`<iframe id="outer" srcdoc="
+		<iframe class='inner' srcdoc='<button>First</button>'></iframe>
+		<iframe class='inner' srcdoc='<button>Second</button>'></iframe>"></iframe>`))
+	outer := page.Locator("#outer").ContentFrame()
+	inner := outer.Locator(".inner")
+	require.NoError(t, expect.Locator(inner).ToHaveCount(2))
+
+	require.NoError(t, expect.Locator(inner.Nth(1).ContentFrame().GetByRole("button")).ToHaveText("Second"))
+	require.NoError(t, expect.Locator(outer.FrameLocator(".inner").Last().Locator("button")).ToHaveText("Second"))
+	require.NoError(t, expect.Locator(outer.FrameLocator(".inner").First().GetByText("First")).ToBeVisible())
+
+	owner := inner.Nth(1).ContentFrame().Owner()
+	require.NoError(t, expect.Locator(owner).ToHaveClass("inner"))
+	srcdoc, err := owner.GetAttribute("srcdoc")
+	require.NoError(t, err)
+	require.Equal(t, "<button>Second</button>", srcdoc)
+	require.NoError(t, expect.Locator(owner.ContentFrame().Owner().ContentFrame().Locator("button")).ToHaveText("Second"))
+}
@@ -2,6 +2,7 @@ package playwright_test
 
 import (
 	"fmt"
+	"net/http"
 	"testing"
 	"time"
 
@@ -232,3 +233,56 @@ func TestFrameShouldHandleNestedFrames(t *testing.T) {
 		"        http://localhost:<PORT>/frames/frame.html (uno)",
 	}, dump)
 }
+
+func TestFrameSetExtraHTTPHeaders(t *testing.T) {
+	BeforeEach(t)
+
+	server.SetRoute("/headers", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(200)
+	})
+	fetchHeaders := func(frame playwright.Frame) http.Header {
+		request := server.WaitForRequestChan("/headers")
+		_, err := frame.Evaluate(`() => fetch('/headers')`)
+		require.NoError(t, err)
+		return (<-request).Header
+	}
+
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	frame, err := utils.AttachFrame(page, "frame1", server.EMPTY_PAGE)
+	require.NoError(t, err)
+	require.NoError(t, context.SetExtraHTTPHeaders(map[string]string{"a": "context", "b": "context"}))
+	require.NoError(t, page.SetExtraHTTPHeaders(map[string]string{"b": "page", "c": "page"}))
+	require.NoError(t, frame.SetExtraHTTPHeaders(map[string]string{"C": "frame", "d": "frame"}))
+
+	headers := fetchHeaders(frame)
+	require.Equal(t, "context", headers.Get("a"))
+	require.Equal(t, "page", headers.Get("b"))
+	require.Equal(t, "frame", headers.Get("c"))
+	require.Equal(t, "frame", headers.Get("d"))
+	headers = fetchHeaders(page.MainFrame())
+	require.Equal(t, "page", headers.Get("c"))
+	require.Empty(t, headers.Get("d"))
+
+	require.NoError(t, page.Route("**/headers", func(route playwright.Route) {
+		headers := route.Request().Headers()
+		require.Equal(t, "frame", headers["d"])
+		headers["d"] = "route"
+		require.NoError(t, route.Fallback(playwright.RouteFallbackOptions{Headers: headers}))
+	}))
+	require.Equal(t, "route", fetchHeaders(frame).Get("d"))
+	require.NoError(t, page.UnrouteAll())
+
+	require.NoError(t, frame.RemoveExtraHTTPHeader("C"))
+	require.NoError(t, page.RemoveExtraHTTPHeader("b"))
+	require.NoError(t, context.RemoveExtraHTTPHeader("a"))
+	require.NoError(t, context.RemoveExtraHTTPHeader("missing"))
+	headers = fetchHeaders(frame)
+	require.Empty(t, headers.Get("a"))
+	require.Equal(t, "context", headers.Get("b"))
+	require.Equal(t, "page", headers.Get("c"))
+	require.Equal(t, "frame", headers.Get("d"))
+
+	require.NoError(t, frame.RemoveExtraHTTPHeader("d"))
+	require.Empty(t, fetchHeaders(frame).Get("d"))
+}
@@ -0,0 +1,107 @@
+package playwright_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageConnectGamepad(t *testing.T) {
+	BeforeEach(t)
+
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	_, err = page.Evaluate(`() => {
+		window.events = [];
+		window.addEventListener('gamepadconnected', e => window.events.push(['connected', e.gamepad.index, e.gamepad.id]));
+		window.addEventListener('gamepaddisconnected', e => window.events.push(['disconnected', e.gamepad.index]));
+	}`)
+	require.NoError(t, err)
+	gamepad, err := page.ConnectGamepad(playwright.PageConnectGamepadOptions{
+		ID: playwright.String("Test Pad"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, gamepad.Index())
+	require.Len(t, page.Gamepads(), 1)
+
+	require.NoError(t, gamepad.SetButton(0, 1))
+	require.NoError(t, gamepad.SetAxis(1, -0.5))
+	state, err := page.Evaluate(`() => {
+		const pad = navigator.getGamepads()[0];
+		return { id: pad.id, mapping: pad.mapping, pressed: pad.buttons[0].pressed, buttons: pad.buttons.length, axis: pad.axes[1] };
+	}`)
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{
+		"id":      "Test Pad",
+		"mapping": "standard",
+		"pressed": true,
+		"buttons": 17,
+		"axis":    -0.5,
+	}, state)
+
+	require.NoError(t, gamepad.Disconnect())
+	require.False(t, gamepad.IsConnected())
+	require.Empty(t, page.Gamepads())
+	events, err := page.Evaluate(`() => window.events`)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{
+		[]interface{}{"connected", 0, "Test Pad"},
+		[]interface{}{"disconnected", 0},
+	}, events)
+	pad, err := page.Evaluate(`() => navigator.getGamepads()[0]`)
+	require.NoError(t, err)
+	require.Nil(t, pad)
+}
+
+func TestPageConnectGamepadShouldSurviveNavigation(t *testing.T) {
+	BeforeEach(t)
+
+	gamepad, err := page.ConnectGamepad()
+	require.NoError(t, err)
+	require.NoError(t, gamepad.SetAxis(0, 1))
+	_, err = page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		axis, err := page.Evaluate(`() => navigator.getGamepads()[0]?.axes[0]`)
+		return err == nil && axis == 1
+	}, 5*time.Second, 50*time.Millisecond)
+}
+
+func TestGamepadPressButton(t *testing.T) {
+	BeforeEach(t)
+
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	first, err := page.ConnectGamepad()
+	require.NoError(t, err)
+	second, err := page.ConnectGamepad(playwright.PageConnectGamepadOptions{Buttons: playwright.Int(2), Axes: playwright.Int(0)})
+	require.NoError(t, err)
+	require.Equal(t, 1, second.Index())
+	require.NoError(t, first.Disconnect())
+	third, err := page.ConnectGamepad()
+	require.NoError(t, err)
+	require.Equal(t, 0, third.Index())
+
+	_, err = page.Evaluate(`() => {
+		window.samples = [];
+		const sample = () => {
+			const pad = navigator.getGamepads()[1];
+			window.samples.push(pad.buttons[1].value);
+			if (window.samples.length < 1000)
+				requestAnimationFrame(sample);
+		};
+		sample();
+	}`)
+	require.NoError(t, err)
+	require.NoError(t, second.PressButton(1, playwright.GamepadPressButtonOptions{
+		Delay: playwright.Float(100),
+		Value: playwright.Float(0.5),
+	}))
+	require.Equal(t, []float64{0, 0}, second.Buttons())
+	samples, err := page.Evaluate(`() => window.samples`)
+	require.NoError(t, err)
+	require.Contains(t, samples, 0.5)
+	require.EqualError(t, second.SetButton(2, 1), "gamepad has no button 2")
+}
@@ -208,6 +208,46 @@ func TestShouldFilterByRegexp(t *testing.T) {
 	require.True(t, strings.HasSuffix(url.String(), "har.html"))
 }
 
+func TestShouldFilterByMultiplePatterns(t *testing.T) {
+	harPath := filepath.Join(t.TempDir(), "log.har")
+	BeforeEach(t, playwright.BrowserNewContextOptions{
+		BaseURL:            &server.PREFIX,
+		RecordHarPath:      playwright.String(harPath),
+		RecordHarURLFilter: []interface{}{"/*.css", regexp.MustCompile(`har\.html$`)},
+	})
+
+	_, err := page.Goto(server.PREFIX + "/har.html")
+	require.NoError(t, err)
+	require.NoError(t, context.Close())
+	data, err := os.ReadFile(harPath)
+	require.NoError(t, err)
+	entries := gjson.GetBytes(data, "log.entries").Array()
+	require.Len(t, entries, 2)
+	for _, entry := range entries {
+		url := entry.Get("request.url").String()
+		require.True(t, strings.HasSuffix(url, "one-style.css") || strings.HasSuffix(url, "har.html"), url)
+	}
+}
+
+func TestShouldFilterByMethod(t *testing.T) {
+	harPath := filepath.Join(t.TempDir(), "log.har")
+	BeforeEach(t, playwright.BrowserNewContextOptions{
+		RecordHarPath:         playwright.String(harPath),
+		RecordHarMethodFilter: []string{"post"},
+	})
+
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	_, err = page.Evaluate(`() => fetch('./post', { method: 'POST', body: 'data' })`)
+	require.NoError(t, err)
+	require.NoError(t, context.Close())
+	data, err := os.ReadFile(harPath)
+	require.NoError(t, err)
+	entries := gjson.GetBytes(data, "log.entries").Array()
+	require.Len(t, entries, 1)
+	require.Equal(t, "POST", entries[0].Get("request.method").String())
+}
+
 func TestShouldContextRouteFromHarMatchingTheMethodAndFollowingRedirects(t *testing.T) {
 	BeforeEach(t)
 
@@ -605,6 +645,31 @@ func TestShouldDisambiguateByHeader(t *testing.T) {
 	require.Equal(t, "baz3", data)
 }
 
+func TestShouldReadAttachedContentFromHar(t *testing.T) {
+	for _, name := range []string{"har.zip", "har.har"} {
+		t.Run(name, func(t *testing.T) {
+			harPath := filepath.Join(t.TempDir(), name)
+			BeforeEach(t, playwright.BrowserNewContextOptions{
+				RecordHarPath:    playwright.String(harPath),
+				RecordHarContent: playwright.HarContentPolicyAttach,
+			})
+
+			_, err := page.Goto(server.PREFIX + "/one-style.html")
+			require.NoError(t, err)
+			require.NoError(t, context.Close())
+
+			har, err := playwright.ReadHar(harPath)
+			require.NoError(t, err)
+			require.Len(t, har.Log.Entries, 2)
+			require.True(t, strings.HasSuffix(har.Log.Entries[1].Request.URL, "one-style.css"))
+			require.NotEmpty(t, har.Log.Entries[1].Response.Content.File)
+			body, err := har.ResponseBody(har.Log.Entries[1])
+			require.NoError(t, err)
+			require.Contains(t, string(body), "background-color")
+		})
+	}
+}
+
 func TestShouldProduceExtractedZip(t *testing.T) {
 	harPath := filepath.Join(t.TempDir(), "har.har")
 	BeforeEach(t, playwright.BrowserNewContextOptions{
@@ -786,3 +851,78 @@ func TestShouldUpdateExtractedHarZipForPage(t *testing.T) {
 	require.Contains(t, string(body), "hello, world!")
 	require.NoError(t, expect.Locator(page2.Locator("body")).ToHaveCSS("background-color", "rgb(255, 192, 203)"))
 }
+
+func TestShouldUpdateHarForPageWithEmbeddedContent(t *testing.T) {
+	BeforeEach(t)
+
+	harPath := filepath.Join(t.TempDir(), "har.har")
+	require.NoError(t, page.RouteFromHAR(harPath, playwright.PageRouteFromHAROptions{
+		Update:        playwright.Bool(true),
+		UpdateContent: playwright.RouteFromHarUpdateContentPolicyEmbed,
+	}))
+	_, err := page.Goto(server.PREFIX + "/one-style.html")
+	require.NoError(t, err)
+	require.NoError(t, context.Close())
+
+	data, err := os.ReadFile(harPath)
+	require.NoError(t, err)
+	require.Contains(t, string(data), "pink")
+	entries, err := os.ReadDir(filepath.Dir(harPath))
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+}
+
+func TestShouldMergeUpdatedHarForPage(t *testing.T) {
+	BeforeEach(t)
+
+	harPath := filepath.Join(t.TempDir(), "har.har")
+	require.NoError(t, page.RouteFromHAR(harPath, playwright.PageRouteFromHAROptions{
+		Update: playwright.Bool(true),
+	}))
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	require.NoError(t, context.Close())
+
+	context2, page2 := newBrowserContextAndPage(t, playwright.BrowserNewContextOptions{})
+	require.NoError(t, page2.RouteFromHAR(harPath, playwright.PageRouteFromHAROptions{
+		Update:      playwright.Bool(true),
+		UpdateMerge: playwright.Bool(true),
+	}))
+	_, err = page2.Goto(server.PREFIX + "/one-style.html")
+	require.NoError(t, err)
+	require.NoError(t, context2.Close())
+
+	har, err := playwright.ReadHar(harPath)
+	require.NoError(t, err)
+	urls := []string{}
+	for _, entry := range har.Log.Entries {
+		urls = append(urls, entry.Request.URL)
+	}
+	require.Contains(t, urls, server.EMPTY_PAGE)
+	require.Contains(t, urls, server.PREFIX+"/one-style.html")
+	require.Contains(t, urls, server.PREFIX+"/one-style.css")
+}
+
+func TestPageRecordHarShouldOnlyRecordThePage(t *testing.T) {
+	BeforeEach(t)
+
+	harPath := filepath.Join(t.TempDir(), "har.har")
+	require.NoError(t, page.RecordHar(harPath, playwright.PageRecordHarOptions{
+		URLFilter: []string{"**/*.html"},
+	}))
+	page2, err := context.NewPage()
+	require.NoError(t, err)
+	_, err = page2.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	_, err = page.Goto(server.PREFIX + "/one-style.html")
+	require.NoError(t, err)
+	require.NoError(t, context.Close())
+
+	har, err := playwright.ReadHar(harPath)
+	require.NoError(t, err)
+	require.Len(t, har.Log.Entries, 1)
+	require.Equal(t, server.PREFIX+"/one-style.html", har.Log.Entries[0].Request.URL)
+	body, err := har.ResponseBody(har.Log.Entries[0])
+	require.NoError(t, err)
+	require.Contains(t, string(body), "hello, world!")
+}
@@ -0,0 +1,75 @@
+package playwright_test
+
+import (
+	"testing"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageHistoryShouldListEntries(t *testing.T) {
+	BeforeEach(t)
+
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	history, err := page.History()
+	if !isChromium {
+		require.ErrorIs(t, err, playwright.ErrHistoryNotSupported)
+		return
+	}
+	require.NoError(t, err)
+	_, err = page.Goto(server.PREFIX + "/grid.html")
+	require.NoError(t, err)
+	_, err = page.Evaluate(`() => history.pushState({}, '', '#step-1')`)
+	require.NoError(t, err)
+
+	history, err = page.History()
+	require.NoError(t, err)
+	urls := make([]string, 0)
+	for _, entry := range history.Entries {
+		urls = append(urls, entry.URL)
+	}
+	require.Equal(t, []string{server.EMPTY_PAGE, server.PREFIX + "/grid.html", server.PREFIX + "/grid.html#step-1"}, urls[len(urls)-3:])
+	require.Equal(t, len(urls)-1, history.CurrentIndex)
+
+	_, err = page.GoBack()
+	require.NoError(t, err)
+	history, err = page.History()
+	require.NoError(t, err)
+	require.Equal(t, len(urls)-2, history.CurrentIndex)
+}
+
+func TestPageGoToHistoryEntry(t *testing.T) {
+	BeforeEach(t)
+	if !isChromium {
+		t.Skip("session history is only supported in Chromium")
+	}
+
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	_, err = page.Goto(server.PREFIX + "/grid.html")
+	require.NoError(t, err)
+	_, err = page.Goto(server.PREFIX + "/one-style.html")
+	require.NoError(t, err)
+	history, err := page.History()
+	require.NoError(t, err)
+	first := history.CurrentIndex - 2
+
+	response, err := page.GoToHistoryEntry(first)
+	require.NoError(t, err)
+	require.NotNil(t, response)
+	require.Equal(t, server.EMPTY_PAGE, page.URL())
+	history, err = page.History()
+	require.NoError(t, err)
+	require.Equal(t, first, history.CurrentIndex)
+
+	response, err = page.GoToHistoryEntry(first)
+	require.NoError(t, err)
+	require.Nil(t, response)
+	response, err = page.GoToHistoryEntry(first + 2)
+	require.NoError(t, err)
+	require.Equal(t, server.PREFIX+"/one-style.html", response.URL())
+
+	_, err = page.GoToHistoryEntry(len(history.Entries))
+	require.ErrorContains(t, err, "out of range")
+}
@@ -0,0 +1,123 @@
+package playwright_test
+
+import (
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+func serveCachedScript(t *testing.T) *atomic.Int32 {
+	t.Helper()
+	hits := &atomic.Int32{}
+	server.SetRoute("/cached.js", func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.Header().Set("Content-Type", "application/javascript")
+		w.Header().Set("Cache-Control", "public, max-age=3600")
+		_, _ = w.Write([]byte("window.cached = true;"))
+	})
+	return hits
+}
+
+func fetchCachedScript(t *testing.T, page playwright.Page) playwright.Response {
+	t.Helper()
+	response, err := page.ExpectResponse("**/cached.js", func() error {
+		_, err := page.Evaluate(`() => fetch('/cached.js').then(r => r.text())`)
+		return err
+	})
+	require.NoError(t, err)
+	return response
+}
+
+func TestResponseFromCache(t *testing.T) {
+	BeforeEach(t)
+	hits := serveCachedScript(t)
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+
+	fromCache, err := fetchCachedScript(t, page).FromCache()
+	require.NoError(t, err)
+	require.False(t, fromCache)
+	fromCache, err = fetchCachedScript(t, page).FromCache()
+	require.NoError(t, err)
+	require.True(t, fromCache)
+	require.Equal(t, int32(1), hits.Load())
+}
+
+func TestBrowserContextSetCacheEnabled(t *testing.T) {
+	BeforeEach(t)
+	hits := serveCachedScript(t)
+	require.NoError(t, context.SetCacheEnabled(false))
+	// Routes of the context still take precedence over the disabled cache.
+	require.NoError(t, context.Route("**/empty.html", func(route playwright.Route) {
+		require.NoError(t, route.Fulfill(playwright.RouteFulfillOptions{
+			ContentType: playwright.String("text/html"),
+			Body:        "routed",
+		}))
+	}))
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	content, err := page.TextContent("body")
+	require.NoError(t, err)
+	require.Equal(t, "routed", content)
+
+	fetchCachedScript(t, page)
+	fromCache, err := fetchCachedScript(t, page).FromCache()
+	require.NoError(t, err)
+	require.False(t, fromCache)
+	require.Equal(t, int32(2), hits.Load())
+
+	require.NoError(t, context.SetCacheEnabled(true))
+	fetchCachedScript(t, page)
+	fromCache, err = fetchCachedScript(t, page).FromCache()
+	require.NoError(t, err)
+	require.True(t, fromCache)
+}
+
+func TestBrowserContextSetCacheEnabledShouldSurviveUnrouteAll(t *testing.T) {
+	BeforeEach(t)
+	hits := serveCachedScript(t)
+	require.NoError(t, context.SetCacheEnabled(false))
+	require.NoError(t, context.UnrouteAll())
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	fetchCachedScript(t, page)
+	fetchCachedScript(t, page)
+	require.Equal(t, int32(2), hits.Load())
+}
+
+func TestBrowserNewContextDisableCache(t *testing.T) {
+	BeforeEach(t)
+	hits := serveCachedScript(t)
+	page, err := browser.NewPage(playwright.BrowserNewPageOptions{
+		DisableCache: playwright.Bool(true),
+	})
+	require.NoError(t, err)
+	defer page.Close()
+	_, err = page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+
+	fetchCachedScript(t, page)
+	fetchCachedScript(t, page)
+	require.Equal(t, int32(2), hits.Load())
+}
+
+func TestBrowserContextClearCache(t *testing.T) {
+	BeforeEach(t)
+	if !isChromium {
+		require.ErrorIs(t, context.ClearCache(), playwright.ErrClearCacheNotSupported)
+		return
+	}
+	hits := serveCachedScript(t)
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+
+	fetchCachedScript(t, page)
+	require.NoError(t, context.ClearCache())
+	fromCache, err := fetchCachedScript(t, page).FromCache()
+	require.NoError(t, err)
+	require.False(t, fromCache)
+	require.Equal(t, int32(2), hits.Load())
+}
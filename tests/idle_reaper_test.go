@@ -0,0 +1,75 @@
+package playwright_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdleReaperShouldCloseIdleContexts(t *testing.T) {
+	BeforeEach(t)
+
+	reaper := playwright.NewIdleReaper(playwright.IdleReaperOptions{
+		Timeout:  500 * time.Millisecond,
+		Interval: 50 * time.Millisecond,
+	})
+	defer reaper.Stop()
+	idle, err := browser.NewContext()
+	require.NoError(t, err)
+	active, err := browser.NewContext()
+	require.NoError(t, err)
+	defer active.Close()
+	activePage, err := active.NewPage()
+	require.NoError(t, err)
+	idleEvents := make(chan playwright.BrowserContext, 1)
+	idle.OnIdle(func(context playwright.BrowserContext) {
+		idleEvents <- context
+	})
+	closed := make(chan bool, 1)
+	idle.OnClose(func(playwright.BrowserContext) {
+		closed <- true
+	})
+	require.NoError(t, reaper.Watch(idle))
+	require.NoError(t, reaper.Watch(active))
+
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		_, err := activePage.Evaluate("1")
+		require.NoError(t, err)
+		time.Sleep(50 * time.Millisecond)
+	}
+	require.Equal(t, idle, <-idleEvents)
+	<-closed
+	require.NotContains(t, browser.Contexts(), idle)
+	require.Contains(t, browser.Contexts(), active)
+}
+
+func TestIdleReaperShouldClosePagesOfWatchedBrowser(t *testing.T) {
+	BeforeEach(t)
+
+	reaper := playwright.NewIdleReaper(playwright.IdleReaperOptions{
+		Timeout:    500 * time.Millisecond,
+		Interval:   50 * time.Millisecond,
+		ClosePages: true,
+	})
+	defer reaper.Stop()
+	idlePage, err := context.NewPage()
+	require.NoError(t, err)
+	idleEvents := make(chan playwright.Page, 1)
+	idlePage.OnIdle(func(p playwright.Page) {
+		idleEvents <- p
+	})
+	require.NoError(t, reaper.Watch(browser))
+
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		_, err := page.Goto(server.EMPTY_PAGE)
+		require.NoError(t, err)
+		time.Sleep(50 * time.Millisecond)
+	}
+	require.Equal(t, idlePage, <-idleEvents)
+	require.Eventually(t, idlePage.IsClosed, time.Second, 10*time.Millisecond)
+	require.False(t, page.IsClosed())
+}
@@ -0,0 +1,102 @@
+package playwright_test
+
+import (
+	"testing"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageRemoveInitScript(t *testing.T) {
+	BeforeEach(t)
+
+	script := playwright.Script{Content: playwright.String(`window['injected'] = 123;`)}
+	require.NoError(t, page.AddInitScript(script))
+	require.NoError(t, page.AddInitScript(playwright.Script{Content: playwright.String(`window['other'] = 1;`)}))
+	require.Len(t, page.InitScripts(), 2)
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	result, err := page.Evaluate(`() => window['injected']`)
+	require.NoError(t, err)
+	require.Equal(t, 123, result)
+
+	require.NoError(t, page.RemoveInitScript(script))
+	require.Len(t, page.InitScripts(), 1)
+	_, err = page.Reload()
+	require.NoError(t, err)
+	result, err = page.Evaluate(`() => [window['injected'], window['other']]`)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{nil, 1}, result)
+}
+
+func TestPageClearInitScripts(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.AddInitScript(playwright.Script{Content: playwright.String(`window['injected'] = 123;`)}))
+	require.NoError(t, page.ClearInitScripts())
+	require.Empty(t, page.InitScripts())
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	result, err := page.Evaluate(`() => window['injected']`)
+	require.NoError(t, err)
+	require.Nil(t, result)
+}
+
+func TestBrowserContextRemoveInitScript(t *testing.T) {
+	BeforeEach(t)
+
+	script := playwright.Script{Content: playwright.String(`window['injected'] = 123;`)}
+	require.NoError(t, context.AddInitScript(script))
+	_, err := page.Goto(server.PREFIX + "/tamperable.html")
+	require.NoError(t, err)
+	result, err := page.Evaluate(`() => window['result']`)
+	require.NoError(t, err)
+	require.Equal(t, 123, result)
+
+	require.NoError(t, context.RemoveInitScript(script))
+	require.Empty(t, context.InitScripts())
+	_, err = page.Reload()
+	require.NoError(t, err)
+	result, err = page.Evaluate(`() => window['injected']`)
+	require.NoError(t, err)
+	require.Nil(t, result)
+
+	newPage, err := context.NewPage()
+	require.NoError(t, err)
+	_, err = newPage.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	result, err = newPage.Evaluate(`() => window['injected']`)
+	require.NoError(t, err)
+	require.Nil(t, result)
+}
+
+func TestBrowserContextInitScriptShouldRunInNewPages(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, context.AddInitScript(playwright.Script{Content: playwright.String(`window['injected'] = 123;`)}))
+	newPage, err := context.NewPage()
+	require.NoError(t, err)
+	_, err = newPage.Goto(server.PREFIX + "/tamperable.html")
+	require.NoError(t, err)
+	result, err := newPage.Evaluate(`() => window['result']`)
+	require.NoError(t, err)
+	require.Equal(t, 123, result)
+}
+
+func TestBrowserContextClearInitScriptsShouldRemoveIsolatedWorldScripts(t *testing.T) {
+	BeforeEach(t)
+	if !isChromium {
+		t.Skip("isolated worlds are only supported in Chromium")
+	}
+
+	require.NoError(t, context.AddInitScript(playwright.Script{
+		Content:       playwright.String(`window.fromInit = 42;`),
+		IsolatedWorld: playwright.String("utility"),
+	}))
+	require.NoError(t, context.ClearInitScripts())
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	result, err := page.IsolatedWorld("utility").Evaluate(`() => window.fromInit`)
+	require.NoError(t, err)
+	require.Nil(t, result)
+}
@@ -0,0 +1,107 @@
+package playwright_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsolatedWorldEvaluateShouldNotSeePageGlobals(t *testing.T) {
+	BeforeEach(t)
+
+	if !isChromium {
+		t.Skip()
+	}
+	_, err := page.Evaluate(`() => { window.secret = 42; JSON.stringify = () => 'tampered'; }`)
+	require.NoError(t, err)
+	world := page.IsolatedWorld("helpers")
+	require.Equal(t, "helpers", world.Name())
+	value, err := world.Evaluate(`arg => { window.helper = arg; return [typeof window.secret, JSON.stringify({ a: 1 })] }`, "installed")
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{"undefined", `{"a":1}`}, value)
+	value, err = world.Evaluate(`window.helper`)
+	require.NoError(t, err)
+	require.Equal(t, "installed", value)
+	value, err = page.IsolatedWorld("other").Evaluate(`typeof window.helper`)
+	require.NoError(t, err)
+	require.Equal(t, "undefined", value)
+	value, err = page.Evaluate(`typeof window.helper`)
+	require.NoError(t, err)
+	require.Equal(t, "undefined", value)
+}
+
+func TestIsolatedWorldAddInitScript(t *testing.T) {
+	BeforeEach(t)
+
+	if !isChromium {
+		t.Skip()
+	}
+	require.NoError(t, context.AddInitScript(playwright.Script{
+		Content:       playwright.String(`window.injected = document.readyState`),
+		IsolatedWorld: playwright.String("helpers"),
+	}))
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	value, err := page.IsolatedWorld("helpers").Evaluate(`window.injected`)
+	require.NoError(t, err)
+	require.Equal(t, "loading", value)
+	value, err = page.Evaluate(`typeof window.injected`)
+	require.NoError(t, err)
+	require.Equal(t, "undefined", value)
+}
+
+func TestIsolatedWorldAddInitScriptShouldApplyToPopups(t *testing.T) {
+	BeforeEach(t)
+
+	if !isChromium {
+		t.Skip()
+	}
+	require.NoError(t, context.AddInitScript(playwright.Script{
+		Content:       playwright.String(`window.injected = true`),
+		IsolatedWorld: playwright.String("helpers"),
+	}))
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	popup, err := page.ExpectPopup(func() error {
+		_, err := page.Evaluate(`url => window.open(url)`, server.EMPTY_PAGE)
+		return err
+	})
+	require.NoError(t, err)
+	value, err := popup.IsolatedWorld("helpers").Evaluate(`window.injected`)
+	require.NoError(t, err)
+	require.Equal(t, true, value)
+}
+
+func TestIsolatedWorldShouldFindFramesWithTheSameURL(t *testing.T) {
+	BeforeEach(t)
+
+	if !isChromium {
+		t.Skip()
+	}
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	require.NoError(t, page.SetContent(fmt.Sprintf(`<iframe src="%[1]s"></iframe><iframe src="%[1]s"></iframe>`, server.EMPTY_PAGE)))
+	frames := page.MainFrame().ChildFrames()
+	require.Len(t, frames, 2)
+	for i, frame := range frames {
+		_, err := frame.Evaluate(`title => document.title = title`, fmt.Sprintf("frame %d", i))
+		require.NoError(t, err)
+	}
+	for i, frame := range frames {
+		value, err := frame.IsolatedWorld("helpers").Evaluate(`document.title`)
+		require.NoError(t, err)
+		require.Equal(t, fmt.Sprintf("frame %d", i), value)
+	}
+}
+
+func TestIsolatedWorldShouldErrorOutsideChromium(t *testing.T) {
+	BeforeEach(t)
+
+	if isChromium {
+		t.Skip()
+	}
+	_, err := page.IsolatedWorld("helpers").Evaluate(`1`)
+	require.ErrorIs(t, err, playwright.ErrIsolatedWorldNotSupported)
+}
@@ -0,0 +1,98 @@
+package playwright_test
+
+import (
+	"testing"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyboardSetLayout(t *testing.T) {
+	BeforeEach(t)
+
+	err := page.Keyboard().SetLayout("de-DE")
+	if !isChromium {
+		require.ErrorIs(t, err, playwright.ErrKeyboardLayoutNotSupported)
+		return
+	}
+	require.NoError(t, err)
+	require.NoError(t, page.SetContent(`<input>`))
+	_, err = page.Evaluate(`() => {
+		window.events = [];
+		document.querySelector('input').addEventListener('keydown', e => window.events.push([e.code, e.key, e.keyCode]));
+	}`)
+	require.NoError(t, err)
+	require.NoError(t, page.Locator("input").Press("KeyY"))
+	require.NoError(t, page.Locator("input").PressSequentially("yß"))
+	events, err := page.Evaluate(`() => window.events`)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{
+		[]interface{}{"KeyY", "z", 90},
+		[]interface{}{"KeyZ", "y", 89},
+		[]interface{}{"Minus", "ß", 219},
+	}, events)
+	value, err := page.Locator("input").InputValue()
+	require.NoError(t, err)
+	require.Equal(t, "zyß", value)
+}
+
+func TestKeyboardSetLayoutShouldApplyModifiers(t *testing.T) {
+	BeforeEach(t)
+
+	if !isChromium {
+		t.Skip("keyboard layouts are only supported in Chromium")
+	}
+	require.NoError(t, page.Keyboard().SetLayout("fr-FR"))
+	require.NoError(t, page.SetContent(`<input>`))
+	_, err := page.Evaluate(`() => {
+		window.shortcuts = [];
+		document.addEventListener('keydown', e => e.ctrlKey && window.shortcuts.push(e.key));
+	}`)
+	require.NoError(t, err)
+	require.NoError(t, page.Locator("input").Focus())
+	require.NoError(t, page.Keyboard().Press("Control+KeyQ"))
+	require.NoError(t, page.Keyboard().Type("Ça"))
+	shortcuts, err := page.Evaluate(`() => window.shortcuts`)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{"Control", "a"}, shortcuts)
+	value, err := page.Locator("input").InputValue()
+	require.NoError(t, err)
+	require.Equal(t, "Ça", value)
+}
+
+func TestKeyboardSetLayoutShouldRejectUnknownLayout(t *testing.T) {
+	BeforeEach(t)
+
+	require.EqualError(t, page.Keyboard().SetLayout("xx-XX"), `unknown keyboard layout: "xx-XX"`)
+	require.NoError(t, page.Keyboard().SetLayout(""))
+}
+
+func TestKeyboardSetLayoutShouldApplyModifiersToMouseAndElementHandles(t *testing.T) {
+	BeforeEach(t)
+
+	if !isChromium {
+		t.Skip("keyboard layouts are only supported in Chromium")
+	}
+	require.NoError(t, page.Keyboard().SetLayout("de-DE"))
+	require.NoError(t, page.SetContent(`<input>`))
+	_, err := page.Evaluate(`() => {
+		window.clicks = [];
+		document.addEventListener('click', e => window.clicks.push(e.shiftKey));
+	}`)
+	require.NoError(t, err)
+	require.NoError(t, page.Keyboard().Down("Shift"))
+	require.NoError(t, page.Mouse().Click(5, 5))
+	require.NoError(t, page.Keyboard().Up("Shift"))
+	require.NoError(t, page.Mouse().Click(5, 5))
+	clicks, err := page.Evaluate(`() => window.clicks`)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{true, false}, clicks)
+
+	input, err := page.QuerySelector("input")
+	require.NoError(t, err)
+	require.NoError(t, input.Type("yz"))
+	require.NoError(t, input.Press("Shift+KeyY"))
+	value, err := input.InputValue()
+	require.NoError(t, err)
+	require.Equal(t, "yzZ", value)
+}
@@ -0,0 +1,90 @@
+package playwright_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinkCheckerCheckPage(t *testing.T) {
+	BeforeEach(t)
+
+	server.SetRedirect("/moved.html", "/empty.html")
+	server.SetRoute("/head-not-allowed", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodHead {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	require.NoError(t, page.SetContent(`
+		<a href="/empty.html#top">Empty</a>
+		<a href="/moved.html">Moved</a>
+		<a href="/missing.html">Missing</a>
+		<a href="/head-not-allowed">GET only</a>
+		<a href="mailto:someone@example.com">Mail</a>
+		<img src="/missing.png" alt="Missing image">`))
+
+	request, err := pw.Request.NewContext()
+	require.NoError(t, err)
+	defer request.Dispose()
+	checker := playwright.NewLinkChecker(request, playwright.LinkCheckerOptions{
+		Concurrency: playwright.Int(2),
+	})
+	report, err := checker.CheckPage(page)
+	require.NoError(t, err)
+	require.Equal(t, []string{server.EMPTY_PAGE}, report.Pages)
+	require.Len(t, report.Links, 5)
+
+	broken := report.Broken()
+	require.Len(t, broken, 2)
+	require.Equal(t, server.PREFIX+"/missing.html", broken[0].URL)
+	require.Equal(t, 404, broken[0].Status)
+	require.Equal(t, server.PREFIX+"/missing.png", broken[1].URL)
+	require.Equal(t, "img", broken[1].Sources[0].Tag)
+	require.Equal(t, "Missing image", broken[1].Sources[0].Text)
+
+	redirected := report.Redirected()
+	require.Len(t, redirected, 1)
+	require.Equal(t, server.EMPTY_PAGE, redirected[0].FinalURL)
+	require.Equal(t, []playwright.LinkRedirect{{URL: server.PREFIX + "/moved.html", Status: 302}}, redirected[0].Redirects)
+	require.Empty(t, report.MixedContent())
+}
+
+func TestLinkCheckerCrawl(t *testing.T) {
+	BeforeEach(t)
+
+	pages := map[string]string{
+		"/crawl/index.html": `<a href="/crawl/a.html">A</a><a href="/crawl/b.html">B</a><a href="` + server.CROSS_PROCESS_PREFIX + `/empty.html">External</a>`,
+		"/crawl/a.html":     `<a href="/crawl/deep.html">Deep</a><a href="/crawl/index.html">Home</a>`,
+		"/crawl/b.html":     `<a href="/crawl/broken.html">Broken</a>`,
+		"/crawl/deep.html":  `<p>Deep</p>`,
+	}
+	for path, content := range pages {
+		content := content
+		server.SetRoute(path, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/html")
+			_, _ = w.Write([]byte(content))
+		})
+	}
+	request, err := pw.Request.NewContext()
+	require.NoError(t, err)
+	defer request.Dispose()
+	checker := playwright.NewLinkChecker(request, playwright.LinkCheckerOptions{
+		MaxDepth: playwright.Int(1),
+	})
+	report, err := checker.Crawl(page, server.PREFIX+"/crawl/index.html")
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		server.PREFIX + "/crawl/index.html",
+		server.PREFIX + "/crawl/a.html",
+		server.PREFIX + "/crawl/b.html",
+	}, report.Pages)
+	broken := report.Broken()
+	require.Len(t, broken, 1)
+	require.True(t, strings.HasSuffix(broken[0].URL, "/crawl/broken.html"))
+	require.Equal(t, server.PREFIX+"/crawl/b.html", broken[0].Sources[0].Page)
+}
@@ -3,6 +3,7 @@ package playwright_test
 import (
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/playwright-community/playwright-go"
 	"github.com/stretchr/testify/require"
@@ -424,3 +425,158 @@ func TestLocatorShouldBeAttachedWithHiddenElement(t *testing.T) {
 	}))
 	require.NoError(t, expect.Locator(page.Locator("input")).Not().ToBeAttached())
 }
+
+func TestLocatorAssertionsWithPollInterval(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.SetContent(`<div id="node">initial</div>`))
+	_, err := page.Evaluate(`() => setTimeout(() => document.querySelector("#node").textContent = "updated", 300)`)
+	require.NoError(t, err)
+	pollingExpect := playwright.Expect(playwright.ExpectOptions{
+		Timeout:      playwright.Float(2000),
+		PollInterval: playwright.Float(50),
+	})
+	locator := page.Locator("#node")
+	require.NoError(t, pollingExpect.Locator(locator).ToHaveText("updated"))
+	require.NoError(t, pollingExpect.Locator(locator).Not().ToHaveText("initial"))
+	require.NoError(t, pollingExpect.Page(page).Not().ToHaveTitle("foo"))
+
+	err = playwright.Expect(playwright.ExpectOptions{
+		Timeout:      playwright.Float(200),
+		PollInterval: playwright.Float(50),
+	}).Locator(locator).ToHaveText("never")
+	require.ErrorContains(t, err, "Locator expected to have text 'never'")
+}
+
+func TestLocatorAssertionsSoft(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.SetContent(`<title>Soft</title><div id="node">text</div>`))
+	soft := playwright.ExpectSoft(playwright.ExpectOptions{
+		Timeout: playwright.Float(100),
+	})
+	require.NoError(t, soft.Locator(page.Locator("#node")).ToHaveText("text"))
+	require.NoError(t, soft.Err())
+
+	require.NoError(t, soft.Locator(page.Locator("#node")).ToHaveText("other"))
+	require.NoError(t, soft.Locator(page.Locator("#node")).Not().ToBeVisible())
+	require.NoError(t, soft.Page(page).ToHaveTitle("Hard"))
+	errs := soft.Errors()
+	require.Len(t, errs, 3)
+	require.ErrorContains(t, errs[0], "Locator expected to have text 'other'")
+	require.ErrorContains(t, errs[1], "Locator expected not to be visible")
+	require.ErrorContains(t, errs[2], "Page title expected to be 'Hard'")
+	require.ErrorContains(t, soft.Err(), "Page title expected to be 'Hard'")
+}
+
+func TestLocatorAriaSnapshot(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.SetContent(`<main>
+		<h1>Title</h1>
+		<ul><li>One</li><li>Two</li></ul>
+		<label><input type="checkbox" checked> Accept</label>
+		<button aria-hidden="true">Hidden</button>
+	</main>`))
+	snapshot, err := page.Locator("main").AriaSnapshot()
+	require.NoError(t, err)
+	require.Equal(t, `- main:
+  - heading "Title" [level=1]
+  - list:
+    - listitem: One
+    - listitem: Two
+  - checkbox "Accept" [checked]
+  - text: Accept`, snapshot)
+}
+
+func TestLocatorAssertionsToMatchAriaSnapshot(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.SetContent(`<ul id="items"><li>Item 1</li></ul>`))
+	_, err := page.Evaluate(`() => setTimeout(() => {
+		const item = document.createElement('li');
+		item.textContent = 'Item 2';
+		document.getElementById('items').appendChild(item);
+	}, 100)`)
+	require.NoError(t, err)
+	require.NoError(t, expect.Locator(page.Locator("#items")).ToMatchAriaSnapshot(`
+		- list:
+		  - listitem: Item 1
+		  - listitem: /Item \d/
+	`))
+	require.NoError(t, expect.Locator(page.Locator("#items")).Not().ToMatchAriaSnapshot(`- listitem: Item 3`))
+	err = expect.Locator(page.Locator("#items")).ToMatchAriaSnapshot(`- listitem: Item 3`, playwright.LocatorAssertionsToMatchAriaSnapshotOptions{
+		Timeout: playwright.Float(200),
+	})
+	require.ErrorContains(t, err, "Locator expected to match aria snapshot")
+	require.ErrorContains(t, err, "- listitem: Item 2")
+}
+
+func TestLocatorAssertionsFailureMessages(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.SetContent(`<ul><li class="a" data-x="1">one</li><li>two</li></ul>`))
+	expect := playwright.Expect(playwright.ExpectOptions{Timeout: playwright.Float(200)})
+
+	err := expect.Locator(page.Locator("li").First()).ToHaveText("uno")
+	var assertionErr *playwright.AssertionError
+	require.ErrorAs(t, err, &assertionErr)
+	require.Equal(t, "one", assertionErr.Received)
+	require.NotEmpty(t, assertionErr.Polls)
+	require.Contains(t, err.Error(), "Locator: li >> nth=0")
+	require.Contains(t, err.Error(), "- uno\n+ one")
+	require.Contains(t, err.Error(), "Poll history:")
+
+	err = expect.Locator(page.Locator("li")).ToHaveCount(3)
+	require.ErrorContains(t, err, "Matched elements (2):\n  1. <li class=\"a\" data-x=\"1\">one</li>\n  2. <li>two</li>")
+
+	err = expect.Locator(page.Locator("li").First()).ToHaveAttribute("data-x", "2")
+	require.ErrorContains(t, err, "Attributes of the first matched element:")
+	require.ErrorContains(t, err, `data-x | "1"`)
+}
+
+func TestLocatorAssertionsToHaveTextWithMatchingOptions(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.SetContent("<div id=node>  Cafe\u0301  \uff21\uff22\uff23 </div>"))
+	locator := page.Locator("#node")
+	require.NoError(t, expect.Locator(locator).ToHaveText("caf\u00e9 abc", playwright.LocatorAssertionsToHaveTextOptions{
+		IgnoreCase:    playwright.Bool(true),
+		Normalization: playwright.UnicodeNormalizationNFKC,
+	}))
+	require.NoError(t, expect.Locator(locator).ToContainText("Caf\u00e9", playwright.LocatorAssertionsToContainTextOptions{
+		Normalization: playwright.UnicodeNormalizationNFC,
+	}))
+	require.NoError(t, expect.Locator(locator).Not().ToHaveText("Caf\u00e9 \uff21\uff22\uff23", playwright.LocatorAssertionsToHaveTextOptions{
+		NormalizeWhiteSpace: playwright.Bool(false),
+		Normalization:       playwright.UnicodeNormalizationNFC,
+		Timeout:             playwright.Float(500),
+	}))
+}
+
+func TestLocatorAssertionsNumericAndDateCoercion(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.SetContent("<span id=\"revenue\">Revenue: 1.234,50 \u20ac</span><input id=\"count\" value=\"42\"><span id=\"updated\">21/03/2024</span>"))
+	revenue := page.Locator("#revenue")
+	require.NoError(t, expect.Locator(revenue).ToHaveValueGreaterThan(1000, playwright.LocatorAssertionsToHaveValueGreaterThanOptions{Locale: playwright.String("de-DE")}))
+	require.NoError(t, expect.Locator(revenue).ToHaveValueBetween(1234, 1235, playwright.LocatorAssertionsToHaveValueBetweenOptions{Locale: playwright.String("de-DE")}))
+	require.NoError(t, expect.Locator(page.Locator("#count")).ToHaveValueCloseTo(40, 2))
+	require.NoError(t, expect.Locator(page.Locator("#count")).Not().ToHaveValueLessThan(42))
+
+	updated := page.Locator("#updated")
+	require.NoError(t, expect.Locator(updated).ToHaveDateBetween(
+		time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC),
+		playwright.LocatorAssertionsToHaveDateBetweenOptions{Locale: playwright.String("en-GB"), TimezoneId: playwright.String("UTC")},
+	))
+
+	err := expect.Locator(page.Locator("#count")).ToHaveValueGreaterThan(100, playwright.LocatorAssertionsToHaveValueGreaterThanOptions{Timeout: playwright.Float(200)})
+	var assertionErr *playwright.AssertionError
+	require.ErrorAs(t, err, &assertionErr)
+	require.Equal(t, float64(42), assertionErr.Received)
+	require.ErrorContains(t, err, "Locator expected to have value greater than '100'")
+
+	err = expect.Locator(updated).ToHaveDateBetween(time.Now(), time.Now(), playwright.LocatorAssertionsToHaveDateBetweenOptions{Timeout: playwright.Float(200)})
+	require.ErrorContains(t, err, `could not parse date "21/03/2024"`)
+}
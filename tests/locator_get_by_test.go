@@ -138,3 +138,88 @@ func TestGetByRole(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 1, count)
 }
+
+func TestGetByRoleWithStateOptions(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.SetContent(`
+	<h1>Title</h1>
+	<h2>Section</h2>
+	<h2>Other section</h2>
+	<button aria-pressed="true">Bold</button>
+	<button aria-pressed="false">Italic</button>
+	<button aria-expanded="true">Menu</button>
+	<input type="checkbox" checked aria-label="Remember me">
+	<input type="checkbox" aria-label="Subscribe">
+	<div role="listbox"><div role="option" aria-selected="true">One</div><div role="option">Two</div></div>
+	<button disabled>Disabled</button>
+	<button style="display: none">Hidden</button>
+	`))
+
+	count := func(locator playwright.Locator) int {
+		t.Helper()
+		count, err := locator.Count()
+		require.NoError(t, err)
+		return count
+	}
+	require.Equal(t, 2, count(page.GetByRole("heading", playwright.PageGetByRoleOptions{Level: playwright.Int(2)})))
+	require.NoError(t, expect.Locator(page.GetByRole("heading", playwright.PageGetByRoleOptions{
+		Level: playwright.Int(1),
+	})).ToHaveText("Title"))
+	require.NoError(t, expect.Locator(page.GetByRole("button", playwright.PageGetByRoleOptions{
+		Pressed: playwright.Bool(true),
+	})).ToHaveText("Bold"))
+	require.NoError(t, expect.Locator(page.GetByRole("button", playwright.PageGetByRoleOptions{
+		Expanded: playwright.Bool(true),
+	})).ToHaveText("Menu"))
+	require.NoError(t, expect.Locator(page.GetByRole("checkbox", playwright.PageGetByRoleOptions{
+		Checked: playwright.Bool(false),
+	})).ToHaveAttribute("aria-label", "Subscribe"))
+	require.NoError(t, expect.Locator(page.GetByRole("option", playwright.PageGetByRoleOptions{
+		Selected: playwright.Bool(true),
+	})).ToHaveText("One"))
+	require.NoError(t, expect.Locator(page.GetByRole("button", playwright.PageGetByRoleOptions{
+		Disabled: playwright.Bool(true),
+	})).ToHaveText("Disabled"))
+
+	require.Equal(t, 0, count(page.GetByRole("button", playwright.PageGetByRoleOptions{Name: "Hidden"})))
+	require.Equal(t, 1, count(page.GetByRole("button", playwright.PageGetByRoleOptions{
+		Name:          "Hidden",
+		IncludeHidden: playwright.Bool(true),
+	})))
+
+	require.Equal(t, 2, count(page.GetByRole("heading", playwright.PageGetByRoleOptions{Name: "section"})))
+	require.Equal(t, 0, count(page.GetByRole("heading", playwright.PageGetByRoleOptions{
+		Name:  "section",
+		Exact: playwright.Bool(true),
+	})))
+	require.Equal(t, 1, count(page.GetByRole("heading", playwright.PageGetByRoleOptions{
+		Name:  regexp.MustCompile(`^Sec`),
+		Exact: playwright.Bool(true),
+	})))
+	require.Equal(t, 1, count(page.Locator("body").GetByRole("heading", playwright.LocatorGetByRoleOptions{
+		Name:  regexp.MustCompile(`(?i)^other`),
+		Level: playwright.Int(2),
+	})))
+}
+
+func TestGetByTextWithNormalization(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.SetContent("<div>Cafe\u0301 \uff21\uff22\uff23</div>"))
+	count, err := page.GetByText("Caf\u00e9", playwright.PageGetByTextOptions{
+		Normalization: playwright.UnicodeNormalizationNFC,
+	}).Count()
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	count, err = page.GetByText("caf\u00e9 abc", playwright.PageGetByTextOptions{
+		Exact:         playwright.Bool(true),
+		IgnoreCase:    playwright.Bool(true),
+		Normalization: playwright.UnicodeNormalizationNFKC,
+	}).Count()
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	count, err = page.GetByText("café", playwright.PageGetByTextOptions{Exact: playwright.Bool(true)}).Count()
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+}
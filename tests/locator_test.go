@@ -2,8 +2,11 @@ package playwright_test
 
 import (
 	"fmt"
+	"math"
 	"os"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/playwright-community/playwright-go"
 	"github.com/stretchr/testify/require"
@@ -392,6 +395,39 @@ func TestLocatorAllShouldWork(t *testing.T) {
 	require.ElementsMatch(t, expected, texts)
 }
 
+func TestLocatorAllSeqShouldWork(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.SetContent(`<div><p>A</p><p>B</p><p>C</p></div>`))
+	texts := make([]string, 0)
+	page.Locator("p").AllSeq()(func(locator playwright.Locator, err error) bool {
+		require.NoError(t, err)
+		content, err := locator.TextContent()
+		require.NoError(t, err)
+		texts = append(texts, content)
+		return content != "B"
+	})
+	require.Equal(t, []string{"A", "B"}, texts)
+}
+
+func TestLocatorAllSeqShouldYieldErrorWhenCountChanges(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.SetContent(`<div><p>A</p><p>B</p><p>C</p></div>`))
+	var errs []error
+	page.Locator("p").AllSeq()(func(locator playwright.Locator, err error) bool {
+		if err != nil {
+			errs = append(errs, err)
+			return true
+		}
+		_, err = locator.Evaluate(`p => p.remove()`, nil)
+		require.NoError(t, err)
+		return true
+	})
+	require.Len(t, errs, 1)
+	require.ErrorIs(t, errs[0], playwright.ErrLocatorCountChanged)
+}
+
 func TestLocatorsShouldReturnBoundingBox(t *testing.T) {
 	BeforeEach(t)
 
@@ -475,6 +511,33 @@ func TestLocatorsDispatchEventShouldWork(t *testing.T) {
 	require.Equal(t, "Clicked", ret)
 }
 
+func TestLocatorsDispatchEventShouldRespectTimeout(t *testing.T) {
+	BeforeEach(t)
+
+	err := page.Locator("button").DispatchEvent("click", nil, playwright.LocatorDispatchEventOptions{
+		Timeout: playwright.Timeout(100 * time.Millisecond),
+	})
+	require.ErrorIs(t, err, playwright.ErrTimeout)
+}
+
+func TestLocatorsShouldRejectInvalidTimeout(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.SetContent(`<button>Click me</button>`))
+	for _, timeout := range []float64{-1, math.NaN()} {
+		err := page.Locator("button").Click(playwright.LocatorClickOptions{
+			Timeout: playwright.Float(timeout),
+		})
+		var timeoutErr *playwright.InvalidTimeoutError
+		require.ErrorAs(t, err, &timeoutErr)
+	}
+	_, err := page.ExpectEvent("console", func() error { return nil }, playwright.PageExpectEventOptions{
+		Timeout: playwright.Float(-1),
+	})
+	var timeoutErr *playwright.InvalidTimeoutError
+	require.ErrorAs(t, err, &timeoutErr)
+}
+
 func TestLocatorsDragToShouldWork(t *testing.T) {
 	BeforeEach(t)
 
@@ -579,6 +642,72 @@ func TestLocatorsEvaluateAllShouldWork(t *testing.T) {
 	require.Equal(t, []interface{}{"100", "10"}, content)
 }
 
+func TestLocatorEvaluateShouldUnmarshalIntoType(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.SetContent(`<html><body><div class="tweet"><div class="like">100</div><div class="like">10</div></div></body></html>`))
+	type like struct {
+		Text  string `json:"text"`
+		Count int    `json:"count"`
+	}
+	likes, err := playwright.LocatorEvaluateAll[[]like](page.Locator(".tweet .like"), `nodes => nodes.map(n => ({ text: n.innerText, count: Number(n.innerText) }))`, nil)
+	require.NoError(t, err)
+	require.Equal(t, []like{{"100", 100}, {"10", 10}}, likes)
+	first, err := playwright.LocatorEvaluate[like](page.Locator(".tweet .like").First(), `(n, factor) => ({ text: n.innerText, count: Number(n.innerText) * factor })`, 2)
+	require.NoError(t, err)
+	require.Equal(t, like{"100", 200}, first)
+}
+
+func TestLocatorEvaluateEach(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.SetContent(`<div class="like">100</div><div class="like">10</div>`))
+	type like struct {
+		Text  string `json:"text"`
+		Count int    `json:"count"`
+	}
+	likes, err := playwright.LocatorEvaluateEach[like](page.Locator(".like"), `(n, factor) => ({ text: n.innerText, count: Number(n.innerText) * factor })`, 2)
+	require.NoError(t, err)
+	require.Equal(t, []like{{"100", 200}, {"10", 20}}, likes)
+	texts, err := playwright.LocatorEvaluateEach[string](page.Locator(".like"), `async n => n.innerText`, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"100", "10"}, texts)
+	texts, err = playwright.LocatorEvaluateEach[string](page.Locator(".like"), `function (n) { return n.innerText; }`, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"100", "10"}, texts)
+	require.NoError(t, page.SetContent(`<title>likes</title><div class="like">100</div><div class="like">10</div>`))
+	titles, err := playwright.LocatorEvaluateEach[string](page.Locator(".like"), `document.title`, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"likes", "likes"}, titles)
+	none, err := playwright.LocatorEvaluateEach[string](page.Locator(".missing"), `n => n.innerText`, nil)
+	require.NoError(t, err)
+	require.Empty(t, none)
+}
+
+func TestLocatorEvaluateShouldRunInIsolatedWorld(t *testing.T) {
+	BeforeEach(t)
+
+	if !isChromium {
+		t.Skip()
+	}
+	require.NoError(t, page.SetContent(`<div class="like">100</div><div class="like">10</div><script>window.secret = 42; Array.prototype.map = () => ['tampered']; window.mutations = 0; new MutationObserver(records => window.mutations += records.length).observe(document, { attributes: true, subtree: true });</script>`))
+	isolated := playwright.LocatorEvaluateOptions{IsolatedWorld: playwright.String("helpers")}
+	value, err := page.Locator(".like").First().Evaluate(`(node, arg) => { window.helper = arg; return [node.innerText, typeof window.secret] }`, "installed", isolated)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{"100", "undefined"}, value)
+	value, err = page.Locator(".like").First().Evaluate(`() => window.helper`, nil, isolated)
+	require.NoError(t, err)
+	require.Equal(t, "installed", value)
+	value, err = page.Evaluate(`() => [typeof window.helper, window.mutations]`)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{"undefined", 0}, value)
+	texts, err := playwright.LocatorEvaluateAll[[]string](page.Locator(".like"), `nodes => nodes.map(n => n.innerText)`, nil, playwright.LocatorEvaluateAllOptions{
+		IsolatedWorld: playwright.String("helpers"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"100", "10"}, texts)
+}
+
 func TestShouldSupportLocatorFilter(t *testing.T) {
 	BeforeEach(t)
 
@@ -591,6 +720,49 @@ func TestShouldSupportLocatorFilter(t *testing.T) {
 	require.NoError(t, expect.Locator(locator).ToHaveCount(1))
 }
 
+func TestShouldSupportLocatorFilterHasNotAndHasNotText(t *testing.T) {
+	BeforeEach(t)
+
+	err := page.SetContent(`<section><div><span>hello</span></div><div><p>world</p></div></section>`)
+	require.NoError(t, err)
+	require.NoError(t, expect.Locator(page.Locator("div").Filter(playwright.LocatorFilterOptions{
+		HasNot: page.Locator("span"),
+	})).ToHaveText("world"))
+	require.NoError(t, expect.Locator(page.Locator("div").Filter(playwright.LocatorFilterOptions{
+		HasNotText: "hello",
+	})).ToHaveText("world"))
+	require.NoError(t, expect.Locator(page.Locator("div").Filter(playwright.LocatorFilterOptions{
+		HasNotText: regexp.MustCompile(`^w`),
+	})).ToHaveText("hello"))
+}
+
+func TestShouldSupportLocatorFilterVisible(t *testing.T) {
+	BeforeEach(t)
+
+	err := page.SetContent(`<div>visible</div><div style="display: none">hidden</div>`)
+	require.NoError(t, err)
+	require.NoError(t, expect.Locator(page.Locator("div").Filter(playwright.LocatorFilterOptions{
+		Visible: playwright.Bool(true),
+	})).ToHaveText("visible"))
+	require.NoError(t, expect.Locator(page.Locator("div").Filter(playwright.LocatorFilterOptions{
+		Visible: playwright.Bool(false),
+	})).ToHaveCount(1))
+	require.NoError(t, expect.Locator(page.Locator("div").Filter(playwright.LocatorFilterOptions{
+		HasText: "hidden",
+		Visible: playwright.Bool(true),
+	})).ToHaveCount(0))
+}
+
+func TestLocatorAndOrShouldRequireSameFrame(t *testing.T) {
+	BeforeEach(t)
+
+	otherPage, err := context.NewPage()
+	require.NoError(t, err)
+	require.ErrorIs(t, page.Locator("div").And(otherPage.Locator("div")).Err(), playwright.ErrLocatorNotSameFrame)
+	require.ErrorIs(t, page.Locator("div").Or(otherPage.Locator("div")).Err(), playwright.ErrLocatorNotSameFrame)
+	require.NoError(t, page.Locator("div").And(page.Locator("span")).Err())
+}
+
 func TestShouldSupportLocatorAnd(t *testing.T) {
 	BeforeEach(t)
 
@@ -0,0 +1,78 @@
+package playwright_test
+
+import (
+	"archive/zip"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBrowserContextByName(t *testing.T) {
+	BeforeEach(t)
+
+	checkout, err := browser.NewContext(playwright.BrowserNewContextOptions{
+		Name:     playwright.String("checkout"),
+		Metadata: map[string]string{"worker": "1", "region": "eu"},
+	})
+	require.NoError(t, err)
+	defer checkout.Close()
+	search, err := browser.NewContext(playwright.BrowserNewContextOptions{
+		Metadata: map[string]string{"worker": "2", "region": "eu"},
+	})
+	require.NoError(t, err)
+	defer search.Close()
+	search.SetName("search")
+
+	require.Equal(t, "checkout", checkout.Name())
+	require.Equal(t, map[string]string{"worker": "1", "region": "eu"}, checkout.Metadata())
+	require.Equal(t, checkout, browser.ContextByName("checkout"))
+	require.Equal(t, search, browser.ContextByName("search"))
+	require.Nil(t, browser.ContextByName("cart"))
+	require.ElementsMatch(t, []playwright.BrowserContext{checkout, search}, browser.ContextsWithMetadata(map[string]string{"region": "eu"}))
+	require.Equal(t, []playwright.BrowserContext{search}, browser.ContextsWithMetadata(map[string]string{"worker": "2"}))
+
+	require.NoError(t, checkout.Close())
+	require.Nil(t, browser.ContextByName("checkout"))
+}
+
+func TestPageName(t *testing.T) {
+	BeforeEach(t)
+
+	page.SetName("main")
+	page.SetMetadata("step", "login")
+	require.Equal(t, "main", page.Name())
+	require.Equal(t, map[string]string{"step": "login"}, page.Metadata())
+	page.SetMetadata("step", "")
+	require.Empty(t, page.Metadata())
+}
+
+func TestTracingShouldDefaultTitleToContextName(t *testing.T) {
+	BeforeEach(t)
+
+	context.SetName("checkout")
+	context.SetMetadata("worker", "1")
+	require.NoError(t, context.Tracing().Start())
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	tracePath := filepath.Join(t.TempDir(), "trace.zip")
+	require.NoError(t, context.Tracing().Stop(tracePath))
+
+	reader, err := zip.OpenReader(tracePath)
+	require.NoError(t, err)
+	defer reader.Close()
+	for _, file := range reader.File {
+		if file.Name != "trace.trace" {
+			continue
+		}
+		f, err := file.Open()
+		require.NoError(t, err)
+		data, err := io.ReadAll(f)
+		require.NoError(t, err)
+		require.Contains(t, string(data), `"title":"checkout {worker=1}"`)
+		return
+	}
+	t.Fatal("trace.trace not found")
+}
@@ -0,0 +1,90 @@
+package playwright_test
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBrowserContextNetworkBudgetShouldLimitRequestsPerPageLoad(t *testing.T) {
+	BeforeEach(t)
+
+	budgeted, err := browser.NewContext(playwright.BrowserNewContextOptions{
+		NetworkBudget: &playwright.NetworkBudget{MaxRequestsPerPageLoad: 2},
+	})
+	require.NoError(t, err)
+	defer budgeted.Close()
+	violations := make(chan playwright.BudgetViolation, 10)
+	budgeted.OnBudgetExceeded(func(violation playwright.BudgetViolation) {
+		violations <- violation
+	})
+	budgetedPage, err := budgeted.NewPage()
+	require.NoError(t, err)
+	// The requests passed on by the routes of the page are counted once.
+	require.NoError(t, budgetedPage.Route("**/*", func(route playwright.Route) {
+		require.NoError(t, route.Fallback())
+	}))
+
+	_, err = budgetedPage.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	result, err := budgetedPage.Evaluate(`async url => {
+		const results = [];
+		for (let i = 0; i < 2; i++)
+			results.push(await fetch(url).then(() => 'sent', () => 'blocked'));
+		return results;
+	}`, server.EMPTY_PAGE)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{"sent", "blocked"}, result)
+	violation := <-violations
+	require.Equal(t, *playwright.BudgetLimitRequestCount, violation.Limit)
+	require.Equal(t, server.EMPTY_PAGE, violation.URL)
+	require.Equal(t, int64(3), violation.Value)
+
+	// A new navigation starts a new page load.
+	response, err := budgetedPage.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	require.True(t, response.Ok())
+}
+
+func TestBrowserContextNetworkBudgetShouldBlockLargeResponses(t *testing.T) {
+	BeforeEach(t)
+
+	server.SetRoute("/large.txt", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, strings.Repeat("a", 2048))
+	})
+	server.SetRoute("/small.txt", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "small")
+	})
+	budgeted, err := browser.NewContext(playwright.BrowserNewContextOptions{
+		NetworkBudget: &playwright.NetworkBudget{MaxResponseSize: 1024},
+	})
+	require.NoError(t, err)
+	defer budgeted.Close()
+	violations := make(chan playwright.BudgetViolation, 10)
+	budgeted.OnBudgetExceeded(func(violation playwright.BudgetViolation) {
+		violations <- violation
+	})
+	budgetedPage, err := budgeted.NewPage()
+	require.NoError(t, err)
+
+	_, err = budgetedPage.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	result, err := budgetedPage.Evaluate(`url => fetch(url).then(r => r.text(), () => 'blocked')`, server.PREFIX+"/small.txt")
+	require.NoError(t, err)
+	require.Equal(t, "small", result)
+	result, err = budgetedPage.Evaluate(`url => fetch(url).then(r => r.text(), () => 'blocked')`, server.PREFIX+"/large.txt")
+	require.NoError(t, err)
+	require.Equal(t, "blocked", result)
+	violation := <-violations
+	require.Equal(t, *playwright.BudgetLimitResponseSize, violation.Limit)
+	require.Equal(t, int64(2048), violation.Value)
+
+	_, err = browser.NewContext(playwright.BrowserNewContextOptions{
+		NetworkBudget: &playwright.NetworkBudget{MaxTotalBytes: -1},
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,62 @@
+package playwright_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageEmulateNetworkConditionsLatency(t *testing.T) {
+	BeforeEach(t)
+
+	err := page.EmulateNetworkConditions(playwright.PageEmulateNetworkConditionsOptions{
+		Latency: playwright.Float(500),
+	})
+	if !isChromium {
+		require.ErrorIs(t, err, playwright.ErrNetworkConditionsNotSupported)
+		return
+	}
+	require.NoError(t, err)
+	start := time.Now()
+	_, err = page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, time.Since(start), 500*time.Millisecond)
+}
+
+func TestPageEmulateNetworkConditionsOffline(t *testing.T) {
+	BeforeEach(t)
+	if !isChromium {
+		t.Skip("network conditions emulation is only supported in Chromium")
+	}
+
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	require.NoError(t, page.EmulateNetworkConditions(playwright.PageEmulateNetworkConditionsOptions{
+		Offline: playwright.Bool(true),
+	}))
+	fetch := `() => fetch('/empty.html').then(() => 'ok', () => 'failed')`
+	result, err := page.Evaluate(fetch)
+	require.NoError(t, err)
+	require.Equal(t, "failed", result)
+
+	require.NoError(t, page.EmulateNetworkConditions())
+	result, err = page.Evaluate(fetch)
+	require.NoError(t, err)
+	require.Equal(t, "ok", result)
+}
+
+func TestPageEmulateNetworkConditionsSlow3G(t *testing.T) {
+	BeforeEach(t)
+	if !isChromium {
+		t.Skip("network conditions emulation is only supported in Chromium")
+	}
+
+	require.NoError(t, page.EmulateNetworkConditions(playwright.NetworkConditionsSlow3G))
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	effectiveType, err := page.Evaluate("navigator.connection.effectiveType")
+	require.NoError(t, err)
+	require.Contains(t, []interface{}{"2g", "3g"}, effectiveType)
+}
@@ -0,0 +1,73 @@
+package playwright_test
+
+import (
+	"testing"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBrowserContextAllowedOriginsShouldBlockOtherOrigins(t *testing.T) {
+	BeforeEach(t)
+
+	allowed, err := browser.NewContext(playwright.BrowserNewContextOptions{
+		AllowedOrigins: []string{server.PREFIX},
+	})
+	require.NoError(t, err)
+	defer allowed.Close()
+	var violations []playwright.OriginViolation
+	allowed.OnOriginViolation(func(violation playwright.OriginViolation) {
+		violations = append(violations, violation)
+	})
+	allowedPage, err := allowed.NewPage()
+	require.NoError(t, err)
+
+	_, err = allowedPage.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	result, err := allowedPage.Evaluate(`url => fetch(url).then(() => 'sent', () => 'blocked')`, server.CROSS_PROCESS_PREFIX+"/empty.html")
+	require.NoError(t, err)
+	require.Equal(t, "blocked", result)
+	_, err = allowedPage.Goto(server.CROSS_PROCESS_PREFIX + "/empty.html")
+	require.Error(t, err)
+
+	_, err = allowed.Request().Get(server.CROSS_PROCESS_PREFIX + "/empty.html")
+	require.ErrorIs(t, err, playwright.ErrOriginNotAllowed)
+	response, err := allowed.Request().Get(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	require.True(t, response.Ok())
+
+	require.Len(t, violations, 3)
+	require.False(t, violations[0].IsNavigation)
+	require.True(t, violations[1].IsNavigation)
+	require.True(t, violations[2].API)
+	for _, violation := range violations {
+		require.Equal(t, server.CROSS_PROCESS_PREFIX, violation.Origin)
+	}
+
+	_, err = browser.NewContext(playwright.BrowserNewContextOptions{
+		AllowedOrigins: []string{"localhost"},
+	})
+	require.Error(t, err)
+}
+
+func TestBrowserContextAllowedOriginsShouldReportRedirects(t *testing.T) {
+	BeforeEach(t)
+
+	allowed, err := browser.NewContext(playwright.BrowserNewContextOptions{
+		AllowedOrigins: []string{server.PREFIX},
+	})
+	require.NoError(t, err)
+	defer allowed.Close()
+	violations := make(chan playwright.OriginViolation, 1)
+	allowed.OnOriginViolation(func(violation playwright.OriginViolation) {
+		violations <- violation
+	})
+	allowedPage, err := allowed.NewPage()
+	require.NoError(t, err)
+	server.SetRedirect("/redirect.html", server.CROSS_PROCESS_PREFIX+"/empty.html")
+	_, _ = allowedPage.Goto(server.PREFIX + "/redirect.html")
+	violation := <-violations
+	require.True(t, violation.Redirect)
+	require.True(t, violation.IsNavigation)
+	require.Equal(t, server.CROSS_PROCESS_PREFIX, violation.Origin)
+}
@@ -216,3 +216,67 @@ func TestPageAddLocatorHandlerShouldWorkWithToBeVisible(t *testing.T) {
 	require.NoError(t, expect.Locator(page.Locator(`#interstitial`)).Not().ToBeVisible())
 	require.Equal(t, 1, called)
 }
+
+func TestPageAddLocatorHandlerShouldRespectTimes(t *testing.T) {
+	BeforeEach(t)
+
+	_, err := page.Goto(fmt.Sprintf("%s/input/handle-locator.html", server.PREFIX))
+	require.NoError(t, err)
+
+	called := atomic.Int32{}
+	err = page.AddLocatorHandler(page.GetByText("This interstitial covers the button"), func() {
+		called.Add(1)
+		require.NoError(t, page.Locator("#close").Click())
+	}, playwright.PageAddLocatorHandlerOptions{Times: playwright.Int(1)})
+	require.NoError(t, err)
+
+	err = page.Locator("#aside").Hover()
+	require.NoError(t, err)
+	_, err = page.Evaluate(`() => { window.clicked = 0; window.setupAnnoyingInterstitial("mouseover", 4); }`, nil)
+	require.NoError(t, err)
+	err = page.Locator("#target").Click(playwright.LocatorClickOptions{
+		Timeout: playwright.Float(3000),
+	})
+	require.ErrorIs(t, err, playwright.ErrTimeout)
+	require.Equal(t, int32(1), called.Load())
+}
+
+func TestPageAddLocatorHandlerShouldRejectNonPositiveTimes(t *testing.T) {
+	BeforeEach(t)
+
+	err := page.AddLocatorHandler(page.Locator("#interstitial"), func() {}, playwright.PageAddLocatorHandlerOptions{
+		Times: playwright.Int(0),
+	})
+	require.ErrorContains(t, err, "times must be positive")
+}
+
+func TestPageRemoveLocatorHandler(t *testing.T) {
+	BeforeEach(t)
+
+	_, err := page.Goto(fmt.Sprintf("%s/input/handle-locator.html", server.PREFIX))
+	require.NoError(t, err)
+
+	called := atomic.Int32{}
+	interstitial := page.GetByText("This interstitial covers the button")
+	err = page.AddLocatorHandler(interstitial, func() {
+		called.Add(1)
+		require.NoError(t, page.Locator("#close").Click())
+	})
+	require.NoError(t, err)
+
+	err = page.Locator("#aside").Hover()
+	require.NoError(t, err)
+	_, err = page.Evaluate(`() => { window.clicked = 0; window.setupAnnoyingInterstitial("none", 1); }`, nil)
+	require.NoError(t, err)
+	require.NoError(t, page.Locator("#target").Click())
+	require.Equal(t, int32(1), called.Load())
+
+	require.NoError(t, page.RemoveLocatorHandler(interstitial))
+	_, err = page.Evaluate(`() => { window.clicked = 0; window.setupAnnoyingInterstitial("none", 1); }`, nil)
+	require.NoError(t, err)
+	err = page.Locator("#target").Click(playwright.LocatorClickOptions{
+		Timeout: playwright.Float(2000),
+	})
+	require.ErrorIs(t, err, playwright.ErrTimeout)
+	require.Equal(t, int32(1), called.Load())
+}
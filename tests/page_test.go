@@ -1,6 +1,7 @@
 package playwright_test
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"math/big"
@@ -10,6 +11,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -154,6 +156,34 @@ func TestPagePDFWithOutline(t *testing.T) {
 	require.Greater(t, len(pdfWithOutline), len(pdfNoOutline))
 }
 
+func TestPagePDFShouldStreamToWriter(t *testing.T) {
+	BeforeEach(t)
+
+	if !isChromium {
+		t.Skip("Skipping")
+	}
+	require.NoError(t, page.SetContent("<h1>foobar</h1>"))
+	path := filepath.Join(t.TempDir(), "page.pdf")
+	var buf bytes.Buffer
+	pdf, err := page.PDF(playwright.PagePdfOptions{
+		Format: playwright.String("A4"),
+		Path:   playwright.String(path),
+		Writer: &buf,
+	})
+	require.NoError(t, err)
+	require.Nil(t, pdf)
+	require.Equal(t, "application/pdf", http.DetectContentType(buf.Bytes()))
+	saved, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, buf.Bytes(), saved)
+
+	_, err = page.PDF(playwright.PagePdfOptions{
+		Format: playwright.String("B5"),
+		Writer: &buf,
+	})
+	require.EqualError(t, err, "unknown paper format: B5")
+}
+
 func TestPageQuerySelector(t *testing.T) {
 	BeforeEach(t)
 
@@ -324,6 +354,27 @@ func TestPageExpectRequestFinished(t *testing.T) {
 	require.Equal(t, "GET", request.Method())
 }
 
+func TestPageWaitForEventTyped(t *testing.T) {
+	BeforeEach(t)
+
+	response, err := playwright.ExpectEvent(page, "response", func() error {
+		_, err := page.Goto(server.EMPTY_PAGE)
+		return err
+	}, playwright.WaitForEventOptions[playwright.Response]{
+		Predicate: func(r playwright.Response) bool {
+			return strings.HasSuffix(r.URL(), "empty.html")
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 200, response.Status())
+
+	_, err = playwright.ExpectEvent[playwright.Page](page, "request", func() error {
+		_, err := page.Reload()
+		return err
+	})
+	require.ErrorContains(t, err, `data of event "request" is a`)
+}
+
 func TestPageExpectPopup(t *testing.T) {
 	BeforeEach(t)
 
@@ -1217,3 +1268,100 @@ func TestPageGotoShouldFailWhenExceedingBrowserContextNavigationTimeout(t *testi
 	require.ErrorContains(t, err, "Timeout 5ms exceeded.")
 	require.ErrorContains(t, err, "/empty.html")
 }
+
+func TestPageGotoShouldRetryWithRetryPolicy(t *testing.T) {
+	BeforeEach(t)
+
+	var mu sync.Mutex
+	attempts := 0
+	server.SetRoute("/flaky.html", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		attempts++
+		current := attempts
+		mu.Unlock()
+		if current < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte("<div>ready</div>"))
+	})
+
+	response, err := page.Goto(server.PREFIX+"/flaky.html", playwright.PageGotoOptions{
+		RetryPolicy: &playwright.GotoRetryPolicy{
+			InitialBackoff: playwright.Duration(10 * time.Millisecond),
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 200, response.Status())
+	require.NoError(t, expect.Locator(page.Locator("div")).ToHaveText("ready"))
+
+	mu.Lock()
+	attempts = 0
+	mu.Unlock()
+	response, err = page.Goto(server.PREFIX+"/flaky.html", playwright.PageGotoOptions{
+		RetryPolicy: &playwright.GotoRetryPolicy{
+			MaxAttempts: playwright.Int(2),
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadGateway, response.Status())
+}
+
+func TestPageEmulateIdleState(t *testing.T) {
+	BeforeEach(t)
+
+	if !isChromium {
+		_, err := page.Goto(server.EMPTY_PAGE)
+		require.NoError(t, err)
+		require.ErrorIs(t, page.EmulateIdleState(playwright.PageEmulateIdleStateOptions{
+			UserState: playwright.IdleUserStateIdle,
+		}), playwright.ErrIdleStateNotSupported)
+		return
+	}
+	require.NoError(t, context.GrantPermissions([]string{"idle-detection"}))
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	_, err = page.Evaluate(`async () => {
+		window.idleStates = [];
+		const detector = new IdleDetector();
+		detector.addEventListener('change', () => window.idleStates.push(detector.userState + '/' + detector.screenState));
+		await detector.start({ threshold: 60000 });
+	}`)
+	require.NoError(t, err)
+
+	require.NoError(t, page.EmulateIdleState(playwright.PageEmulateIdleStateOptions{
+		UserState:   playwright.IdleUserStateIdle,
+		ScreenState: playwright.IdleScreenStateLocked,
+	}))
+	_, err = page.WaitForFunction(`() => window.idleStates.includes('idle/locked')`, nil)
+	require.NoError(t, err)
+	require.NoError(t, page.EmulateIdleState(playwright.PageEmulateIdleStateOptions{
+		UserState: playwright.IdleUserStateActive,
+	}))
+	_, err = page.WaitForFunction(`() => window.idleStates.includes('active/unlocked')`, nil)
+	require.NoError(t, err)
+	require.NoError(t, page.EmulateIdleState())
+}
+
+func TestPageActivateUser(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.SetContent(`<button onclick="window.clicked = true">click</button>`))
+	active, err := page.Evaluate(`() => navigator.userActivation ? navigator.userActivation.isActive : null`)
+	require.NoError(t, err)
+	if active == nil {
+		t.Skip("navigator.userActivation is not supported")
+	}
+	require.Equal(t, false, active)
+
+	require.NoError(t, page.ActivateUser())
+	active, err = page.Evaluate(`() => navigator.userActivation.isActive`)
+	require.NoError(t, err)
+	require.Equal(t, true, active)
+	clicked, err := page.Evaluate(`() => window.clicked === true`)
+	require.NoError(t, err)
+	require.Equal(t, false, clicked)
+	count, err := page.Locator("div").Count()
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+}
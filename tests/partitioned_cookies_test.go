@@ -0,0 +1,72 @@
+package playwright_test
+
+import (
+	"testing"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBrowserContextShouldAddPartitionedCookies(t *testing.T) {
+	BeforeEach(t)
+
+	partitioned := playwright.OptionalCookie{
+		Name:         "partitioned",
+		Value:        "1",
+		URL:          playwright.String("https://example.com/"),
+		Secure:       playwright.Bool(true),
+		PartitionKey: playwright.String("https://top-level.com"),
+	}
+	err := context.AddCookies([]playwright.OptionalCookie{partitioned, {
+		Name:  "unpartitioned",
+		Value: "2",
+		URL:   playwright.String("https://example.com/"),
+	}})
+	if !isChromium {
+		require.ErrorIs(t, err, playwright.ErrPartitionedCookiesNotSupported)
+		return
+	}
+	require.NoError(t, err)
+	cookies, err := context.Cookies()
+	require.NoError(t, err)
+	keys := map[string]*string{}
+	for _, cookie := range cookies {
+		keys[cookie.Name] = cookie.PartitionKey
+	}
+	require.Len(t, keys, 2)
+	require.NotNil(t, keys["partitioned"])
+	require.Equal(t, "https://top-level.com", *keys["partitioned"])
+	require.Nil(t, keys["unpartitioned"])
+
+	state, err := context.StorageState()
+	require.NoError(t, err)
+	context2, err := browser.NewContext(playwright.BrowserNewContextOptions{
+		StorageState: state.ToOptionalStorageState(),
+	})
+	require.NoError(t, err)
+	defer context2.Close()
+	_, err = context2.NewPage()
+	require.NoError(t, err)
+	cookies, err = context2.Cookies("https://example.com/")
+	require.NoError(t, err)
+	for _, cookie := range cookies {
+		if cookie.Name == "partitioned" {
+			require.NotNil(t, cookie.PartitionKey)
+			require.Equal(t, "https://top-level.com", *cookie.PartitionKey)
+			return
+		}
+	}
+	t.Fatal("the partitioned cookie was not restored")
+}
+
+func TestBrowserContextShouldRejectInsecurePartitionedCookies(t *testing.T) {
+	BeforeEach(t)
+
+	err := context.AddCookies([]playwright.OptionalCookie{{
+		Name:         "partitioned",
+		Value:        "1",
+		URL:          playwright.String("https://example.com/"),
+		PartitionKey: playwright.String("https://top-level.com"),
+	}})
+	require.Error(t, err)
+}
@@ -0,0 +1,69 @@
+package playwright_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageExpectPrerenderActivationShouldReportActivation(t *testing.T) {
+	BeforeEach(t)
+
+	server.SetRoute("/speculation.html", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, `<script type="speculationrules">{"prerender": [{"source": "list", "urls": ["/prerendered.html"]}]}</script>
+<a href="/prerendered.html">prerendered</a>`)
+	})
+	server.SetRoute("/prerendered.html", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, `<title>prerendered</title>`)
+	})
+	if !isChromium {
+		_, err := page.ExpectPrerenderActivation(func() error {
+			return nil
+		})
+		require.ErrorIs(t, err, playwright.ErrPrerenderNotSupported)
+		return
+	}
+	ready := make(chan playwright.PrerenderAttempt, 1)
+	page.OnPrerender(func(attempt playwright.PrerenderAttempt) {
+		if attempt.Status == *playwright.PrerenderStatusReady {
+			ready <- attempt
+		}
+	})
+	require.NoError(t, page.CapturePrerenders())
+	_, err := page.Goto(server.PREFIX + "/speculation.html")
+	require.NoError(t, err)
+	var attempt playwright.PrerenderAttempt
+	select {
+	case attempt = <-ready:
+	case <-time.After(10 * time.Second):
+		t.Fatal("the page was not prerendered")
+	}
+	require.Equal(t, server.PREFIX+"/prerendered.html", attempt.URL)
+	activation, err := page.ExpectPrerenderActivation(func() error {
+		return page.Locator("a").Click()
+	}, playwright.PageExpectPrerenderActivationOptions{
+		URL: "**/prerendered.html",
+	})
+	require.NoError(t, err)
+	require.Equal(t, *playwright.PrerenderStatusSuccess, activation.Status)
+	require.Equal(t, "Activated", activation.FinalStatus)
+	prerendered, err := page.WasPrerendered()
+	require.NoError(t, err)
+	require.True(t, prerendered)
+}
+
+func TestPageWasPrerenderedShouldBeFalseAfterNavigation(t *testing.T) {
+	BeforeEach(t)
+
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	prerendered, err := page.WasPrerendered()
+	require.NoError(t, err)
+	require.False(t, prerendered)
+}
@@ -0,0 +1,70 @@
+package playwright_test
+
+import (
+	"testing"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+const printLayoutContent = `
+	<style>
+		body { margin: 0; }
+		section { height: 300px; }
+		@media print {
+			h2 { break-before: page; }
+			.keep { break-inside: avoid; }
+			.screen-only { display: none; }
+		}
+	</style>
+	<div class="screen-only" style="height: 5000px"></div>
+	<h2 id="first">First</h2>
+	<section></section>
+	<h2 id="second">Second</h2>
+	<section style="height: 900px"></section>
+	<div class="keep" style="height: 400px"></div>
+	<div class="split" style="height: 2000px"></div>`
+
+func TestPagePrintLayout(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.SetContent(printLayoutContent))
+	layout, err := page.PrintLayout(playwright.PagePrintLayoutOptions{
+		Selectors: []string{"h2", "#second", ".keep", ".split"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, playwright.Size{Width: 816, Height: 1056}, layout.PageSize)
+	require.NoError(t, layout.ExpectStartsPage("#second"))
+	headings := layout.ElementsOf("h2")
+	require.Equal(t, 1, headings[0].StartPage)
+	require.Equal(t, 2, headings[1].StartPage)
+	require.NoError(t, layout.ExpectNotSplit(".keep"))
+	require.NoError(t, layout.ExpectOnPage(".keep", 3))
+	require.ErrorContains(t, layout.ExpectNotSplit(".split"), `expected element 0 of ".split" not to be split across pages`)
+	require.Equal(t, 5, layout.PageCount)
+
+	// The media type and the viewport are restored.
+	visible, err := page.Locator(".screen-only").IsVisible()
+	require.NoError(t, err)
+	require.True(t, visible)
+	require.Equal(t, 1280, page.ViewportSize().Width)
+}
+
+func TestPagePrintLayoutShouldUsePaperSize(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.SetContent(`<div id="content" style="height: 100px"></div>`))
+	layout, err := page.PrintLayout(playwright.PagePrintLayoutOptions{
+		Format:    playwright.String("A4"),
+		Landscape: playwright.Bool(true),
+		Margin:    &playwright.Margin{Top: playwright.String("1in"), Bottom: playwright.String("1in")},
+	})
+	require.NoError(t, err)
+	require.Equal(t, playwright.Size{Width: 1123, Height: 602}, layout.PageSize)
+	require.Equal(t, 1, layout.PageCount)
+
+	_, err = page.PrintLayout(playwright.PagePrintLayoutOptions{
+		Margin: &playwright.Margin{Top: playwright.String("6in"), Bottom: playwright.String("6in")},
+	})
+	require.ErrorContains(t, err, "the margins are larger than the paper")
+}
@@ -0,0 +1,126 @@
+package playwright_test
+
+import (
+	"bytes"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+const privacyMaskContent = `
+<style>body { margin: 0; background: white; }</style>
+<div id="email" style="position: absolute; left: 10px; top: 10px; width: 100px; height: 40px; background: rgb(0, 0, 255);">a@b.c</div>
+<div id="phone" style="position: absolute; left: 10px; top: 100px; width: 100px; height: 40px; background: rgb(0, 0, 255);">555</div>
+`
+
+func privacyMaskPixel(t *testing.T, screenshot []byte, x, y int) color.RGBA {
+	t.Helper()
+	img, err := png.Decode(bytes.NewReader(screenshot))
+	require.NoError(t, err)
+	r, g, b, _ := img.At(x, y).RGBA()
+	return color.RGBA{R: uint8(r >> 8), G: uint8(g >> 8), B: uint8(b >> 8), A: 255}
+}
+
+func requirePrivacyMaskColor(t *testing.T, expected color.RGBA, actual color.RGBA) {
+	t.Helper()
+	for _, pair := range [][2]uint8{{expected.R, actual.R}, {expected.G, actual.G}, {expected.B, actual.B}} {
+		require.InDelta(t, pair[0], pair[1], 8, "expected %v, got %v", expected, actual)
+	}
+}
+
+func TestBrowserContextAddPrivacyMaskShouldMaskSelectorsAndRegions(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.SetViewportSize(300, 300))
+	require.NoError(t, page.SetContent(privacyMaskContent))
+	require.NoError(t, context.AddPrivacyMask(playwright.BrowserContextAddPrivacyMaskOptions{
+		Selectors: []string{"#email"},
+		Regions:   []playwright.Rect{{X: 200, Y: 200, Width: 50, Height: 50}},
+		Color:     playwright.String("rgb(255, 0, 0)"),
+	}))
+	_, err := page.WaitForFunction(`() => document.querySelector('x-pw-privacy-mask')`, nil)
+	require.NoError(t, err)
+
+	screenshot, err := page.Screenshot()
+	require.NoError(t, err)
+	requirePrivacyMaskColor(t, color.RGBA{R: 255}, privacyMaskPixel(t, screenshot, 50, 30))
+	requirePrivacyMaskColor(t, color.RGBA{B: 255}, privacyMaskPixel(t, screenshot, 50, 120))
+	requirePrivacyMaskColor(t, color.RGBA{R: 255}, privacyMaskPixel(t, screenshot, 225, 225))
+
+	// The masks apply to the documents loaded afterwards.
+	newPage, err := context.NewPage()
+	require.NoError(t, err)
+	require.NoError(t, newPage.SetViewportSize(300, 300))
+	require.NoError(t, newPage.SetContent(privacyMaskContent))
+	_, err = newPage.WaitForFunction(`() => document.querySelector('x-pw-privacy-mask')`, nil)
+	require.NoError(t, err)
+	screenshot, err = newPage.Screenshot()
+	require.NoError(t, err)
+	requirePrivacyMaskColor(t, color.RGBA{R: 255}, privacyMaskPixel(t, screenshot, 50, 30))
+
+	// The overlays don't receive pointer events.
+	require.NoError(t, page.Locator("#email").Click())
+
+	require.NoError(t, context.ClearPrivacyMasks())
+	_, err = page.Evaluate(`() => new Promise(requestAnimationFrame)`)
+	require.NoError(t, err)
+	screenshot, err = page.Screenshot()
+	require.NoError(t, err)
+	requirePrivacyMaskColor(t, color.RGBA{B: 255}, privacyMaskPixel(t, screenshot, 50, 30))
+	requirePrivacyMaskColor(t, color.RGBA{R: 255, G: 255, B: 255}, privacyMaskPixel(t, screenshot, 225, 225))
+}
+
+func TestBrowserContextAddPrivacyMaskShouldMaskLocatorsInScreenshots(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.SetViewportSize(300, 300))
+	require.NoError(t, page.SetContent(privacyMaskContent))
+	require.NoError(t, context.AddPrivacyMask(playwright.BrowserContextAddPrivacyMaskOptions{
+		Locators: []playwright.Locator{page.Locator("#phone")},
+		Color:    playwright.String("rgb(0, 255, 0)"),
+	}))
+	// Locators are masked by overlays, so they are hidden in videos too.
+	count, err := page.Locator("x-pw-privacy-mask").Count()
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	screenshot, err := page.Screenshot()
+	require.NoError(t, err)
+	requirePrivacyMaskColor(t, color.RGBA{G: 255}, privacyMaskPixel(t, screenshot, 50, 120))
+	requirePrivacyMaskColor(t, color.RGBA{B: 255}, privacyMaskPixel(t, screenshot, 50, 30))
+
+	screenshot, err = page.Locator("#phone").Screenshot()
+	require.NoError(t, err)
+	requirePrivacyMaskColor(t, color.RGBA{G: 255}, privacyMaskPixel(t, screenshot, 50, 20))
+
+	// The locators are re-rooted at the main frame of other pages.
+	newPage, err := context.NewPage()
+	require.NoError(t, err)
+	require.NoError(t, newPage.SetViewportSize(300, 300))
+	require.NoError(t, newPage.SetContent(privacyMaskContent))
+	screenshot, err = newPage.Screenshot()
+	require.NoError(t, err)
+	requirePrivacyMaskColor(t, color.RGBA{G: 255}, privacyMaskPixel(t, screenshot, 50, 120))
+
+	// The elements are looked up again when the DOM changes.
+	_, err = newPage.Evaluate(`() => {
+		document.querySelector('#phone').id = 'old-phone';
+		document.querySelector('#email').id = 'phone';
+	}`)
+	require.NoError(t, err)
+	_, err = newPage.WaitForFunction(`() => new Promise(requestAnimationFrame).then(() => true)`, nil)
+	require.NoError(t, err)
+	screenshot, err = newPage.Screenshot()
+	require.NoError(t, err)
+	requirePrivacyMaskColor(t, color.RGBA{G: 255}, privacyMaskPixel(t, screenshot, 50, 30))
+	requirePrivacyMaskColor(t, color.RGBA{B: 255}, privacyMaskPixel(t, screenshot, 50, 120))
+
+	require.Error(t, context.AddPrivacyMask(playwright.BrowserContextAddPrivacyMaskOptions{}))
+	require.Error(t, context.AddPrivacyMask(playwright.BrowserContextAddPrivacyMaskOptions{
+		Selectors: []string{"#email"},
+		Style:     (*playwright.PrivacyMaskStyle)(playwright.String("pixelate")),
+	}))
+}
@@ -0,0 +1,72 @@
+package playwright_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProxyRouterRotatesAndSkipsFailingProxies(t *testing.T) {
+	BeforeEach(t)
+
+	proxied := 0
+	proxyServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied++
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprintf(w, "<title>proxied %s</title>", r.URL.Path)
+	}))
+	defer proxyServer.Close()
+	deadProxy := httptest.NewServer(http.NotFoundHandler())
+	deadProxy.Close()
+
+	pool, err := playwright.NewProxyPool([]playwright.Proxy{
+		{Server: deadProxy.URL},
+		{Server: proxyServer.URL},
+	}, playwright.ProxyPoolOptions{MaxFailures: playwright.Int(1)})
+	require.NoError(t, err)
+	router := playwright.NewProxyRouter(pw.Request, pool)
+	defer router.Close()
+	require.NoError(t, page.Route("**/*", router.Handle))
+
+	_, err = page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	title, err := page.Title()
+	require.NoError(t, err)
+	require.Equal(t, "proxied /empty.html", title)
+
+	_, err = page.Goto(server.PREFIX + "/other.html")
+	require.NoError(t, err)
+	require.Equal(t, 2, proxied)
+
+	health := pool.Health()
+	require.False(t, health[0].Healthy)
+	require.Equal(t, 1, health[0].Failures)
+	require.Equal(t, 2, health[1].Successes)
+}
+
+func TestBrowserNewContextWithProxyProvider(t *testing.T) {
+	BeforeEach(t)
+
+	proxyServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, "<title>proxied</title>")
+	}))
+	defer proxyServer.Close()
+	pool, err := playwright.NewProxyPool([]playwright.Proxy{{Server: proxyServer.URL}})
+	require.NoError(t, err)
+
+	context, err := browser.NewContext(playwright.BrowserNewContextOptions{ProxyProvider: pool})
+	require.NoError(t, err)
+	defer context.Close()
+	page, err := context.NewPage()
+	require.NoError(t, err)
+	_, err = page.Goto("http://proxy-rotation.test/")
+	require.NoError(t, err)
+	title, err := page.Title()
+	require.NoError(t, err)
+	require.Equal(t, "proxied", title)
+}
@@ -0,0 +1,69 @@
+package playwright_test
+
+import (
+	"archive/zip"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactSecretsInHar(t *testing.T) {
+	playwright.RedactSecrets("s3cr3t-token")
+	defer playwright.ResetRedactions()
+	harPath := filepath.Join(t.TempDir(), "log.har")
+	BeforeEach(t, playwright.BrowserNewContextOptions{
+		RecordHarPath: playwright.String(harPath),
+	})
+
+	require.NoError(t, context.SetExtraHTTPHeaders(map[string]string{"Authorization": "Bearer s3cr3t-token"}))
+	_, err := page.Goto(server.EMPTY_PAGE + "?token=s3cr3t-token")
+	require.NoError(t, err)
+	require.NoError(t, context.Close())
+	data, err := os.ReadFile(harPath)
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "s3cr3t-token")
+	require.Contains(t, string(data), "?token="+playwright.RedactionPlaceholder)
+}
+
+func TestRedactSecretsInTrace(t *testing.T) {
+	playwright.RedactPatterns(regexp.MustCompile(`s3cr3t-\w+`))
+	defer playwright.ResetRedactions()
+	BeforeEach(t)
+
+	require.NoError(t, context.Tracing().Start(playwright.TracingStartOptions{Snapshots: playwright.Bool(true)}))
+	_, err := page.Goto(server.EMPTY_PAGE + "?token=s3cr3t-token")
+	require.NoError(t, err)
+	require.NoError(t, page.SetContent(`<input id="password">`))
+	require.NoError(t, page.Locator("#password").Fill("s3cr3t-password"))
+	tracePath := filepath.Join(t.TempDir(), "trace.zip")
+	require.NoError(t, context.Tracing().Stop(tracePath))
+
+	reader, err := zip.OpenReader(tracePath)
+	require.NoError(t, err)
+	defer reader.Close()
+	require.NotEmpty(t, reader.File)
+	for _, file := range reader.File {
+		r, err := file.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(r)
+		require.NoError(t, r.Close())
+		require.NoError(t, err)
+		require.NotContains(t, string(content), "s3cr3t-", file.Name)
+	}
+}
+
+func TestRedactSecretsInErrors(t *testing.T) {
+	playwright.RedactSecrets("s3cr3t-selector")
+	defer playwright.ResetRedactions()
+	BeforeEach(t)
+
+	err := page.Locator("#s3cr3t-selector").Click(playwright.LocatorClickOptions{Timeout: playwright.Float(100)})
+	require.ErrorIs(t, err, playwright.ErrTimeout)
+	require.NotContains(t, err.Error(), "s3cr3t-selector")
+	require.Contains(t, err.Error(), playwright.RedactionPlaceholder)
+}
@@ -3,9 +3,13 @@ package playwright_test
 import (
 	"bufio"
 	"fmt"
+	"io"
+	"net"
+	"net/url"
 	"os"
 	"os/exec"
 	"strings"
+	"sync"
 
 	"github.com/playwright-community/playwright-go"
 )
@@ -46,3 +50,93 @@ func (s *remoteServer) Close() {
 	_ = s.cmd.Process.Kill()
 	_ = s.cmd.Wait()
 }
+
+// flakyProxy forwards TCP connections to a remote server, simulating network blips by dropping or stalling them.
+type flakyProxy struct {
+	sync.Mutex
+	listener net.Listener
+	target   string
+	conns    []net.Conn
+	stalled  bool
+}
+
+// newFlakyProxy forwards to the server of wsEndpoint, and returns the proxy with the endpoint to connect to through it.
+func newFlakyProxy(wsEndpoint string) (*flakyProxy, string, error) {
+	endpoint, err := url.Parse(wsEndpoint)
+	if err != nil {
+		return nil, "", err
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, "", err
+	}
+	p := &flakyProxy{listener: listener, target: endpoint.Host}
+	go p.serve()
+	endpoint.Host = listener.Addr().String()
+	return p, endpoint.String(), nil
+}
+
+func (p *flakyProxy) serve() {
+	for {
+		client, err := p.listener.Accept()
+		if err != nil {
+			return
+		}
+		server, err := net.Dial("tcp", p.target)
+		if err != nil {
+			client.Close()
+			continue
+		}
+		p.Lock()
+		p.conns = append(p.conns, client, server)
+		p.Unlock()
+		go p.forward(client, server)
+		go p.forward(server, client)
+	}
+}
+
+func (p *flakyProxy) forward(dst, src net.Conn) {
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := src.Read(buf)
+		p.Lock()
+		stalled := p.stalled
+		p.Unlock()
+		if stalled {
+			// Swallows the data, as a connection that stopped delivering packets.
+			_, _ = io.Copy(io.Discard, src)
+			return
+		}
+		if n > 0 {
+			if _, err := dst.Write(buf[:n]); err != nil {
+				return
+			}
+		}
+		if err != nil {
+			dst.Close()
+			return
+		}
+	}
+}
+
+// Drop closes the forwarded connections, new connections are forwarded again.
+func (p *flakyProxy) Drop() {
+	p.Lock()
+	defer p.Unlock()
+	for _, conn := range p.conns {
+		conn.Close()
+	}
+	p.conns = nil
+}
+
+// Stall stops delivering the data of the forwarded connections, without closing them.
+func (p *flakyProxy) Stall() {
+	p.Lock()
+	defer p.Unlock()
+	p.stalled = true
+}
+
+func (p *flakyProxy) Close() {
+	p.listener.Close()
+	p.Drop()
+}
@@ -0,0 +1,51 @@
+package playwright_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageRoutesShouldReportHitCounts(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.Route("**/empty.html", func(route playwright.Route) {
+		require.NoError(t, route.Continue())
+	}))
+	require.NoError(t, page.Route(regexp.MustCompile(`\.png$`), func(route playwright.Route) {
+		require.NoError(t, route.Abort())
+	}, 5))
+
+	routes := page.Routes()
+	require.Len(t, routes, 2)
+	require.Equal(t, `/\.png$/`, routes[0].Pattern)
+	require.Equal(t, 5, *routes[0].Remaining)
+	require.Equal(t, "**/empty.html", routes[1].Pattern)
+	require.Nil(t, routes[1].Remaining)
+
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	_, err = page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	routes = page.Routes()
+	require.Equal(t, 0, routes[0].HitCount)
+	require.Equal(t, 2, routes[1].HitCount)
+
+	require.NoError(t, page.Unroute("**/empty.html"))
+	require.Len(t, page.Routes(), 1)
+}
+
+func TestBrowserContextRoutesShouldDropExhaustedRoutes(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, context.Route("**/empty.html", func(route playwright.Route) {
+		require.NoError(t, route.Continue())
+	}, 1))
+	require.Len(t, context.Routes(), 1)
+	require.Empty(t, page.Routes())
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	require.Empty(t, context.Routes())
+}
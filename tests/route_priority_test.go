@@ -0,0 +1,47 @@
+package playwright_test
+
+import (
+	"testing"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouteWithPriorityShouldOrderPageAndContextHandlers(t *testing.T) {
+	BeforeEach(t)
+
+	calls := newSyncSlice[string]()
+	require.NoError(t, context.RouteWithPriority("**/empty.html", func(route playwright.Route) {
+		calls.Append("context-high")
+		require.NoError(t, route.Fallback())
+	}, 10))
+	require.NoError(t, context.Route("**/empty.html", func(route playwright.Route) {
+		calls.Append("context")
+		require.NoError(t, route.Fallback())
+	}))
+	require.NoError(t, page.RouteWithPriority("**/empty.html", func(route playwright.Route) {
+		calls.Append("page-low")
+		require.NoError(t, route.Fallback())
+	}, -1))
+	require.NoError(t, page.Route("**/empty.html", func(route playwright.Route) {
+		calls.Append("page")
+		require.NoError(t, route.Fallback())
+	}))
+	require.NoError(t, page.Route("**/other.html", func(route playwright.Route) {
+		require.NoError(t, route.Abort())
+	}))
+
+	routes := page.ListRoutes(server.EMPTY_PAGE)
+	require.Len(t, routes, 4)
+	require.True(t, routes[0].Context)
+	require.Equal(t, 10, routes[0].Priority)
+	require.False(t, routes[1].Context)
+	require.True(t, routes[2].Context)
+	require.Equal(t, -1, routes[3].Priority)
+	require.Len(t, context.ListRoutes(server.EMPTY_PAGE), 2)
+
+	response, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	require.True(t, response.Ok())
+	require.Equal(t, []string{"context-high", "page", "context", "page-low"}, calls.Get())
+}
@@ -2,8 +2,12 @@ package playwright_test
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
+	"net"
 	"net/http"
+	"os"
+	"strings"
 	"testing"
 
 	"github.com/playwright-community/playwright-go"
@@ -171,6 +175,43 @@ func TestRouteFulfillPath(t *testing.T) {
 	require.Equal(t, "image/png", response.Headers()["content-type"])
 }
 
+func TestRouteFulfillPathShouldInferContentTypeFromExtension(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.Route("**/style.css", func(route playwright.Route) {
+		require.NoError(t, route.Fulfill(playwright.RouteFulfillOptions{
+			Path: playwright.String(Asset("one-style.css")),
+		}))
+	}))
+	response, err := page.Goto(server.PREFIX + "/style.css")
+	require.NoError(t, err)
+	require.True(t, response.Ok())
+	require.Equal(t, "text/css; charset=utf-8", response.Headers()["content-type"])
+	body, err := response.Body()
+	require.NoError(t, err)
+	expected, err := os.ReadFile(Asset("one-style.css"))
+	require.NoError(t, err)
+	require.Equal(t, expected, body)
+}
+
+func TestRouteFulfillShouldReadBodyFromReader(t *testing.T) {
+	BeforeEach(t)
+
+	payload := strings.Repeat("fixture ", 64*1024)
+	require.NoError(t, page.Route("**/empty.html", func(route playwright.Route) {
+		require.NoError(t, route.Fulfill(playwright.RouteFulfillOptions{
+			Body:        io.NopCloser(strings.NewReader(payload)),
+			ContentType: playwright.String("text/plain"),
+		}))
+	}))
+	response, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprint(len(payload)), response.Headers()["content-length"])
+	text, err := response.Text()
+	require.NoError(t, err)
+	require.Equal(t, payload, text)
+}
+
 func TestRequestFinished(t *testing.T) {
 	BeforeEach(t)
 
@@ -260,6 +301,40 @@ func TestRequestPostData(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestRequestPostDataParts(t *testing.T) {
+	BeforeEach(t)
+
+	server.SetRoute("/foobar", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(200)
+	})
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	require.NoError(t, page.Route("**/foobar", func(route playwright.Route) {
+		request := route.Request()
+		parts, err := request.PostDataParts()
+		require.NoError(t, err)
+		require.Equal(t, []playwright.PostDataPart{
+			{Name: "name", Content: []byte("John")},
+			{Name: "file", Filename: "a.bin", ContentType: "application/octet-stream", Content: []byte{0xff, 0x00, 0x80}},
+		}, parts)
+		reader, err := request.PostDataReader()
+		require.NoError(t, err)
+		raw, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		buffer, err := request.PostDataBuffer()
+		require.NoError(t, err)
+		require.Equal(t, buffer, raw)
+		require.NoError(t, route.Continue())
+	}))
+	_, err = page.Evaluate(`url => {
+		const form = new FormData();
+		form.append('name', 'John');
+		form.append('file', new Blob([new Uint8Array([0xff, 0x00, 0x80])], { type: 'application/octet-stream' }), 'a.bin');
+		return fetch(url, { method: 'POST', body: form });
+	}`, server.PREFIX+"/foobar")
+	require.NoError(t, err)
+}
+
 func TestFulfillWithURLOverride(t *testing.T) {
 	BeforeEach(t)
 
@@ -286,6 +361,46 @@ func TestFulfillWithURLOverride(t *testing.T) {
 	require.Equal(t, "bar", headers["foo"])
 }
 
+func TestRouteContinueWithResponse(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.Route("**/*.html", func(route playwright.Route) {
+		require.NoError(t, route.ContinueWithResponse(func(response playwright.APIResponse) (*playwright.RouteFulfillOptions, error) {
+			headers := response.Headers()
+			headers["foo"] = "bar"
+			return &playwright.RouteFulfillOptions{Headers: headers}, nil
+		}))
+	}))
+	response, err := page.Goto(server.PREFIX + "/one-style.html")
+	require.NoError(t, err)
+	require.True(t, response.Ok())
+	body, err := response.Text()
+	require.NoError(t, err)
+	require.Contains(t, body, "one-style.css")
+	headers, err := response.AllHeaders()
+	require.NoError(t, err)
+	require.Equal(t, "bar", headers["foo"])
+}
+
+func TestRouteContinueWithResponseShouldAbortLargeBodies(t *testing.T) {
+	BeforeEach(t)
+
+	server.SetRoute("/large.txt", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = w.Write([]byte(strings.Repeat("0123456789", 100*1024)))
+	})
+	require.NoError(t, page.Route("**/large.txt", func(route playwright.Route) {
+		require.NoError(t, route.ContinueWithResponse(func(response playwright.APIResponse) (*playwright.RouteFulfillOptions, error) {
+			if response.Headers()["content-length"] == "1024000" {
+				return nil, playwright.ErrAbortResponse
+			}
+			return nil, nil
+		}))
+	}))
+	_, err := page.Goto(server.PREFIX + "/large.txt")
+	require.Error(t, err)
+}
+
 func TestResponseSecurityDetails(t *testing.T) {
 	BeforeEach(t)
 
@@ -324,3 +439,98 @@ func TestRequestTimingShouldWork(t *testing.T) {
 	require.GreaterOrEqual(t, timing.ResponseEnd, timing.ResponseStart)
 	require.Less(t, timing.ResponseEnd, 10000.0)
 }
+
+func TestRouteFallbackShouldChainOverrides(t *testing.T) {
+	BeforeEach(t)
+
+	// The context injects the auth header, after the page handlers set the method and the body.
+	require.NoError(t, context.Route("**/api", func(route playwright.Route) {
+		headers := route.Request().Headers()
+		headers["authorization"] = "Bearer token"
+		require.NoError(t, route.Fallback(playwright.RouteFallbackOptions{Headers: headers}))
+	}))
+	require.NoError(t, page.Route("**/api", func(route playwright.Route) {
+		require.NoError(t, route.Fallback(playwright.RouteFallbackOptions{
+			PostData: map[string]interface{}{"stub": true},
+		}))
+	}))
+	require.NoError(t, page.Route("**/api", func(route playwright.Route) {
+		require.NoError(t, route.Fallback(playwright.RouteFallbackOptions{
+			Method:  playwright.String("POST"),
+			Headers: map[string]string{"x-layer": "page"},
+		}))
+	}))
+
+	requestChan := server.WaitForRequestChan("/api")
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	_, err = page.Evaluate(`url => fetch(url)`, server.PREFIX+"/api")
+	require.NoError(t, err)
+	request := <-requestChan
+	require.Equal(t, "POST", request.Method)
+	require.Equal(t, "page", request.Header.Get("x-layer"))
+	require.Equal(t, "Bearer token", request.Header.Get("authorization"))
+	body, err := io.ReadAll(request.Body)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"stub":true}`, string(body))
+}
+
+func TestRouteFetchShouldApplyRedirectRetryAndTimeoutOverrides(t *testing.T) {
+	BeforeEach(t)
+
+	server.SetRedirect("/redirect", "/empty.html")
+	attempts := 0
+	server.SetRoute("/flaky", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			if hw, ok := w.(http.Hijacker); ok {
+				if conn, _, err := hw.Hijack(); err == nil {
+					_ = conn.(*net.TCPConn).SetLinger(0)
+					conn.Close()
+					return
+				}
+			}
+		}
+		_, _ = w.Write([]byte("recovered"))
+	})
+	server.SetRoute("/slow", func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	})
+
+	results := make(chan error, 4)
+	require.NoError(t, page.Route("**/redirect", func(route playwright.Route) {
+		response, err := route.Fetch(playwright.RouteFetchOptions{MaxRedirects: playwright.Int(0)})
+		if err == nil && response.Status() != http.StatusFound {
+			err = fmt.Errorf("expected a redirect, got %d", response.Status())
+		}
+		results <- err
+		require.NoError(t, route.Fulfill(playwright.RouteFulfillOptions{Status: playwright.Int(200)}))
+	}))
+	require.NoError(t, page.Route("**/flaky", func(route playwright.Route) {
+		response, err := route.Fetch(playwright.RouteFetchOptions{MaxRetries: playwright.Int(2)})
+		results <- err
+		require.NoError(t, err)
+		require.NoError(t, route.Fulfill(playwright.RouteFulfillOptions{Response: response}))
+	}))
+	require.NoError(t, page.Route("**/slow", func(route playwright.Route) {
+		_, err := route.Fetch(playwright.RouteFetchOptions{Timeout: playwright.Float(100)})
+		results <- err
+		require.NoError(t, route.Abort())
+	}))
+
+	_, err := page.Goto(server.PREFIX + "/redirect")
+	require.NoError(t, err)
+	require.NoError(t, <-results)
+
+	response, err := page.Goto(server.PREFIX + "/flaky")
+	require.NoError(t, err)
+	require.NoError(t, <-results)
+	body, err := response.Text()
+	require.NoError(t, err)
+	require.Equal(t, "recovered", body)
+	require.Equal(t, 2, attempts)
+
+	_, err = page.Goto(server.PREFIX + "/slow")
+	require.Error(t, err)
+	require.ErrorContains(t, <-results, "Timeout")
+}
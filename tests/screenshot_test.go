@@ -1,6 +1,9 @@
 package playwright_test
 
 import (
+	"bytes"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/playwright-community/playwright-go"
@@ -56,3 +59,73 @@ func TestShouldScreenshotWithMask(t *testing.T) {
 	require.NoError(t, err)
 	AssertToBeGolden(t, screenshot, "mask-should-work-with-elementhandle.png")
 }
+
+func TestLocatorScreenshotStabilizedShouldWaitForAnimation(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.SetContent(`<div id="box" style="width: 50px; height: 50px; background: red"></div>
+		<script>
+			let step = 0;
+			const timer = setInterval(() => {
+				box.style.background = 'rgb(' + (step * 40) + ', 0, 0)';
+				if (++step === 6) {
+					box.style.background = 'blue';
+					clearInterval(timer);
+				}
+			}, 100);
+		</script>`))
+	screenshot, err := page.Locator("#box").ScreenshotStabilized(playwright.LocatorScreenshotStabilizedOptions{
+		StableFrames: playwright.Int(3),
+	})
+	require.NoError(t, err)
+	background, err := page.Locator("#box").Evaluate("e => e.style.background", nil)
+	require.NoError(t, err)
+	require.Equal(t, "blue", background)
+	expected, err := page.Locator("#box").Screenshot()
+	require.NoError(t, err)
+	require.True(t, bytes.Equal(expected, screenshot))
+}
+
+func TestLocatorScreenshotStabilizedShouldWaitForLayout(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.SetContent(`<div id="box" style="position: absolute; left: 0; top: 0; width: 50px; height: 50px; background: red"></div>
+		<script>
+			let left = 0;
+			const timer = setInterval(() => {
+				left += 10;
+				box.style.left = left + 'px';
+				if (left === 80)
+					clearInterval(timer);
+			}, 50);
+		</script>`))
+	path := filepath.Join(t.TempDir(), "box.jpeg")
+	screenshot, err := page.Locator("#box").ScreenshotStabilized(playwright.LocatorScreenshotStabilizedOptions{
+		Path: playwright.String(path),
+	})
+	require.NoError(t, err)
+	box, err := page.Locator("#box").BoundingBox()
+	require.NoError(t, err)
+	require.Equal(t, float64(80), box.X)
+	// The type of the screenshot is inferred from the extension of its path.
+	require.Equal(t, []byte{0xff, 0xd8}, screenshot[:2])
+	saved, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, screenshot, saved)
+}
+
+func TestLocatorScreenshotStabilizedShouldTimeout(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.SetContent(`<div id="counter" style="font-size: 40px">0</div>
+		<script>
+			setInterval(() => counter.textContent = String(Number(counter.textContent) + 1), 30);
+		</script>`))
+	_, err := page.Locator("#counter").ScreenshotStabilized(playwright.LocatorScreenshotStabilizedOptions{
+		Timeout: playwright.Float(1000),
+	})
+	var timeoutErr *playwright.TimeoutError
+	require.ErrorAs(t, err, &timeoutErr)
+	require.ErrorIs(t, err, playwright.ErrTimeout)
+	require.ErrorContains(t, err, "waiting for the element to stop changing")
+}
@@ -0,0 +1,105 @@
+package playwright_test
+
+import (
+	"testing"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageScrollTo(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.SetViewportSize(500, 500))
+	require.NoError(t, page.SetContent(`<div style="width: 2000px; height: 3000px"></div>`))
+	position, err := page.ScrollPosition()
+	require.NoError(t, err)
+	require.Equal(t, &playwright.Position{X: 0, Y: 0}, position)
+
+	position, err = page.ScrollTo(100, 500, playwright.PageScrollToOptions{
+		Behavior: playwright.ScrollBehaviorSmooth,
+	})
+	require.NoError(t, err)
+	require.Equal(t, &playwright.Position{X: 100, Y: 500}, position)
+	scrollY, err := page.Evaluate("window.scrollY")
+	require.NoError(t, err)
+	require.Equal(t, 500, scrollY)
+
+	position, err = page.ScrollBy(0, 100)
+	require.NoError(t, err)
+	require.Equal(t, &playwright.Position{X: 100, Y: 600}, position)
+
+	position, err = page.ScrollTo(0, 100000)
+	require.NoError(t, err)
+	maxY, err := page.Evaluate("document.scrollingElement.scrollHeight - window.innerHeight")
+	require.NoError(t, err)
+	require.Equal(t, float64(maxY.(int)), position.Y)
+}
+
+func TestLocatorScrollTo(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.SetContent(`<div id="scroller" style="width: 100px; height: 100px; overflow: auto; scroll-behavior: smooth">
+		<div style="height: 1000px"></div>
+	</div>`))
+	scroller := page.Locator("#scroller")
+	position, err := scroller.ScrollTo(0, 200)
+	require.NoError(t, err)
+	require.Equal(t, &playwright.Position{X: 0, Y: 200}, position)
+
+	position, err = scroller.ScrollBy(0, 50, playwright.LocatorScrollByOptions{
+		Behavior: playwright.ScrollBehaviorInstant,
+	})
+	require.NoError(t, err)
+	require.Equal(t, &playwright.Position{X: 0, Y: 250}, position)
+
+	position, err = scroller.ScrollPosition()
+	require.NoError(t, err)
+	require.Equal(t, &playwright.Position{X: 0, Y: 250}, position)
+	scrollY, err := page.Evaluate("window.scrollY")
+	require.NoError(t, err)
+	require.Equal(t, 0, scrollY)
+}
+
+func TestPageWaitForScrollEnd(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.SetViewportSize(500, 500))
+	require.NoError(t, page.SetContent(`<style>html { scroll-behavior: smooth }</style>
+		<div style="height: 3000px"></div>`))
+	_, err := page.Evaluate("window.scrollTo(0, 1000)")
+	require.NoError(t, err)
+	position, err := page.WaitForScrollEnd()
+	require.NoError(t, err)
+	require.Equal(t, &playwright.Position{X: 0, Y: 1000}, position)
+
+	// Nothing is scrolling, it ends once the position stayed the same.
+	position, err = page.WaitForScrollEnd(playwright.PageWaitForScrollEndOptions{
+		Settle: playwright.Float(50),
+	})
+	require.NoError(t, err)
+	require.Equal(t, &playwright.Position{X: 0, Y: 1000}, position)
+}
+
+func TestLocatorWaitForScrollEndShouldLoadMoreItems(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.SetContent(`<ul id="feed" style="height: 200px; overflow: auto; margin: 0"></ul>
+		<script>
+			const load = () => {
+				for (let i = 0; i < 10; i++)
+					feed.appendChild(Object.assign(document.createElement('li'), { textContent: 'item', style: 'height: 50px' }));
+			};
+			load();
+			feed.addEventListener('scroll', () => {
+				if (feed.scrollTop + feed.clientHeight >= feed.scrollHeight - 10)
+					load();
+			});
+		</script>`))
+	feed := page.Locator("#feed")
+	require.NoError(t, feed.Hover())
+	require.NoError(t, page.Mouse().Wheel(0, 400))
+	_, err := feed.WaitForScrollEnd()
+	require.NoError(t, err)
+	require.NoError(t, expect.Locator(feed.Locator("li")).ToHaveCount(20))
+}
@@ -74,6 +74,7 @@ func TestSelectorsShouldUseDataTestIdInStrictErrors(t *testing.T) {
 	BeforeEach(t)
 
 	pw.Selectors.SetTestIdAttribute("data-custom-id")
+	defer pw.Selectors.SetTestIdAttribute("data-testid")
 	require.NoError(t, page.SetContent(`
 	<div>
 		<div></div>
@@ -98,6 +99,48 @@ func TestSelectorsShouldUseDataTestIdInStrictErrors(t *testing.T) {
 	require.ErrorContains(t, err, `aka getByTestId('One')`)
 }
 
+func TestSelectorsSetTestIdAttribute(t *testing.T) {
+	BeforeEach(t)
+
+	pw.Selectors.SetTestIdAttribute("data-qa")
+	defer pw.Selectors.SetTestIdAttribute("data-testid")
+	require.NoError(t, page.SetContent(`
+		<div data-testid="ignored">Ignored</div>
+		<section data-qa="form"><button data-qa="submit">Send</button></section>
+		<iframe srcdoc="<span data-qa='inner'>Inner</span>"></iframe>`))
+
+	text, err := page.GetByTestId("submit").TextContent()
+	require.NoError(t, err)
+	require.Equal(t, "Send", text)
+	text, err = page.GetByTestId("form").GetByTestId("submit").TextContent()
+	require.NoError(t, err)
+	require.Equal(t, "Send", text)
+	text, err = page.FrameLocator("iframe").GetByTestId("inner").TextContent()
+	require.NoError(t, err)
+	require.Equal(t, "Inner", text)
+	count, err := page.GetByTestId("ignored").Count()
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+}
+
+func TestSelectorsSetTestIdAttributeShouldApplyToBrowsersLaunchedAfter(t *testing.T) {
+	BeforeEach(t)
+
+	pw.Selectors.SetTestIdAttribute("data-cy")
+	defer pw.Selectors.SetTestIdAttribute("data-testid")
+	browser2, err := browserType.Launch()
+	require.NoError(t, err)
+	defer browser2.Close()
+	page2, err := browser2.NewPage()
+	require.NoError(t, err)
+	require.NoError(t, page2.SetContent(`<div class="item" data-cy="one"></div><div class="item" data-cy="two"></div>`))
+	err = page2.Locator(".item").Click(playwright.LocatorClickOptions{
+		Timeout: playwright.Float(500),
+	})
+	require.ErrorContains(t, err, "strict mode violation")
+	require.ErrorContains(t, err, `aka getByTestId('one')`)
+}
+
 func TestSelectorsShouldWorkWithPath(t *testing.T) {
 	BeforeEach(t)
 
@@ -0,0 +1,106 @@
+package playwright_test
+
+import (
+	"testing"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageDispatchDeviceOrientation(t *testing.T) {
+	BeforeEach(t)
+
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	_, err = page.Evaluate(`() => window.addEventListener('deviceorientation', e => window.result = [e.alpha, e.beta, e.gamma, e.absolute])`)
+	require.NoError(t, err)
+	require.NoError(t, page.DispatchDeviceOrientation(playwright.DeviceOrientation{Alpha: 90, Beta: 45, Gamma: -30, Absolute: true}))
+	result, err := page.Evaluate(`() => window.result`)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{90, 45, -30, true}, result)
+}
+
+func TestPageDispatchDeviceMotion(t *testing.T) {
+	BeforeEach(t)
+
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	_, err = page.Evaluate(`() => window.addEventListener('devicemotion', e => window.result = {
+		x: e.accelerationIncludingGravity.x,
+		acceleration: e.acceleration && e.acceleration.x,
+		alpha: e.rotationRate.alpha,
+		interval: e.interval,
+	})`)
+	require.NoError(t, err)
+	require.NoError(t, page.DispatchDeviceMotion(playwright.DeviceMotion{
+		AccelerationIncludingGravity: &playwright.DeviceMotionVector{X: 1, Y: 2, Z: 9},
+		RotationRate:                 &playwright.DeviceRotationRate{Alpha: 10},
+	}))
+	result, err := page.Evaluate(`() => window.result`)
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"x": 1, "acceleration": nil, "alpha": 10, "interval": 16}, result)
+}
+
+func TestPageEmulateDeviceOrientation(t *testing.T) {
+	BeforeEach(t)
+
+	err := page.EmulateDeviceOrientation(playwright.PageEmulateDeviceOrientationOptions{
+		Orientation: &playwright.DeviceOrientation{Alpha: 10, Beta: 20, Gamma: 30},
+	})
+	if !isChromium {
+		require.ErrorIs(t, err, playwright.ErrSensorsNotSupported)
+		return
+	}
+	require.NoError(t, err)
+	_, err = page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	result, err := page.Evaluate(`() => new Promise(resolve => window.addEventListener('deviceorientation', e => resolve([e.alpha, e.beta, e.gamma]), { once: true }))`)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{10, 20, 30}, result)
+	require.NoError(t, page.EmulateDeviceOrientation())
+}
+
+func TestPageEmulateSensor(t *testing.T) {
+	BeforeEach(t)
+
+	err := page.EmulateSensor(playwright.SensorTypeAccelerometer, playwright.PageEmulateSensorOptions{
+		Reading: []float64{1, 2, 3},
+	})
+	if !isChromium {
+		require.ErrorIs(t, err, playwright.ErrSensorsNotSupported)
+		return
+	}
+	require.NoError(t, err)
+	_, err = page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	require.NoError(t, context.GrantPermissions([]string{"accelerometer"}))
+	result, err := page.Evaluate(`() => new Promise((resolve, reject) => {
+		const sensor = new Accelerometer();
+		sensor.onreading = () => { sensor.stop(); resolve([sensor.x, sensor.y, sensor.z]); };
+		sensor.onerror = e => reject(e.error);
+		sensor.start();
+	})`)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{1, 2, 3}, result)
+
+	require.NoError(t, page.EmulateSensor(playwright.SensorTypeAccelerometer, playwright.PageEmulateSensorOptions{
+		Available: playwright.Bool(false),
+	}))
+	result, err = page.Evaluate(`() => new Promise(resolve => {
+		const sensor = new Accelerometer();
+		sensor.onreading = () => resolve('reading');
+		sensor.onerror = e => resolve(e.error.name);
+		sensor.start();
+	})`)
+	require.NoError(t, err)
+	require.Equal(t, "NotReadableError", result)
+	require.NoError(t, page.EmulateSensor(playwright.SensorTypeAccelerometer))
+}
+
+func TestPageEmulateSensorShouldRejectInvalidReading(t *testing.T) {
+	BeforeEach(t)
+
+	require.EqualError(t, page.EmulateSensor(playwright.SensorTypeGyroscope, playwright.PageEmulateSensorOptions{
+		Reading: []float64{1},
+	}), "invalid reading of gyroscope sensor: expected 3 values, got 1")
+}
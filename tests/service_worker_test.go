@@ -0,0 +1,79 @@
+package playwright_test
+
+import (
+	"testing"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBrowserContextRouteShouldInterceptServiceWorkerRequests(t *testing.T) {
+	if !isChromium {
+		t.Skip("service worker network events are only supported in Chromium")
+	}
+	BeforeEach(t)
+
+	swPlaywright, err := playwright.Run(&playwright.RunOptions{ServiceWorkerNetworkEvents: true})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, swPlaywright.Stop())
+	}()
+	swBrowser, err := swPlaywright.Chromium.Launch()
+	require.NoError(t, err)
+	defer swBrowser.Close()
+	swContext, err := swBrowser.NewContext()
+	require.NoError(t, err)
+
+	routed := make(chan playwright.Request, 1)
+	require.NoError(t, swContext.Route("**/request-from-within-worker.txt", func(route playwright.Route) {
+		routed <- route.Request()
+		require.NoError(t, route.Fulfill(playwright.RouteFulfillOptions{Body: "intercepted"}))
+	}))
+	swPage, err := swContext.NewPage()
+	require.NoError(t, err)
+	_, err = swPage.Goto(server.PREFIX + "/serviceworkers/fetch/sw.html")
+	require.NoError(t, err)
+	_, err = swPage.Evaluate(`() => window.activationPromise`)
+	require.NoError(t, err)
+
+	request := <-routed
+	require.NotNil(t, request.ServiceWorker())
+	require.Nil(t, request.Frame())
+}
+
+func TestBrowserContextWaitForServiceWorker(t *testing.T) {
+	if !isChromium {
+		t.Skip("service workers are only supported in Chromium")
+	}
+	BeforeEach(t)
+
+	_, err := page.Goto(server.PREFIX + "/serviceworkers/fetch/sw.html")
+	require.NoError(t, err)
+	worker, err := context.WaitForServiceWorker(playwright.BrowserContextWaitForServiceWorkerOptions{
+		URL: "**/serviceworkers/fetch/sw.js",
+	})
+	require.NoError(t, err)
+	require.Equal(t, server.PREFIX+"/serviceworkers/fetch/sw.js", worker.URL())
+	require.Contains(t, context.ServiceWorkers(), worker)
+	// The worker which is already running is returned right away.
+	again, err := context.WaitForServiceWorker()
+	require.NoError(t, err)
+	require.Equal(t, worker, again)
+
+	_, err = page.Evaluate(`() => window.activationPromise`)
+	require.NoError(t, err)
+	require.NoError(t, page.UpdateServiceWorkers())
+	require.NoError(t, page.UnregisterServiceWorkers())
+	registrations, err := page.Evaluate(`() => navigator.serviceWorker.getRegistrations().then(r => r.length)`)
+	require.NoError(t, err)
+	require.Equal(t, 0, registrations)
+}
+
+func TestPageServiceWorkersShouldDoNothingInInsecureContexts(t *testing.T) {
+	BeforeEach(t)
+
+	_, err := page.Goto("data:text/html,<p>insecure</p>")
+	require.NoError(t, err)
+	require.NoError(t, page.UpdateServiceWorkers())
+	require.NoError(t, page.UnregisterServiceWorkers())
+}
@@ -0,0 +1,54 @@
+package playwright_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionShouldSaveAndRestore(t *testing.T) {
+	BeforeEach(t)
+
+	_, err := page.Goto(server.PREFIX + "/grid.html")
+	require.NoError(t, err)
+	_, err = page.Evaluate(`() => {
+		localStorage.setItem("name", "value");
+		document.cookie = "username=John Doe";
+		window.scrollTo(0, 100);
+	}`)
+	require.NoError(t, err)
+	page2, err := context.NewPage()
+	require.NoError(t, err)
+	_, err = page2.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "session.json")
+	saved, err := playwright.SaveSession(context, path)
+	require.NoError(t, err)
+	require.Len(t, saved.Pages, 2)
+	require.Equal(t, float64(100), saved.Pages[0].ScrollY)
+
+	session, err := playwright.LoadSession(path)
+	require.NoError(t, err)
+	require.Equal(t, saved, session)
+	restored, err := session.Restore(browser)
+	require.NoError(t, err)
+	defer restored.Close()
+
+	pages := restored.Pages()
+	require.Len(t, pages, 2)
+	require.Equal(t, server.PREFIX+"/grid.html", pages[0].URL())
+	require.Equal(t, server.EMPTY_PAGE, pages[1].URL())
+	scrollY, err := pages[0].Evaluate(`() => window.scrollY`)
+	require.NoError(t, err)
+	require.Equal(t, 100, scrollY)
+	value, err := pages[0].Evaluate(`() => localStorage.getItem("name")`)
+	require.NoError(t, err)
+	require.Equal(t, "value", value)
+	cookies, err := restored.Cookies()
+	require.NoError(t, err)
+	require.Len(t, cookies, 1)
+	require.Equal(t, "username", cookies[0].Name)
+}
@@ -0,0 +1,71 @@
+package playwright_test
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageToHaveScreenshotShouldWriteMissingSnapshot(t *testing.T) {
+	BeforeEach(t)
+
+	dir := t.TempDir()
+	expect := playwright.Expect(playwright.ExpectOptions{SnapshotDir: playwright.String(dir)})
+	require.NoError(t, page.SetContent(`<div style="width: 50px; height: 50px; background: red"></div>`))
+	err := expect.Page(page).ToHaveScreenshot("red")
+	require.ErrorContains(t, err, "writing actual")
+	require.FileExists(t, filepath.Join(dir, runtime.GOOS, "red.png"))
+	require.NoError(t, expect.Page(page).ToHaveScreenshot("red"))
+}
+
+func TestPageToHaveScreenshotShouldFailOnDifference(t *testing.T) {
+	BeforeEach(t)
+
+	dir := t.TempDir()
+	expect := playwright.Expect(playwright.ExpectOptions{SnapshotDir: playwright.String(dir), Timeout: playwright.Float(500)})
+	require.NoError(t, page.SetContent(`<div style="width: 50px; height: 50px; background: red"></div>`))
+	require.Error(t, expect.Page(page).ToHaveScreenshot("box.png"))
+	require.NoError(t, page.SetContent(`<div style="width: 50px; height: 50px; background: blue"></div>`))
+	err := expect.Page(page).ToHaveScreenshot("box.png")
+	require.ErrorContains(t, err, "pixels")
+	require.FileExists(t, filepath.Join(dir, runtime.GOOS, "box-actual.png"))
+	require.FileExists(t, filepath.Join(dir, runtime.GOOS, "box-diff.png"))
+	require.NoError(t, expect.Page(page).ToHaveScreenshot("box.png", playwright.PageAssertionsToHaveScreenshotOptions{
+		MaxDiffPixelRatio: playwright.Float(1),
+	}))
+	require.NoError(t, expect.Page(page).Not().ToHaveScreenshot("box.png"))
+}
+
+func TestLocatorToHaveScreenshotShouldRespectMask(t *testing.T) {
+	BeforeEach(t)
+
+	dir := t.TempDir()
+	expect := playwright.Expect(playwright.ExpectOptions{SnapshotDir: playwright.String(dir), Timeout: playwright.Float(500)})
+	require.NoError(t, page.SetContent(`<div id="box" style="width: 50px; height: 50px"><span id="clock">10:00</span></div>`))
+	options := playwright.LocatorAssertionsToHaveScreenshotOptions{
+		Mask: []playwright.Locator{page.Locator("#clock")},
+	}
+	require.Error(t, expect.Locator(page.Locator("#box")).ToHaveScreenshot("box.png", options))
+	_, err := page.Locator("#clock").Evaluate(`element => element.textContent = '11:30'`, nil)
+	require.NoError(t, err)
+	require.NoError(t, expect.Locator(page.Locator("#box")).ToHaveScreenshot("box.png", options))
+}
+
+func TestSnapshotToMatchSnapshotShouldCompareScreenshots(t *testing.T) {
+	BeforeEach(t)
+
+	dir := t.TempDir()
+	t.Setenv(playwright.SnapshotUpdateEnv, "all")
+	expect := playwright.Expect(playwright.ExpectOptions{SnapshotDir: playwright.String(dir)})
+	require.NoError(t, page.SetContent(`<div style="width: 50px; height: 50px; background: green"></div>`))
+	screenshot, err := page.Screenshot()
+	require.NoError(t, err)
+	require.NoError(t, expect.Snapshot(screenshot).ToMatchSnapshot("green.png"))
+	stored, err := os.ReadFile(filepath.Join(dir, runtime.GOOS, "green.png"))
+	require.NoError(t, err)
+	require.Equal(t, screenshot, stored)
+}
@@ -0,0 +1,97 @@
+package playwright_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpeechShouldReportSpokenText(t *testing.T) {
+	BeforeEach(t)
+
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	speech := page.Speech()
+	require.NoError(t, speech.Install())
+	spoken := make(chan playwright.SpeechUtterance, 2)
+	speech.OnSpeak(func(utterance playwright.SpeechUtterance) {
+		spoken <- utterance
+	})
+	ended, err := page.Evaluate(`() => new Promise(resolve => {
+		const utterance = new SpeechSynthesisUtterance('Hello world');
+		utterance.lang = 'en-GB';
+		utterance.rate = 2;
+		utterance.onend = () => resolve(speechSynthesis.speaking);
+		speechSynthesis.speak(utterance);
+	})`)
+	require.NoError(t, err)
+	require.Equal(t, false, ended)
+	select {
+	case utterance := <-spoken:
+		require.Equal(t, "Hello world", utterance.Text)
+		require.Equal(t, "en-GB", utterance.Lang)
+		require.Equal(t, 2.0, utterance.Rate)
+		require.Equal(t, page.MainFrame(), utterance.Frame)
+	case <-time.After(5 * time.Second):
+		t.Fatal("utterance was not reported")
+	}
+	require.Len(t, speech.Utterances(), 1)
+
+	voices, err := page.Evaluate(`() => speechSynthesis.getVoices().map(voice => voice.lang)`)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{"en-US"}, voices)
+}
+
+func TestSpeechShouldDeliverRecognitionResults(t *testing.T) {
+	BeforeEach(t)
+
+	speech := page.Speech()
+	require.NoError(t, speech.Install(playwright.SpeechInstallOptions{
+		Voices: []playwright.SpeechVoice{{Name: "Anna", Lang: "de-DE", Default: true}},
+	}))
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	started := make(chan playwright.SpeechRecognitionStart, 1)
+	speech.OnRecognitionStart(func(start playwright.SpeechRecognitionStart) {
+		started <- start
+	})
+	require.EqualError(t, speech.Recognize("too early"), "no speech recognition is listening")
+	_, err = page.Evaluate(`() => {
+		window.events = [];
+		const recognition = new webkitSpeechRecognition();
+		recognition.lang = 'de-DE';
+		recognition.maxAlternatives = 2;
+		recognition.onresult = e => window.events.push([...e.results[e.resultIndex]].map(a => a.transcript));
+		recognition.onend = () => window.events.push('end');
+		recognition.start();
+	}`)
+	require.NoError(t, err)
+	select {
+	case start := <-started:
+		require.Equal(t, "de-DE", start.Lang)
+		require.Equal(t, 2, start.MaxAlternatives)
+	case <-time.After(5 * time.Second):
+		t.Fatal("recognition start was not reported")
+	}
+	require.NoError(t, speech.Recognize("Guten Tag", playwright.SpeechRecognizeOptions{
+		Alternatives: []string{"Gute Nacht", "Guten Abend"},
+		Confidence:   playwright.Float(0.8),
+	}))
+	events, err := page.Evaluate(`() => window.events`)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{[]interface{}{"Guten Tag", "Gute Nacht"}, "end"}, events)
+	require.EqualError(t, speech.FailRecognition("no-speech"), "no speech recognition is listening")
+
+	_, err = page.Evaluate(`() => {
+		const recognition = new SpeechRecognition();
+		recognition.onerror = e => window.events.push(e.error);
+		recognition.start();
+	}`)
+	require.NoError(t, err)
+	require.NoError(t, speech.FailRecognition("no-speech"))
+	events, err = page.Evaluate(`() => window.events.slice(2)`)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{"no-speech"}, events)
+}
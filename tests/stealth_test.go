@@ -0,0 +1,52 @@
+package playwright_test
+
+import (
+	"testing"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/playwright-community/playwright-go/stealth"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStealthApply(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, stealth.Apply(page, stealth.Options{
+		WebGLVendor:   playwright.String("Test Vendor"),
+		WebGLRenderer: playwright.String("Test Renderer"),
+	}))
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+
+	result, err := page.Evaluate(`async () => {
+		const gl = document.createElement('canvas').getContext('webgl');
+		return {
+			webdriver: navigator.webdriver === undefined,
+			plugins: navigator.plugins.length > 0 && navigator.plugins[0].name !== undefined,
+			mimeTypes: navigator.mimeTypes['application/pdf'] !== undefined,
+			vendor: gl ? gl.getParameter(0x9245) : 'Test Vendor',
+			renderer: gl ? gl.getParameter(0x9246) : 'Test Renderer',
+			native: Permissions.prototype.query.toString().includes('[native code]'),
+		};
+	}`)
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{
+		"webdriver": true,
+		"plugins":   true,
+		"mimeTypes": true,
+		"vendor":    "Test Vendor",
+		"renderer":  "Test Renderer",
+		"native":    true,
+	}, result)
+}
+
+func TestStealthApplyWithPatchesDisabled(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, stealth.Apply(page, stealth.Options{Webdriver: playwright.Bool(false)}))
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	webdriver, err := page.Evaluate(`() => navigator.webdriver`)
+	require.NoError(t, err)
+	require.Equal(t, true, webdriver)
+}
@@ -0,0 +1,75 @@
+package playwright_test
+
+import (
+	"testing"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+func loginOnEmptyPage(t *testing.T, page playwright.Page) {
+	t.Helper()
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	_, err = page.Evaluate(`() => {
+		document.cookie = 'session=abc; path=/';
+		localStorage.setItem('token', 'secret');
+	}`)
+	require.NoError(t, err)
+}
+
+func expectLoggedIn(t *testing.T, context playwright.BrowserContext) {
+	t.Helper()
+	page, err := context.NewPage()
+	require.NoError(t, err)
+	defer page.Close()
+	_, err = page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	cookie, err := page.Evaluate("document.cookie")
+	require.NoError(t, err)
+	require.Equal(t, "session=abc", cookie)
+	token, err := page.Evaluate("localStorage.getItem('token')")
+	require.NoError(t, err)
+	require.Equal(t, "secret", token)
+}
+
+func TestCopyStorageState(t *testing.T) {
+	BeforeEach(t)
+
+	loginOnEmptyPage(t, page)
+	context2, err := browser.NewContext()
+	require.NoError(t, err)
+	defer context2.Close()
+	require.NoError(t, playwright.CopyStorageState(context, context2))
+	require.Empty(t, context2.Pages())
+	expectLoggedIn(t, context2)
+}
+
+func TestBroadcastStorageState(t *testing.T) {
+	BeforeEach(t)
+
+	loginOnEmptyPage(t, page)
+	workers := make([]playwright.BrowserContext, 3)
+	for i := range workers {
+		worker, err := browser.NewContext()
+		require.NoError(t, err)
+		defer worker.Close()
+		workers[i] = worker
+	}
+	require.NoError(t, playwright.BroadcastStorageState(context, workers...))
+	for _, worker := range workers {
+		expectLoggedIn(t, worker)
+	}
+}
+
+func TestApplyStorageStateShouldRejectUnsupportedOrigins(t *testing.T) {
+	BeforeEach(t)
+
+	err := playwright.ApplyStorageState(context, &playwright.OptionalStorageState{
+		Origins: []playwright.Origin{
+			{Origin: "file://", LocalStorage: []playwright.NameValue{{Name: "a", Value: "b"}}},
+		},
+	})
+	require.ErrorContains(t, err, `could not set local storage of origin "file://"`)
+	require.Len(t, context.Pages(), 1)
+}
@@ -0,0 +1,61 @@
+package playwright_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageStructuredData(t *testing.T) {
+	BeforeEach(t)
+
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	require.NoError(t, page.SetContent(`
+		<script type="application/ld+json">
+			{"@context": "https://schema.org", "@type": "Organization", "name": "ACME", "url": "https://acme.test"}
+		</script>
+		<script type="application/ld+json">{ broken</script>
+		<div itemscope itemtype="https://schema.org/Product" itemid="urn:product:1">
+			<h1 itemprop="name">Rocket</h1>
+			<img itemprop="image" src="/rocket.png">
+			<div itemprop="offers" itemscope itemtype="https://schema.org/Offer">
+				<meta itemprop="priceCurrency" content="USD">
+				<span itemprop="price">99</span>
+			</div>
+			<span itemprop="color">red</span>
+			<span itemprop="color">blue</span>
+		</div>
+		<div itemscope itemtype="https://schema.org/Person"></div>`))
+
+	data, err := page.StructuredData()
+	require.NoError(t, err)
+	require.Len(t, data.Items, 3)
+	require.Equal(t, "json-ld", data.Items[0].Format)
+	require.Equal(t, "ACME", data.Items[0].Properties["name"])
+
+	products := data.ItemsOf("Product")
+	require.Len(t, products, 1)
+	require.Equal(t, "urn:product:1", products[0].ID)
+	var product struct {
+		Name   string   `json:"name"`
+		Image  string   `json:"image"`
+		Color  []string `json:"color"`
+		Offers struct {
+			Type          string `json:"@type"`
+			Price         string `json:"price"`
+			PriceCurrency string `json:"priceCurrency"`
+		} `json:"offers"`
+	}
+	require.NoError(t, products[0].Decode(&product))
+	require.Equal(t, "Rocket", product.Name)
+	require.Equal(t, server.PREFIX+"/rocket.png", product.Image)
+	require.Equal(t, []string{"red", "blue"}, product.Color)
+	require.Equal(t, "https://schema.org/Offer", product.Offers.Type)
+	require.Equal(t, "99", product.Offers.Price)
+	require.Equal(t, "USD", product.Offers.PriceCurrency)
+
+	require.Len(t, data.Errors, 2)
+	require.Contains(t, data.Errors[0].Message, "invalid JSON")
+	require.Equal(t, `microdata 1 (Person): Person is missing required property "name"`, data.Errors[1].Error())
+}
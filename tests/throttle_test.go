@@ -0,0 +1,94 @@
+package playwright_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHostThrottleShouldDelayRequests(t *testing.T) {
+	BeforeEach(t)
+
+	throttle := playwright.NewHostThrottle(playwright.ThrottleOptions{
+		MaxConcurrency:    playwright.Int(1),
+		RequestsPerSecond: playwright.Float(10),
+	})
+	require.NoError(t, throttle.Apply(context))
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+
+	start := time.Now()
+	_, err = page.Evaluate(`() => Promise.all([fetch("/one.json"), fetch("/two.json"), fetch("/three.json")])`)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+}
+
+func TestHostThrottleShouldFallbackToOtherRoutes(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, context.Route("**/empty.html", func(route playwright.Route) {
+		require.NoError(t, route.Fulfill(playwright.RouteFulfillOptions{
+			Status: playwright.Int(http.StatusOK),
+			Body:   "intercepted",
+		}))
+	}))
+	throttle := playwright.NewHostThrottle(playwright.ThrottleOptions{
+		MaxConcurrency: playwright.Int(1),
+	})
+	require.NoError(t, throttle.Apply(context))
+	response, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	body, err := response.Text()
+	require.NoError(t, err)
+	require.Equal(t, "intercepted", body)
+	_, err = page.Reload()
+	require.NoError(t, err)
+}
+
+func TestHostThrottleShouldReleaseSlotsOfClosedPages(t *testing.T) {
+	BeforeEach(t)
+
+	stall := make(chan struct{})
+	defer close(stall)
+	server.SetRoute("/stall", func(w http.ResponseWriter, r *http.Request) {
+		<-stall
+	})
+	throttle := playwright.NewHostThrottle(playwright.ThrottleOptions{
+		MaxConcurrency: playwright.Int(1),
+	})
+	require.NoError(t, throttle.Apply(context))
+	stalled, err := context.NewPage()
+	require.NoError(t, err)
+	_, err = stalled.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	_, err = stalled.ExpectRequest("**/stall", func() error {
+		_, err := stalled.Evaluate(`() => { fetch("/stall"); }`)
+		return err
+	})
+	require.NoError(t, err)
+	require.NoError(t, stalled.Close())
+
+	_, err = page.Goto(server.EMPTY_PAGE, playwright.PageGotoOptions{
+		Timeout: playwright.Float(5000),
+	})
+	require.NoError(t, err)
+}
+
+func TestFetchRobotsTxt(t *testing.T) {
+	BeforeEach(t)
+
+	server.SetRoute("/robots.txt", func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write([]byte("User-agent: *\nDisallow: /private\nCrawl-delay: 1\n"))
+		require.NoError(t, err)
+	})
+	robots, err := playwright.FetchRobotsTxt(context.Request(), server.EMPTY_PAGE)
+	require.NoError(t, err)
+	require.True(t, robots.Allowed("playwright", server.EMPTY_PAGE))
+	require.False(t, robots.Allowed("playwright", server.PREFIX+"/private/index.html"))
+	delay, ok := robots.CrawlDelay("playwright")
+	require.True(t, ok)
+	require.Equal(t, time.Second, delay)
+}
@@ -439,3 +439,45 @@ func TestRouteFulfillShouldNotThrowIfPageHasBeenClosed(t *testing.T) {
 	// Should not throw (upstream).
 	require.NoError(t, route.Fulfill())
 }
+
+func TestPageUnrouteAllShouldLetPendingHandlersUseThePage(t *testing.T) {
+	BeforeEach(t)
+
+	routeChan := make(chan playwright.Route)
+	routeBarrier := make(chan struct{})
+	require.NoError(t, page.Route("**/*", func(route playwright.Route) {
+		routeChan <- route
+		<-routeBarrier
+		// Routing again while UnrouteAll waits for the handler does not deadlock.
+		require.NoError(t, page.Route("**/other", func(route playwright.Route) {}))
+		require.NoError(t, route.Continue())
+	}))
+
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, _ = page.Goto(server.EMPTY_PAGE)
+	}()
+	<-routeChan
+
+	unrouted := make(chan error, 1)
+	go func() {
+		unrouted <- page.UnrouteAll(playwright.PageUnrouteAllOptions{
+			Behavior: playwright.UnrouteBehaviorWait,
+		})
+	}()
+	select {
+	case <-unrouted:
+		t.Fatal("UnrouteAll returned before the pending handler completed")
+	case <-time.After(200 * time.Millisecond):
+	}
+	close(routeBarrier)
+	select {
+	case err := <-unrouted:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("UnrouteAll did not return")
+	}
+	wg.Wait()
+}
@@ -0,0 +1,56 @@
+package playwright_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageShouldEmitViewportSizeChange(t *testing.T) {
+	BeforeEach(t)
+
+	sizes := make(chan *playwright.Size, 1)
+	page.OnViewportSizeChange(func(size *playwright.Size) {
+		sizes <- size
+	})
+	require.NoError(t, page.SetViewportSize(123, 456))
+	size := <-sizes
+	require.Equal(t, 123, size.Width)
+	require.Equal(t, 456, size.Height)
+}
+
+func TestPageForEachBreakpoint(t *testing.T) {
+	BeforeEach(t)
+
+	_, err := page.Goto(server.PREFIX + "/grid.html")
+	require.NoError(t, err)
+	original := *page.ViewportSize()
+	breakpoints := []playwright.Breakpoint{
+		{Name: "mobile", Width: 375, Height: 667},
+		{Name: "tablet", Width: 768, Height: 1024},
+		{Name: "desktop", Width: 1280, Height: 800},
+	}
+	visited := []string{}
+	require.NoError(t, page.ForEachBreakpoint(breakpoints, func(breakpoint playwright.Breakpoint) error {
+		visited = append(visited, breakpoint.Name)
+		width, err := page.Evaluate(`() => window.innerWidth`)
+		require.NoError(t, err)
+		require.Equal(t, breakpoint.Width, width)
+		return nil
+	}))
+	require.Equal(t, []string{"mobile", "tablet", "desktop"}, visited)
+	require.Equal(t, original, *page.ViewportSize())
+	utils.VerifyViewport(t, page, original.Width, original.Height)
+
+	errStop := errors.New("stop")
+	visited = []string{}
+	err = page.ForEachBreakpoint(breakpoints, func(breakpoint playwright.Breakpoint) error {
+		visited = append(visited, breakpoint.Name)
+		return errStop
+	})
+	require.ErrorIs(t, err, errStop)
+	require.Equal(t, []string{"mobile"}, visited)
+	require.Equal(t, original, *page.ViewportSize())
+}
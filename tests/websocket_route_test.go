@@ -0,0 +1,129 @@
+package playwright_test
+
+import (
+	"testing"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageRouteWebSocketShouldMockConnection(t *testing.T) {
+	BeforeEach(t)
+
+	messages := make(chan interface{}, 1)
+	require.NoError(t, page.RouteWebSocket("**/ws", func(ws playwright.WebSocketRoute) {
+		ws.OnMessage(func(message interface{}) {
+			messages <- message
+			require.NoError(t, ws.Send("response"))
+		})
+	}))
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	value, err := page.Evaluate(`() => new Promise(resolve => {
+		const ws = new WebSocket('ws://localhost:1/ws');
+		ws.addEventListener('open', () => ws.send('request'));
+		ws.addEventListener('message', event => resolve(event.data));
+	})`)
+	require.NoError(t, err)
+	require.Equal(t, "response", value)
+	require.Equal(t, "request", <-messages)
+}
+
+func TestPageRouteWebSocketShouldCloseFromRoute(t *testing.T) {
+	BeforeEach(t)
+
+	require.NoError(t, page.RouteWebSocket("**/ws", func(ws playwright.WebSocketRoute) {
+		ws.OnMessage(func(message interface{}) {
+			require.NoError(t, ws.Close(playwright.WebSocketRouteCloseOptions{
+				Code:   playwright.Int(3008),
+				Reason: playwright.String("oops"),
+			}))
+		})
+	}))
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	value, err := page.Evaluate(`() => new Promise(resolve => {
+		const ws = new WebSocket('ws://localhost:1/ws');
+		ws.addEventListener('open', () => ws.send('close me'));
+		ws.addEventListener('close', event => resolve(event.code + ':' + event.reason));
+	})`)
+	require.NoError(t, err)
+	require.Equal(t, "3008:oops", value)
+}
+
+func TestPageRouteWebSocketShouldConnectToServer(t *testing.T) {
+	BeforeEach(t)
+
+	wsServer := newWebsocketServer()
+	defer wsServer.Stop()
+	fromServer := make(chan interface{}, 2)
+	require.NoError(t, page.RouteWebSocket("**/ws", func(ws playwright.WebSocketRoute) {
+		server, err := ws.ConnectToServer()
+		require.NoError(t, err)
+		ws.OnMessage(func(message interface{}) {
+			if message == "echo-text" {
+				require.NoError(t, server.Send("echo-bin"))
+				return
+			}
+			require.NoError(t, server.Send(message))
+		})
+		server.OnMessage(func(message interface{}) {
+			fromServer <- message
+			require.NoError(t, ws.Send(message))
+		})
+	}))
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	value, err := page.Evaluate(`port => new Promise(resolve => {
+		const ws = new WebSocket('ws://localhost:' + port + '/ws');
+		ws.binaryType = 'arraybuffer';
+		ws.addEventListener('message', event => {
+			if (typeof event.data === 'string')
+				ws.send('echo-text');
+			else
+				resolve(Array.from(new Uint8Array(event.data)));
+		});
+	})`, wsServer.PORT)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{4, 2}, value)
+	require.Equal(t, "incoming", <-fromServer)
+	require.Equal(t, []byte{4, 2}, <-fromServer)
+}
+
+func TestBrowserContextRouteWebSocketShouldPassThroughUnmatched(t *testing.T) {
+	BeforeEach(t)
+
+	wsServer := newWebsocketServer()
+	defer wsServer.Stop()
+	require.NoError(t, context.RouteWebSocket("**/mocked", func(ws playwright.WebSocketRoute) {}))
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	value, err := page.Evaluate(`port => new Promise(resolve => {
+		const ws = new WebSocket('ws://localhost:' + port + '/ws');
+		ws.addEventListener('message', event => { ws.close(); resolve(event.data); });
+	})`, wsServer.PORT)
+	require.NoError(t, err)
+	require.Equal(t, "incoming", value)
+}
+
+func TestPageRouteWebSocketShouldMockLoadedDocument(t *testing.T) {
+	BeforeEach(t)
+
+	_, err := page.Goto(server.EMPTY_PAGE)
+	require.NoError(t, err)
+	require.NoError(t, page.RouteWebSocket("**/ws", func(ws playwright.WebSocketRoute) {
+		ws.OnMessage(func(message interface{}) {
+			require.NoError(t, ws.Send("response"))
+		})
+	}))
+	value, err := page.Evaluate(`() => new Promise(resolve => {
+		const ws = new WebSocket('ws://localhost:1/ws');
+		ws.addEventListener('open', () => ws.send('request'));
+		ws.addEventListener('message', event => resolve(event.data));
+	})`)
+	require.NoError(t, err)
+	require.Equal(t, "response", value)
+	hidden, err := page.Evaluate(`() => !('__pwWebSocketRoute' in window)`)
+	require.NoError(t, err)
+	require.Equal(t, true, hidden)
+}
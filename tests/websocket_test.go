@@ -258,3 +258,67 @@ func TestWebSocketShouldEmitErrorEvent(t *testing.T) {
 		require.Contains(t, msg, ": 404")
 	}
 }
+
+func TestWebSocketShouldEmitTypedFrameEvents(t *testing.T) {
+	BeforeEach(t)
+
+	wsServer := newWebsocketServer()
+	defer wsServer.Stop()
+
+	frames := []playwright.WebSocketFrame{}
+	page.OnWebSocket(func(ws playwright.WebSocket) {
+		ws.OnFrame(func(frame playwright.WebSocketFrame) {
+			frames = append(frames, frame)
+		})
+	})
+	ws, err := page.ExpectWebSocket(func() error {
+		_, err := page.Evaluate(`port => {
+            let count = 0;
+            const ws = new WebSocket('ws://localhost:' + port + '/ws');
+            ws.addEventListener('open', () => ws.send('echo-bin'));
+            ws.addEventListener('message', data => { count++; if (count >= 2) { ws.close() } });
+        }`, wsServer.PORT)
+		return err
+	})
+	require.NoError(t, err)
+	if !ws.IsClosed() {
+		_, err = ws.WaitForEvent("close")
+		require.NoError(t, err)
+	}
+
+	require.Len(t, frames, 3)
+	require.Equal(t, *playwright.WebSocketFrameDirectionReceived, frames[0].Direction)
+	require.False(t, frames[0].IsBinary())
+	require.Equal(t, "incoming", frames[0].Text())
+	require.Equal(t, *playwright.WebSocketFrameDirectionSent, frames[1].Direction)
+	require.Equal(t, "echo-bin", frames[1].Text())
+	require.Equal(t, *playwright.WebSocketFrameDirectionReceived, frames[2].Direction)
+	require.True(t, frames[2].IsBinary())
+	require.Equal(t, []byte{4, 2}, frames[2].Payload)
+}
+
+func TestWebSocketShouldReportCloseStatus(t *testing.T) {
+	BeforeEach(t)
+
+	wsServer := newWebsocketServer()
+	defer wsServer.Stop()
+
+	require.NoError(t, context.EnableWebSocketCloseStatus())
+	ws, err := page.ExpectWebSocket(func() error {
+		_, err := page.Evaluate(`port => {
+            const ws = new WebSocket('ws://localhost:' + port + '/ws');
+            ws.addEventListener('message', () => ws.close(4000, 'bye'));
+        }`, wsServer.PORT)
+		return err
+	})
+	require.NoError(t, err)
+	if ws.CloseStatus() == nil {
+		_, err = ws.WaitForEvent("closestatus")
+		require.NoError(t, err)
+	}
+	require.Equal(t, &playwright.WebSocketCloseStatus{
+		Code:     4000,
+		Reason:   "bye",
+		WasClean: true,
+	}, ws.CloseStatus())
+}
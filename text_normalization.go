@@ -0,0 +1,165 @@
+package playwright
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+func getUnicodeNormalization(in string) *UnicodeNormalization {
+	v := UnicodeNormalization(in)
+	return &v
+}
+
+// UnicodeNormalization is the Unicode normalization form text is compared in, so that text written with different
+// code points for the same characters matches.
+type UnicodeNormalization string
+
+var (
+	// Canonical equivalence: precomposed and decomposed characters match, e.g. "é" and "é".
+	UnicodeNormalizationNFC *UnicodeNormalization = getUnicodeNormalization("NFC")
+	// Compatibility equivalence: additionally matches compatibility characters to their plain form, e.g. the
+	// fullwidth "Ａ" to "A" or "①" to "1".
+	UnicodeNormalizationNFKC = getUnicodeNormalization("NFKC")
+)
+
+var (
+	compatibilityVariantsOnce sync.Once
+	// compatibilityVariants maps NFKC normalized text to the characters normalizing to it.
+	compatibilityVariants map[string][]string
+)
+
+// normalizedTextPattern returns the source of a regular expression matching text in every form equivalent to it under
+// normalization, or only as it is if normalization is empty. With normalizeWhiteSpace, runs of whitespace match any whitespace, as text is compared with its
+// whitespace normalized. With exact, the whole text has to match instead of a substring.
+func normalizedTextPattern(text string, normalization UnicodeNormalization, normalizeWhiteSpace bool, exact bool) string {
+	var sb strings.Builder
+	if exact {
+		sb.WriteString("^")
+	}
+	if normalizeWhiteSpace {
+		if exact {
+			sb.WriteString(`\s*`)
+		}
+		for i, field := range strings.Fields(text) {
+			if i > 0 {
+				sb.WriteString(`\s+`)
+			}
+			writeNormalizedPattern(&sb, field, normalization)
+		}
+		if exact {
+			sb.WriteString(`\s*`)
+		}
+	} else {
+		writeNormalizedPattern(&sb, text, normalization)
+	}
+	if exact {
+		sb.WriteString("$")
+	}
+	return sb.String()
+}
+
+func writeNormalizedPattern(sb *strings.Builder, text string, normalization UnicodeNormalization) {
+	if normalization == "" {
+		sb.WriteString(regexp.QuoteMeta(text))
+		return
+	}
+	form, decomposed := norm.NFC, norm.NFD
+	if normalization == *UnicodeNormalizationNFKC {
+		form, decomposed = norm.NFKC, norm.NFKD
+	}
+	var it norm.Iter
+	it.InitString(form, text)
+	for !it.Done() {
+		segment := string(it.Next())
+		variants := []string{segment, decomposed.String(segment)}
+		if form == norm.NFKC {
+			variants = append(variants, compatibilityVariantsOf(segment)...)
+		}
+		variants = uniqueStrings(variants)
+		if len(variants) == 1 {
+			sb.WriteString(regexp.QuoteMeta(segment))
+			continue
+		}
+		for i, variant := range variants {
+			variants[i] = regexp.QuoteMeta(variant)
+		}
+		sb.WriteString("(?:" + strings.Join(variants, "|") + ")")
+	}
+}
+
+func compatibilityVariantsOf(segment string) []string {
+	compatibilityVariantsOnce.Do(func() {
+		compatibilityVariants = make(map[string][]string)
+		for r := rune(0); r <= unicode.MaxRune; r++ {
+			if !utf8.ValidRune(r) {
+				continue
+			}
+			s := string(r)
+			if norm.NFKC.IsNormalString(s) {
+				continue
+			}
+			normalized := norm.NFKC.String(s)
+			compatibilityVariants[normalized] = append(compatibilityVariants[normalized], s)
+		}
+	})
+	return compatibilityVariants[segment]
+}
+
+func uniqueStrings(values []string) []string {
+	sort.Strings(values)
+	out := values[:0]
+	for i, value := range values {
+		if i == 0 || value != values[i-1] {
+			out = append(out, value)
+		}
+	}
+	return out
+}
+
+// normalizeExpectedText converts the strings of values into regular expressions matching them under normalization,
+// which are matched in Unicode mode. Regular expressions are left as they are.
+func normalizeExpectedText(values []expectedTextValue, normalization UnicodeNormalization) {
+	for i, value := range values {
+		if value.Str == nil {
+			continue
+		}
+		source := normalizedTextPattern(
+			*value.Str,
+			normalization,
+			value.NormalizeWhiteSpace != nil && *value.NormalizeWhiteSpace,
+			value.MatchSubstring == nil || !*value.MatchSubstring,
+		)
+		values[i].Str = nil
+		values[i].RegexSource = String(source)
+		values[i].RegexFlags = String("u")
+	}
+}
+
+// prepareExpectedText prepares values of text assertions for matching with Unicode case folding if ignoreCase is set,
+// and under normalization if it is not nil.
+func prepareExpectedText(values []expectedTextValue, ignoreCase *bool, normalization *UnicodeNormalization) {
+	if normalization != nil {
+		normalizeExpectedText(values, *normalization)
+	} else if ignoreCase != nil && *ignoreCase {
+		normalizeExpectedText(values, "")
+	}
+}
+
+// getByNormalizedTextSelector returns the selector of GetByText for text compared under normalization. Text is matched
+// case-insensitively unless exact, or if ignoreCase is set.
+func getByNormalizedTextSelector(text string, exact bool, ignoreCase *bool, normalization UnicodeNormalization) string {
+	flags := "u"
+	if (ignoreCase == nil && !exact) || (ignoreCase != nil && *ignoreCase) {
+		flags = "iu"
+	}
+	// ">" is escaped by code point, as the selector parser splits on ">>" and identity escapes are invalid in
+	// Unicode mode.
+	pattern := strings.ReplaceAll(normalizedTextPattern(text, normalization, true, exact), ">", `\x3e`)
+	return "internal:text=/" + pattern + "/" + flags
+}
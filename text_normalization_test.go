@@ -0,0 +1,49 @@
+package playwright
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizedTextPattern(t *testing.T) {
+	require.Equal(t, `^\s*a\.b\s+c\s*$`, normalizedTextPattern(" a.b \n c ", "", true, true))
+	require.Equal(t, `a  b`, normalizedTextPattern("a  b", "", false, false))
+
+	pattern := normalizedTextPattern("Caf\u00e9", *UnicodeNormalizationNFC, true, true)
+	re := regexp.MustCompile(pattern)
+	require.True(t, re.MatchString("Caf\u00e9"))
+	require.True(t, re.MatchString("Cafe\u0301"))
+	require.False(t, re.MatchString("Cafe"))
+
+	pattern = normalizedTextPattern("ABC 1", *UnicodeNormalizationNFKC, true, false)
+	re = regexp.MustCompile(pattern)
+	require.True(t, re.MatchString("x ＡＢＣ ① y"))
+	require.True(t, re.MatchString("ABC 1"))
+	require.False(t, re.MatchString("ABD 1"))
+}
+
+func TestPrepareExpectedText(t *testing.T) {
+	values, err := toExpectedTextValues([]interface{}{"Straße", regexp.MustCompile("x")}, true, true, Bool(true))
+	require.NoError(t, err)
+	prepareExpectedText(values, Bool(true), nil)
+	require.Nil(t, values[0].Str)
+	require.Equal(t, `Straße`, *values[0].RegexSource)
+	require.Equal(t, "u", *values[0].RegexFlags)
+	require.Equal(t, "x", *values[1].RegexSource)
+
+	values, err = toExpectedTextValues([]interface{}{"text"}, false, true, nil)
+	require.NoError(t, err)
+	prepareExpectedText(values, nil, nil)
+	require.Equal(t, "text", *values[0].Str)
+}
+
+func TestGetByTextSelectorWithMatchingOptions(t *testing.T) {
+	require.Equal(t, `internal:text="a"i`, getByTextSelector("a"))
+	require.Equal(t, `internal:text=/a\x3e\x3eb/iu`, getByTextSelector("a>>b", LocatorGetByTextOptions{IgnoreCase: Bool(true)}))
+	require.Equal(t, "internal:text=/^\\s*(?:e\u0301|\u00e9)\\s*$/u", getByTextSelector("\u00e9", LocatorGetByTextOptions{
+		Exact:         Bool(true),
+		Normalization: UnicodeNormalizationNFC,
+	}))
+}
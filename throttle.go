@@ -0,0 +1,198 @@
+package playwright
+
+import (
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ThrottleOptions limits the requests sent to a single host, see [HostThrottle].
+type ThrottleOptions struct {
+	// Maximum number of in-flight requests per host. Unlimited when nil or 0.
+	MaxConcurrency *int
+	// Maximum number of requests started per second per host. Unlimited when nil or 0.
+	RequestsPerSecond *float64
+	// Minimum delay between two requests to the same host, e.g. the "Crawl-delay" of a
+	// robots.txt. Combined with RequestsPerSecond, the longer interval wins.
+	Delay *time.Duration
+}
+
+// HostThrottle caps request concurrency and rate per host for a [BrowserContext].
+// It is applied with [BrowserContext.Route], so it delays requests without modifying them
+// and other route handlers keep working:
+//
+//	throttle := playwright.NewHostThrottle(playwright.ThrottleOptions{
+//		MaxConcurrency:    playwright.Int(2),
+//		RequestsPerSecond: playwright.Float(5),
+//	})
+//	if err := throttle.Apply(context); err != nil {
+//		log.Fatal(err)
+//	}
+type HostThrottle struct {
+	mu       sync.Mutex
+	defaults ThrottleOptions
+	hosts    map[string]ThrottleOptions
+	states   map[string]*hostThrottleState
+	inFlight map[Request]*throttledRequest
+}
+
+// throttledRequest is a request holding a slot of its host.
+type throttledRequest struct {
+	state   *hostThrottleState
+	context BrowserContext
+}
+
+type hostThrottleState struct {
+	sync.Mutex
+	slots    chan struct{}
+	interval time.Duration
+	next     time.Time
+}
+
+// NewHostThrottle creates a throttle that applies options to every host.
+func NewHostThrottle(options ThrottleOptions) *HostThrottle {
+	return &HostThrottle{
+		defaults: options,
+		hosts:    make(map[string]ThrottleOptions),
+		states:   make(map[string]*hostThrottleState),
+		inFlight: make(map[Request]*throttledRequest),
+	}
+}
+
+// SetHostOptions overrides the options of a single host, e.g. "example.com" or "example.com:8080".
+// It only affects requests that reach the host for the first time after the call.
+func (t *HostThrottle) SetHostOptions(host string, options ThrottleOptions) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	host = strings.ToLower(host)
+	t.hosts[host] = options
+	delete(t.states, host)
+}
+
+// Apply installs the throttle on context. Requests wait for a free slot of their host
+// and are then passed on to the next matching route handler with [Route.Fallback].
+func (t *HostThrottle) Apply(context BrowserContext) error {
+	context.OnRequestFinished(t.release)
+	context.OnRequestFailed(t.release)
+	// The requests in flight when their page or the context closes never finish, so their
+	// slots are released with the page or the context.
+	context.OnPage(func(page Page) {
+		page.OnClose(t.releasePage)
+	})
+	for _, page := range context.Pages() {
+		page.OnClose(t.releasePage)
+	}
+	context.OnClose(t.releaseContext)
+	return context.Route("**/*", func(route Route) {
+		t.handle(context, route)
+	})
+}
+
+func (t *HostThrottle) handle(context BrowserContext, route Route) {
+	request := route.Request()
+	if state := t.stateOf(request.URL()); state != nil {
+		state.acquire()
+		t.mu.Lock()
+		t.inFlight[request] = &throttledRequest{state: state, context: context}
+		t.mu.Unlock()
+	}
+	if err := route.Fallback(); err != nil {
+		t.release(request)
+	}
+}
+
+func (t *HostThrottle) release(request Request) {
+	t.mu.Lock()
+	inFlight, ok := t.inFlight[request]
+	delete(t.inFlight, request)
+	t.mu.Unlock()
+	if ok && inFlight.state.slots != nil {
+		<-inFlight.state.slots
+	}
+}
+
+// releasePage releases the slots of the requests of page that are still in flight.
+func (t *HostThrottle) releasePage(page Page) {
+	t.releaseWhere(func(request Request, inFlight *throttledRequest) bool {
+		return request.(*requestImpl).safePage() == page
+	})
+}
+
+// releaseContext releases the slots of the requests of context that are still in flight,
+// including those of its service workers.
+func (t *HostThrottle) releaseContext(context BrowserContext) {
+	t.releaseWhere(func(request Request, inFlight *throttledRequest) bool {
+		return inFlight.context == context
+	})
+}
+
+func (t *HostThrottle) releaseWhere(match func(Request, *throttledRequest) bool) {
+	t.mu.Lock()
+	requests := make([]Request, 0)
+	for request, inFlight := range t.inFlight {
+		if match(request, inFlight) {
+			requests = append(requests, request)
+		}
+	}
+	t.mu.Unlock()
+	for _, request := range requests {
+		t.release(request)
+	}
+}
+
+func (t *HostThrottle) stateOf(rawURL string) *hostThrottleState {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return nil
+	}
+	host := strings.ToLower(u.Host)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if state, ok := t.states[host]; ok {
+		return state
+	}
+	options, ok := t.hosts[host]
+	if !ok {
+		options, ok = t.hosts[u.Hostname()]
+	}
+	if !ok {
+		options = t.defaults
+	}
+	state := newHostThrottleState(options)
+	t.states[host] = state
+	return state
+}
+
+func newHostThrottleState(options ThrottleOptions) *hostThrottleState {
+	state := &hostThrottleState{}
+	if options.MaxConcurrency != nil && *options.MaxConcurrency > 0 {
+		state.slots = make(chan struct{}, *options.MaxConcurrency)
+	}
+	if options.RequestsPerSecond != nil && *options.RequestsPerSecond > 0 {
+		state.interval = time.Duration(float64(time.Second) / *options.RequestsPerSecond)
+	}
+	if options.Delay != nil && *options.Delay > state.interval {
+		state.interval = *options.Delay
+	}
+	return state
+}
+
+// acquire blocks until a slot is free and the rate limit allows the next request.
+func (s *hostThrottleState) acquire() {
+	if s.slots != nil {
+		s.slots <- struct{}{}
+	}
+	if s.interval == 0 {
+		return
+	}
+	s.Lock()
+	now := time.Now()
+	start := s.next
+	if start.Before(now) {
+		start = now
+	}
+	s.next = start.Add(s.interval)
+	s.Unlock()
+	time.Sleep(time.Until(start))
+}
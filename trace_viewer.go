@@ -0,0 +1,74 @@
+package playwright
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// ShowTraceViewerOptions are the options of [ShowTraceViewer].
+type ShowTraceViewerOptions struct {
+	// Browser to open the trace viewer in, one of `chromium`, `firefox` or `webkit`. Defaults to `chromium`.
+	Browser *string
+	// Host to serve the trace viewer on. When Host or Port is set, the trace viewer is served over HTTP to be opened
+	// in any browser instead of being opened in a new browser window.
+	Host *string
+	// Port to serve the trace viewer on, see Host.
+	Port *int
+}
+
+// ShowTraceViewer opens the traces recorded with [Tracing.Stop] or [Tracing.StopChunk] in the Playwright trace viewer
+// and waits until it is closed. Traces are paths of trace files or URLs of traces served over HTTP.
+//
+// Requires the driver to be installed before, see [Install].
+func ShowTraceViewer(traces []string, options ...ShowTraceViewerOptions) error {
+	driver, err := NewDriver(transformRunOptions(nil))
+	if err != nil {
+		return fmt.Errorf("could not get driver instance: %w", err)
+	}
+	up2date, err := driver.isUpToDateDriver()
+	if err != nil || !up2date {
+		return fmt.Errorf("please install the driver (v%s) first: %w", playwrightCliVersion, err)
+	}
+	return driver.ShowTraceViewer(traces, options...)
+}
+
+// ShowTraceViewer opens traces in the trace viewer of the driver and waits until it is closed, see [ShowTraceViewer].
+func (d *PlaywrightDriver) ShowTraceViewer(traces []string, options ...ShowTraceViewerOptions) error {
+	args, err := showTraceArgs(traces, options...)
+	if err != nil {
+		return err
+	}
+	cmd := d.Command(args...)
+	cmd.Stdout = d.options.Stdout
+	cmd.Stderr = d.options.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("could not run trace viewer: %w", err)
+	}
+	return nil
+}
+
+// showTraceArgs returns the driver arguments to show traces, checking that trace files exist.
+func showTraceArgs(traces []string, options ...ShowTraceViewerOptions) ([]string, error) {
+	args := []string{"show-trace"}
+	if len(options) == 1 {
+		if options[0].Browser != nil {
+			args = append(args, "--browser", *options[0].Browser)
+		}
+		if options[0].Host != nil {
+			args = append(args, "--host", *options[0].Host)
+		}
+		if options[0].Port != nil {
+			args = append(args, "--port", strconv.Itoa(*options[0].Port))
+		}
+	}
+	for _, trace := range traces {
+		if !strings.HasPrefix(trace, "http://") && !strings.HasPrefix(trace, "https://") {
+			if _, err := os.Stat(trace); err != nil {
+				return nil, fmt.Errorf("could not open trace: %w", err)
+			}
+		}
+	}
+	return append(args, traces...), nil
+}
@@ -0,0 +1,23 @@
+package playwright
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestShowTraceArgs(t *testing.T) {
+	trace := filepath.Join(t.TempDir(), "trace.zip")
+	require.NoError(t, os.WriteFile(trace, []byte{}, 0o644))
+	args, err := showTraceArgs([]string{trace, "https://example.com/trace.zip"}, ShowTraceViewerOptions{
+		Browser: String("firefox"),
+		Port:    Int(9323),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"show-trace", "--browser", "firefox", "--port", "9323", trace, "https://example.com/trace.zip"}, args)
+
+	_, err = showTraceArgs([]string{filepath.Join(t.TempDir(), "missing.zip")})
+	require.ErrorContains(t, err, "could not open trace")
+}
@@ -1,6 +1,9 @@
 package playwright
 
-import "fmt"
+import (
+	"fmt"
+	"os"
+)
 
 type tracingImpl struct {
 	channelOwner
@@ -8,9 +11,28 @@ type tracingImpl struct {
 	isTracing      bool
 	stacksId       string
 	tracesDir      string
+	context        *browserContextImpl
+}
+
+// defaultTitle returns the title of a trace chunk, defaulting to the label of the context, see
+// [BrowserContext.Name].
+func (t *tracingImpl) defaultTitle(title *string) *string {
+	if title != nil || t.context == nil {
+		return title
+	}
+	if l := t.context.label(); l != "" {
+		return String(l)
+	}
+	return nil
 }
 
 func (t *tracingImpl) Start(options ...TracingStartOptions) error {
+	option := TracingStartOptions{}
+	if len(options) == 1 {
+		option = options[0]
+	}
+	option.Title = t.defaultTitle(option.Title)
+	options = []TracingStartOptions{option}
 	chunkOption := TracingStartChunkOptions{}
 	if len(options) == 1 {
 		if options[0].Sources != nil {
@@ -20,10 +42,10 @@ func (t *tracingImpl) Start(options ...TracingStartOptions) error {
 		chunkOption.Title = options[0].Title
 	}
 	innerStart := func() (interface{}, error) {
-		if _, err := t.channel.Send("tracingStart", options); err != nil {
+		if _, err := t.channel.Send("tracingStart", optionsOf(options)); err != nil {
 			return "", err
 		}
-		return t.channel.Send("tracingStartChunk", options)
+		return t.channel.Send("tracingStartChunk", optionsOf(options))
 	}
 	result, err := t.connection.WrapAPICall(innerStart, true)
 	if err != nil {
@@ -37,7 +59,13 @@ func (t *tracingImpl) Start(options ...TracingStartOptions) error {
 }
 
 func (t *tracingImpl) StartChunk(options ...TracingStartChunkOptions) error {
-	result, err := t.channel.Send("tracingStartChunk", options)
+	option := TracingStartChunkOptions{}
+	if len(options) == 1 {
+		option = options[0]
+	}
+	option.Title = t.defaultTitle(option.Title)
+	options = []TracingStartChunkOptions{option}
+	result, err := t.channel.Send("tracingStartChunk", optionsOf(options))
 	if err != nil {
 		return err
 	}
@@ -54,7 +82,13 @@ func (t *tracingImpl) StopChunk(path ...string) error {
 		filePath = path[0]
 	}
 	_, err := t.connection.WrapAPICall(func() (interface{}, error) {
-		return nil, t.doStopChunk(filePath)
+		if err := t.doStopChunk(filePath); err != nil {
+			return nil, err
+		}
+		if err := t.redact(filePath); err != nil {
+			return nil, err
+		}
+		return nil, t.attachAuditLog(filePath)
 	}, true)
 	return err
 }
@@ -68,6 +102,12 @@ func (t *tracingImpl) Stop(path ...string) error {
 		if err := t.doStopChunk(filePath); err != nil {
 			return nil, err
 		}
+		if err := t.redact(filePath); err != nil {
+			return nil, err
+		}
+		if err := t.attachAuditLog(filePath); err != nil {
+			return nil, err
+		}
 		return t.channel.Send("tracingStop")
 	}, true)
 	return err
@@ -146,6 +186,29 @@ func (t *tracingImpl) doStopChunk(filePath string) (err error) {
 	return err
 }
 
+// redact masks the secrets registered with [RedactSecrets] in the trace saved at filePath, if any.
+func (t *tracingImpl) redact(filePath string) error {
+	if filePath == "" {
+		return nil
+	}
+	if _, err := os.Stat(filePath); err != nil {
+		// The trace is not saved when the browser closed while stopping tracing.
+		return nil
+	}
+	return redactFile(filePath)
+}
+
+// attachAuditLog adds the audit log of the context to the trace saved at filePath, if the context has one.
+func (t *tracingImpl) attachAuditLog(filePath string) error {
+	if filePath == "" || t.context == nil {
+		return nil
+	}
+	if _, err := os.Stat(filePath); err != nil {
+		return nil
+	}
+	return t.context.attachAuditLog(filePath)
+}
+
 func (t *tracingImpl) startCollectingStacks(name string) (err error) {
 	if !t.isTracing {
 		t.isTracing = true
@@ -43,8 +43,10 @@ func (t *pipeTransport) Poll() (*message, error) {
 	}
 	if os.Getenv("DEBUGP") != "" {
 		fmt.Fprint(os.Stdout, "\x1b[33mRECV>\x1b[0m\n")
-		if err := json.NewEncoder(os.Stdout).Encode(msg); err != nil {
+		if data, err := json.Marshal(msg); err != nil {
 			logger.Printf("could not encode json: %v\n", err)
+		} else {
+			fmt.Fprintln(os.Stdout, Redact(string(data)))
 		}
 	}
 	return msg, nil
@@ -71,8 +73,10 @@ func (t *pipeTransport) Send(msg map[string]interface{}) error {
 	}
 	if os.Getenv("DEBUGP") != "" {
 		fmt.Fprint(os.Stdout, "\x1b[32mSEND>\x1b[0m\n")
-		if err := json.NewEncoder(os.Stdout).Encode(msg); err != nil {
+		if data, err := json.Marshal(msg); err != nil {
 			logger.Printf("could not encode json: %v\n", err)
+		} else {
+			fmt.Fprintln(os.Stdout, Redact(string(data)))
 		}
 	}
 	lengthPadding := make([]byte, 4)
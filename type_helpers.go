@@ -1,5 +1,7 @@
 package playwright
 
+import "time"
+
 // String is a helper routine that allocates a new string value
 // to store v and returns a pointer to it.
 func String(v string) *string {
@@ -24,6 +26,18 @@ func Float(v float64) *float64 {
 	return &v
 }
 
+// Duration is a helper routine that allocates a new time.Duration value
+// to store v and returns a pointer to it.
+func Duration(v time.Duration) *time.Duration {
+	return &v
+}
+
+// Timeout is a helper routine that converts v to milliseconds and returns a pointer to it,
+// for use as the Timeout field of option structs.
+func Timeout(v time.Duration) *float64 {
+	return Float(float64(v) / float64(time.Millisecond))
+}
+
 // Null will be used in certain scenarios where a strict nil pointer
 // check is not possible
 func Null() interface{} {
@@ -60,13 +74,14 @@ func (s StorageState) ToOptionalStorageState() *OptionalStorageState {
 
 func (c Cookie) ToOptionalCookie() OptionalCookie {
 	return OptionalCookie{
-		Name:     c.Name,
-		Value:    c.Value,
-		Domain:   String(c.Domain),
-		Path:     String(c.Path),
-		Expires:  Float(c.Expires),
-		HttpOnly: Bool(c.HttpOnly),
-		Secure:   Bool(c.Secure),
-		SameSite: c.SameSite,
+		Name:         c.Name,
+		Value:        c.Value,
+		Domain:       String(c.Domain),
+		Path:         String(c.Path),
+		Expires:      Float(c.Expires),
+		HttpOnly:     Bool(c.HttpOnly),
+		Secure:       Bool(c.Secure),
+		SameSite:     c.SameSite,
+		PartitionKey: c.PartitionKey,
 	}
 }
@@ -0,0 +1,284 @@
+package playwright
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+// coercedValueExpression reads the value of form controls and the rendered text of other elements.
+const coercedValueExpression = `e => (e instanceof HTMLInputElement || e instanceof HTMLTextAreaElement || e instanceof HTMLSelectElement) ? e.value : e.innerText`
+
+var numberPattern = regexp.MustCompile(`[-+\x{2212}]?\d(?:[\d.,'\x{2019}\s\x{00a0}\x{2009}\x{202f}]*\d)?`)
+
+// decimalCommaLanguages are the languages writing numbers with a decimal comma, such as "1.234,5" in German.
+var decimalCommaLanguages = map[string]bool{
+	"bg": true, "cs": true, "da": true, "de": true, "el": true, "es": true, "et": true, "fi": true, "fr": true,
+	"hr": true, "hu": true, "id": true, "it": true, "lt": true, "lv": true, "nb": true, "nl": true, "nn": true,
+	"no": true, "pl": true, "pt": true, "ro": true, "ru": true, "sk": true, "sl": true, "sr": true, "sv": true,
+	"tr": true, "uk": true, "vi": true,
+}
+
+// decimalPointRegions are the exceptions to decimalCommaLanguages, e.g. "1'234.5" in Swiss German.
+var decimalPointRegions = map[string]bool{
+	"de-CH": true, "de-LI": true, "it-CH": true, "es-MX": true, "es-US": true,
+}
+
+// monthFirstRegions are the regions writing numeric dates month first, as "01/02/2006".
+var monthFirstRegions = map[string]bool{"US": true, "PH": true, "FM": true, "MH": true, "PW": true}
+
+// parseLocaleNumber parses the first number of text, as written in locale. Grouping separators, whitespace, currency
+// symbols and units around the number are ignored.
+func parseLocaleNumber(text string, locale string) (float64, error) {
+	match := numberPattern.FindString(text)
+	if match == "" {
+		return 0, fmt.Errorf("no number in %q", text)
+	}
+	decimal := "."
+	if usesDecimalComma(locale) {
+		decimal = ","
+	}
+	var sb strings.Builder
+	for _, r := range match {
+		switch {
+		case r == '\u2212' || r == '-':
+			sb.WriteByte('-')
+		case r == '+' || (r >= '0' && r <= '9'):
+			sb.WriteRune(r)
+		case string(r) == decimal:
+			sb.WriteByte('.')
+		}
+	}
+	number, err := strconv.ParseFloat(sb.String(), 64)
+	if err != nil {
+		return 0, fmt.Errorf("could not parse number %q: %w", match, err)
+	}
+	return number, nil
+}
+
+func usesDecimalComma(locale string) bool {
+	if locale == "" {
+		return false
+	}
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return false
+	}
+	base, _ := tag.Base()
+	region, _ := tag.Region()
+	if decimalPointRegions[base.String()+"-"+region.String()] {
+		return false
+	}
+	return decimalCommaLanguages[base.String()]
+}
+
+// dateLayouts returns the layouts dates are tried to be parsed with in locale, from the most specific.
+func dateLayouts(locale string) []string {
+	layouts := []string{
+		time.RFC3339, "2006-01-02T15:04:05", "2006-01-02 15:04:05", "2006-01-02 15:04", "2006-01-02",
+		time.RFC1123Z, time.RFC1123, "Mon, Jan 2, 2006", "Monday, January 2, 2006",
+		"January 2, 2006 15:04", "January 2, 2006", "Jan 2, 2006 15:04", "Jan 2, 2006",
+		"2 January 2006 15:04", "2 January 2006", "2 Jan 2006 15:04", "2 Jan 2006",
+	}
+	numeric := []string{"02/01/2006 15:04:05", "02/01/2006 15:04", "2/1/2006", "02.01.2006 15:04", "2.1.2006", "02-01-2006"}
+	if monthFirst(locale) {
+		numeric = []string{"01/02/2006 3:04:05 PM", "01/02/2006 3:04 PM", "01/02/2006 15:04", "1/2/2006", "01-02-2006"}
+	}
+	return append(layouts, numeric...)
+}
+
+func monthFirst(locale string) bool {
+	if locale == "" {
+		locale = "en-US"
+	}
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return false
+	}
+	region, _ := tag.Region()
+	return monthFirstRegions[region.String()]
+}
+
+// parseLocaleDate parses text as a date written in locale with one of layouts, or with the layouts of locale if there
+// are none. Dates without a timezone are in location.
+func parseLocaleDate(text string, layouts []string, locale string, location *time.Location) (time.Time, error) {
+	text = strings.Join(strings.Fields(text), " ")
+	if len(layouts) == 0 {
+		layouts = dateLayouts(locale)
+	}
+	for _, layout := range layouts {
+		if date, err := time.ParseInLocation(layout, text, location); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("could not parse date %q", text)
+}
+
+// coercedRange describes the expected range of a coerced value. Unlike a string, it is not diffed with the received
+// value in failures.
+type coercedRange string
+
+// expectCoerced retries until the value of the locator, converted by coerce, matches, or the timeout is reached.
+func (la *locatorAssertionsImpl) expectCoerced(
+	timeout *float64,
+	message string,
+	expected interface{},
+	coerce func(text string) (interface{}, error),
+	matches func(value interface{}) bool,
+) error {
+	return la.soft.record(la.checkCoerced(timeout, message, expected, coerce, matches))
+}
+
+func (la *locatorAssertionsImpl) checkCoerced(
+	timeout *float64,
+	message string,
+	expected interface{},
+	coerce func(text string) (interface{}, error),
+	matches func(value interface{}) bool,
+) error {
+	if timeout == nil {
+		timeout = la.defaultTimeout
+	}
+	if la.isNot {
+		message = strings.ReplaceAll(message, "expected to", "expected not to")
+	}
+	interval := 100 * time.Millisecond
+	if la.pollInterval != nil {
+		interval = time.Duration(*la.pollInterval * float64(time.Millisecond))
+	}
+	deadline := time.Now().Add(time.Duration(*timeout * float64(time.Millisecond)))
+	var polls []AssertionPoll
+	for {
+		remaining := float64(time.Until(deadline).Milliseconds())
+		if remaining < 1 {
+			remaining = 1
+		}
+		var received interface{}
+		details := ""
+		text, err := la.actualLocator.Evaluate(coercedValueExpression, nil, LocatorEvaluateOptions{Timeout: Float(remaining)})
+		if err != nil {
+			if !errors.Is(err, ErrTimeout) {
+				return err
+			}
+			received = "<element not found>"
+		} else {
+			value, err := coerce(fmt.Sprint(text))
+			if err != nil {
+				received = text
+				details = err.Error()
+			} else {
+				received = value
+				details = fmt.Sprintf("Parsed from: %q", text)
+				if matches(value) != la.isNot {
+					return nil
+				}
+			}
+		}
+		polls = append(polls, AssertionPoll{Time: time.Now(), Received: received})
+		if time.Now().Add(interval).After(deadline) {
+			failure := &AssertionError{
+				Message:  message,
+				Expected: expected,
+				Received: received,
+				Polls:    polls,
+				Details:  details,
+			}
+			if locator, ok := la.actualLocator.(*locatorImpl); ok {
+				failure.Selector = locator.selector
+			}
+			return failure
+		}
+		time.Sleep(interval)
+	}
+}
+
+func (la *locatorAssertionsImpl) expectNumber(
+	locale *string,
+	timeout *float64,
+	message string,
+	expected interface{},
+	matches func(number float64) bool,
+) error {
+	lang := ""
+	if locale != nil {
+		lang = *locale
+	}
+	return la.expectCoerced(timeout, message, expected,
+		func(text string) (interface{}, error) {
+			return parseLocaleNumber(text, lang)
+		},
+		func(value interface{}) bool {
+			return matches(value.(float64))
+		},
+	)
+}
+
+func (la *locatorAssertionsImpl) ToHaveValueGreaterThan(value float64, options ...LocatorAssertionsToHaveValueGreaterThanOptions) error {
+	option := LocatorAssertionsToHaveValueGreaterThanOptions{}
+	if len(options) == 1 {
+		option = options[0]
+	}
+	return la.expectNumber(option.Locale, option.Timeout, "Locator expected to have value greater than", value,
+		func(number float64) bool { return number > value })
+}
+
+func (la *locatorAssertionsImpl) ToHaveValueLessThan(value float64, options ...LocatorAssertionsToHaveValueLessThanOptions) error {
+	option := LocatorAssertionsToHaveValueLessThanOptions{}
+	if len(options) == 1 {
+		option = options[0]
+	}
+	return la.expectNumber(option.Locale, option.Timeout, "Locator expected to have value less than", value,
+		func(number float64) bool { return number < value })
+}
+
+func (la *locatorAssertionsImpl) ToHaveValueBetween(min, max float64, options ...LocatorAssertionsToHaveValueBetweenOptions) error {
+	option := LocatorAssertionsToHaveValueBetweenOptions{}
+	if len(options) == 1 {
+		option = options[0]
+	}
+	return la.expectNumber(option.Locale, option.Timeout, "Locator expected to have value between", coercedRange(fmt.Sprintf("%v and %v", min, max)),
+		func(number float64) bool { return number >= min && number <= max })
+}
+
+func (la *locatorAssertionsImpl) ToHaveValueCloseTo(value, tolerance float64, options ...LocatorAssertionsToHaveValueCloseToOptions) error {
+	option := LocatorAssertionsToHaveValueCloseToOptions{}
+	if len(options) == 1 {
+		option = options[0]
+	}
+	return la.expectNumber(option.Locale, option.Timeout, "Locator expected to have value close to", coercedRange(fmt.Sprintf("%v ± %v", value, tolerance)),
+		func(number float64) bool { return math.Abs(number-value) <= tolerance })
+}
+
+func (la *locatorAssertionsImpl) ToHaveDateBetween(from, to time.Time, options ...LocatorAssertionsToHaveDateBetweenOptions) error {
+	option := LocatorAssertionsToHaveDateBetweenOptions{}
+	if len(options) == 1 {
+		option = options[0]
+	}
+	locale := ""
+	if option.Locale != nil {
+		locale = *option.Locale
+	}
+	location := time.Local
+	if option.TimezoneId != nil {
+		var err error
+		if location, err = time.LoadLocation(*option.TimezoneId); err != nil {
+			return fmt.Errorf("invalid timezone: %w", err)
+		}
+	}
+	return la.expectCoerced(option.Timeout, "Locator expected to have date between",
+		coercedRange(fmt.Sprintf("%s and %s", from.Format(time.RFC3339), to.Format(time.RFC3339))),
+		func(text string) (interface{}, error) {
+			return parseLocaleDate(text, option.Layouts, locale, location)
+		},
+		func(value interface{}) bool {
+			date := value.(time.Time)
+			return !date.Before(from) && !date.After(to)
+		},
+	)
+}
@@ -0,0 +1,55 @@
+package playwright
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLocaleNumber(t *testing.T) {
+	for _, tc := range []struct {
+		text     string
+		locale   string
+		expected float64
+	}{
+		{"42", "", 42},
+		{"Total: $1,234.50", "", 1234.5},
+		{"1.234,50 \u20ac", "de-DE", 1234.5},
+		{"1\u202f234,5\u00a0%", "fr-FR", 1234.5},
+		{"CHF 1'234.50", "de-CH", 1234.5},
+		{"\u22123.5 \u00b0C", "", -3.5},
+		{"-12", "en-US", -12},
+		{"+7 new", "", 7},
+	} {
+		number, err := parseLocaleNumber(tc.text, tc.locale)
+		require.NoError(t, err, tc.text)
+		require.Equal(t, tc.expected, number, tc.text)
+	}
+	_, err := parseLocaleNumber("n/a", "")
+	require.ErrorContains(t, err, `no number in "n/a"`)
+}
+
+func TestParseLocaleDate(t *testing.T) {
+	for _, tc := range []struct {
+		text     string
+		locale   string
+		expected time.Time
+	}{
+		{"2024-03-21", "", time.Date(2024, 3, 21, 0, 0, 0, 0, time.UTC)},
+		{"03/04/2024", "en-US", time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)},
+		{"03/04/2024", "en-GB", time.Date(2024, 4, 3, 0, 0, 0, 0, time.UTC)},
+		{"21.03.2024  14:30", "de-DE", time.Date(2024, 3, 21, 14, 30, 0, 0, time.UTC)},
+		{"March 21, 2024", "", time.Date(2024, 3, 21, 0, 0, 0, 0, time.UTC)},
+	} {
+		date, err := parseLocaleDate(tc.text, nil, tc.locale, time.UTC)
+		require.NoError(t, err, tc.text)
+		require.Equal(t, tc.expected, date, tc.text)
+	}
+	date, err := parseLocaleDate("2024/21/03", []string{"2006/02/01"}, "", time.UTC)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, 3, 21, 0, 0, 0, 0, time.UTC), date)
+
+	_, err = parseLocaleDate("yesterday", nil, "", time.UTC)
+	require.ErrorContains(t, err, `could not parse date "yesterday"`)
+}
@@ -0,0 +1,45 @@
+package playwright
+
+import "fmt"
+
+// Breakpoint is a named viewport size, see [Page.ForEachBreakpoint].
+type Breakpoint struct {
+	Name   string
+	Width  int
+	Height int
+}
+
+// layoutSettleScript resolves after fonts are loaded and two animation frames have been
+// rendered, so the layout reflects the new viewport size.
+const layoutSettleScript = `async () => {
+	await document.fonts.ready;
+	await new Promise(resolve => requestAnimationFrame(() => requestAnimationFrame(resolve)));
+}`
+
+func (p *pageImpl) ForEachBreakpoint(breakpoints []Breakpoint, callback func(Breakpoint) error) (err error) {
+	// a zero size means the context was created with NoViewport, there is nothing to restore
+	if original := p.ViewportSize(); original != nil && (original.Width != 0 || original.Height != 0) {
+		width, height := original.Width, original.Height
+		defer func() {
+			if restoreErr := p.SetViewportSize(width, height); err == nil {
+				err = restoreErr
+			}
+		}()
+	}
+	for _, breakpoint := range breakpoints {
+		if err := p.SetViewportSize(breakpoint.Width, breakpoint.Height); err != nil {
+			return fmt.Errorf("could not resize to breakpoint %s: %w", breakpoint.Name, err)
+		}
+		if _, err := p.Evaluate(layoutSettleScript); err != nil {
+			return fmt.Errorf("could not wait for layout at breakpoint %s: %w", breakpoint.Name, err)
+		}
+		if err := callback(breakpoint); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *pageImpl) OnViewportSizeChange(fn func(*Size)) {
+	p.On("viewportsizechange", fn)
+}
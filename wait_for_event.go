@@ -0,0 +1,93 @@
+package playwright
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// WaitForEventOptions are the options of [WaitForEvent] and [ExpectEvent].
+type WaitForEventOptions[T any] struct {
+	// Receives the event data and returns true when the waiting should resolve.
+	Predicate func(T) bool
+	// Maximum time to wait for in milliseconds. Defaults to the default timeout of the target, or of the page or the
+	// context of a [Frame] or a [Worker], and to `30000` (30 seconds) for other targets. Pass `0` to disable timeout.
+	Timeout *float64
+}
+
+// WaitForEvent waits for event to be emitted by target, such as a [Page], [BrowserContext] or [WebSocket], and returns
+// the event data as T. It fails if the data of the event is not a T, and, like the WaitForEvent method of target, if
+// target is closed first.
+//
+//	request, err := playwright.WaitForEvent[playwright.Request](page, "requestfinished")
+func WaitForEvent[T any](target EventEmitter, event string, options ...WaitForEventOptions[T]) (T, error) {
+	return ExpectEvent(target, event, nil, options...)
+}
+
+// ExpectEvent calls cb and waits for event to be emitted by target, returning the event data as T. See [WaitForEvent].
+func ExpectEvent[T any](target EventEmitter, event string, cb func() error, options ...WaitForEventOptions[T]) (T, error) {
+	option := WaitForEventOptions[T]{}
+	if len(options) == 1 {
+		option = options[0]
+	}
+	// Data of the wrong type resolves the waiter, so that it is reported instead of timing out.
+	predicate := func(data interface{}) bool {
+		value, ok := eventDataAs[T](data)
+		if !ok || option.Predicate == nil {
+			return true
+		}
+		return option.Predicate(value)
+	}
+	var zero T
+	ret, err := waitForEvent(target, event, cb, option.Timeout, predicate)
+	if err != nil {
+		return zero, err
+	}
+	value, ok := eventDataAs[T](ret)
+	if !ok {
+		return zero, fmt.Errorf("data of event %q is a %T, not a %s", event, ret, reflect.TypeOf(&zero).Elem())
+	}
+	return value, nil
+}
+
+// eventDataAs converts the data of an event to T. Events without data convert to the zero value.
+func eventDataAs[T any](data interface{}) (T, bool) {
+	if data == nil {
+		var zero T
+		return zero, true
+	}
+	value, ok := data.(T)
+	return value, ok
+}
+
+func waitForEvent(target EventEmitter, event string, cb func() error, timeout *float64, predicate func(interface{}) bool) (interface{}, error) {
+	switch t := target.(type) {
+	case *pageImpl:
+		return t.waiterForEvent(event, PageWaitForEventOptions{Predicate: predicate, Timeout: timeout}).RunAndWait(cb)
+	case *browserContextImpl:
+		return t.waiterForEvent(event, BrowserContextWaitForEventOptions{Predicate: predicate, Timeout: timeout}).RunAndWait(cb)
+	case *webSocketImpl:
+		return t.expectEvent(event, cb, WebSocketExpectEventOptions{Predicate: predicate, Timeout: timeout})
+	}
+	if timeout == nil {
+		timeout = Float(defaultTimeoutOf(target))
+	}
+	return newWaiter().WithTimeout(*timeout).WaitForEvent(target, event, predicate).RunAndWait(cb)
+}
+
+// defaultTimeoutOf returns the default timeout of the page or the context target belongs to.
+func defaultTimeoutOf(target EventEmitter) float64 {
+	switch t := target.(type) {
+	case *frameImpl:
+		if t.page != nil {
+			return t.page.timeoutSettings.Timeout()
+		}
+	case *workerImpl:
+		if t.page != nil {
+			return t.page.timeoutSettings.Timeout()
+		}
+		if t.context != nil {
+			return t.context.timeoutSettings.Timeout()
+		}
+	}
+	return defaultTimeout
+}
@@ -0,0 +1,58 @@
+package playwright
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitForEventReturnsTypedData(t *testing.T) {
+	emitter := &eventEmitter{}
+	value, err := ExpectEvent(emitter, testEventNameFoobar, func() error {
+		go func() {
+			emitter.Emit(testEventNameFoobar, "1")
+			emitter.Emit(testEventNameFoobar, testEventPayload)
+		}()
+		return nil
+	}, WaitForEventOptions[string]{
+		Predicate: func(payload string) bool { return payload == testEventPayload },
+		Timeout:   Float(500),
+	})
+	require.NoError(t, err)
+	require.Equal(t, testEventPayload, value)
+}
+
+func TestWaitForEventShouldFailOnDataOfWrongType(t *testing.T) {
+	emitter := &eventEmitter{}
+	go emitter.Emit(testEventNameFoobar, testEventPayload)
+	value, err := WaitForEvent[int](emitter, testEventNameFoobar, WaitForEventOptions[int]{Timeout: Float(500)})
+	require.ErrorContains(t, err, `data of event "foobar" is a string, not a int`)
+	require.Zero(t, value)
+}
+
+func TestWaitForEventWithoutData(t *testing.T) {
+	emitter := &eventEmitter{}
+	go emitter.Emit(testEventNameFoobar)
+	value, err := WaitForEvent(emitter, testEventNameFoobar, WaitForEventOptions[Page]{
+		Predicate: func(page Page) bool { return page == nil },
+		Timeout:   Float(500),
+	})
+	require.NoError(t, err)
+	require.Nil(t, value)
+}
+
+func TestWaitForEventShouldTimeout(t *testing.T) {
+	emitter := &eventEmitter{}
+	_, err := WaitForEvent[string](emitter, testEventNameFoobar, WaitForEventOptions[string]{Timeout: Float(50)})
+	require.True(t, errors.Is(err, ErrTimeout))
+}
+
+func TestWaitForEventShouldUseDefaultTimeoutOfFramePage(t *testing.T) {
+	page := &pageImpl{timeoutSettings: newTimeoutSettings(nil)}
+	page.timeoutSettings.SetDefaultTimeout(Float(50))
+	frame := &frameImpl{page: page}
+	_, err := WaitForEvent[string](frame, testEventNameFoobar)
+	require.ErrorIs(t, err, ErrTimeout)
+	require.ErrorContains(t, err, "Timeout 50.00ms exceeded")
+}
@@ -40,8 +40,7 @@ func (w *waiter) RejectOnEvent(emitter EventEmitter, event string, err error, pr
 		if len(predicates) == 0 {
 			w.reject(err)
 		} else {
-			result := reflect.ValueOf(predicates[0]).Call([]reflect.Value{reflect.ValueOf(ev[0])})
-			if result[0].Bool() {
+			if callPredicate(predicates[0], ev) {
 				w.reject(err)
 			}
 		}
@@ -63,6 +62,10 @@ func (w *waiter) WithTimeout(timeout float64) *waiter {
 		w.reject(fmt.Errorf("waiter: please set timeout before WaitForEvent"))
 		return w
 	}
+	if err := validateTimeout(timeout); err != nil {
+		w.reject(err)
+		return w
+	}
 	w.timeout = timeout
 	return w
 }
@@ -83,8 +86,7 @@ func (w *waiter) WaitForEvent(emitter EventEmitter, event string, predicate inte
 		go func() {
 			select {
 			case <-time.After(time.Duration(timeout) * time.Millisecond):
-				err := fmt.Errorf("%w:Timeout %.2fms exceeded.", ErrTimeout, timeout)
-				w.reject(err)
+				w.reject(timeoutError(timeout))
 				return
 			case <-ctx.Done():
 				return
@@ -151,23 +153,29 @@ func (w *waiter) createHandler(evChan chan<- interface{}, predicate interface{})
 		if w.fulfilled.Load() {
 			return
 		}
-		if predicate == nil || reflect.ValueOf(predicate).IsNil() {
-			w.fulfilled.Store(true)
-			if len(ev) == 1 {
-				evChan <- ev[0]
-			} else {
-				evChan <- nil
-			}
+		if predicate != nil && !reflect.ValueOf(predicate).IsNil() && !callPredicate(predicate, ev) {
+			return
+		}
+		w.fulfilled.Store(true)
+		if len(ev) == 1 {
+			evChan <- ev[0]
 		} else {
-			result := reflect.ValueOf(predicate).Call([]reflect.Value{reflect.ValueOf(ev[0])})
-			if result[0].Bool() {
-				w.fulfilled.Store(true)
-				evChan <- ev[0]
-			}
+			evChan <- nil
 		}
 	}
 }
 
+// callPredicate calls predicate with the data of an event, or with the zero value of its parameter if the event has
+// no data.
+func callPredicate(predicate interface{}, ev []interface{}) bool {
+	fn := reflect.ValueOf(predicate)
+	arg := reflect.Zero(fn.Type().In(0))
+	if len(ev) > 0 && ev[0] != nil {
+		arg = reflect.ValueOf(ev[0])
+	}
+	return fn.Call([]reflect.Value{arg})[0].Bool()
+}
+
 func (w *waiter) reject(err error) {
 	w.fulfilled.Store(true)
 	w.errChan <- err
@@ -136,3 +136,11 @@ func TestWaiterReturnErrorWhenMisuse(t *testing.T) {
 	_, err = waiter.Wait()
 	require.ErrorContains(t, err, "call RejectOnEvent before WaitForEvent")
 }
+
+func TestWaiterRejectsInvalidTimeout(t *testing.T) {
+	emitter := &eventEmitter{}
+	waiter := newWaiter().WithTimeout(-1)
+	waiter.WaitForEvent(emitter, testEventNameFoobar, nil)
+	_, err := waiter.Wait()
+	require.ErrorContains(t, err, "invalid timeout -1")
+}
@@ -3,12 +3,81 @@ package playwright
 import (
 	"encoding/base64"
 	"errors"
+	"fmt"
+	"time"
 )
 
+func getWebSocketFrameDirection(in string) *WebSocketFrameDirection {
+	v := WebSocketFrameDirection(in)
+	return &v
+}
+
+// WebSocketFrameDirection is whether a [WebSocketFrame] was sent or received by the page.
+type WebSocketFrameDirection string
+
+var (
+	// The frame was sent by the page.
+	WebSocketFrameDirectionSent *WebSocketFrameDirection = getWebSocketFrameDirection("sent")
+	// The frame was received by the page.
+	WebSocketFrameDirectionReceived = getWebSocketFrameDirection("received")
+)
+
+// WebSocketFrame is a data frame sent or received by a [WebSocket], see [WebSocket.OnFrame].
+type WebSocketFrame struct {
+	// Time the frame was reported.
+	Time      time.Time
+	Direction WebSocketFrameDirection
+	// Opcode of the frame, 1 for text and 2 for binary.
+	Opcode int
+	// Payload of the frame, UTF-8 for text frames.
+	Payload []byte
+}
+
+// IsBinary returns whether the frame is a binary frame.
+func (f WebSocketFrame) IsBinary() bool {
+	return f.Opcode == 2
+}
+
+// Text returns the payload of the frame as a string.
+func (f WebSocketFrame) Text() string {
+	return string(f.Payload)
+}
+
+// newWebSocketFrame returns the frame of a `frameSent` or `frameReceived` event, the binary payloads being base64
+// encoded.
+func newWebSocketFrame(direction *WebSocketFrameDirection, opcode float64, data string) (WebSocketFrame, error) {
+	frame := WebSocketFrame{
+		Time:      time.Now(),
+		Direction: *direction,
+		Opcode:    int(opcode),
+	}
+	if frame.IsBinary() {
+		payload, err := base64.StdEncoding.DecodeString(data)
+		if err != nil {
+			return WebSocketFrame{}, fmt.Errorf("could not decode binary frame: %w", err)
+		}
+		frame.Payload = payload
+	} else {
+		frame.Payload = []byte(data)
+	}
+	return frame, nil
+}
+
+// WebSocketCloseStatus is how a [WebSocket] was closed, see [WebSocket.CloseStatus].
+type WebSocketCloseStatus struct {
+	// Close code, such as 1000 for a normal closure or 1006 if the connection was lost.
+	Code int
+	// Close reason, if any.
+	Reason string
+	// Whether the closing handshake completed.
+	WasClean bool
+}
+
 type webSocketImpl struct {
 	channelOwner
-	isClosed bool
-	page     *pageImpl
+	isClosed    bool
+	closeStatus *WebSocketCloseStatus
+	page        *pageImpl
 }
 
 func (ws *webSocketImpl) URL() string {
@@ -23,6 +92,7 @@ func newWebsocket(parent *channelOwner, objectType string, guid string, initiali
 		ws.Lock()
 		ws.isClosed = true
 		ws.Unlock()
+		ws.page.webSocketClose.removeSocket(ws)
 		ws.Emit("close", ws)
 	})
 	ws.channel.On(
@@ -47,29 +117,23 @@ func newWebsocket(parent *channelOwner, objectType string, guid string, initiali
 }
 
 func (ws *webSocketImpl) onFrameSent(opcode float64, data string) {
-	if opcode == 2 {
-		payload, err := base64.StdEncoding.DecodeString(data)
-		if err != nil {
-			logger.Printf("could not decode WebSocket.onFrameSent payload: %v\n", err)
-			return
-		}
-		ws.Emit("framesent", payload)
-	} else {
-		ws.Emit("framesent", []byte(data))
+	frame, err := newWebSocketFrame(WebSocketFrameDirectionSent, opcode, data)
+	if err != nil {
+		logger.Printf("could not decode WebSocket.onFrameSent payload: %v\n", err)
+		return
 	}
+	ws.Emit("framesent", frame.Payload)
+	ws.Emit("frame", frame)
 }
 
 func (ws *webSocketImpl) onFrameReceived(opcode float64, data string) {
-	if opcode == 2 {
-		payload, err := base64.StdEncoding.DecodeString(data)
-		if err != nil {
-			logger.Printf("could not decode WebSocket.onFrameReceived payload: %v\n", err)
-			return
-		}
-		ws.Emit("framereceived", payload)
-	} else {
-		ws.Emit("framereceived", []byte(data))
+	frame, err := newWebSocketFrame(WebSocketFrameDirectionReceived, opcode, data)
+	if err != nil {
+		logger.Printf("could not decode WebSocket.onFrameReceived payload: %v\n", err)
+		return
 	}
+	ws.Emit("framereceived", frame.Payload)
+	ws.Emit("frame", frame)
 }
 
 func (ws *webSocketImpl) ExpectEvent(event string, cb func() error, options ...WebSocketExpectEventOptions) (interface{}, error) {
@@ -117,10 +181,31 @@ func (ws *webSocketImpl) IsClosed() bool {
 	return ws.isClosed
 }
 
+func (ws *webSocketImpl) CloseStatus() *WebSocketCloseStatus {
+	ws.RLock()
+	defer ws.RUnlock()
+	return ws.closeStatus
+}
+
+func (ws *webSocketImpl) setCloseStatus(status WebSocketCloseStatus) {
+	ws.Lock()
+	ws.closeStatus = &status
+	ws.Unlock()
+	ws.Emit("closestatus", status)
+}
+
 func (ws *webSocketImpl) OnClose(fn func(WebSocket)) {
 	ws.On("close", fn)
 }
 
+func (ws *webSocketImpl) OnCloseStatus(fn func(WebSocketCloseStatus)) {
+	ws.On("closestatus", fn)
+}
+
+func (ws *webSocketImpl) OnFrame(fn func(WebSocketFrame)) {
+	ws.On("frame", fn)
+}
+
 func (ws *webSocketImpl) OnFrameReceived(fn func(payload []byte)) {
 	ws.On("framereceived", fn)
 }
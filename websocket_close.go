@@ -0,0 +1,207 @@
+package playwright
+
+import "sync"
+
+// webSocketCloseBinding is the binding [webSocketCloseScript] reports the WebSockets of the documents with.
+const webSocketCloseBinding = "__pwWebSocketClose"
+
+// webSocketCloseScript reports the WebSockets created by the documents, with an id per document, and their `close`
+// events. The constructor is wrapped in a proxy so that `instanceof` and the static constants keep working.
+const webSocketCloseScript = `(() => {
+  if (window.__pwWebSocketCloseListener)
+    return;
+  window.__pwWebSocketCloseListener = true;
+  const report = window.` + webSocketCloseBinding + `;
+  let lastId = 0;
+  window.WebSocket = new Proxy(window.WebSocket, {
+    construct(target, args, newTarget) {
+      const ws = Reflect.construct(target, args, newTarget);
+      const id = ++lastId;
+      report({ id, url: ws.url }).catch(() => {});
+      ws.addEventListener('close', event => {
+        report({
+          id,
+          closed: true,
+          code: event.code,
+          reason: event.reason,
+          wasClean: event.wasClean,
+        }).catch(() => {});
+      });
+      return ws;
+    },
+  });
+})()`
+
+// webSocketCloseReport is a WebSocket created or closed, reported by [webSocketCloseScript].
+type webSocketCloseReport struct {
+	ID       int    `json:"id"`
+	URL      string `json:"url"`
+	Closed   bool   `json:"closed"`
+	Code     int    `json:"code"`
+	Reason   string `json:"reason"`
+	WasClean bool   `json:"wasClean"`
+}
+
+// webSocketKey is a WebSocket reported by a document of a frame.
+type webSocketKey struct {
+	frame Frame
+	id    int
+}
+
+// reportedWebSocket is a WebSocket reported by a document before the driver reported it.
+type reportedWebSocket struct {
+	key webSocketKey
+	url string
+}
+
+// webSocketCloseTracker pairs the WebSockets of a page with the ones reported by its documents, in the order they were
+// created, to report their close status.
+type webSocketCloseTracker struct {
+	sync.Mutex
+	// sockets are the WebSockets not reported by a document yet.
+	sockets []*webSocketImpl
+	// reported are the WebSockets reported by a document not created by the driver yet.
+	reported []reportedWebSocket
+	paired   map[webSocketKey]*webSocketImpl
+}
+
+func newWebSocketCloseTracker() *webSocketCloseTracker {
+	return &webSocketCloseTracker{
+		paired: make(map[webSocketKey]*webSocketImpl),
+	}
+}
+
+func (t *webSocketCloseTracker) addSocket(ws *webSocketImpl) {
+	t.Lock()
+	defer t.Unlock()
+	for i, reported := range t.reported {
+		if reported.url == ws.URL() {
+			t.reported = append(t.reported[:i], t.reported[i+1:]...)
+			t.paired[reported.key] = ws
+			return
+		}
+	}
+	t.sockets = append(t.sockets, ws)
+}
+
+// removeSocket stops waiting for a document to report a closed WebSocket.
+func (t *webSocketCloseTracker) removeSocket(ws *webSocketImpl) {
+	t.Lock()
+	defer t.Unlock()
+	for i, socket := range t.sockets {
+		if socket == ws {
+			t.sockets = append(t.sockets[:i], t.sockets[i+1:]...)
+			return
+		}
+	}
+}
+
+func (t *webSocketCloseTracker) onCreated(key webSocketKey, url string) {
+	t.Lock()
+	defer t.Unlock()
+	for i, ws := range t.sockets {
+		if ws.URL() == url {
+			t.sockets = append(t.sockets[:i], t.sockets[i+1:]...)
+			t.paired[key] = ws
+			return
+		}
+	}
+	t.reported = append(t.reported, reportedWebSocket{key: key, url: url})
+}
+
+// onClosed returns the WebSocket reported closed, nil if it wasn't created by the driver.
+func (t *webSocketCloseTracker) onClosed(key webSocketKey) *webSocketImpl {
+	t.Lock()
+	defer t.Unlock()
+	ws, ok := t.paired[key]
+	if ok {
+		delete(t.paired, key)
+		return ws
+	}
+	for i, reported := range t.reported {
+		if reported.key == key {
+			t.reported = append(t.reported[:i], t.reported[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+// dropFrame forgets the WebSockets of the document of the frame, which won't report them anymore once it navigated
+// or was detached.
+func (t *webSocketCloseTracker) dropFrame(frame Frame) {
+	t.Lock()
+	defer t.Unlock()
+	for key := range t.paired {
+		if key.frame == frame {
+			delete(t.paired, key)
+		}
+	}
+	reported := make([]reportedWebSocket, 0, len(t.reported))
+	for _, entry := range t.reported {
+		if entry.key.frame != frame {
+			reported = append(reported, entry)
+		}
+	}
+	t.reported = reported
+}
+
+func (b *browserContextImpl) EnableWebSocketCloseStatus() error {
+	b.webSocketCloseMu.Lock()
+	defer b.webSocketCloseMu.Unlock()
+	if b.webSocketCloseTracking {
+		return nil
+	}
+	if err := b.ExposeBinding(webSocketCloseBinding, b.onWebSocketCloseBinding); err != nil {
+		return err
+	}
+	b.webSocketCloseTracking = true
+	if err := b.addUntrackedInitScript(webSocketCloseScript); err != nil {
+		return err
+	}
+	for _, page := range b.Pages() {
+		for _, frame := range page.Frames() {
+			// The frames may navigate or be detached meanwhile, the new documents have the proxy anyway.
+			_, _ = frame.Evaluate(webSocketCloseScript)
+		}
+	}
+	return nil
+}
+
+func (b *browserContextImpl) isTrackingWebSocketClose() bool {
+	b.webSocketCloseMu.Lock()
+	defer b.webSocketCloseMu.Unlock()
+	return b.webSocketCloseTracking
+}
+
+func (b *browserContextImpl) onWebSocketCloseBinding(source *BindingSource, args ...interface{}) interface{} {
+	if len(args) != 1 || source.Page == nil || source.Frame == nil {
+		return nil
+	}
+	report, err := decodeCDPValue[webSocketCloseReport](args[0])
+	if err != nil {
+		b.logf("could not decode WebSocket report: %v\n", err)
+		return nil
+	}
+	tracker := source.Page.(*pageImpl).webSocketClose
+	key := webSocketKey{frame: source.Frame, id: report.ID}
+	if !report.Closed {
+		tracker.onCreated(key, report.URL)
+		return nil
+	}
+	if ws := tracker.onClosed(key); ws != nil {
+		ws.setCloseStatus(WebSocketCloseStatus{
+			Code:     report.Code,
+			Reason:   report.Reason,
+			WasClean: report.WasClean,
+		})
+	}
+	return nil
+}
+
+// trackWebSocket waits for a document to report the WebSocket, if the close status is tracked.
+func (p *pageImpl) trackWebSocket(ws *webSocketImpl) {
+	if p.browserContext.isTrackingWebSocketClose() {
+		p.webSocketClose.addSocket(ws)
+	}
+}
@@ -0,0 +1,571 @@
+package playwright
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// webSocketRouteBinding is the binding the WebSocket mock installed by
+// [webSocketMockScript] uses to report connections and frames to the client.
+const webSocketRouteBinding = "__pwWebSocketRoute"
+
+// webSocketMockScript replaces window.WebSocket with a mock that asks the client whether
+// a connection is routed. Routed connections are driven by a [WebSocketRoute], all others
+// are passed through to the native WebSocket. Calls into the binding are chained per
+// socket, so the client observes frames in the order the page sent them.
+const webSocketMockScript = `(() => {
+  if (window.__pwWebSocketDispatch)
+    return;
+  // The binding is only reachable from the mock, the page can't report connections or frames itself.
+  const report = window.` + webSocketRouteBinding + `;
+  delete window.` + webSocketRouteBinding + `;
+  const NativeWebSocket = window.WebSocket;
+  const sockets = new Map();
+  const idPrefix = Math.random().toString(36).slice(2) + ':';
+  let lastId = 0;
+
+  const encode = async data => {
+    if (typeof data === 'string')
+      return { data, isBase64: false };
+    if (data instanceof Blob)
+      data = await data.arrayBuffer();
+    const bytes = ArrayBuffer.isView(data) ? new Uint8Array(data.buffer, data.byteOffset, data.byteLength) : new Uint8Array(data);
+    let binary = '';
+    for (let i = 0; i < bytes.length; i++)
+      binary += String.fromCharCode(bytes[i]);
+    return { data: btoa(binary), isBase64: true };
+  };
+  const decode = (message, binaryType) => {
+    if (!message.isBase64)
+      return message.data;
+    const binary = atob(message.data);
+    const bytes = new Uint8Array(binary.length);
+    for (let i = 0; i < binary.length; i++)
+      bytes[i] = binary.charCodeAt(i);
+    return binaryType === 'arraybuffer' ? bytes.buffer : new Blob([bytes]);
+  };
+
+  class WebSocketMock extends EventTarget {
+    constructor(url, protocols) {
+      super();
+      this.url = new URL(url, location.href).href.replace(/^http/, 'ws');
+      this.protocol = '';
+      this.extensions = '';
+      this.binaryType = 'blob';
+      this.bufferedAmount = 0;
+      this.readyState = WebSocketMock.CONNECTING;
+      this.onopen = null;
+      this.onmessage = null;
+      this.onerror = null;
+      this.onclose = null;
+      this._id = idPrefix + (++lastId);
+      this._protocols = protocols;
+      this._server = null;
+      this._passthrough = false;
+      this._queue = Promise.resolve();
+      sockets.set(this._id, this);
+      this._report({ type: 'connect', url: this.url }).then(routed => {
+        if (!routed)
+          this._connectToServer(true);
+        else if (!this._server)
+          this._open();
+      }, () => this._connectToServer(true));
+    }
+
+    _report(event) {
+      const result = this._queue.then(async () => report({ id: this._id, ...(await event) }));
+      this._queue = result.catch(() => {});
+      return result;
+    }
+
+    _fire(type, init) {
+      let event;
+      if (type === 'message')
+        event = new MessageEvent('message', init);
+      else if (type === 'close')
+        event = new CloseEvent('close', init);
+      else
+        event = new Event(type);
+      this.dispatchEvent(event);
+      const handler = this['on' + type];
+      if (typeof handler === 'function')
+        handler.call(this, event);
+    }
+
+    _open() {
+      if (this.readyState !== WebSocketMock.CONNECTING)
+        return;
+      this.readyState = WebSocketMock.OPEN;
+      this._fire('open');
+    }
+
+    _receive(data) {
+      if (this.readyState !== WebSocketMock.OPEN)
+        return;
+      this._fire('message', { data, origin: new URL(this.url).origin });
+    }
+
+    _closed(code, reason, wasClean) {
+      if (this.readyState === WebSocketMock.CLOSED)
+        return;
+      this.readyState = WebSocketMock.CLOSED;
+      this._fire('close', { code: code === undefined ? 1005 : code, reason: reason || '', wasClean: wasClean !== false });
+    }
+
+    _connectToServer(passthrough) {
+      const server = new NativeWebSocket(this.url, this._protocols);
+      server.binaryType = 'arraybuffer';
+      this._server = server;
+      this._passthrough = passthrough;
+      server.addEventListener('open', () => {
+        this.protocol = server.protocol;
+        this.extensions = server.extensions;
+        this._open();
+      });
+      server.addEventListener('message', event => {
+        if (passthrough)
+          this._receive(typeof event.data === 'string' || this.binaryType === 'arraybuffer' ? event.data : new Blob([event.data]));
+        else
+          this._report(encode(event.data).then(message => ({ type: 'serverMessage', ...message })));
+      });
+      server.addEventListener('error', () => {
+        if (passthrough)
+          this._fire('error');
+      });
+      server.addEventListener('close', event => {
+        if (passthrough)
+          this._closed(event.code, event.reason, event.wasClean);
+        else
+          this._report({ type: 'serverClose', code: event.code, reason: event.reason });
+      });
+    }
+
+    send(data) {
+      if (this.readyState === WebSocketMock.CONNECTING)
+        throw new DOMException("Failed to execute 'send' on 'WebSocket': Still in CONNECTING state.", 'InvalidStateError');
+      if (this.readyState !== WebSocketMock.OPEN)
+        return;
+      if (this._passthrough)
+        this._server.send(data);
+      else
+        this._report(encode(data).then(message => ({ type: 'message', ...message })));
+    }
+
+    close(code, reason) {
+      if (this.readyState === WebSocketMock.CLOSING || this.readyState === WebSocketMock.CLOSED)
+        return;
+      if (this._passthrough) {
+        this.readyState = WebSocketMock.CLOSING;
+        this._server.close(code, reason);
+        return;
+      }
+      this._closed(code, reason, true);
+      this._report({ type: 'close', code, reason });
+    }
+  }
+  ['CONNECTING', 'OPEN', 'CLOSING', 'CLOSED'].forEach((name, value) => {
+    WebSocketMock[name] = value;
+    WebSocketMock.prototype[name] = value;
+  });
+
+  window.WebSocket = WebSocketMock;
+  window.__pwWebSocketDispatch = (id, event) => {
+    const socket = sockets.get(id);
+    if (!socket)
+      return;
+    switch (event.type) {
+      case 'message':
+        socket._receive(decode(event, socket.binaryType));
+        break;
+      case 'close':
+        socket._closed(event.code, event.reason, true);
+        break;
+      case 'connect':
+        socket._connectToServer(false);
+        break;
+      case 'sendToServer':
+        if (socket._server && socket._server.readyState === NativeWebSocket.OPEN)
+          socket._server.send(decode(event, 'arraybuffer'));
+        break;
+      case 'closeServer':
+        if (socket._server) {
+          try {
+            socket._server.close(event.code, event.reason);
+          } catch (e) {
+            socket._server.close();
+          }
+        }
+        break;
+    }
+  };
+})();`
+
+type webSocketRouteHandler struct {
+	matcher *urlMatcher
+	handler func(WebSocketRoute)
+}
+
+type webSocketRouteKey struct {
+	frame Frame
+	id    string
+}
+
+// webSocketRouter tracks the routed WebSocket connections of a browser context.
+type webSocketRouter struct {
+	sync.Mutex
+	installed bool
+	sockets   map[webSocketRouteKey]*webSocketRouteImpl
+}
+
+func newWebSocketRouter() *webSocketRouter {
+	return &webSocketRouter{
+		sockets: make(map[webSocketRouteKey]*webSocketRouteImpl),
+	}
+}
+
+func (r *webSocketRouter) get(key webSocketRouteKey) *webSocketRouteImpl {
+	r.Lock()
+	defer r.Unlock()
+	return r.sockets[key]
+}
+
+func (r *webSocketRouter) set(key webSocketRouteKey, route *webSocketRouteImpl) {
+	r.Lock()
+	defer r.Unlock()
+	if route == nil {
+		delete(r.sockets, key)
+		return
+	}
+	r.sockets[key] = route
+}
+
+// installWebSocketRouting exposes the binding and installs the WebSocket mock on first use, in
+// the documents already loaded as well as in the later ones. The sockets created before are not
+// routed.
+func (b *browserContextImpl) installWebSocketRouting() error {
+	router := b.webSocketRouter
+	router.Lock()
+	defer router.Unlock()
+	if router.installed {
+		return nil
+	}
+	if err := b.ExposeBinding(webSocketRouteBinding, b.onWebSocketRouteBinding); err != nil {
+		return err
+	}
+	router.installed = true
+	if err := b.addUntrackedInitScript(webSocketMockScript); err != nil {
+		return err
+	}
+	for _, page := range b.Pages() {
+		for _, frame := range page.Frames() {
+			// The frames may navigate or be detached meanwhile, the new documents have the mock anyway.
+			_, _ = frame.Evaluate(webSocketMockScript)
+		}
+	}
+	return nil
+}
+
+func (b *browserContextImpl) webSocketRouteHandlerFor(page Page, url string) func(WebSocketRoute) {
+	if p, ok := page.(*pageImpl); ok {
+		p.Lock()
+		for _, entry := range p.webSocketRoutes {
+			if entry.matcher.Matches(url) {
+				p.Unlock()
+				return entry.handler
+			}
+		}
+		p.Unlock()
+	}
+	b.Lock()
+	defer b.Unlock()
+	for _, entry := range b.webSocketRoutes {
+		if entry.matcher.Matches(url) {
+			return entry.handler
+		}
+	}
+	return nil
+}
+
+func (b *browserContextImpl) onWebSocketRouteBinding(source *BindingSource, args ...interface{}) interface{} {
+	if len(args) != 1 {
+		return nil
+	}
+	event, ok := args[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	id, _ := event["id"].(string)
+	key := webSocketRouteKey{frame: source.Frame, id: id}
+	if event["type"] == "connect" {
+		url, _ := event["url"].(string)
+		handler := b.webSocketRouteHandlerFor(source.Page, url)
+		if handler == nil {
+			return false
+		}
+		route := &webSocketRouteImpl{
+			frame: source.Frame,
+			id:    id,
+			url:   url,
+			release: func() {
+				b.webSocketRouter.set(key, nil)
+			},
+		}
+		b.webSocketRouter.set(key, route)
+		handler(route)
+		return true
+	}
+	if route := b.webSocketRouter.get(key); route != nil {
+		route.onEvent(event)
+	}
+	return nil
+}
+
+// webSocketRouteImpl is the page side of a routed WebSocket connection.
+type webSocketRouteImpl struct {
+	sync.Mutex
+	frame       Frame
+	id          string
+	url         string
+	onMessage   func(interface{})
+	onClose     func(*int, *string)
+	server      *webSocketServerRouteImpl
+	pageClosed  bool
+	release     func()
+	releaseOnce sync.Once
+}
+
+// webSocketServerRouteImpl is the server side of a routed WebSocket connection, see
+// [WebSocketRoute.ConnectToServer].
+type webSocketServerRouteImpl struct {
+	sync.Mutex
+	route     *webSocketRouteImpl
+	onMessage func(interface{})
+	onClose   func(*int, *string)
+	closed    bool
+}
+
+func (r *webSocketRouteImpl) URL() string {
+	return r.url
+}
+
+func (r *webSocketRouteImpl) OnMessage(fn func(interface{})) {
+	r.Lock()
+	defer r.Unlock()
+	r.onMessage = fn
+}
+
+func (r *webSocketRouteImpl) OnClose(fn func(*int, *string)) {
+	r.Lock()
+	defer r.Unlock()
+	r.onClose = fn
+}
+
+func (r *webSocketRouteImpl) Send(message interface{}) error {
+	return r.dispatchMessage("message", message)
+}
+
+func (r *webSocketRouteImpl) Close(options ...WebSocketRouteCloseOptions) error {
+	r.Lock()
+	r.pageClosed = true
+	r.Unlock()
+	r.releaseIfDone()
+	return r.dispatch(webSocketCloseEvent("close", options...))
+}
+
+func (r *webSocketRouteImpl) ConnectToServer() (WebSocketRoute, error) {
+	r.Lock()
+	if r.server != nil {
+		r.Unlock()
+		return nil, errors.New("already connected to the server")
+	}
+	server := &webSocketServerRouteImpl{route: r}
+	r.server = server
+	r.Unlock()
+	if err := r.dispatch(map[string]interface{}{"type": "connect"}); err != nil {
+		return nil, err
+	}
+	return server, nil
+}
+
+func (r *webSocketRouteImpl) dispatch(event map[string]interface{}) error {
+	_, err := r.frame.Evaluate(`([id, event]) => window.__pwWebSocketDispatch && window.__pwWebSocketDispatch(id, event)`, []interface{}{r.id, event})
+	return err
+}
+
+func (r *webSocketRouteImpl) dispatchMessage(eventType string, message interface{}) error {
+	event := map[string]interface{}{"type": eventType}
+	switch v := message.(type) {
+	case string:
+		event["data"] = v
+		event["isBase64"] = false
+	case []byte:
+		event["data"] = base64.StdEncoding.EncodeToString(v)
+		event["isBase64"] = true
+	default:
+		return fmt.Errorf("message must be a string or []byte, got %T", message)
+	}
+	return r.dispatch(event)
+}
+
+// releaseIfDone stops tracking the connection once neither side can produce events.
+func (r *webSocketRouteImpl) releaseIfDone() {
+	r.Lock()
+	done := r.pageClosed && (r.server == nil || r.server.isClosed())
+	r.Unlock()
+	if done {
+		r.releaseOnce.Do(r.release)
+	}
+}
+
+func (r *webSocketRouteImpl) onEvent(event map[string]interface{}) {
+	r.Lock()
+	server := r.server
+	r.Unlock()
+	var err error
+	switch event["type"] {
+	case "message":
+		message, decodeErr := decodeWebSocketRouteMessage(event)
+		if decodeErr != nil {
+			logger.Printf("could not decode WebSocketRoute message: %v\n", decodeErr)
+			return
+		}
+		r.Lock()
+		handler := r.onMessage
+		r.Unlock()
+		if handler != nil {
+			handler(message)
+		} else if server != nil {
+			err = server.Send(message)
+		}
+	case "close":
+		r.Lock()
+		r.pageClosed = true
+		handler := r.onClose
+		r.Unlock()
+		code, reason := webSocketCloseCodeAndReason(event)
+		if handler != nil {
+			handler(code, reason)
+		} else if server != nil {
+			err = server.Close(WebSocketRouteCloseOptions{Code: code, Reason: reason})
+		}
+		r.releaseIfDone()
+	case "serverMessage":
+		if server == nil {
+			return
+		}
+		message, decodeErr := decodeWebSocketRouteMessage(event)
+		if decodeErr != nil {
+			logger.Printf("could not decode WebSocketRoute message: %v\n", decodeErr)
+			return
+		}
+		server.Lock()
+		handler := server.onMessage
+		server.Unlock()
+		if handler != nil {
+			handler(message)
+		} else {
+			err = r.Send(message)
+		}
+	case "serverClose":
+		if server == nil {
+			return
+		}
+		server.Lock()
+		server.closed = true
+		handler := server.onClose
+		server.Unlock()
+		code, reason := webSocketCloseCodeAndReason(event)
+		if handler != nil {
+			handler(code, reason)
+		} else {
+			err = r.Close(WebSocketRouteCloseOptions{Code: code, Reason: reason})
+		}
+		r.releaseIfDone()
+	}
+	if err != nil {
+		logger.Printf("could not forward WebSocketRoute %s event: %v\n", event["type"], err)
+	}
+}
+
+func (s *webSocketServerRouteImpl) URL() string {
+	return s.route.url
+}
+
+func (s *webSocketServerRouteImpl) OnMessage(fn func(interface{})) {
+	s.Lock()
+	defer s.Unlock()
+	s.onMessage = fn
+}
+
+func (s *webSocketServerRouteImpl) OnClose(fn func(*int, *string)) {
+	s.Lock()
+	defer s.Unlock()
+	s.onClose = fn
+}
+
+func (s *webSocketServerRouteImpl) Send(message interface{}) error {
+	return s.route.dispatchMessage("sendToServer", message)
+}
+
+func (s *webSocketServerRouteImpl) Close(options ...WebSocketRouteCloseOptions) error {
+	s.Lock()
+	s.closed = true
+	s.Unlock()
+	s.route.releaseIfDone()
+	return s.route.dispatch(webSocketCloseEvent("closeServer", options...))
+}
+
+func (s *webSocketServerRouteImpl) ConnectToServer() (WebSocketRoute, error) {
+	return nil, errors.New("ConnectToServer must be called on the page side of the route")
+}
+
+func (s *webSocketServerRouteImpl) isClosed() bool {
+	s.Lock()
+	defer s.Unlock()
+	return s.closed
+}
+
+func webSocketCloseEvent(eventType string, options ...WebSocketRouteCloseOptions) map[string]interface{} {
+	event := map[string]interface{}{"type": eventType}
+	if len(options) == 1 {
+		if options[0].Code != nil {
+			event["code"] = *options[0].Code
+		}
+		if options[0].Reason != nil {
+			event["reason"] = *options[0].Reason
+		}
+	}
+	return event
+}
+
+func webSocketCloseCodeAndReason(event map[string]interface{}) (*int, *string) {
+	var code *int
+	var reason *string
+	if v, ok := event["code"].(float64); ok {
+		code = Int(int(v))
+	}
+	if v, ok := event["reason"].(string); ok {
+		reason = String(v)
+	}
+	return code, reason
+}
+
+func decodeWebSocketRouteMessage(event map[string]interface{}) (interface{}, error) {
+	data, _ := event["data"].(string)
+	if isBase64, _ := event["isBase64"].(bool); isBase64 {
+		return base64.StdEncoding.DecodeString(data)
+	}
+	return data, nil
+}
+
+// webSocketBaseURL turns an http(s) base URL into the ws(s) URL WebSocket routes are
+// matched against.
+func webSocketBaseURL(baseURL *string) *string {
+	if baseURL == nil || !strings.HasPrefix(*baseURL, "http") {
+		return baseURL
+	}
+	return String("ws" + strings.TrimPrefix(*baseURL, "http"))
+}
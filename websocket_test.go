@@ -0,0 +1,50 @@
+package playwright
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWebSocketFrame(t *testing.T) {
+	frame, err := newWebSocketFrame(WebSocketFrameDirectionSent, 1, "hello")
+	require.NoError(t, err)
+	require.Equal(t, *WebSocketFrameDirectionSent, frame.Direction)
+	require.False(t, frame.IsBinary())
+	require.Equal(t, "hello", frame.Text())
+
+	frame, err = newWebSocketFrame(WebSocketFrameDirectionReceived, 2, "BAI=")
+	require.NoError(t, err)
+	require.Equal(t, *WebSocketFrameDirectionReceived, frame.Direction)
+	require.True(t, frame.IsBinary())
+	require.Equal(t, []byte{4, 2}, frame.Payload)
+
+	_, err = newWebSocketFrame(WebSocketFrameDirectionReceived, 2, "not base64!")
+	require.ErrorContains(t, err, "could not decode binary frame")
+}
+
+func TestWebSocketCloseTrackerPairsSocketsInOrder(t *testing.T) {
+	newSocket := func(url string) *webSocketImpl {
+		return &webSocketImpl{channelOwner: channelOwner{initializer: map[string]interface{}{"url": url}}}
+	}
+	frame := &frameImpl{}
+	tracker := newWebSocketCloseTracker()
+	first, second := newSocket("ws://a/"), newSocket("ws://a/")
+	tracker.addSocket(first)
+	tracker.onCreated(webSocketKey{frame, 1}, "ws://a/")
+	tracker.onCreated(webSocketKey{frame, 2}, "ws://a/")
+	tracker.addSocket(second)
+
+	require.Same(t, second, tracker.onClosed(webSocketKey{frame, 2}))
+	require.Same(t, first, tracker.onClosed(webSocketKey{frame, 1}))
+	require.Nil(t, tracker.onClosed(webSocketKey{frame, 1}))
+
+	tracker.onCreated(webSocketKey{frame, 3}, "ws://b/")
+	tracker.dropFrame(frame)
+	require.Empty(t, tracker.reported)
+	third := newSocket("ws://b/")
+	tracker.addSocket(third)
+	tracker.removeSocket(third)
+	require.Empty(t, tracker.sockets)
+	require.Empty(t, tracker.paired)
+}
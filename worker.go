@@ -64,6 +64,9 @@ func (w *workerImpl) onClose() {
 		w.context.Unlock()
 	}
 	w.Emit("close", w)
+	if w.context != nil {
+		w.context.Emit("serviceworkerclose", w)
+	}
 }
 
 func (w *workerImpl) OnClose(fn func(Worker)) {